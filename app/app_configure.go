@@ -85,6 +85,7 @@ func akashKVStoreKeys() []string {
 func akashSubspaces(k paramskeeper.Keeper) paramskeeper.Keeper {
 	k.Subspace(deployment.ModuleName)
 	k.Subspace(market.ModuleName)
+	k.Subspace(audit.ModuleName)
 	k.Subspace(inflation.ModuleName)
 	k.Subspace(astaking.ModuleName)
 	k.Subspace(agov.ModuleName)
@@ -138,6 +139,7 @@ func (app *AkashApp) setAkashKeepers() {
 	app.Keepers.Akash.Audit = akeeper.NewKeeper(
 		app.appCodec,
 		app.keys[audit.StoreKey],
+		app.GetSubspace(audit.ModuleName),
 	)
 
 	app.Keepers.Akash.Cert = ckeeper.NewKeeper(
@@ -200,11 +202,14 @@ func (app *AkashApp) akashAppModules() []module.AppModule {
 			app.Keepers.Akash.Provider,
 			app.Keepers.Cosmos.Bank,
 			app.Keepers.Akash.Market,
+			app.Keepers.Akash.Deployment,
+			app.Keepers.Akash.Escrow,
 		),
 
 		audit.NewAppModule(
 			app.appCodec,
 			app.Keepers.Akash.Audit,
+			app.Keepers.Akash.Escrow,
 		),
 
 		cert.NewAppModule(
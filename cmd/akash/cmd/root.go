@@ -41,6 +41,7 @@ import (
 	"github.com/akash-network/node/client"
 	"github.com/akash-network/node/cmd/akash/cmd/testnetify"
 	ecmd "github.com/akash-network/node/events/cmd"
+	scmd "github.com/akash-network/node/sdl/cmd"
 	utilcli "github.com/akash-network/node/util/cli"
 	"github.com/akash-network/node/util/server"
 )
@@ -143,6 +144,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 	rootCmd.AddCommand(
 		rpc.StatusCommand(),
 		ecmd.EventCmd(),
+		scmd.SDLCmd(),
 		QueryCmd(),
 		TxCmd(),
 		keys.Commands(app.DefaultHome),
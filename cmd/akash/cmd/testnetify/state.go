@@ -33,10 +33,13 @@ import (
 	mtypes "github.com/akash-network/akash-api/go/node/market/v1beta4"
 
 	"github.com/akash-network/node/x/audit"
+	av1beta4 "github.com/akash-network/node/x/audit/types/v1beta4"
 	"github.com/akash-network/node/x/cert"
 	"github.com/akash-network/node/x/deployment"
 	"github.com/akash-network/node/x/escrow"
+	ev1beta4 "github.com/akash-network/node/x/escrow/types/v1beta4"
 	"github.com/akash-network/node/x/market"
+	mv1beta5 "github.com/akash-network/node/x/market/types/v1beta5"
 	"github.com/akash-network/node/x/provider"
 )
 
@@ -120,7 +123,7 @@ type DistributionState struct {
 
 type AuditState struct {
 	gstate map[string]json.RawMessage
-	state  *atypes.GenesisState
+	state  *av1beta4.GenesisState
 	once   sync.Once
 }
 
@@ -138,13 +141,13 @@ type DeploymentState struct {
 
 type EscrowState struct {
 	gstate map[string]json.RawMessage
-	state  *etypes.GenesisState
+	state  *ev1beta4.GenesisState
 	once   sync.Once
 }
 
 type MarketState struct {
 	gstate map[string]json.RawMessage
-	state  *mtypes.GenesisState
+	state  *mv1beta5.GenesisState
 	once   sync.Once
 }
 
@@ -18,6 +18,11 @@ import (
 	"github.com/akash-network/akash-api/go/sdkutil"
 
 	"github.com/akash-network/node/pubsub"
+	av1beta4 "github.com/akash-network/node/x/audit/types/v1beta4"
+	cv1beta4 "github.com/akash-network/node/x/cert/types/v1beta4"
+	dv1beta4 "github.com/akash-network/node/x/deployment/types/v1beta4"
+	ev1beta4 "github.com/akash-network/node/x/escrow/types/v1beta4"
+	mv1beta5 "github.com/akash-network/node/x/market/types/v1beta5"
 )
 
 // Publish events using tm buses to clients. Waits on context
@@ -119,5 +124,25 @@ func processEvent(bev abci.Event) (interface{}, bool) {
 		return mev, true
 	}
 
+	if mev, err := av1beta4.ParseEvent(ev); err == nil {
+		return mev, true
+	}
+
+	if mev, err := dv1beta4.ParseEvent(ev); err == nil {
+		return mev, true
+	}
+
+	if mev, err := ev1beta4.ParseEvent(ev); err == nil {
+		return mev, true
+	}
+
+	if mev, err := cv1beta4.ParseEvent(ev); err == nil {
+		return mev, true
+	}
+
+	if mev, err := mv1beta5.ParseEvent(ev); err == nil {
+		return mev, true
+	}
+
 	return nil, false
 }
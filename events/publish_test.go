@@ -7,11 +7,17 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	dtypes "github.com/akash-network/akash-api/go/node/deployment/v1beta3"
+	etypes "github.com/akash-network/akash-api/go/node/escrow/v1beta3"
 	mtypes "github.com/akash-network/akash-api/go/node/market/v1beta4"
 	ptypes "github.com/akash-network/akash-api/go/node/provider/v1beta3"
 	"github.com/akash-network/akash-api/go/sdkutil"
 
 	"github.com/akash-network/node/testutil"
+	av1beta4 "github.com/akash-network/node/x/audit/types/v1beta4"
+	cv1beta4 "github.com/akash-network/node/x/cert/types/v1beta4"
+	dv1beta4 "github.com/akash-network/node/x/deployment/types/v1beta4"
+	ev1beta4 "github.com/akash-network/node/x/escrow/types/v1beta4"
+	mv1beta5 "github.com/akash-network/node/x/market/types/v1beta5"
 )
 
 func Test_processEvent(t *testing.T) {
@@ -34,6 +40,31 @@ func Test_processEvent(t *testing.T) {
 		ptypes.NewEventProviderCreated(testutil.AccAddress(t)),
 		ptypes.NewEventProviderUpdated(testutil.AccAddress(t)),
 		ptypes.NewEventProviderDeleted(testutil.AccAddress(t)),
+
+		// x/deployment/keeper events
+		dv1beta4.NewEventDeploymentTransferred(testutil.DeploymentID(t), testutil.AccAddress(t).String()),
+
+		// x/escrow events
+		ev1beta4.NewEventAccountClosed(etypes.AccountID{Scope: "deployment", XID: "akash1/1"}, testutil.AccAddress(t).String()),
+		ev1beta4.NewEventAccountOverdrawn(etypes.AccountID{Scope: "deployment", XID: "akash1/1"}, testutil.AccAddress(t).String()),
+		ev1beta4.NewEventPaymentWithdrawn(etypes.AccountID{Scope: "deployment", XID: "akash1/1"}, "1/1/akash1", testutil.AccAddress(t).String(), testutil.AkashCoin(t, 10)),
+		ev1beta4.NewEventPaymentClosed(etypes.AccountID{Scope: "deployment", XID: "akash1/1"}, "1/1/akash1", testutil.AccAddress(t).String(), etypes.PaymentOverdrawn),
+
+		// x/cert events
+		cv1beta4.NewEventCertificateCreated(testutil.AccAddress(t), "1234"),
+		cv1beta4.NewEventCertificateRevoked(testutil.AccAddress(t), "1234"),
+
+		// x/market/keeper events
+		mv1beta5.NewEventProviderDraining(testutil.AccAddress(t), 100),
+		mv1beta5.NewEventLeaseClosedProviderDeregistered(testutil.LeaseID(t)),
+		mv1beta5.NewEventLeaseClosedAttributesChanged(testutil.LeaseID(t)),
+		mv1beta5.NewEventProviderInventoryUpdated(testutil.AccAddress(t)),
+
+		// x/audit/keeper events
+		av1beta4.NewEventAuditorRegistered(testutil.AccAddress(t)),
+		av1beta4.NewEventAuditorDeregistered(testutil.AccAddress(t)),
+		av1beta4.NewEventAuditorDelegateCreated(testutil.AccAddress(t), testutil.AccAddress(t)),
+		av1beta4.NewEventAuditorDelegateDeleted(testutil.AccAddress(t), testutil.AccAddress(t)),
 	}
 
 	for _, test := range tests {
@@ -3,4 +3,8 @@ generate: $(MOCKERY)
 	$(GO) generate ./...
 
 .PHONY: codegen
-codegen: generate
+codegen: proto-gen generate
+
+.PHONY: proto-gen
+proto-gen: $(PROTOC) $(PROTOC_GEN_GOCOSMOS)
+	./script/protocgen.sh
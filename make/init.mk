@@ -55,6 +55,8 @@ STATIK_VERSION               ?= v0.1.7
 GIT_CHGLOG_VERSION           ?= v0.15.1
 MOCKERY_VERSION              ?= 2.42.0
 COSMOVISOR_VERSION           ?= v1.5.0
+PROTOC_VERSION               ?= 25.3
+PROTOC_GEN_GOCOSMOS_VERSION  ?= $(shell $(GO) list -mod=mod -m -f '{{ .Version }}' github.com/regen-network/cosmos-proto)
 
 # ==== Build tools version tracking ====
 # <TOOL>_VERSION_FILE points to the marker file for the installed version.
@@ -64,6 +66,8 @@ MOCKERY_VERSION_FILE             := $(AKASH_DEVCACHE_VERSIONS)/mockery/v$(MOCKER
 GOLANGCI_LINT_VERSION_FILE       := $(AKASH_DEVCACHE_VERSIONS)/golangci-lint/$(GOLANGCI_LINT_VERSION)
 STATIK_VERSION_FILE              := $(AKASH_DEVCACHE_VERSIONS)/statik/$(STATIK_VERSION)
 COSMOVISOR_VERSION_FILE          := $(AKASH_DEVCACHE_VERSIONS)/cosmovisor/$(COSMOVISOR_VERSION)
+PROTOC_VERSION_FILE              := $(AKASH_DEVCACHE_VERSIONS)/protoc/$(PROTOC_VERSION)
+PROTOC_GEN_GOCOSMOS_VERSION_FILE := $(AKASH_DEVCACHE_VERSIONS)/protoc-gen-gocosmos/$(PROTOC_GEN_GOCOSMOS_VERSION)
 
 # ==== Build tools executables ====
 GIT_CHGLOG                       := $(AKASH_DEVCACHE_BIN)/git-chglog
@@ -72,6 +76,19 @@ NPM                              := npm
 GOLANGCI_LINT                    := $(AKASH_DEVCACHE_BIN)/golangci-lint
 STATIK                           := $(AKASH_DEVCACHE_BIN)/statik
 COSMOVISOR                       := $(AKASH_DEVCACHE_BIN)/cosmovisor
+PROTOC                           := $(AKASH_DEVCACHE_BIN)/protoc
+PROTOC_GEN_GOCOSMOS              := $(AKASH_DEVCACHE_BIN)/protoc-gen-gocosmos
+
+ifeq ($(UNAME_OS),Linux)
+	ifeq ($(UNAME_ARCH),aarch64)
+		PROTOC_ZIP ?= protoc-$(PROTOC_VERSION)-linux-aarch_64.zip
+	else
+		PROTOC_ZIP ?= protoc-$(PROTOC_VERSION)-linux-$(UNAME_ARCH).zip
+	endif
+endif
+ifeq ($(UNAME_OS),Darwin)
+	PROTOC_ZIP ?= protoc-$(PROTOC_VERSION)-osx-universal_binary.zip
+endif
 
 RELEASE_TAG           ?= $(shell git describe --tags --abbrev=0)
 
@@ -53,5 +53,27 @@ $(COSMOVISOR_VERSION_FILE): $(AKASH_DEVCACHE)
 	touch $@
 $(COSMOVISOR): $(COSMOVISOR_VERSION_FILE)
 
+$(PROTOC_VERSION_FILE): $(AKASH_DEVCACHE)
+	@echo "installing protoc $(PROTOC_VERSION) ..."
+	rm -f $(PROTOC)
+	(cd /tmp; \
+	curl -sOL "https://github.com/protocolbuffers/protobuf/releases/download/v$(PROTOC_VERSION)/$(PROTOC_ZIP)"; \
+	unzip -oq $(PROTOC_ZIP) -d $(AKASH_DEVCACHE) bin/protoc; \
+	unzip -oq $(PROTOC_ZIP) -d $(AKASH_DEVCACHE) 'include/*'; \
+	rm -f $(PROTOC_ZIP))
+	rm -rf "$(dir $@)"
+	mkdir -p "$(dir $@)"
+	touch $@
+$(PROTOC): $(PROTOC_VERSION_FILE)
+
+$(PROTOC_GEN_GOCOSMOS_VERSION_FILE): $(AKASH_DEVCACHE)
+	@echo "installing protoc-gen-gocosmos $(PROTOC_GEN_GOCOSMOS_VERSION) ..."
+	rm -f $(PROTOC_GEN_GOCOSMOS)
+	GOBIN=$(AKASH_DEVCACHE_BIN) $(GO) install github.com/regen-network/cosmos-proto/protoc-gen-gocosmos
+	rm -rf "$(dir $@)"
+	mkdir -p "$(dir $@)"
+	touch $@
+$(PROTOC_GEN_GOCOSMOS): $(PROTOC_GEN_GOCOSMOS_VERSION_FILE)
+
 cache-clean:
 	rm -rf $(AKASH_DEVCACHE)
@@ -0,0 +1,34 @@
+#!/usr/bin/env bash
+
+# generates go code of protobuf types defined by node,
+# types imported from akash-api and cosmos-sdk are resolved from go modules cache
+
+set -eo pipefail
+
+PATH=$(pwd)/.cache/bin:$PATH
+export PATH=$PATH
+
+function cleanup {
+    rm -rf github.com
+}
+
+trap cleanup EXIT
+
+AKASH_API=$(go list -m -f '{{ .Dir }}' github.com/akash-network/akash-api)
+COSMOS_SDK=$(go list -m -f '{{ .Dir }}' github.com/cosmos/cosmos-sdk)
+
+proto_dirs=$(find ./proto -path -prune -o -name '*.proto' -print0 | xargs -0 -n1 dirname | sort | uniq)
+#shellcheck disable=SC2046
+for dir in $proto_dirs; do
+    protoc \
+        -I proto/node \
+        -I .cache/include \
+        -I "${AKASH_API}/proto/node" \
+        -I "${COSMOS_SDK}/proto" \
+        -I "${COSMOS_SDK}/third_party/proto" \
+        --gocosmos_out=plugins=interfacetype+grpc,Mgoogle/protobuf/any.proto=github.com/cosmos/cosmos-sdk/codec/types:. \
+        $(find "${dir}" -maxdepth 1 -name '*.proto')
+done
+
+# move generated files to the right places
+cp -r github.com/akash-network/node/* ./
@@ -0,0 +1,46 @@
+package sdl
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+
+	dtypes "github.com/akash-network/akash-api/go/node/deployment/v1beta3"
+)
+
+// v2PlacementAuction opts orders of placement group into reverse auction mode
+type v2PlacementAuction struct {
+	// Window is number of blocks order stays open for bids
+	Window int64 `yaml:"window"`
+}
+
+func (sdl *v2PlacementAuction) UnmarshalYAML(node *yaml.Node) error {
+	var res struct {
+		Window int64 `yaml:"window"`
+	}
+
+	if err := node.Decode(&res); err != nil {
+		return err
+	}
+
+	if res.Window <= 0 {
+		return newNodeError(node, ErrCodeInvalidValue, fmt.Errorf("%w: auction window must be positive", errSDLInvalid))
+	}
+
+	*sdl = v2PlacementAuction(res)
+
+	return nil
+}
+
+// v2DeploymentAuctions returns auction window of deployment groups which placement opts into reverse auction mode
+func v2DeploymentAuctions(placements map[string]v2ProfilePlacement, dgroups dtypes.GroupSpecs) map[string]int64 {
+	auctions := make(map[string]int64)
+
+	for _, dgroup := range dgroups {
+		if placement, ok := placements[dgroup.Name]; ok && placement.Auction != nil {
+			auctions[dgroup.Name] = placement.Auction.Window
+		}
+	}
+
+	return auctions
+}
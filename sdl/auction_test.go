@@ -0,0 +1,36 @@
+package sdl
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeploymentAuctions(t *testing.T) {
+	obj := readModified(t, "_testdata/v2.1-simple.yaml")
+
+	auctions, err := obj.DeploymentAuctions()
+	require.NoError(t, err)
+	require.Empty(t, auctions)
+
+	obj = readModified(t, "_testdata/v2.1-simple.yaml",
+		"      pricing:\n", "      auction:\n        window: 20\n      pricing:\n")
+
+	auctions, err = obj.DeploymentAuctions()
+	require.NoError(t, err)
+	require.Equal(t, map[string]int64{"westcoast": 20}, auctions)
+}
+
+func TestDeploymentAuctionsInvalidWindow(t *testing.T) {
+	buf, err := os.ReadFile("_testdata/v2.1-simple.yaml")
+	require.NoError(t, err)
+
+	for _, window := range []string{"0", "-10"} {
+		_, err = Read([]byte(strings.Replace(string(buf),
+			"      pricing:\n", "      auction:\n        window: "+window+"\n      pricing:\n", 1)))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "auction window must be positive")
+	}
+}
@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/akash-network/node/sdl"
+)
+
+const (
+	FlagPlacement = "placement"
+	FlagDenom     = "denom"
+	FlagPrice     = "price"
+	FlagOut       = "out"
+)
+
+// FromComposeCmd converts docker-compose file into SDL
+func FromComposeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "from-compose [compose-file]",
+		Short: "Convert docker-compose file into SDL v2.1",
+		Long: "Convert docker-compose file into SDL v2.1. Compose keys which have no SDL counterpart " +
+			"are reported and left out of the result, which should be reviewed before deploying.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var opts sdl.ComposeOptions
+			var err error
+
+			if opts.Placement, err = cmd.Flags().GetString(FlagPlacement); err != nil {
+				return err
+			}
+
+			if opts.Denom, err = cmd.Flags().GetString(FlagDenom); err != nil {
+				return err
+			}
+
+			if opts.Amount, err = cmd.Flags().GetString(FlagPrice); err != nil {
+				return err
+			}
+
+			out, err := cmd.Flags().GetString(FlagOut)
+			if err != nil {
+				return err
+			}
+
+			res, err := sdl.FromComposeFile(args[0], opts)
+			if err != nil {
+				return err
+			}
+
+			for _, key := range res.Unsupported {
+				_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "unsupported: %s\n", key)
+			}
+
+			if out == "" {
+				_, err = cmd.OutOrStdout().Write(res.SDL)
+			} else {
+				err = os.WriteFile(out, res.SDL, 0o600)
+			}
+
+			if err != nil {
+				return err
+			}
+
+			// result is written anyway so it can be fixed by hand
+			if _, err := sdl.Read(res.SDL); err != nil {
+				return fmt.Errorf("converted SDL is invalid: %w", err)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagPlacement, "dcloud", "Name of placement profile services are deployed to")
+	cmd.Flags().String(FlagDenom, "uakt", "Denomination of pricing")
+	cmd.Flags().String(FlagPrice, "1000", "Maximum price per block of each service instance")
+	cmd.Flags().StringP(FlagOut, "f", "", "Write SDL to file instead of standard output")
+
+	return cmd
+}
@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/akash-network/node/sdl"
+	dcli "github.com/akash-network/node/x/deployment/client/cli"
+)
+
+// diffResult is output of diff command in json format
+type diffResult struct {
+	ChangesGroups bool     `json:"changes_groups"`
+	Changes       sdl.Diff `json:"changes"`
+}
+
+// DiffCmd shows semantic difference between two SDLs
+func DiffCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff [from-sdl-file] [to-sdl-file]",
+		Short: "Show what updating deployment from one SDL to another changes",
+		Long: "Show semantic difference between deployments described by two SDLs. Each change is marked " +
+			"manifest when it is applied by sending updated manifest to providers, or group when it alters group specs on chain, " +
+			"which deployment update cannot do.",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			output, err := cmd.Flags().GetString(tmcli.OutputFlag)
+			if err != nil {
+				return err
+			}
+
+			if output != "text" && output != "json" {
+				return fmt.Errorf("invalid output format %q, expected text|json", output)
+			}
+
+			sdlOpts, err := dcli.SDLReadOptionsFromFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			from, err := sdl.ReadFile(args[0], sdlOpts...)
+			if err != nil {
+				return err
+			}
+
+			to, err := sdl.ReadFile(args[1], sdlOpts...)
+			if err != nil {
+				return err
+			}
+
+			diff, err := sdl.Compare(from, to)
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+
+			if output == "json" {
+				if diff == nil {
+					diff = sdl.Diff{}
+				}
+
+				data, err := json.MarshalIndent(diffResult{
+					ChangesGroups: diff.ChangesGroups(),
+					Changes:       diff,
+				}, "", "  ")
+				if err != nil {
+					return err
+				}
+
+				_, err = fmt.Fprintln(out, string(data))
+				return err
+			}
+
+			if diff.Empty() {
+				_, err = fmt.Fprintln(out, "no changes")
+				return err
+			}
+
+			_, err = fmt.Fprintln(out, diff.String())
+			return err
+		},
+	}
+
+	cmd.Flags().StringP(tmcli.OutputFlag, "o", "text", "Output format (text|json)")
+	dcli.AddSDLVarFlags(cmd.Flags())
+
+	return cmd
+}
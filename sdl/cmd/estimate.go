@@ -0,0 +1,263 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+
+	dtypes "github.com/akash-network/akash-api/go/node/deployment/v1beta3"
+
+	"github.com/akash-network/node/cmd/common"
+	"github.com/akash-network/node/sdl"
+	netutil "github.com/akash-network/node/util/network"
+	dcli "github.com/akash-network/node/x/deployment/client/cli"
+	"github.com/akash-network/node/x/escrow/client/util"
+)
+
+const (
+	FlagBlockTime = "block-time"
+
+	day   = 24 * time.Hour
+	month = 30 * day
+
+	// decimal places amounts are displayed with in text output
+	displayPrecision = 6
+)
+
+// duration is marshaled to json in human readable form
+type duration time.Duration
+
+func (d duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+type groupCost struct {
+	Name     string      `json:"name"`
+	PerBlock sdk.DecCoin `json:"per_block"`
+	PerDay   sdk.DecCoin `json:"per_day"`
+	PerMonth sdk.DecCoin `json:"per_month"`
+}
+
+type totalCost struct {
+	PerBlock sdk.DecCoins `json:"per_block"`
+	PerDay   sdk.DecCoins `json:"per_day"`
+	PerMonth sdk.DecCoins `json:"per_month"`
+}
+
+type depositCost struct {
+	Amount   sdk.Coin `json:"amount"`
+	Blocks   int64    `json:"blocks"`
+	Duration duration `json:"duration"`
+}
+
+// costEstimate is the worst case cost of a deployment, every group leased at maximum price of its pricing
+type costEstimate struct {
+	BlockTime duration     `json:"block_time"`
+	Groups    []groupCost  `json:"groups"`
+	Total     totalCost    `json:"total"`
+	Deposit   *depositCost `json:"deposit,omitempty"`
+}
+
+// EstimateCmd estimates cost of a deployment from pricing of its SDL
+func EstimateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate [sdl-file]",
+		Short: "Estimate worst case cost of a deployment from pricing in its SDL",
+		Long: "Estimate worst case cost of a deployment, with every group leased at maximum price set by its pricing. " +
+			"If deposit is given, reports how many blocks it lasts. Time based figures use average block time.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			output, err := cmd.Flags().GetString(tmcli.OutputFlag)
+			if err != nil {
+				return err
+			}
+
+			if output != "text" && output != "json" {
+				return fmt.Errorf("invalid output format %q, expected text|json", output)
+			}
+
+			blockTime, err := cmd.Flags().GetDuration(FlagBlockTime)
+			if err != nil {
+				return err
+			}
+
+			if blockTime <= 0 {
+				return fmt.Errorf("%s must be positive", FlagBlockTime)
+			}
+
+			sdlOpts, err := dcli.SDLReadOptionsFromFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			sdlManifest, err := sdl.ReadFile(args[0], sdlOpts...)
+			if err != nil {
+				return err
+			}
+
+			groups, err := sdlManifest.DeploymentGroups()
+			if err != nil {
+				return err
+			}
+
+			res := estimateCost(groups, blockTime)
+
+			if cmd.Flags().Changed(common.FlagDeposit) {
+				depositStr, err := cmd.Flags().GetString(common.FlagDeposit)
+				if err != nil {
+					return err
+				}
+
+				deposit, err := sdk.ParseCoinNormalized(depositStr)
+				if err != nil {
+					return err
+				}
+
+				if res.Deposit, err = estimateDeposit(res, deposit); err != nil {
+					return err
+				}
+			}
+
+			out := cmd.OutOrStdout()
+
+			if output == "json" {
+				data, err := json.MarshalIndent(res, "", "  ")
+				if err != nil {
+					return err
+				}
+
+				_, err = fmt.Fprintln(out, string(data))
+				return err
+			}
+
+			tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+
+			_, _ = fmt.Fprintln(tw, "GROUP\tPER BLOCK\tPER DAY\tPER MONTH")
+			for _, group := range res.Groups {
+				_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n",
+					group.Name,
+					formatDecCoin(group.PerBlock),
+					formatDecCoin(group.PerDay),
+					formatDecCoin(group.PerMonth),
+				)
+			}
+
+			_, _ = fmt.Fprintf(tw, "TOTAL\t%s\t%s\t%s\n",
+				formatDecCoins(res.Total.PerBlock),
+				formatDecCoins(res.Total.PerDay),
+				formatDecCoins(res.Total.PerMonth),
+			)
+
+			if err := tw.Flush(); err != nil {
+				return err
+			}
+
+			_, _ = fmt.Fprintf(out, "\nestimated with average block time %s, month of 30 days\n", time.Duration(res.BlockTime))
+
+			if res.Deposit != nil {
+				_, _ = fmt.Fprintf(out, "deposit of %s lasts %d blocks (~%s)\n",
+					res.Deposit.Amount, res.Deposit.Blocks, time.Duration(res.Deposit.Duration).Round(time.Minute))
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringP(tmcli.OutputFlag, "o", "text", "Output format (text|json)")
+	cmd.Flags().Duration(FlagBlockTime, netutil.AverageBlockTime, "Average block time")
+	common.AddDepositFlags(cmd.Flags())
+	dcli.AddSDLVarFlags(cmd.Flags())
+
+	return cmd
+}
+
+// estimateCost returns cost of given groups, each priced by maximum price of its resources
+func estimateCost(groups dtypes.GroupSpecs, blockTime time.Duration) costEstimate {
+	blocksPerDay := blocksPer(day, blockTime)
+	blocksPerMonth := blocksPer(month, blockTime)
+
+	res := costEstimate{
+		BlockTime: duration(blockTime),
+		Groups:    make([]groupCost, 0, len(groups)),
+		Total: totalCost{
+			PerBlock: sdk.DecCoins{},
+			PerDay:   sdk.DecCoins{},
+			PerMonth: sdk.DecCoins{},
+		},
+	}
+
+	for _, group := range groups {
+		price := group.Price()
+
+		cost := groupCost{
+			Name:     group.Name,
+			PerBlock: price,
+			PerDay:   sdk.NewDecCoinFromDec(price.Denom, price.Amount.Mul(blocksPerDay)),
+			PerMonth: sdk.NewDecCoinFromDec(price.Denom, price.Amount.Mul(blocksPerMonth)),
+		}
+
+		res.Groups = append(res.Groups, cost)
+		res.Total.PerBlock = res.Total.PerBlock.Add(cost.PerBlock)
+		res.Total.PerDay = res.Total.PerDay.Add(cost.PerDay)
+		res.Total.PerMonth = res.Total.PerMonth.Add(cost.PerMonth)
+	}
+
+	return res
+}
+
+// estimateDeposit returns how long deposit pays for the deployment
+func estimateDeposit(res costEstimate, deposit sdk.Coin) (*depositCost, error) {
+	rate := res.Total.PerBlock.AmountOf(deposit.Denom)
+	if !rate.IsPositive() {
+		return nil, fmt.Errorf("deployment is not priced in %s", deposit.Denom)
+	}
+
+	blocks := util.LeaseCalcBlocksCovered(sdk.NewDecFromInt(deposit.Amount), rate)
+	blockTime := time.Duration(res.BlockTime)
+
+	lasts := time.Duration(math.MaxInt64)
+	if blocks < int64(math.MaxInt64/blockTime) {
+		lasts = time.Duration(blocks) * blockTime
+	}
+
+	return &depositCost{
+		Amount:   deposit,
+		Blocks:   blocks,
+		Duration: duration(lasts),
+	}, nil
+}
+
+func blocksPer(period time.Duration, blockTime time.Duration) sdk.Dec {
+	return sdk.NewDec(int64(period)).QuoInt64(int64(blockTime))
+}
+
+func formatDecCoins(coins sdk.DecCoins) string {
+	res := make([]string, 0, len(coins))
+	for _, coin := range coins {
+		res = append(res, formatDecCoin(coin))
+	}
+
+	return strings.Join(res, ",")
+}
+
+// formatDecCoin formats coin with at most displayPrecision decimal places and no trailing zeros
+func formatDecCoin(coin sdk.DecCoin) string {
+	amount := coin.Amount.String()
+
+	if idx := strings.IndexByte(amount, '.'); idx >= 0 {
+		if len(amount) > idx+1+displayPrecision {
+			amount = amount[:idx+1+displayPrecision]
+		}
+
+		amount = strings.TrimRight(strings.TrimRight(amount, "0"), ".")
+	}
+
+	return amount + coin.Denom
+}
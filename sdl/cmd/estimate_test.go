@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/akash-network/node/sdl"
+)
+
+func TestEstimateCost(t *testing.T) {
+	obj, err := sdl.ReadFile("../_testdata/include/flat.yaml")
+	require.NoError(t, err)
+
+	groups, err := obj.DeploymentGroups()
+	require.NoError(t, err)
+
+	// 2 web instances at 50 and a db instance at 100 per block
+	res := estimateCost(groups, 6*time.Second)
+	require.Len(t, res.Groups, 1)
+	require.Equal(t, "westcoast", res.Groups[0].Name)
+	require.Equal(t, sdk.NewDecCoin("uakt", sdk.NewInt(200)), res.Groups[0].PerBlock)
+	require.Equal(t, sdk.NewDecCoin("uakt", sdk.NewInt(200*14400)), res.Groups[0].PerDay)
+	require.Equal(t, sdk.NewDecCoin("uakt", sdk.NewInt(200*14400*30)), res.Groups[0].PerMonth)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoin("uakt", sdk.NewInt(200))), res.Total.PerBlock)
+
+	deposit, err := estimateDeposit(res, sdk.NewInt64Coin("uakt", 5000099))
+	require.NoError(t, err)
+	require.Equal(t, int64(25000), deposit.Blocks)
+	require.Equal(t, duration(25000*6*time.Second), deposit.Duration)
+
+	_, err = estimateDeposit(res, sdk.NewInt64Coin("uusdc", 5000000))
+	require.Error(t, err)
+}
+
+func TestFormatDecCoin(t *testing.T) {
+	require.Equal(t, "150uakt", formatDecCoin(sdk.NewDecCoin("uakt", sdk.NewInt(150))))
+	require.Equal(t, "0.333333uakt", formatDecCoin(sdk.NewDecCoinFromDec("uakt", sdk.OneDec().QuoInt64(3))))
+	require.Equal(t, "1.5uakt", formatDecCoin(sdk.NewDecCoinFromDec("uakt", sdk.NewDecWithPrec(15, 1))))
+}
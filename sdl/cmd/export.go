@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/akash-network/node/sdl"
+	dcli "github.com/akash-network/node/x/deployment/client/cli"
+)
+
+// ExportCmd writes SDL in canonical form
+func ExportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "export [sdl-file]",
+		Aliases: []string{"fmt"},
+		Short:   "Write SDL in canonical form",
+		Long: "Write SDL in canonical form: includes and variables resolved, keys sorted, defaults filled in " +
+			"and compute profiles named after services using them. Deployments of SDLs with the same canonical form are identical.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out, err := cmd.Flags().GetString(FlagOut)
+			if err != nil {
+				return err
+			}
+
+			sdlOpts, err := dcli.SDLReadOptionsFromFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			sdlManifest, err := sdl.ReadFile(args[0], sdlOpts...)
+			if err != nil {
+				return err
+			}
+
+			res, err := sdl.Marshal(sdlManifest)
+			if err != nil {
+				return err
+			}
+
+			if out == "" {
+				_, err = cmd.OutOrStdout().Write(res)
+				return err
+			}
+
+			return os.WriteFile(out, res, 0o600)
+		},
+	}
+
+	cmd.Flags().StringP(FlagOut, "f", "", "Write SDL to file instead of standard output")
+	dcli.AddSDLVarFlags(cmd.Flags())
+
+	return cmd
+}
+
+// SchemaCmd prints JSON Schema of SDL
+func SchemaCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schema [version]",
+		Short: "Print JSON Schema of SDL for editor validation and completion",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			version := "2.1"
+			if len(args) > 0 {
+				version = args[0]
+			}
+
+			res, err := sdl.JSONSchema(version)
+			if err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(res))
+			return err
+		},
+	}
+
+	return cmd
+}
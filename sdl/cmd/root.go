@@ -0,0 +1,26 @@
+package cmd
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+)
+
+// SDLCmd groups commands working with SDL files offline
+func SDLCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "sdl",
+		Short:                      "SDL utilities which do not require a node",
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		EstimateCmd(),
+		FromComposeCmd(),
+		ExportCmd(),
+		DiffCmd(),
+		SchemaCmd(),
+	)
+
+	return cmd
+}
@@ -0,0 +1,737 @@
+package sdl
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/blang/semver/v4"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"gopkg.in/yaml.v3"
+
+	"github.com/akash-network/akash-api/go/node/types/unit"
+)
+
+const (
+	composeDefaultPlacement  = "dcloud"
+	composeDefaultDenom      = "uakt"
+	composeDefaultAmount     = "1000"
+	composeDefaultCPU        = cpuQuantity(500)
+	composeDefaultMemory     = byteQuantity(512 * unit.Mi)
+	composeDefaultStorage    = byteQuantity(unit.Gi)
+	composeDefaultVolumeSize = byteQuantity(unit.Gi)
+)
+
+var (
+	errCompose        = errors.New("compose")
+	errComposeInvalid = fmt.Errorf("%w: invalid", errCompose)
+
+	composeMemorySuffixes = map[string]uint64{
+		"b":  1,
+		"k":  1 << 10,
+		"kb": 1 << 10,
+		"m":  1 << 20,
+		"mb": 1 << 20,
+		"g":  1 << 30,
+		"gb": 1 << 30,
+	}
+
+	// keys of compose file converted into SDL, other keys are reported as unsupported
+	composeSupportedKeys = map[string]bool{
+		"version":  true,
+		"services": true,
+		"volumes":  true,
+	}
+
+	composeSupportedServiceKeys = map[string]bool{
+		"image":       true,
+		"command":     true,
+		"entrypoint":  true,
+		"environment": true,
+		"env_file":    true,
+		"ports":       true,
+		"expose":      true,
+		"volumes":     true,
+		"deploy":      true,
+	}
+
+	composeSupportedDeployKeys = map[string]bool{
+		"replicas":  true,
+		"resources": true,
+	}
+
+	composeSupportedLimitsKeys = map[string]bool{
+		"cpus":   true,
+		"memory": true,
+	}
+)
+
+// ComposeOptions sets pricing and placement of SDL converted from compose file
+type ComposeOptions struct {
+	// Placement is the name of placement profile all services are deployed to
+	Placement string
+	// Denom and Amount are the maximum price per block of each service instance
+	Denom  string
+	Amount string
+}
+
+// ComposeResult is SDL converted from compose file
+type ComposeResult struct {
+	// SDL is the converted SDL v2.1 document
+	SDL []byte
+	// Unsupported lists compose keys which have no SDL counterpart and have not been converted
+	Unsupported []string
+}
+
+// composeConverter converts compose file into SDL collecting keys it has no counterpart for
+type composeConverter struct {
+	dir         string
+	opts        ComposeOptions
+	price       v2Coin
+	unsupported []string
+}
+
+// FromComposeFile converts docker-compose file at given path into SDL v2.1.
+// Services become SDL services deployed to a single placement, each with its own compute profile
+// sized by deploy.resources.limits. Published ports are exposed globally, other ports to the rest of services.
+// Named volumes become persistent storage. Keys which cannot be converted are reported in the result.
+func FromComposeFile(path string, opts ComposeOptions) (ComposeResult, error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return ComposeResult{}, err
+	}
+
+	return fromCompose(buf, filepath.Dir(path), opts)
+}
+
+func fromCompose(buf []byte, dir string, opts ComposeOptions) (ComposeResult, error) {
+	if opts.Placement == "" {
+		opts.Placement = composeDefaultPlacement
+	}
+
+	if opts.Denom == "" {
+		opts.Denom = composeDefaultDenom
+	}
+
+	if opts.Amount == "" {
+		opts.Amount = composeDefaultAmount
+	}
+
+	doc := &yaml.Node{}
+	if err := yaml.Unmarshal(buf, doc); err != nil {
+		return ComposeResult{}, err
+	}
+
+	root := documentMapping(doc)
+	if root == nil {
+		return ComposeResult{}, fmt.Errorf("%w: document is not a map", errComposeInvalid)
+	}
+
+	c := &composeConverter{
+		dir:  dir,
+		opts: opts,
+	}
+
+	c.checkKeys(root, composeSupportedKeys, "")
+
+	services := mappingValue(root, "services")
+	if services == nil || services.Kind != yaml.MappingNode || len(services.Content) == 0 {
+		return ComposeResult{}, fmt.Errorf("%w: no services", errComposeInvalid)
+	}
+
+	names := make([]string, 0, len(services.Content)/2)
+	for i := 0; i < len(services.Content); i += 2 {
+		names = append(names, services.Content[i].Value)
+	}
+
+	amount, err := sdk.NewDecFromStr(opts.Amount)
+	if err != nil {
+		return ComposeResult{}, fmt.Errorf("%w: amount: %s", errComposeInvalid, err.Error())
+	}
+
+	c.price = v2Coin{Value: sdk.NewDecCoinFromDec(opts.Denom, amount)}
+
+	res := &v2_1{
+		Services:    make(map[string]v2Service),
+		Deployments: make(v2Deployments),
+		Profiles: v2profiles{
+			Compute: make(map[string]v2ProfileCompute),
+			Placement: map[string]v2ProfilePlacement{
+				opts.Placement: {Pricing: make(v2PlacementPricing)},
+			},
+		},
+		ver: semver.MustParse("2.1.0"),
+	}
+
+	for i := 0; i < len(services.Content); i += 2 {
+		name := services.Content[i].Value
+
+		if err := c.convertService(res, name, services.Content[i+1], names); err != nil {
+			return ComposeResult{}, err
+		}
+	}
+
+	if err := res.buildGroups(); err != nil {
+		return ComposeResult{}, err
+	}
+
+	obj := &sdl{Ver: res.ver, data: res}
+	if err := obj.validate(); err != nil {
+		return ComposeResult{}, err
+	}
+
+	out, err := Marshal(obj)
+	if err != nil {
+		return ComposeResult{}, err
+	}
+
+	sort.Strings(c.unsupported)
+
+	return ComposeResult{
+		SDL:         out,
+		Unsupported: c.unsupported,
+	}, nil
+}
+
+func (c *composeConverter) convertService(res *v2_1, name string, node *yaml.Node, names []string) error {
+	path := "services." + name
+
+	if node.Kind != yaml.MappingNode {
+		return fmt.Errorf("%w: %s: service must be a map", errComposeInvalid, path)
+	}
+
+	c.checkKeys(node, composeSupportedServiceKeys, path)
+
+	var svc v2Service
+
+	if err := decodeComposeValue(node, "image", &svc.Image); err != nil {
+		return fmt.Errorf("%w: %s.image: %s", errComposeInvalid, path, err.Error())
+	}
+
+	if svc.Image == "" {
+		return fmt.Errorf("%w: %s: image is required, services built from source cannot be deployed", errComposeInvalid, path)
+	}
+
+	var err error
+
+	if svc.Command, err = composeCommand(mappingValue(node, "entrypoint")); err != nil {
+		return fmt.Errorf("%w: %s.entrypoint: %s", errComposeInvalid, path, err.Error())
+	}
+
+	if svc.Args, err = composeCommand(mappingValue(node, "command")); err != nil {
+		return fmt.Errorf("%w: %s.command: %s", errComposeInvalid, path, err.Error())
+	}
+
+	if svc.Env, err = c.environment(node, path); err != nil {
+		return err
+	}
+
+	if svc.Expose, err = c.expose(node, path, name, names); err != nil {
+		return err
+	}
+
+	compute := &v2ComputeResources{
+		CPU:    &v2ResourceCPU{Units: composeDefaultCPU},
+		Memory: &v2ResourceMemory{Quantity: composeDefaultMemory},
+		Storage: v2ResourceStorageArray{{
+			Name:     StorageClassDefault,
+			Quantity: composeDefaultStorage,
+		}},
+	}
+
+	if err := c.volumes(node, path, &svc, compute); err != nil {
+		return err
+	}
+
+	compute.Storage.sort()
+
+	count, err := c.deploy(node, path, compute)
+	if err != nil {
+		return err
+	}
+
+	res.Services[name] = svc
+	res.Profiles.Compute[name] = v2ProfileCompute{Resources: compute}
+	res.Profiles.Placement[c.opts.Placement].Pricing[name] = c.price
+	res.Deployments[name] = v2Deployment{
+		c.opts.Placement: {
+			Profile: name,
+			Count:   count,
+		},
+	}
+
+	return nil
+}
+
+// environment merges env files and environment of the service, the latter taking precedence
+func (c *composeConverter) environment(node *yaml.Node, path string) ([]string, error) {
+	vars := make(map[string]string)
+	var order []string
+
+	set := func(key, val string) {
+		if _, exists := vars[key]; !exists {
+			order = append(order, key)
+		}
+		vars[key] = val
+	}
+
+	files, err := composeStrings(mappingValue(node, "env_file"))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s.env_file: %s", errComposeInvalid, path, err.Error())
+	}
+
+	for _, file := range files {
+		if !filepath.IsAbs(file) {
+			file = filepath.Join(c.dir, file)
+		}
+
+		if err := readComposeEnvFile(file, set); err != nil {
+			return nil, fmt.Errorf("%w: %s.env_file: %s", errComposeInvalid, path, err.Error())
+		}
+	}
+
+	if env := mappingValue(node, "environment"); env != nil {
+		switch env.Kind {
+		case yaml.MappingNode:
+			for i := 0; i < len(env.Content); i += 2 {
+				key, val := env.Content[i].Value, env.Content[i+1]
+				if val.Tag == "!!null" {
+					c.report(path + ".environment." + key)
+					continue
+				}
+				set(key, val.Value)
+			}
+		case yaml.SequenceNode:
+			for _, item := range env.Content {
+				key, val, found := strings.Cut(item.Value, "=")
+				if !found {
+					// value is taken from environment compose runs in
+					c.report(path + ".environment." + key)
+					continue
+				}
+				set(key, val)
+			}
+		default:
+			return nil, fmt.Errorf("%w: %s.environment: must be a map or list", errComposeInvalid, path)
+		}
+	}
+
+	res := make([]string, 0, len(order))
+	for _, key := range order {
+		res = append(res, key+"="+vars[key])
+	}
+
+	return res, nil
+}
+
+// expose converts published ports into globally exposed ports and exposed ports into ports
+// reachable by other services of the compose file
+func (c *composeConverter) expose(node *yaml.Node, path string, name string, names []string) (v2Exposes, error) {
+	var res v2Exposes
+
+	if ports := mappingValue(node, "ports"); ports != nil {
+		if ports.Kind != yaml.SequenceNode {
+			return nil, fmt.Errorf("%w: %s.ports: must be a list", errComposeInvalid, path)
+		}
+
+		for idx, item := range ports.Content {
+			ipath := fmt.Sprintf("%s.ports[%d]", path, idx)
+
+			expose, supported, err := composePort(item)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %s: %s", errComposeInvalid, ipath, err.Error())
+			}
+
+			if !supported {
+				c.report(ipath)
+				continue
+			}
+
+			res = append(res, expose)
+		}
+	}
+
+	var peers []v2ExposeTo
+	for _, peer := range names {
+		if peer != name {
+			peers = append(peers, v2ExposeTo{Service: peer})
+		}
+	}
+
+	exposed, err := composeStrings(mappingValue(node, "expose"))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s.expose: %s", errComposeInvalid, path, err.Error())
+	}
+
+	for idx, item := range exposed {
+		port, proto, _ := strings.Cut(item, "/")
+
+		val, err := strconv.ParseUint(port, 10, 16)
+		if err != nil || len(peers) == 0 {
+			c.report(fmt.Sprintf("%s.expose[%d]", path, idx))
+			continue
+		}
+
+		res = append(res, v2Expose{
+			Port:  uint32(val),
+			Proto: strings.ToLower(proto),
+			To:    peers,
+		})
+	}
+
+	return res, nil
+}
+
+// volumes converts named volumes into persistent storage of the service
+func (c *composeConverter) volumes(node *yaml.Node, path string, svc *v2Service, compute *v2ComputeResources) error {
+	volumes := mappingValue(node, "volumes")
+	if volumes == nil {
+		return nil
+	}
+
+	if volumes.Kind != yaml.SequenceNode {
+		return fmt.Errorf("%w: %s.volumes: must be a list", errComposeInvalid, path)
+	}
+
+	for idx, item := range volumes.Content {
+		var source, target string
+		var readOnly bool
+
+		switch item.Kind {
+		case yaml.ScalarNode:
+			parts := strings.Split(item.Value, ":")
+			if len(parts) >= 2 {
+				source, target = parts[0], parts[1]
+			}
+			if len(parts) == 3 {
+				readOnly = parts[2] == "ro"
+			}
+		case yaml.MappingNode:
+			var long struct {
+				Type     string `yaml:"type"`
+				Source   string `yaml:"source"`
+				Target   string `yaml:"target"`
+				ReadOnly bool   `yaml:"read_only"`
+			}
+
+			if err := item.Decode(&long); err != nil {
+				return fmt.Errorf("%w: %s.volumes[%d]: %s", errComposeInvalid, path, idx, err.Error())
+			}
+
+			if long.Type == "" || long.Type == "volume" {
+				source, target, readOnly = long.Source, long.Target, long.ReadOnly
+			}
+		}
+
+		// anonymous volumes, bind mounts and tmpfs have no SDL counterpart
+		if source == "" || target == "" || strings.ContainsAny(source, "/.~") {
+			c.report(fmt.Sprintf("%s.volumes[%d]", path, idx))
+			continue
+		}
+
+		if svc.Params == nil {
+			svc.Params = &v2ServiceParams{Storage: make(map[string]v2ServiceStorageParams)}
+		}
+
+		if _, exists := svc.Params.Storage[source]; exists {
+			return fmt.Errorf("%w: %s.volumes[%d]: volume %q mounted twice", errComposeInvalid, path, idx, source)
+		}
+
+		svc.Params.Storage[source] = v2ServiceStorageParams{
+			Mount:    target,
+			ReadOnly: readOnly,
+		}
+
+		compute.Storage = append(compute.Storage, v2ResourceStorage{
+			Name:     source,
+			Quantity: composeDefaultVolumeSize,
+			Attributes: v2StorageAttributes{
+				{Key: StorageAttributeClass, Value: StorageClassDefault},
+				{Key: StorageAttributePersistent, Value: valueTrue},
+			},
+		})
+	}
+
+	return nil
+}
+
+// deploy sets compute resources from deploy.resources.limits and returns number of replicas
+func (c *composeConverter) deploy(node *yaml.Node, path string, compute *v2ComputeResources) (uint32, error) {
+	deploy := mappingValue(node, "deploy")
+	if deploy == nil {
+		return 1, nil
+	}
+
+	path += ".deploy"
+
+	if deploy.Kind != yaml.MappingNode {
+		return 0, fmt.Errorf("%w: %s: must be a map", errComposeInvalid, path)
+	}
+
+	c.checkKeys(deploy, composeSupportedDeployKeys, path)
+
+	count := uint32(1)
+	if err := decodeComposeValue(deploy, "replicas", &count); err != nil {
+		return 0, fmt.Errorf("%w: %s.replicas: %s", errComposeInvalid, path, err.Error())
+	}
+
+	if count == 0 {
+		return 0, fmt.Errorf("%w: %s.replicas: must be positive", errComposeInvalid, path)
+	}
+
+	resources := mappingValue(deploy, "resources")
+	if resources == nil {
+		return count, nil
+	}
+
+	path += ".resources"
+
+	c.checkKeys(resources, map[string]bool{"limits": true}, path)
+
+	limits := mappingValue(resources, "limits")
+	if limits == nil {
+		return count, nil
+	}
+
+	path += ".limits"
+
+	c.checkKeys(limits, composeSupportedLimitsKeys, path)
+
+	if cpus := mappingValue(limits, "cpus"); cpus != nil {
+		if err := cpus.Decode(&compute.CPU.Units); err != nil {
+			return 0, fmt.Errorf("%w: %s.cpus: %s", errComposeInvalid, path, err.Error())
+		}
+	}
+
+	if memory := mappingValue(limits, "memory"); memory != nil {
+		size, err := composeMemory(memory.Value)
+		if err != nil {
+			return 0, fmt.Errorf("%w: %s.memory: %s", errComposeInvalid, path, err.Error())
+		}
+
+		compute.Memory.Quantity = size
+	}
+
+	return count, nil
+}
+
+func (c *composeConverter) checkKeys(node *yaml.Node, supported map[string]bool, path string) {
+	if node.Kind != yaml.MappingNode {
+		return
+	}
+
+	for i := 0; i < len(node.Content); i += 2 {
+		key := node.Content[i].Value
+		if supported[key] || strings.HasPrefix(key, "x-") {
+			continue
+		}
+
+		if path != "" {
+			key = path + "." + key
+		}
+
+		c.report(key)
+	}
+}
+
+func (c *composeConverter) report(path string) {
+	c.unsupported = append(c.unsupported, path)
+}
+
+func decodeComposeValue(node *yaml.Node, key string, val interface{}) error {
+	if vnode := mappingValue(node, key); vnode != nil {
+		return vnode.Decode(val)
+	}
+
+	return nil
+}
+
+// composePort converts entry of ports. Port ranges and ports bound to host address are not supported.
+func composePort(node *yaml.Node) (v2Expose, bool, error) {
+	var target, published, proto string
+
+	switch node.Kind {
+	case yaml.ScalarNode:
+		var spec string
+		spec, proto, _ = strings.Cut(node.Value, "/")
+
+		parts := strings.Split(spec, ":")
+		switch len(parts) {
+		case 1:
+			target = parts[0]
+		case 2:
+			published, target = parts[0], parts[1]
+		default:
+			return v2Expose{}, false, nil
+		}
+	case yaml.MappingNode:
+		var long struct {
+			Target    string `yaml:"target"`
+			Published string `yaml:"published"`
+			Protocol  string `yaml:"protocol"`
+			HostIP    string `yaml:"host_ip"`
+		}
+
+		if err := node.Decode(&long); err != nil {
+			return v2Expose{}, false, err
+		}
+
+		if long.HostIP != "" {
+			return v2Expose{}, false, nil
+		}
+
+		target, published, proto = long.Target, long.Published, long.Protocol
+	default:
+		return v2Expose{}, false, fmt.Errorf("must be a string or map")
+	}
+
+	port, err := strconv.ParseUint(target, 10, 16)
+	if err != nil {
+		return v2Expose{}, false, nil
+	}
+
+	res := v2Expose{
+		Port:  uint32(port),
+		Proto: strings.ToLower(proto),
+		To:    []v2ExposeTo{{Global: true}},
+	}
+
+	if published != "" {
+		as, err := strconv.ParseUint(published, 10, 16)
+		if err != nil {
+			return v2Expose{}, false, nil
+		}
+
+		if as != port {
+			res.As = uint32(as)
+		}
+	}
+
+	return res, true, nil
+}
+
+// composeCommand converts command or entrypoint which is either a list or a string split the way shell does
+func composeCommand(node *yaml.Node) ([]string, error) {
+	if node == nil || node.Kind != yaml.ScalarNode {
+		return composeStrings(node)
+	}
+
+	return splitComposeCommand(node.Value)
+}
+
+// composeStrings decodes value which is either a list of strings or a single string
+func composeStrings(node *yaml.Node) ([]string, error) {
+	if node == nil {
+		return nil, nil
+	}
+
+	if node.Kind == yaml.ScalarNode {
+		return []string{node.Value}, nil
+	}
+
+	var res []string
+	if err := node.Decode(&res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// composeMemory converts memory size the way docker parses it into size in bytes
+func composeMemory(val string) (byteQuantity, error) {
+	sval := strings.ToLower(strings.TrimSpace(val))
+
+	multiplier := uint64(1)
+	for _, suffix := range []string{"kb", "mb", "gb", "b", "k", "m", "g"} {
+		if strings.HasSuffix(sval, suffix) {
+			multiplier = composeMemorySuffixes[suffix]
+			sval = strings.TrimSuffix(sval, suffix)
+			break
+		}
+	}
+
+	size, err := strconv.ParseFloat(sval, 64)
+	if err != nil || size <= 0 {
+		return 0, fmt.Errorf("invalid memory size %q", val)
+	}
+
+	return byteQuantity(size * float64(multiplier)), nil
+}
+
+func readComposeEnvFile(path string, set func(string, string)) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		_ = file.Close()
+	}()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		key, val, _ := strings.Cut(line, "=")
+		set(strings.TrimSpace(key), val)
+	}
+
+	return scanner.Err()
+}
+
+// splitComposeCommand splits command into words honoring quotes and escapes
+func splitComposeCommand(cmd string) ([]string, error) {
+	var res []string
+	var word strings.Builder
+	var quote rune
+
+	inWord := false
+	escaped := false
+
+	for _, r := range cmd {
+		switch {
+		case escaped:
+			word.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'':
+			escaped = true
+			inWord = true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				word.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote = r
+			inWord = true
+		case r == ' ' || r == '\t' || r == '\n':
+			if inWord {
+				res = append(res, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteRune(r)
+			inWord = true
+		}
+	}
+
+	if quote != 0 || escaped {
+		return nil, fmt.Errorf("unterminated quote in %q", cmd)
+	}
+
+	if inWord {
+		res = append(res, word.String())
+	}
+
+	return res, nil
+}
@@ -0,0 +1,77 @@
+package sdl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromComposeFile(t *testing.T) {
+	res, err := FromComposeFile("_testdata/compose/docker-compose.yaml", ComposeOptions{})
+	require.NoError(t, err)
+
+	require.Equal(t, []string{
+		"networks",
+		"services.db.deploy.resources.reservations",
+		"services.db.volumes[1]",
+		"services.web.depends_on",
+		"services.web.environment.HOME_DIR",
+		"services.web.ports[1]",
+		"services.web.restart",
+	}, res.Unsupported)
+
+	obj, err := Read(res.SDL)
+	require.NoError(t, err)
+
+	groups, err := obj.DeploymentGroups()
+	require.NoError(t, err)
+	require.Len(t, groups, 1)
+	require.Equal(t, composeDefaultPlacement, groups[0].Name)
+
+	mani, err := obj.Manifest()
+	require.NoError(t, err)
+	require.Len(t, mani, 1)
+
+	services := mani[0].Services
+	require.Len(t, services, 2)
+
+	db, web := services[0], services[1]
+
+	require.Equal(t, "db", db.Name)
+	require.Equal(t, []string{"postgres", "-c", "max_connections=200"}, db.Args)
+	require.Equal(t, []string{"POSTGRES_PASSWORD=secret"}, db.Env)
+	require.Len(t, db.Params.Storage, 1)
+	require.Equal(t, "/var/lib/postgresql/data", db.Params.Storage[0].Mount)
+	require.Equal(t, uint64(1<<30), db.Resources.Memory.Quantity.Val.Uint64())
+	require.Len(t, db.Expose, 1)
+	require.Equal(t, uint32(5432), db.Expose[0].Port)
+	require.False(t, db.Expose[0].Global)
+	require.Equal(t, "web", db.Expose[0].Service)
+
+	require.Equal(t, "web", web.Name)
+	require.Equal(t, uint32(2), web.Count)
+	require.Equal(t, []string{"WORKERS=4", "LOG_LEVEL=debug"}, web.Env)
+	require.Equal(t, uint64(250), web.Resources.CPU.Units.Val.Uint64())
+	require.Len(t, web.Expose, 1)
+	require.Equal(t, uint32(8080), web.Expose[0].Port)
+	require.Equal(t, uint32(80), web.Expose[0].ExternalPort)
+	require.True(t, web.Expose[0].Global)
+}
+
+func TestFromComposeNoImage(t *testing.T) {
+	_, err := fromCompose([]byte(`
+services:
+  app:
+    build: .
+`), ".", ComposeOptions{})
+	require.ErrorIs(t, err, errComposeInvalid)
+}
+
+func TestSplitComposeCommand(t *testing.T) {
+	res, err := splitComposeCommand(`sh -c 'echo "a b"' x\ y`)
+	require.NoError(t, err)
+	require.Equal(t, []string{"sh", "-c", `echo "a b"`, "x y"}, res)
+
+	_, err = splitComposeCommand(`echo "unterminated`)
+	require.Error(t, err)
+}
@@ -0,0 +1,630 @@
+package sdl
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	manifest "github.com/akash-network/akash-api/go/manifest/v2beta2"
+	dtypes "github.com/akash-network/akash-api/go/node/deployment/v1beta3"
+	types "github.com/akash-network/akash-api/go/node/types/v1beta3"
+)
+
+// ChangeKind tells whether value has been added, removed or modified
+type ChangeKind string
+
+// ChangeScope tells what a change requires to be applied
+type ChangeScope string
+
+const (
+	ChangeAdded    ChangeKind = "added"
+	ChangeRemoved  ChangeKind = "removed"
+	ChangeModified ChangeKind = "modified"
+
+	// ScopeManifest changes are applied by sending updated manifest to providers, leases are kept
+	ScopeManifest ChangeScope = "manifest"
+	// ScopeGroup changes alter group specs on chain, which requires closing the deployment and bidding again
+	ScopeGroup ChangeScope = "group"
+	// ScopeLifecycle changes alter service lifecycle, which manifest does not carry yet.
+	// They do not change deployment version and are not delivered to providers.
+	ScopeLifecycle ChangeScope = "lifecycle"
+
+	redacted = "<redacted>"
+)
+
+// Change is a single difference between two SDLs
+type Change struct {
+	Path  string      `json:"path"`
+	Kind  ChangeKind  `json:"kind"`
+	Scope ChangeScope `json:"scope"`
+	Old   string      `json:"old,omitempty"`
+	New   string      `json:"new,omitempty"`
+}
+
+func (c Change) String() string {
+	var sign string
+
+	switch c.Kind {
+	case ChangeAdded:
+		sign = "+"
+	case ChangeRemoved:
+		sign = "-"
+	default:
+		sign = "~"
+	}
+
+	var val string
+
+	switch c.Kind {
+	case ChangeAdded:
+		val = c.New
+	case ChangeRemoved:
+		val = c.Old
+	default:
+		val = c.Old + " -> " + c.New
+	}
+
+	if val != "" {
+		val = ": " + val
+	}
+
+	return fmt.Sprintf("%s %s%s [%s]", sign, c.Path, val, c.Scope)
+}
+
+// Diff is list of changes between two SDLs ordered by path
+type Diff []Change
+
+func (d Diff) String() string {
+	lines := make([]string, 0, len(d))
+	for _, change := range d {
+		lines = append(lines, change.String())
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// Empty returns true if SDLs are semantically equal
+func (d Diff) Empty() bool {
+	return len(d) == 0
+}
+
+// ChangesGroups returns true if any of the changes alters group specs on chain
+func (d Diff) ChangesGroups() bool {
+	for _, change := range d {
+		if change.Scope == ScopeGroup {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Compare returns semantic difference between deployments described by two SDLs
+func Compare(from, to SDL) (Diff, error) {
+	fromGroups, err := from.DeploymentGroups()
+	if err != nil {
+		return nil, err
+	}
+
+	toGroups, err := to.DeploymentGroups()
+	if err != nil {
+		return nil, err
+	}
+
+	fromManifest, err := from.Manifest()
+	if err != nil {
+		return nil, err
+	}
+
+	toManifest, err := to.Manifest()
+	if err != nil {
+		return nil, err
+	}
+
+	fromLifecycles, err := Lifecycle(from)
+	if err != nil {
+		return nil, err
+	}
+
+	toLifecycles, err := Lifecycle(to)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &differ{}
+	d.groups(fromGroups, toGroups)
+	d.manifest(fromManifest, toManifest)
+	d.lifecycles(fromLifecycles, toLifecycles)
+
+	return d.result(), nil
+}
+
+// CompareGroups returns difference between group specs, such as the ones of a deployment on chain and the ones of updated SDL
+func CompareGroups(from, to dtypes.GroupSpecs) Diff {
+	d := &differ{}
+	d.groups(from, to)
+
+	return d.result()
+}
+
+// CompareManifests returns difference between manifests, changes of service counts are reported as group changes
+func CompareManifests(from, to manifest.Manifest) Diff {
+	d := &differ{}
+	d.manifest(from, to)
+
+	return d.result()
+}
+
+type differ struct {
+	changes Diff
+}
+
+func (d *differ) result() Diff {
+	sort.SliceStable(d.changes, func(i, j int) bool {
+		return d.changes[i].Path < d.changes[j].Path
+	})
+
+	return d.changes
+}
+
+func (d *differ) add(scope ChangeScope, kind ChangeKind, path []string, from, to string) {
+	d.changes = append(d.changes, Change{
+		Path:  formatPath(path),
+		Kind:  kind,
+		Scope: scope,
+		Old:   from,
+		New:   to,
+	})
+}
+
+// value compares scalar values, empty value means absent
+func (d *differ) value(scope ChangeScope, path []string, from, to string) {
+	switch {
+	case from == to:
+	case from == "":
+		d.add(scope, ChangeAdded, path, "", to)
+	case to == "":
+		d.add(scope, ChangeRemoved, path, from, "")
+	default:
+		d.add(scope, ChangeModified, path, from, to)
+	}
+}
+
+// values compares maps of values key by key
+func (d *differ) values(scope ChangeScope, path []string, from, to map[string]string) {
+	for _, key := range unionKeys(from, to) {
+		d.value(scope, subPath(path, key), from[key], to[key])
+	}
+}
+
+// list compares lists as a whole, order matters
+func (d *differ) list(scope ChangeScope, path []string, from, to []string) {
+	d.value(scope, path, formatList(from), formatList(to))
+}
+
+func (d *differ) groups(from, to dtypes.GroupSpecs) {
+	fromGroups := make(map[string]*dtypes.GroupSpec)
+	toGroups := make(map[string]*dtypes.GroupSpec)
+
+	for _, group := range from {
+		fromGroups[group.Name] = group
+	}
+
+	for _, group := range to {
+		toGroups[group.Name] = group
+	}
+
+	for _, name := range unionKeys(fromGroups, toGroups) {
+		path := []string{name}
+
+		fgroup, tgroup := fromGroups[name], toGroups[name]
+
+		switch {
+		case fgroup == nil:
+			d.add(ScopeGroup, ChangeAdded, path, "", "group")
+			continue
+		case tgroup == nil:
+			d.add(ScopeGroup, ChangeRemoved, path, "group", "")
+			continue
+		}
+
+		d.values(ScopeGroup, subPath(path, "requirements", "attributes"),
+			attributesMap(fgroup.Requirements.Attributes), attributesMap(tgroup.Requirements.Attributes))
+		d.list(ScopeGroup, subPath(path, "requirements", "signedBy", "allOf"),
+			fgroup.Requirements.SignedBy.AllOf, tgroup.Requirements.SignedBy.AllOf)
+		d.list(ScopeGroup, subPath(path, "requirements", "signedBy", "anyOf"),
+			fgroup.Requirements.SignedBy.AnyOf, tgroup.Requirements.SignedBy.AnyOf)
+
+		d.resourceUnits(subPath(path, "resources"), fgroup.Resources, tgroup.Resources)
+	}
+}
+
+func (d *differ) resourceUnits(path []string, from, to dtypes.ResourceUnits) {
+	fromUnits := make(map[string]dtypes.ResourceUnit)
+	toUnits := make(map[string]dtypes.ResourceUnit)
+
+	for _, ru := range from {
+		fromUnits[strconv.FormatUint(uint64(ru.ID), 10)] = ru
+	}
+
+	for _, ru := range to {
+		toUnits[strconv.FormatUint(uint64(ru.ID), 10)] = ru
+	}
+
+	for _, id := range unionKeys(fromUnits, toUnits) {
+		upath := subPath(path, id)
+
+		fru, fexists := fromUnits[id]
+		tru, texists := toUnits[id]
+
+		switch {
+		case !fexists:
+			d.add(ScopeGroup, ChangeAdded, upath, "", formatResourceUnit(tru))
+			continue
+		case !texists:
+			d.add(ScopeGroup, ChangeRemoved, upath, formatResourceUnit(fru), "")
+			continue
+		}
+
+		d.value(ScopeGroup, subPath(upath, "count"), formatUint(fru.Count), formatUint(tru.Count))
+		d.value(ScopeGroup, subPath(upath, "price"), formatPrice(fru.Price), formatPrice(tru.Price))
+		d.resources(upath, fru.Resources, tru.Resources)
+	}
+}
+
+func (d *differ) resources(path []string, from, to types.Resources) {
+	var fcpu, tcpu string
+	var fcpuAttrs, tcpuAttrs types.Attributes
+
+	if from.CPU != nil {
+		fcpu = fmt.Sprintf("%dm", from.CPU.Units.Val.Uint64())
+		fcpuAttrs = from.CPU.Attributes
+	}
+
+	if to.CPU != nil {
+		tcpu = fmt.Sprintf("%dm", to.CPU.Units.Val.Uint64())
+		tcpuAttrs = to.CPU.Attributes
+	}
+
+	d.value(ScopeGroup, subPath(path, "cpu", "units"), fcpu, tcpu)
+	d.values(ScopeGroup, subPath(path, "cpu", "attributes"), attributesMap(fcpuAttrs), attributesMap(tcpuAttrs))
+
+	var fmem, tmem string
+	if from.Memory != nil {
+		fmem = exportQuantity(from.Memory.Quantity.Val.Uint64())
+	}
+
+	if to.Memory != nil {
+		tmem = exportQuantity(to.Memory.Quantity.Val.Uint64())
+	}
+
+	d.value(ScopeGroup, subPath(path, "memory", "size"), fmem, tmem)
+
+	var fgpu, tgpu string
+	var fgpuAttrs, tgpuAttrs types.Attributes
+
+	if from.GPU != nil && !from.GPU.Units.Val.IsZero() {
+		fgpu = from.GPU.Units.Val.String()
+		fgpuAttrs = from.GPU.Attributes
+	}
+
+	if to.GPU != nil && !to.GPU.Units.Val.IsZero() {
+		tgpu = to.GPU.Units.Val.String()
+		tgpuAttrs = to.GPU.Attributes
+	}
+
+	d.value(ScopeGroup, subPath(path, "gpu", "units"), fgpu, tgpu)
+	d.values(ScopeGroup, subPath(path, "gpu", "attributes"), attributesMap(fgpuAttrs), attributesMap(tgpuAttrs))
+
+	fromStorage := make(map[string]types.Storage)
+	toStorage := make(map[string]types.Storage)
+
+	for _, volume := range from.Storage {
+		fromStorage[volume.Name] = volume
+	}
+
+	for _, volume := range to.Storage {
+		toStorage[volume.Name] = volume
+	}
+
+	for _, name := range unionKeys(fromStorage, toStorage) {
+		spath := subPath(path, "storage", name)
+
+		fvol, fexists := fromStorage[name]
+		tvol, texists := toStorage[name]
+
+		switch {
+		case !fexists:
+			d.add(ScopeGroup, ChangeAdded, spath, "", exportQuantity(tvol.Quantity.Val.Uint64()))
+			continue
+		case !texists:
+			d.add(ScopeGroup, ChangeRemoved, spath, exportQuantity(fvol.Quantity.Val.Uint64()), "")
+			continue
+		}
+
+		d.value(ScopeGroup, subPath(spath, "size"),
+			exportQuantity(fvol.Quantity.Val.Uint64()), exportQuantity(tvol.Quantity.Val.Uint64()))
+		d.values(ScopeGroup, subPath(spath, "attributes"), attributesMap(fvol.Attributes), attributesMap(tvol.Attributes))
+	}
+
+	d.value(ScopeGroup, subPath(path, "endpoints"), formatEndpoints(from.Endpoints), formatEndpoints(to.Endpoints))
+}
+
+func (d *differ) manifest(from, to manifest.Manifest) {
+	fromServices := make(map[string]manifest.Service)
+	toServices := make(map[string]manifest.Service)
+
+	for _, group := range from {
+		for _, svc := range group.Services {
+			fromServices[group.Name+"."+svc.Name] = svc
+		}
+	}
+
+	for _, group := range to {
+		for _, svc := range group.Services {
+			toServices[group.Name+"."+svc.Name] = svc
+		}
+	}
+
+	for _, key := range unionKeys(fromServices, toServices) {
+		group, name, _ := strings.Cut(key, ".")
+		path := []string{group, "services", name}
+
+		fsvc, fexists := fromServices[key]
+		tsvc, texists := toServices[key]
+
+		// services deployed to a group are its resources, adding or removing one changes the group spec
+		switch {
+		case !fexists:
+			d.add(ScopeGroup, ChangeAdded, path, "", tsvc.Image)
+			continue
+		case !texists:
+			d.add(ScopeGroup, ChangeRemoved, path, fsvc.Image, "")
+			continue
+		}
+
+		d.value(ScopeGroup, subPath(path, "count"), formatUint(fsvc.Count), formatUint(tsvc.Count))
+		d.value(ScopeManifest, subPath(path, "image"), fsvc.Image, tsvc.Image)
+		d.list(ScopeManifest, subPath(path, "command"), fsvc.Command, tsvc.Command)
+		d.list(ScopeManifest, subPath(path, "args"), fsvc.Args, tsvc.Args)
+		d.values(ScopeManifest, subPath(path, "env"), envMap(fsvc.Env), envMap(tsvc.Env))
+		d.exposes(subPath(path, "expose"), fsvc.Expose, tsvc.Expose)
+		d.values(ScopeManifest, subPath(path, "params", "storage"), storageParamsMap(fsvc.Params), storageParamsMap(tsvc.Params))
+		d.values(ScopeManifest, subPath(path, "credentials"), credentialsMap(fsvc.Credentials), credentialsMap(tsvc.Credentials))
+	}
+}
+
+// lifecycles compares lifecycles of services present in both SDLs
+func (d *differ) lifecycles(from, to Lifecycles) {
+	for _, group := range unionKeys(from, to) {
+		for _, name := range unionKeys(from[group], to[group]) {
+			flc, fexists := from.Service(group, name)
+			tlc, texists := to.Service(group, name)
+
+			var fvals, tvals map[string]string
+			if fexists {
+				fvals = lifecycleMap(flc)
+			}
+
+			if texists {
+				tvals = lifecycleMap(tlc)
+			}
+
+			d.values(ScopeLifecycle, []string{group, "services", name}, fvals, tvals)
+		}
+	}
+}
+
+// exposes compares exposes as sets as manifest keeps them sorted
+func (d *differ) exposes(path []string, from, to manifest.ServiceExposes) {
+	fromExposes := make(map[string]bool)
+	toExposes := make(map[string]bool)
+
+	for _, expose := range from {
+		fromExposes[formatExpose(expose)] = true
+	}
+
+	for _, expose := range to {
+		toExposes[formatExpose(expose)] = true
+	}
+
+	for _, expose := range unionKeys(fromExposes, toExposes) {
+		switch {
+		case !fromExposes[expose]:
+			d.add(ScopeManifest, ChangeAdded, path, "", expose)
+		case !toExposes[expose]:
+			d.add(ScopeManifest, ChangeRemoved, path, expose, "")
+		}
+	}
+}
+
+func unionKeys[V any](from, to map[string]V) []string {
+	keys := make([]string, 0, len(from)+len(to))
+
+	for key := range from {
+		keys = append(keys, key)
+	}
+
+	for key := range to {
+		if _, exists := from[key]; !exists {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+func subPath(path []string, elems ...string) []string {
+	return append(append(make([]string, 0, len(path)+len(elems)), path...), elems...)
+}
+
+func attributesMap(attrs types.Attributes) map[string]string {
+	res := make(map[string]string, len(attrs))
+	for _, attr := range attrs {
+		res[attr.Key] = attr.Value
+	}
+
+	return res
+}
+
+func envMap(env []string) map[string]string {
+	res := make(map[string]string, len(env))
+	for _, item := range env {
+		key, val, _ := strings.Cut(item, "=")
+		// keep variables set to empty value apart from absent ones
+		res[key] = strconv.Quote(val)
+	}
+
+	return res
+}
+
+func storageParamsMap(params *manifest.ServiceParams) map[string]string {
+	res := make(map[string]string)
+	if params == nil {
+		return res
+	}
+
+	for _, storage := range params.Storage {
+		val := storage.Mount
+		if storage.ReadOnly {
+			val += " (read only)"
+		}
+
+		res[storage.Name] = val
+	}
+
+	return res
+}
+
+func credentialsMap(creds *manifest.ServiceImageCredentials) map[string]string {
+	res := make(map[string]string)
+	if creds == nil {
+		return res
+	}
+
+	res["host"] = creds.Host
+	res["email"] = creds.Email
+	res["username"] = creds.Username
+
+	// password is never shown, only the fact it has changed
+	if creds.Password != "" {
+		res["password"] = redacted + " " + shortHash(creds.Password)
+	}
+
+	return res
+}
+
+func formatList(items []string) string {
+	if len(items) == 0 {
+		return ""
+	}
+
+	quoted := make([]string, 0, len(items))
+	for _, item := range items {
+		quoted = append(quoted, strconv.Quote(item))
+	}
+
+	return "[" + strings.Join(quoted, ", ") + "]"
+}
+
+func formatUint(val uint32) string {
+	return strconv.FormatUint(uint64(val), 10)
+}
+
+func formatResourceUnit(ru dtypes.ResourceUnit) string {
+	return fmt.Sprintf("count %d, price %s", ru.Count, formatPrice(ru.Price))
+}
+
+func formatEndpoints(endpoints types.Endpoints) string {
+	items := make([]string, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		items = append(items, fmt.Sprintf("%s/%d", endpoint.Kind, endpoint.SequenceNumber))
+	}
+
+	sort.Strings(items)
+
+	return strings.Join(items, ",")
+}
+
+func formatExpose(expose manifest.ServiceExpose) string {
+	var sb strings.Builder
+
+	_, _ = fmt.Fprintf(&sb, "%d/%s", expose.Port, strings.ToLower(string(expose.Proto)))
+
+	if expose.ExternalPort != 0 {
+		_, _ = fmt.Fprintf(&sb, " as %d", expose.ExternalPort)
+	}
+
+	switch {
+	case expose.Global:
+		sb.WriteString(" to global")
+	case expose.Service != "":
+		sb.WriteString(" to " + expose.Service)
+	}
+
+	if expose.IP != "" {
+		sb.WriteString(" ip " + expose.IP)
+	}
+
+	if len(expose.Hosts) > 0 {
+		sb.WriteString(" accept " + strings.Join(expose.Hosts, ","))
+	}
+
+	opts := expose.HTTPOptions
+	_, _ = fmt.Fprintf(&sb, " http_options{max_body_size:%d read_timeout:%d send_timeout:%d next_tries:%d next_timeout:%d next_cases:%s}",
+		opts.MaxBodySize, opts.ReadTimeout, opts.SendTimeout, opts.NextTries, opts.NextTimeout, strings.Join(opts.NextCases, ","))
+
+	return sb.String()
+}
+
+// shortHash identifies secret value without revealing it
+func shortHash(val string) string {
+	sum := sha256.Sum256([]byte(val))
+	return hex.EncodeToString(sum[:4])
+}
+
+func formatPrice(price sdk.DecCoin) string {
+	return exportDec(price.Amount.String()) + price.Denom
+}
+
+func lifecycleMap(lifecycle ServiceLifecycle) map[string]string {
+	res := map[string]string{
+		"shutdown_timeout":       formatUint(lifecycle.ShutdownTimeout),
+		"restart_policy":         lifecycle.RestartPolicy,
+		"update.max_surge":       lifecycle.Update.MaxSurge,
+		"update.max_unavailable": lifecycle.Update.MaxUnavailable,
+	}
+
+	if lifecycle.Readiness != nil {
+		res["health.readiness"] = formatProbe(*lifecycle.Readiness)
+	}
+
+	if lifecycle.Liveness != nil {
+		res["health.liveness"] = formatProbe(*lifecycle.Liveness)
+	}
+
+	return res
+}
+
+func formatProbe(probe Probe) string {
+	var check string
+
+	switch {
+	case probe.HTTP != nil:
+		check = fmt.Sprintf("http %d%s", probe.HTTP.Port, probe.HTTP.Path)
+	case probe.TCP != nil:
+		check = fmt.Sprintf("tcp %d", probe.TCP.Port)
+	case probe.Exec != nil:
+		check = "exec " + formatList(probe.Exec.Command)
+	}
+
+	return fmt.Sprintf("%s initial_delay:%d period:%d timeout:%d success_threshold:%d failure_threshold:%d",
+		check, probe.InitialDelay, probe.Period, probe.Timeout, probe.SuccessThreshold, probe.FailureThreshold)
+}
@@ -0,0 +1,95 @@
+package sdl
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func readModified(t *testing.T, file string, replacements ...string) SDL {
+	t.Helper()
+
+	buf, err := os.ReadFile(file)
+	require.NoError(t, err)
+
+	obj, err := Read([]byte(strings.NewReplacer(replacements...).Replace(string(buf))))
+	require.NoError(t, err)
+
+	return obj
+}
+
+func TestCompareEqual(t *testing.T) {
+	from := readModified(t, "_testdata/v2.1-simple.yaml")
+	to := readModified(t, "_testdata/v2.1-simple.yaml")
+
+	diff, err := Compare(from, to)
+	require.NoError(t, err)
+	require.True(t, diff.Empty())
+	require.False(t, diff.ChangesGroups())
+}
+
+func TestCompareManifestOnly(t *testing.T) {
+	from := readModified(t, "_testdata/v2.1-simple.yaml")
+	to := readModified(t, "_testdata/v2.1-simple.yaml",
+		"image: nginx", "image: nginx:1.25\n    env:\n      - MODE=prod",
+		"proto: udp", "proto: tcp",
+	)
+
+	diff, err := Compare(from, to)
+	require.NoError(t, err)
+	require.False(t, diff.ChangesGroups())
+
+	require.Equal(t, Diff{
+		{Path: "westcoast.services.web.env.MODE", Kind: ChangeAdded, Scope: ScopeManifest, New: `"prod"`},
+		{
+			Path:  "westcoast.services.web.expose",
+			Kind:  ChangeAdded,
+			Scope: ScopeManifest,
+			New:   "12345/tcp to global http_options{max_body_size:1048576 read_timeout:60000 send_timeout:60000 next_tries:3 next_timeout:0 next_cases:error,timeout}",
+		},
+		{
+			Path:  "westcoast.services.web.expose",
+			Kind:  ChangeRemoved,
+			Scope: ScopeManifest,
+			Old:   "12345/udp to global http_options{max_body_size:1048576 read_timeout:60000 send_timeout:60000 next_tries:3 next_timeout:0 next_cases:error,timeout}",
+		},
+		{Path: "westcoast.services.web.image", Kind: ChangeModified, Scope: ScopeManifest, Old: "nginx", New: "nginx:1.25"},
+	}, diff)
+}
+
+func TestCompareGroupChanges(t *testing.T) {
+	from := readModified(t, "_testdata/v2.1-simple.yaml")
+	to := readModified(t, "_testdata/v2.1-simple.yaml",
+		`units: "100m"`, `units: "200m"`,
+		"region: us-west", "region: us-east",
+		"amount: 50", "amount: 75",
+		"count: 2", "count: 3",
+	)
+
+	diff, err := Compare(from, to)
+	require.NoError(t, err)
+	require.True(t, diff.ChangesGroups())
+
+	require.Equal(t, Diff{
+		{Path: "westcoast.requirements.attributes.region", Kind: ChangeModified, Scope: ScopeGroup, Old: "us-west", New: "us-east"},
+		{Path: "westcoast.resources.1.count", Kind: ChangeModified, Scope: ScopeGroup, Old: "2", New: "3"},
+		{Path: "westcoast.resources.1.cpu.units", Kind: ChangeModified, Scope: ScopeGroup, Old: "100m", New: "200m"},
+		{Path: "westcoast.resources.1.price", Kind: ChangeModified, Scope: ScopeGroup, Old: "50uakt", New: "75uakt"},
+		{Path: "westcoast.services.web.count", Kind: ChangeModified, Scope: ScopeGroup, Old: "2", New: "3"},
+	}, diff)
+}
+
+func TestCompareCredentialsRedacted(t *testing.T) {
+	from := readModified(t, "_testdata/v2.1-credentials.yaml")
+	to := readModified(t, "_testdata/v2.1-credentials.yaml", `password: "foo"`, `password: "bar"`)
+
+	diff, err := Compare(from, to)
+	require.NoError(t, err)
+	require.Len(t, diff, 1)
+	require.Equal(t, ScopeManifest, diff[0].Scope)
+	require.Contains(t, diff[0].Old, redacted)
+	require.NotContains(t, diff.String(), "bar")
+	require.NotContains(t, diff.String(), "foo")
+}
@@ -0,0 +1,324 @@
+package sdl
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Error codes identify kind of SDL problem independently of its message
+const (
+	ErrCodeInvalid         = "invalid"
+	ErrCodeParse           = "parse"
+	ErrCodeUnexpectedField = "unexpected-field"
+	ErrCodeInvalidName     = "invalid-name"
+	ErrCodeInvalidValue    = "invalid-value"
+	ErrCodeUndefined       = "undefined"
+	ErrCodeConflict        = "conflict"
+	ErrCodeUnused          = "unused"
+	ErrCodeVariable        = "variable"
+	ErrCodeInclude         = "include"
+	ErrCodeGroup           = "group"
+	ErrCodeManifest        = "manifest"
+)
+
+var (
+	yamlLineRegex = regexp.MustCompile(`^(?:yaml: )?line (\d+): (.*)$`)
+)
+
+// Error is a problem found in SDL tied to its source.
+// File, Line and Column are set when the source is known, Path is YAML path of the offending node.
+type Error struct {
+	File    string `json:"file,omitempty"`
+	Line    int    `json:"line,omitempty"`
+	Column  int    `json:"column,omitempty"`
+	Path    string `json:"path,omitempty"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+
+	err  error
+	path []string
+	node *yaml.Node
+}
+
+var _ error = (*Error)(nil)
+
+func (e *Error) Error() string {
+	var prefix string
+
+	if e.File != "" {
+		prefix = e.File + ":"
+	}
+
+	if e.Line > 0 {
+		prefix += fmt.Sprintf("%d:%d:", e.Line, e.Column)
+	}
+
+	if prefix != "" {
+		return prefix + " " + e.Message
+	}
+
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.err
+}
+
+// Errors is a list of problems found in SDL
+type Errors []*Error
+
+var _ error = (Errors)(nil)
+
+func (e Errors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, err := range e {
+		msgs = append(msgs, err.Error())
+	}
+
+	return strings.Join(msgs, "\n")
+}
+
+func (e Errors) Unwrap() []error {
+	res := make([]error, 0, len(e))
+	for _, err := range e {
+		res = append(res, err)
+	}
+
+	return res
+}
+
+// AsErrors returns problems err consists of. Errors which are not tied to SDL source are returned without position.
+func AsErrors(err error) Errors {
+	if err == nil {
+		return nil
+	}
+
+	var errs Errors
+	if errors.As(err, &errs) {
+		return errs
+	}
+
+	var serr *Error
+	if errors.As(err, &serr) {
+		return Errors{serr}
+	}
+
+	var terr *yaml.TypeError
+	if errors.As(err, &terr) {
+		res := make(Errors, 0, len(terr.Errors))
+		for _, msg := range terr.Errors {
+			res = append(res, newLineError(ErrCodeParse, msg, err))
+		}
+
+		return res
+	}
+
+	code := ErrCodeInvalid
+
+	switch {
+	case errors.Is(err, errSDLInclude):
+		code = ErrCodeInclude
+	case errors.Is(err, errSDLVariable):
+		code = ErrCodeVariable
+	case !errors.Is(err, errSDLInvalid) && yamlLineRegex.MatchString(err.Error()):
+		code = ErrCodeParse
+	}
+
+	return Errors{newLineError(code, err.Error(), err)}
+}
+
+// newLineError creates error from message which may be prefixed with line number the way yaml parser reports it
+func newLineError(code string, msg string, err error) *Error {
+	res := &Error{
+		Code:    code,
+		Message: msg,
+		err:     err,
+	}
+
+	if match := yamlLineRegex.FindStringSubmatch(strings.TrimSpace(msg)); match != nil {
+		res.Line, _ = strconv.Atoi(match[1])
+		res.Message = match[2]
+	}
+
+	return res
+}
+
+// newPathError creates error tied to node at given YAML path.
+// Position of the node is resolved once the error is returned by Read or ReadFile.
+func newPathError(code string, err error, path ...string) *Error {
+	return &Error{
+		Path:    formatPath(path),
+		Code:    code,
+		Message: err.Error(),
+		err:     err,
+		path:    path,
+	}
+}
+
+// newNodeError creates error tied to given node
+func newNodeError(node *yaml.Node, code string, err error) *Error {
+	return &Error{
+		Line:    node.Line,
+		Column:  node.Column,
+		Code:    code,
+		Message: err.Error(),
+		err:     err,
+		node:    node,
+	}
+}
+
+// errorList collects problems found during validation
+type errorList struct {
+	errs Errors
+}
+
+func (l *errorList) add(code string, err error, path ...string) {
+	res := newPathError(code, err, path...)
+
+	// same problem is found once per placement service is deployed to
+	for _, existing := range l.errs {
+		if existing.Path == res.Path && existing.Message == res.Message {
+			return
+		}
+	}
+
+	l.errs = append(l.errs, res)
+}
+
+func (l *errorList) err() error {
+	if len(l.errs) == 0 {
+		return nil
+	}
+
+	return l.errs
+}
+
+// pathIndex formats index of sequence item as YAML path element
+func pathIndex(idx int) string {
+	return "[" + strconv.Itoa(idx) + "]"
+}
+
+func formatPath(path []string) string {
+	var sb strings.Builder
+
+	for _, elem := range path {
+		if sb.Len() > 0 && !strings.HasPrefix(elem, "[") {
+			sb.WriteString(".")
+		}
+		sb.WriteString(elem)
+	}
+
+	return sb.String()
+}
+
+// sourceFiles maps nodes to files they have been read from
+type sourceFiles map[*yaml.Node]string
+
+func (s sourceFiles) add(file string, node *yaml.Node) {
+	if node == nil {
+		return
+	}
+
+	s[node] = file
+
+	for _, child := range node.Content {
+		s.add(file, child)
+	}
+}
+
+// resolveErrors sets position of SDL errors within err from doc and sorts them by position.
+// Errors which are not tied to SDL source are returned unchanged.
+func resolveErrors(err error, doc *yaml.Node, files sourceFiles, file string) error {
+	var errs Errors
+	var serr *Error
+
+	switch {
+	case errors.As(err, &errs):
+	case errors.As(err, &serr):
+		errs = Errors{serr}
+	default:
+		return err
+	}
+
+	var root *yaml.Node
+	if doc != nil {
+		root = documentMapping(doc)
+	}
+
+	for _, e := range errs {
+		node := e.node
+		if node == nil && e.path != nil && root != nil {
+			node = lookupPath(root, e.path)
+		}
+
+		if node != nil {
+			e.Line = node.Line
+			e.Column = node.Column
+			e.File = files[node]
+		}
+
+		if e.File == "" {
+			e.File = file
+		}
+	}
+
+	sort.SliceStable(errs, func(i, j int) bool {
+		if errs[i].File != errs[j].File {
+			return errs[i].File < errs[j].File
+		}
+
+		if errs[i].Line != errs[j].Line {
+			return errs[i].Line < errs[j].Line
+		}
+
+		return errs[i].Column < errs[j].Column
+	})
+
+	if len(errs) == 1 {
+		return errs[0]
+	}
+
+	return errs
+}
+
+// lookupPath returns node at given path within root. If path does not exist the deepest existing node is returned.
+// Collections are reported by their key, as block collections start on the line after it.
+func lookupPath(root *yaml.Node, path []string) *yaml.Node {
+	node := root
+	res := root
+
+	for _, elem := range path {
+		switch {
+		case node.Kind == yaml.MappingNode:
+			idx := mappingKeyIndex(node, elem)
+			if idx < 0 {
+				return res
+			}
+
+			res = node.Content[idx]
+			node = node.Content[idx+1]
+
+			if node.Kind == yaml.ScalarNode {
+				res = node
+			}
+		case node.Kind == yaml.SequenceNode && strings.HasPrefix(elem, "["):
+			idx, err := strconv.Atoi(strings.Trim(elem, "[]"))
+			if err != nil || idx < 0 || idx >= len(node.Content) {
+				return res
+			}
+
+			node = node.Content[idx]
+			res = node
+		default:
+			return res
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,69 @@
+package sdl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSDLErrorsPositioned(t *testing.T) {
+	_, err := ReadFile("_testdata/v2.1-invalid.yaml")
+	require.ErrorIs(t, err, errSDLInvalid)
+
+	errs := AsErrors(err)
+	require.Len(t, errs, 4)
+
+	type expected struct {
+		line int
+		path string
+		code string
+	}
+
+	exp := []expected{
+		{22, "profiles.placement.westcoast.pricing", ErrCodeUndefined},
+		{29, "deployment.web.westcoast.profile", ErrCodeUndefined},
+		{31, "deployment.api", ErrCodeUndefined},
+		{36, "endpoints.unused", ErrCodeUnused},
+	}
+
+	for idx, e := range errs {
+		require.Equal(t, "_testdata/v2.1-invalid.yaml", e.File)
+		require.Equal(t, exp[idx].line, e.Line, e.Error())
+		require.Equal(t, exp[idx].path, e.Path)
+		require.Equal(t, exp[idx].code, e.Code)
+	}
+}
+
+func TestSDLErrorsIncludedFile(t *testing.T) {
+	_, err := ReadFile("_testdata/include/conflict.yaml")
+
+	errs := AsErrors(err)
+	require.Len(t, errs, 1)
+	require.Equal(t, "_testdata/include/services.yaml", errs[0].File)
+	require.Equal(t, ErrCodeConflict, errs[0].Code)
+	require.Equal(t, 5, errs[0].Line)
+}
+
+func TestSDLErrorsUnexpectedField(t *testing.T) {
+	_, err := Read([]byte(`---
+version: "2.1"
+service:
+  web:
+    image: nginx
+`))
+
+	errs := AsErrors(err)
+	require.Len(t, errs, 1)
+	require.Equal(t, ErrCodeUnexpectedField, errs[0].Code)
+	require.Equal(t, 3, errs[0].Line)
+	require.Equal(t, 1, errs[0].Column)
+}
+
+func TestSDLErrorsSyntax(t *testing.T) {
+	_, err := Read([]byte("version: \"2.1\"\nservices: [\n"))
+
+	errs := AsErrors(err)
+	require.Len(t, errs, 1)
+	require.Equal(t, ErrCodeParse, errs[0].Code)
+	require.NotZero(t, errs[0].Line)
+}
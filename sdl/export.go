@@ -0,0 +1,495 @@
+package sdl
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/blang/semver/v4"
+	"gopkg.in/yaml.v3"
+
+	manifest "github.com/akash-network/akash-api/go/manifest/v2beta2"
+	dtypes "github.com/akash-network/akash-api/go/node/deployment/v1beta3"
+	"github.com/akash-network/akash-api/go/node/types/unit"
+	types "github.com/akash-network/akash-api/go/node/types/v1beta3"
+)
+
+var (
+	errSDLExport = fmt.Errorf("%w: export", errSDLInvalid)
+
+	gpuModelPrefix = "vendor/nvidia/model/"
+
+	// suffixes quantities are exported with, largest first
+	exportSuffixes = []struct {
+		suffix string
+		value  uint64
+	}{
+		{"Ei", unit.Ei},
+		{"Pi", unit.Pi},
+		{"Ti", unit.Ti},
+		{"Gi", unit.Gi},
+		{"Mi", unit.Mi},
+		{"Ki", unit.Ki},
+	}
+)
+
+// document is a generic SDL document, its keys are sorted when marshaled
+type document map[string]interface{}
+
+// Marshal returns canonical YAML form of SDL. Keys are sorted, defaults are resolved and
+// compute profiles are named after services using them.
+func Marshal(obj SDL) ([]byte, error) {
+	s, valid := obj.(*sdl)
+	if !valid || s.data == nil {
+		return nil, errUninitializedConfig
+	}
+
+	groups, err := s.DeploymentGroups()
+	if err != nil {
+		return nil, err
+	}
+
+	mani, err := s.Manifest()
+	if err != nil {
+		return nil, err
+	}
+
+	lifecycles, err := Lifecycle(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	return marshalGroups(s.Ver, groups, mani, lifecycles)
+}
+
+// MarshalGroups returns canonical YAML form of SDL of given version which results in given deployment groups and manifest.
+// Services of version 2.2 are given default lifecycle.
+func MarshalGroups(ver semver.Version, groups dtypes.GroupSpecs, mani manifest.Manifest) ([]byte, error) {
+	return marshalGroups(ver, groups, mani, nil)
+}
+
+func marshalGroups(ver semver.Version, groups dtypes.GroupSpecs, mani manifest.Manifest, lifecycles Lifecycles) ([]byte, error) {
+	if !ver.EQ(semver.MustParse("2.0.0")) && !ver.EQ(semver.MustParse("2.1.0")) && !ver.EQ(lifecycleVersion) {
+		return nil, fmt.Errorf("%w: unsupported version %q", errSDLExport, ver)
+	}
+
+	services := make(document)
+	computes := make(document)
+	placements := make(document)
+	deployments := make(document)
+	endpoints := make(document)
+
+	for _, mgroup := range mani {
+		var dgroup *dtypes.GroupSpec
+		for _, group := range groups {
+			if group.Name == mgroup.Name {
+				dgroup = group
+				break
+			}
+		}
+
+		if dgroup == nil {
+			return nil, fmt.Errorf("%w: no deployment group for manifest group %q", errSDLExport, mgroup.Name)
+		}
+
+		pricing := make(document)
+		placement := document{
+			"pricing": pricing,
+		}
+
+		if attrs := exportAttributes(dgroup.Requirements.Attributes); attrs != nil {
+			placement["attributes"] = attrs
+		}
+
+		if signedBy := exportSignedBy(dgroup.Requirements.SignedBy); signedBy != nil {
+			placement["signedBy"] = signedBy
+		}
+
+		placements[mgroup.Name] = placement
+
+		// services sharing resource unit of the group share compute profile
+		profiles := make(map[uint32]string)
+
+		for _, svc := range mgroup.Services {
+			var ru *dtypes.ResourceUnit
+			for idx := range dgroup.Resources {
+				if dgroup.Resources[idx].ID == svc.Resources.ID {
+					ru = &dgroup.Resources[idx]
+					break
+				}
+			}
+
+			if ru == nil {
+				return nil, fmt.Errorf("%w: %s.%s: no resources with id %d", errSDLExport, mgroup.Name, svc.Name, svc.Resources.ID)
+			}
+
+			profile, bound := profiles[ru.ID]
+			if !bound {
+				compute := document{"resources": exportResources(ru.Resources)}
+
+				profile = svc.Name
+				for suffix := 0; ; suffix++ {
+					if suffix == 1 {
+						profile = svc.Name + "-" + mgroup.Name
+					} else if suffix > 1 {
+						profile = fmt.Sprintf("%s-%s-%d", svc.Name, mgroup.Name, suffix)
+					}
+
+					existing, exists := computes[profile]
+					if !exists || reflect.DeepEqual(existing, compute) {
+						break
+					}
+				}
+
+				computes[profile] = compute
+				profiles[ru.ID] = profile
+
+				pricing[profile] = document{
+					"denom":  ru.Price.Denom,
+					"amount": exportDec(ru.Price.Amount.String()),
+				}
+			}
+
+			depl, exists := deployments[svc.Name].(document)
+			if !exists {
+				depl = make(document)
+				deployments[svc.Name] = depl
+			}
+
+			depl[mgroup.Name] = document{
+				"profile": profile,
+				"count":   svc.Count,
+			}
+
+			if _, exists := services[svc.Name]; exists {
+				continue
+			}
+
+			service := exportService(svc)
+
+			if lifecycle, exists := lifecycles.Service(mgroup.Name, svc.Name); exists {
+				exportLifecycle(service, lifecycle)
+			}
+
+			services[svc.Name] = service
+
+			for _, expose := range svc.Expose {
+				if expose.IP != "" {
+					endpoints[expose.IP] = document{"kind": endpointKindIP}
+				}
+			}
+		}
+	}
+
+	doc := document{
+		sdlVersionField: fmt.Sprintf("%d.%d", ver.Major, ver.Minor),
+		"services":      services,
+		"profiles": document{
+			"compute":   computes,
+			"placement": placements,
+		},
+		"deployment": deployments,
+	}
+
+	if len(endpoints) > 0 {
+		doc["endpoints"] = endpoints
+	}
+
+	buf := bytes.NewBufferString("---\n")
+
+	enc := yaml.NewEncoder(buf)
+	enc.SetIndent(2)
+
+	if err := enc.Encode(doc); err != nil {
+		return nil, err
+	}
+
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func exportService(svc manifest.Service) document {
+	res := document{
+		"image": svc.Image,
+	}
+
+	if len(svc.Command) > 0 {
+		res["command"] = svc.Command
+	}
+
+	if len(svc.Args) > 0 {
+		res["args"] = svc.Args
+	}
+
+	if len(svc.Env) > 0 {
+		res["env"] = svc.Env
+	}
+
+	if expose := exportExpose(svc.Expose); len(expose) > 0 {
+		res["expose"] = expose
+	}
+
+	if svc.Params != nil && len(svc.Params.Storage) > 0 {
+		storage := make(document)
+		for _, params := range svc.Params.Storage {
+			storage[params.Name] = document{
+				"mount":    params.Mount,
+				"readOnly": params.ReadOnly,
+			}
+		}
+
+		res["params"] = document{"storage": storage}
+	}
+
+	if creds := svc.Credentials; creds != nil {
+		credentials := document{
+			"host":     creds.Host,
+			"username": creds.Username,
+			"password": creds.Password,
+		}
+
+		if creds.Email != "" {
+			credentials["email"] = creds.Email
+		}
+
+		res["credentials"] = credentials
+	}
+
+	return res
+}
+
+// exportExpose merges manifest exposes, one per destination, back into SDL exposes with list of destinations
+func exportExpose(exposes manifest.ServiceExposes) []document {
+	var res []document
+	index := make(map[string]document)
+
+	for _, expose := range exposes {
+		key := fmt.Sprintf("%d/%d/%s/%v/%v", expose.Port, expose.ExternalPort, expose.Proto, expose.Hosts, expose.HTTPOptions)
+
+		item, exists := index[key]
+		if !exists {
+			item = document{
+				"port":  expose.Port,
+				"proto": strings.ToLower(string(expose.Proto)),
+				"http_options": document{
+					"max_body_size": expose.HTTPOptions.MaxBodySize,
+					"read_timeout":  expose.HTTPOptions.ReadTimeout,
+					"send_timeout":  expose.HTTPOptions.SendTimeout,
+					"next_tries":    expose.HTTPOptions.NextTries,
+					"next_timeout":  expose.HTTPOptions.NextTimeout,
+					"next_cases":    expose.HTTPOptions.NextCases,
+				},
+			}
+
+			if expose.ExternalPort != 0 {
+				item["as"] = expose.ExternalPort
+			}
+
+			if len(expose.Hosts) > 0 {
+				item["accept"] = expose.Hosts
+			}
+
+			index[key] = item
+			res = append(res, item)
+		}
+
+		to := make(document)
+
+		if expose.Service != "" {
+			to["service"] = expose.Service
+		}
+
+		if expose.Global {
+			to["global"] = true
+		}
+
+		if expose.IP != "" {
+			to["ip"] = expose.IP
+		}
+
+		if len(to) > 0 {
+			list, _ := item["to"].([]document)
+			item["to"] = append(list, to)
+		}
+	}
+
+	return res
+}
+
+func exportLifecycle(service document, lifecycle ServiceLifecycle) {
+	health := make(document)
+
+	if lifecycle.Readiness != nil {
+		health["readiness"] = exportProbe(*lifecycle.Readiness)
+	}
+
+	if lifecycle.Liveness != nil {
+		health["liveness"] = exportProbe(*lifecycle.Liveness)
+	}
+
+	if len(health) > 0 {
+		service["health"] = health
+	}
+
+	service["shutdown_timeout"] = lifecycle.ShutdownTimeout
+	service["restart_policy"] = lifecycle.RestartPolicy
+	service["update"] = document{
+		"max_surge":       lifecycle.Update.MaxSurge,
+		"max_unavailable": lifecycle.Update.MaxUnavailable,
+	}
+}
+
+func exportProbe(probe Probe) document {
+	res := document{
+		"initial_delay":     probe.InitialDelay,
+		"period":            probe.Period,
+		"timeout":           probe.Timeout,
+		"success_threshold": probe.SuccessThreshold,
+		"failure_threshold": probe.FailureThreshold,
+	}
+
+	switch {
+	case probe.HTTP != nil:
+		res["http"] = document{"path": probe.HTTP.Path, "port": probe.HTTP.Port}
+	case probe.TCP != nil:
+		res["tcp"] = document{"port": probe.TCP.Port}
+	case probe.Exec != nil:
+		res["exec"] = document{"command": probe.Exec.Command}
+	}
+
+	return res
+}
+
+func exportResources(res types.Resources) document {
+	doc := make(document)
+
+	if res.CPU != nil {
+		cpu := document{
+			"units": fmt.Sprintf("%dm", res.CPU.Units.Val.Uint64()),
+		}
+
+		if attrs := exportAttributes(res.CPU.Attributes); attrs != nil {
+			cpu["attributes"] = attrs
+		}
+
+		doc["cpu"] = cpu
+	}
+
+	if res.Memory != nil {
+		doc["memory"] = document{
+			"size": exportQuantity(res.Memory.Quantity.Val.Uint64()),
+		}
+	}
+
+	if res.GPU != nil && res.GPU.Units.Val.Uint64() > 0 {
+		doc["gpu"] = document{
+			"units":      res.GPU.Units.Val.Uint64(),
+			"attributes": exportGPUAttributes(res.GPU.Attributes),
+		}
+	}
+
+	storage := make([]document, 0, len(res.Storage))
+	for _, volume := range res.Storage {
+		item := document{
+			"name": volume.Name,
+			"size": exportQuantity(volume.Quantity.Val.Uint64()),
+		}
+
+		if attrs := exportAttributes(volume.Attributes); attrs != nil {
+			item["attributes"] = attrs
+		}
+
+		storage = append(storage, item)
+	}
+
+	if len(storage) > 0 {
+		doc["storage"] = storage
+	}
+
+	return doc
+}
+
+// exportGPUAttributes converts GPU attributes back into vendor and model specification
+func exportGPUAttributes(attrs types.Attributes) document {
+	models := make([]document, 0, len(attrs))
+
+	for _, attr := range attrs {
+		if !strings.HasPrefix(attr.Key, gpuModelPrefix) {
+			continue
+		}
+
+		parts := strings.Split(strings.TrimPrefix(attr.Key, gpuModelPrefix), "/")
+		if parts[0] == "*" {
+			continue
+		}
+
+		model := document{"model": parts[0]}
+		for i := 1; i+1 < len(parts); i += 2 {
+			model[parts[i]] = parts[i+1]
+		}
+
+		models = append(models, model)
+	}
+
+	return document{
+		"vendor": document{
+			"nvidia": models,
+		},
+	}
+}
+
+func exportAttributes(attrs types.Attributes) document {
+	if len(attrs) == 0 {
+		return nil
+	}
+
+	res := make(document, len(attrs))
+	for _, attr := range attrs {
+		res[attr.Key] = attr.Value
+	}
+
+	return res
+}
+
+func exportSignedBy(signedBy types.SignedBy) document {
+	res := make(document)
+
+	if len(signedBy.AllOf) > 0 {
+		res["allOf"] = signedBy.AllOf
+	}
+
+	if len(signedBy.AnyOf) > 0 {
+		res["anyOf"] = signedBy.AnyOf
+	}
+
+	if len(res) == 0 {
+		return nil
+	}
+
+	return res
+}
+
+// exportQuantity formats quantity with the largest binary suffix it is a multiple of
+func exportQuantity(val uint64) string {
+	for _, suffix := range exportSuffixes {
+		if val >= suffix.value && val%suffix.value == 0 {
+			return strconv.FormatUint(val/suffix.value, 10) + suffix.suffix
+		}
+	}
+
+	return strconv.FormatUint(val, 10)
+}
+
+// exportDec strips trailing zeros of decimal
+func exportDec(val string) string {
+	if strings.Contains(val, ".") {
+		val = strings.TrimRight(strings.TrimRight(val, "0"), ".")
+	}
+
+	return val
+}
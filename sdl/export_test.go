@@ -0,0 +1,86 @@
+package sdl
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarshalRoundTrip(t *testing.T) {
+	files := []string{
+		"_testdata/simple.yaml",
+		"_testdata/simple-with-ip.yaml",
+		"_testdata/storageClass6.yaml",
+		"_testdata/v2.1-simple.yaml",
+		"_testdata/v2.1-simple-gpu.yaml",
+		"_testdata/v2.1-simple-with-ip.yaml",
+		"_testdata/v2.1-service-mix.yaml",
+		"_testdata/v2.1-credentials.yaml",
+	}
+
+	for _, file := range files {
+		t.Run(file, func(t *testing.T) {
+			obj, err := ReadFile(file)
+			require.NoError(t, err)
+
+			buf, err := Marshal(obj)
+			require.NoError(t, err)
+
+			exported, err := Read(buf)
+			require.NoError(t, err, string(buf))
+
+			expectedGroups, err := obj.DeploymentGroups()
+			require.NoError(t, err)
+
+			groups, err := exported.DeploymentGroups()
+			require.NoError(t, err)
+			require.Equal(t, expectedGroups, groups)
+
+			expectedVersion, err := obj.Version()
+			require.NoError(t, err)
+
+			version, err := exported.Version()
+			require.NoError(t, err)
+			require.Equal(t, expectedVersion, version)
+
+			// canonical form is stable
+			again, err := Marshal(exported)
+			require.NoError(t, err)
+			require.Equal(t, string(buf), string(again))
+		})
+	}
+}
+
+func TestMarshalResolvesDefaults(t *testing.T) {
+	obj, err := ReadFile("_testdata/storageClass6.yaml")
+	require.NoError(t, err)
+
+	buf, err := Marshal(obj)
+	require.NoError(t, err)
+
+	out := string(buf)
+	require.Contains(t, out, "max_body_size: 1048576")
+	require.Contains(t, out, "class: default")
+	require.Contains(t, out, "units: 100m")
+}
+
+func TestMarshalUninitialized(t *testing.T) {
+	_, err := Marshal(&sdl{})
+	require.ErrorIs(t, err, errUninitializedConfig)
+}
+
+func TestJSONSchema(t *testing.T) {
+	for _, version := range []string{"2.0", "2.1", "2.2"} {
+		buf, err := JSONSchema(version)
+		require.NoError(t, err)
+
+		var res map[string]interface{}
+		require.NoError(t, json.Unmarshal(buf, &res))
+		require.Equal(t, jsonSchemaDraft, res["$schema"])
+		require.Contains(t, res["properties"], "services")
+	}
+
+	_, err := JSONSchema("1.0")
+	require.Error(t, err)
+}
@@ -19,7 +19,13 @@ type groupsBuilderV2_1 struct {
 func (sdl *v2_1) buildGroups() error {
 	endpointsNames := sdl.computeEndpointSequenceNumbers()
 
+	svcLifecycles, err := sdl.serviceLifecycles()
+	if err != nil {
+		return err
+	}
+
 	groups := make(map[string]*groupsBuilderV2_1)
+	lifecycles := make(Lifecycles)
 
 	for _, svcName := range sdl.Deployments.svcNames() {
 		depl := sdl.Deployments[svcName]
@@ -134,6 +140,14 @@ func (sdl *v2_1) buildGroups() error {
 			}
 
 			group.mgroup.Services = append(group.mgroup.Services, msvc)
+
+			if lifecycle, exists := svcLifecycles[svcName]; exists {
+				if _, exists := lifecycles[placementName]; !exists {
+					lifecycles[placementName] = make(map[string]ServiceLifecycle)
+				}
+
+				lifecycles[placementName][svcName] = lifecycle
+			}
 		}
 	}
 
@@ -147,6 +161,10 @@ func (sdl *v2_1) buildGroups() error {
 	sdl.result.dgroups = make(dtypes.GroupSpecs, 0, len(names))
 	sdl.result.mgroups = make(manifest.Groups, 0, len(names))
 
+	if svcLifecycles != nil {
+		sdl.result.lifecycles = lifecycles
+	}
+
 	for _, name := range names {
 		mgroup := *groups[name].mgroup
 		// stable ordering services by name
@@ -0,0 +1,306 @@
+package sdl
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/blang/semver/v4"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	sdlIncludeField = "include"
+)
+
+var (
+	errSDLInclude         = fmt.Errorf("%w: include", errSDLInvalid)
+	errSDLIncludeCycle    = fmt.Errorf("%w: cycle", errSDLInclude)
+	errSDLIncludeEscape   = fmt.Errorf("%w: path escapes base directory", errSDLInclude)
+	errSDLIncludeConflict = fmt.Errorf("%w: conflicting definition", errSDLInclude)
+	errSDLIncludeNoFile   = fmt.Errorf("%w: can only be resolved when reading SDL from file", errSDLInclude)
+)
+
+// includeResolver composes SDL document from a file and files it includes.
+// Includes are resolved relative to the including file and must stay within base directory,
+// which is the directory of the top level file. Each file is merged once even if included multiple times.
+type includeResolver struct {
+	base    string
+	display string
+	version *semver.Version
+	stack   map[string]bool
+	merged  map[string]bool
+	files   sourceFiles
+}
+
+// readFileNode reads SDL document from given path with all includes merged into it.
+// It returns the document along with files each of its nodes has been read from.
+func readFileNode(path string) (*yaml.Node, sourceFiles, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resolved, err := filepath.EvalSymlinks(abs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &includeResolver{
+		base:    filepath.Dir(resolved),
+		display: filepath.Dir(path),
+		stack:   make(map[string]bool),
+		merged:  make(map[string]bool),
+		files:   make(sourceFiles),
+	}
+
+	doc, err := r.resolve(resolved)
+	if err != nil {
+		return nil, nil, resolveErrors(err, nil, r.files, path)
+	}
+
+	return doc, r.files, nil
+}
+
+func (r *includeResolver) resolve(path string) (*yaml.Node, error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := &yaml.Node{}
+	if err := yaml.Unmarshal(buf, doc); err != nil {
+		perr := newLineError(ErrCodeParse, err.Error(), err)
+		perr.File = r.displayPath(path)
+
+		return nil, perr
+	}
+
+	r.files.add(r.displayPath(path), doc)
+
+	root := documentMapping(doc)
+	if root == nil {
+		return doc, nil
+	}
+
+	if err := r.checkVersion(path, root); err != nil {
+		return nil, err
+	}
+
+	idx := mappingKeyIndex(root, sdlIncludeField)
+	if idx < 0 {
+		return doc, nil
+	}
+
+	var includes []string
+	if err := root.Content[idx+1].Decode(&includes); err != nil {
+		return nil, fmt.Errorf("%w: %s: %s", errSDLInclude, path, err.Error())
+	}
+
+	// drop the directive, merged document is self-contained
+	root.Content = append(root.Content[:idx], root.Content[idx+2:]...)
+
+	r.stack[path] = true
+	defer delete(r.stack, path)
+
+	for _, include := range includes {
+		target, err := r.includePath(path, include)
+		if err != nil {
+			return nil, err
+		}
+
+		if r.stack[target] {
+			return nil, fmt.Errorf("%w: %s includes %q", errSDLIncludeCycle, path, include)
+		}
+
+		if r.merged[target] {
+			continue
+		}
+
+		idoc, err := r.resolve(target)
+		if err != nil {
+			return nil, err
+		}
+
+		r.merged[target] = true
+
+		if src := documentMapping(idoc); src != nil {
+			if err := mergeIncluded(root, src, include); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return doc, nil
+}
+
+// displayPath returns path the way it is reported in errors, relative to the directory of top level file as given
+func (r *includeResolver) displayPath(path string) string {
+	rel, err := filepath.Rel(r.base, path)
+	if err != nil {
+		return path
+	}
+
+	return filepath.Join(r.display, rel)
+}
+
+// includePath resolves include relative to the including file and ensures it does not escape base directory
+func (r *includeResolver) includePath(from string, include string) (string, error) {
+	target := include
+	if !filepath.IsAbs(target) {
+		target = filepath.Join(filepath.Dir(from), target)
+	}
+
+	resolved, err := filepath.EvalSymlinks(target)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s: %s", errSDLInclude, from, err.Error())
+	}
+
+	rel, err := filepath.Rel(r.base, resolved)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("%w: %s includes %q", errSDLIncludeEscape, from, include)
+	}
+
+	return resolved, nil
+}
+
+// checkVersion ensures all files declaring version declare the same one as the top level file
+func (r *includeResolver) checkVersion(path string, root *yaml.Node) error {
+	idx := mappingKeyIndex(root, sdlVersionField)
+	if idx < 0 {
+		return nil
+	}
+
+	ver, err := semver.ParseTolerant(root.Content[idx+1].Value)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	if r.version == nil {
+		r.version = &ver
+		return nil
+	}
+
+	if !ver.EQ(*r.version) {
+		return fmt.Errorf("%w: %s: version %q does not match %q", errSDLInclude, path, ver, r.version)
+	}
+
+	return nil
+}
+
+// mergeIncluded merges sections of included document into the including one
+func mergeIncluded(dst, src *yaml.Node, include string) error {
+	for i := 0; i < len(src.Content); i += 2 {
+		key := src.Content[i].Value
+		val := src.Content[i+1]
+
+		var err error
+
+		switch key {
+		case sdlVersionField:
+			continue
+		case "services", "endpoints", sdlVariablesField:
+			err = mergeSection(dst, key, val, 1, include)
+		case "deployment":
+			err = mergeSection(dst, key, val, 2, include)
+		case "profiles":
+			if val.Kind != yaml.MappingNode {
+				return fmt.Errorf("%w: %q: profiles must be a map", errSDLInclude, include)
+			}
+
+			profiles := mappingValue(dst, key)
+			if profiles == nil {
+				profiles = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+				dst.Content = append(dst.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: key}, profiles)
+			} else if profiles.Kind != yaml.MappingNode {
+				return fmt.Errorf("%w: profiles must be a map", errSDLInvalid)
+			}
+
+			for j := 0; j < len(val.Content) && err == nil; j += 2 {
+				switch pkey := val.Content[j].Value; pkey {
+				case "compute", "placement":
+					err = mergeSection(profiles, pkey, val.Content[j+1], 1, include, key)
+				default:
+					err = fmt.Errorf("%w: %q: unexpected field %s.%s", errSDLInclude, include, key, pkey)
+				}
+			}
+		default:
+			err = fmt.Errorf("%w: %q: unexpected field %s", errSDLInclude, include, key)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// mergeSection merges map src into the map under key of dst. Entries are merged depth levels deep,
+// entries defined on both sides at the last level are conflicts.
+func mergeSection(dst *yaml.Node, key string, src *yaml.Node, depth int, include string, parents ...string) error {
+	path := append(append([]string{}, parents...), key)
+
+	if src.Kind != yaml.MappingNode {
+		return fmt.Errorf("%w: %q: %s must be a map", errSDLInclude, include, strings.Join(path, "."))
+	}
+
+	target := mappingValue(dst, key)
+	if target == nil {
+		dst.Content = append(dst.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: key}, src)
+		return nil
+	}
+
+	if target.Kind != yaml.MappingNode {
+		return fmt.Errorf("%w: %s must be a map", errSDLInvalid, strings.Join(path, "."))
+	}
+
+	for i := 0; i < len(src.Content); i += 2 {
+		name := src.Content[i].Value
+
+		if mappingKeyIndex(target, name) < 0 {
+			target.Content = append(target.Content, src.Content[i], src.Content[i+1])
+			continue
+		}
+
+		if depth > 1 {
+			if err := mergeSection(target, name, src.Content[i+1], depth-1, include, path...); err != nil {
+				return err
+			}
+			continue
+		}
+
+		return newNodeError(src.Content[i], ErrCodeConflict,
+			fmt.Errorf("%w: %q: %s.%s is already defined", errSDLIncludeConflict, include, strings.Join(path, "."), name))
+	}
+
+	return nil
+}
+
+func documentMapping(doc *yaml.Node) *yaml.Node {
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return nil
+	}
+
+	return doc.Content[0]
+}
+
+func mappingKeyIndex(node *yaml.Node, key string) int {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	idx := mappingKeyIndex(node, key)
+	if idx < 0 {
+		return nil
+	}
+
+	return node.Content[idx+1]
+}
@@ -0,0 +1,75 @@
+package sdl
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSDLIncludeMerged(t *testing.T) {
+	obj, err := ReadFile("_testdata/include/main.yaml")
+	require.NoError(t, err)
+
+	flat, err := ReadFile("_testdata/include/flat.yaml")
+	require.NoError(t, err)
+
+	groups, err := obj.DeploymentGroups()
+	require.NoError(t, err)
+
+	expected, err := flat.DeploymentGroups()
+	require.NoError(t, err)
+	require.Equal(t, expected, groups)
+
+	version, err := obj.Version()
+	require.NoError(t, err)
+
+	expectedVersion, err := flat.Version()
+	require.NoError(t, err)
+	require.Equal(t, expectedVersion, version)
+}
+
+func TestSDLIncludeCycle(t *testing.T) {
+	_, err := ReadFile("_testdata/include/cycle-a.yaml")
+	require.ErrorIs(t, err, errSDLIncludeCycle)
+}
+
+func TestSDLIncludeConflict(t *testing.T) {
+	_, err := ReadFile("_testdata/include/conflict.yaml")
+	require.ErrorIs(t, err, errSDLIncludeConflict)
+	require.Contains(t, err.Error(), "services.web")
+}
+
+func TestSDLIncludeEscape(t *testing.T) {
+	_, err := ReadFile("_testdata/include/escape.yaml")
+	require.ErrorIs(t, err, errSDLIncludeEscape)
+}
+
+func TestSDLIncludeFromBuffer(t *testing.T) {
+	buf, err := os.ReadFile("_testdata/include/main.yaml")
+	require.NoError(t, err)
+
+	_, err = Read(buf)
+	require.ErrorIs(t, err, errSDLIncludeNoFile)
+}
+
+func TestSDLIncludeEmptyFromBuffer(t *testing.T) {
+	buf, err := os.ReadFile("_testdata/include/flat.yaml")
+	require.NoError(t, err)
+
+	flat, err := Read(buf)
+	require.NoError(t, err)
+
+	buf = bytes.Replace(buf, []byte("version: \"2.1\"\n"), []byte("version: \"2.1\"\ninclude: []\n"), 1)
+
+	obj, err := Read(buf)
+	require.NoError(t, err)
+
+	version, err := obj.Version()
+	require.NoError(t, err)
+
+	expected, err := flat.Version()
+	require.NoError(t, err)
+	require.Equal(t, expected, version)
+}
@@ -0,0 +1,407 @@
+package sdl
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/blang/semver/v4"
+)
+
+const (
+	RestartPolicyAlways    = "always"
+	RestartPolicyOnFailure = "on-failure"
+	RestartPolicyNever     = "never"
+
+	// probe timings are in seconds
+	defaultProbeInitialDelay     = uint32(0)
+	upperLimitProbeInitialDelay  = uint32(3600)
+	defaultProbePeriod           = uint32(10)
+	upperLimitProbePeriod        = uint32(300)
+	defaultProbeTimeout          = uint32(1)
+	upperLimitProbeTimeout       = uint32(60)
+	defaultProbeSuccessThreshold = uint32(1)
+	defaultProbeFailureThreshold = uint32(3)
+	upperLimitProbeThreshold     = uint32(100)
+
+	defaultShutdownTimeout    = uint32(30)
+	upperLimitShutdownTimeout = uint32(3600)
+
+	defaultRollingUpdateMaxSurge       = "25%"
+	defaultRollingUpdateMaxUnavailable = "25%"
+	upperLimitRollingUpdateInstances   = uint64(1000)
+)
+
+var (
+	// lifecycleVersion is the first SDL version services may declare health checks and rollout strategy with
+	lifecycleVersion = semver.MustParse("2.2.0")
+
+	errLifecycleNotAllowed = errors.New("lifecycle option not allowed")
+	errLifecycleVersion    = fmt.Errorf("%w: requires SDL version %d.%d", errLifecycleNotAllowed, lifecycleVersion.Major, lifecycleVersion.Minor)
+)
+
+type v2HTTPProbe struct {
+	Path string `yaml:"path"`
+	Port uint32 `yaml:"port"`
+}
+
+type v2TCPProbe struct {
+	Port uint32 `yaml:"port"`
+}
+
+type v2ExecProbe struct {
+	Command []string `yaml:"command"`
+}
+
+type v2Probe struct {
+	HTTP             *v2HTTPProbe `yaml:"http,omitempty"`
+	TCP              *v2TCPProbe  `yaml:"tcp,omitempty"`
+	Exec             *v2ExecProbe `yaml:"exec,omitempty"`
+	InitialDelay     uint32       `yaml:"initial_delay"`
+	Period           uint32       `yaml:"period"`
+	Timeout          uint32       `yaml:"timeout"`
+	SuccessThreshold uint32       `yaml:"success_threshold"`
+	FailureThreshold uint32       `yaml:"failure_threshold"`
+}
+
+type v2ServiceHealth struct {
+	Readiness *v2Probe `yaml:"readiness,omitempty"`
+	Liveness  *v2Probe `yaml:"liveness,omitempty"`
+}
+
+type v2ServiceUpdate struct {
+	MaxSurge       string `yaml:"max_surge"`
+	MaxUnavailable string `yaml:"max_unavailable"`
+}
+
+// HTTPProbe checks service by HTTP GET request, any status from 200 to 399 is a success
+type HTTPProbe struct {
+	Path string `json:"path"`
+	Port uint32 `json:"port"`
+}
+
+// TCPProbe checks service by opening TCP connection
+type TCPProbe struct {
+	Port uint32 `json:"port"`
+}
+
+// ExecProbe checks service by running command in its container, zero exit code is a success
+type ExecProbe struct {
+	Command []string `json:"command"`
+}
+
+// Probe is a health check of service with defaults resolved. Timings are in seconds.
+type Probe struct {
+	HTTP             *HTTPProbe `json:"http,omitempty"`
+	TCP              *TCPProbe  `json:"tcp,omitempty"`
+	Exec             *ExecProbe `json:"exec,omitempty"`
+	InitialDelay     uint32     `json:"initial_delay"`
+	Period           uint32     `json:"period"`
+	Timeout          uint32     `json:"timeout"`
+	SuccessThreshold uint32     `json:"success_threshold"`
+	FailureThreshold uint32     `json:"failure_threshold"`
+}
+
+// RollingUpdate limits instances created above and taken down below service count while it is updated.
+// Values are either instance counts or percentages of the count.
+type RollingUpdate struct {
+	MaxSurge       string `json:"max_surge"`
+	MaxUnavailable string `json:"max_unavailable"`
+}
+
+// ServiceLifecycle tells how to check health of service instances, stop and restart them and roll out updates
+type ServiceLifecycle struct {
+	Readiness       *Probe        `json:"readiness,omitempty"`
+	Liveness        *Probe        `json:"liveness,omitempty"`
+	ShutdownTimeout uint32        `json:"shutdown_timeout"`
+	RestartPolicy   string        `json:"restart_policy"`
+	Update          RollingUpdate `json:"update"`
+}
+
+// Lifecycles of services by manifest group and service name
+type Lifecycles map[string]map[string]ServiceLifecycle
+
+// Service returns lifecycle of service deployed to given group
+func (l Lifecycles) Service(group, service string) (ServiceLifecycle, bool) {
+	res, exists := l[group][service]
+	return res, exists
+}
+
+// Lifecycle returns validated lifecycle of services for each of the manifest groups.
+// SDL prior to version 2.2 has no lifecycle.
+//
+// Manifest Service type is defined by akash-api and has no lifecycle fields yet, so lifecycle is neither
+// part of the manifest nor of the deployment version and providers do not receive it. Carrying it into
+// manifest groups returned by Manifest() waits on the manifest type being extended upstream.
+func Lifecycle(obj SDL) (Lifecycles, error) {
+	s, valid := obj.(*sdl)
+	if !valid || s.data == nil {
+		return nil, errUninitializedConfig
+	}
+
+	if data, valid := s.data.(*v2_1); valid && data.result.lifecycles != nil {
+		return data.result.lifecycles, nil
+	}
+
+	return Lifecycles{}, nil
+}
+
+// validateLifecycleVersion reports lifecycle options used by SDL of version not supporting them
+func (svc v2Service) validateLifecycleVersion(errs *errorList, ver semver.Version, svcName string) {
+	if ver.GTE(lifecycleVersion) {
+		return
+	}
+
+	fields := []struct {
+		name string
+		set  bool
+	}{
+		{"health", svc.Health != nil},
+		{"shutdown_timeout", svc.ShutdownTimeout != nil},
+		{"restart_policy", svc.RestartPolicy != ""},
+		{"update", svc.Update != nil},
+	}
+
+	for _, field := range fields {
+		if field.set {
+			errs.add(ErrCodeUnexpectedField, fmt.Errorf("%w: %s: %w", errSDLInvalid, field.name, errLifecycleVersion),
+				"services", svcName, field.name)
+		}
+	}
+}
+
+// lifecycle validates lifecycle options of service and resolves their defaults
+func (svc v2Service) lifecycle(svcName string) (ServiceLifecycle, error) {
+	var errs errorList
+
+	path := []string{"services", svcName}
+
+	res := ServiceLifecycle{
+		ShutdownTimeout: defaultShutdownTimeout,
+		RestartPolicy:   RestartPolicyAlways,
+		Update: RollingUpdate{
+			MaxSurge:       defaultRollingUpdateMaxSurge,
+			MaxUnavailable: defaultRollingUpdateMaxUnavailable,
+		},
+	}
+
+	if svc.Health != nil {
+		if svc.Health.Readiness != nil {
+			res.Readiness = svc.Health.Readiness.asProbe(&errs, subPath(path, "health", "readiness"), false)
+		}
+
+		if svc.Health.Liveness != nil {
+			res.Liveness = svc.Health.Liveness.asProbe(&errs, subPath(path, "health", "liveness"), true)
+		}
+	}
+
+	if svc.ShutdownTimeout != nil {
+		if *svc.ShutdownTimeout > upperLimitShutdownTimeout {
+			errs.add(ErrCodeInvalidValue, fmt.Errorf("%w: shutdown timeout cannot be greater than %d s",
+				errLifecycleNotAllowed, upperLimitShutdownTimeout), subPath(path, "shutdown_timeout")...)
+		}
+
+		res.ShutdownTimeout = *svc.ShutdownTimeout
+	}
+
+	switch svc.RestartPolicy {
+	case "":
+	case RestartPolicyAlways, RestartPolicyOnFailure, RestartPolicyNever:
+		res.RestartPolicy = svc.RestartPolicy
+	default:
+		errs.add(ErrCodeInvalidValue, fmt.Errorf("%w: unknown restart policy %q, expected %s|%s|%s", errLifecycleNotAllowed,
+			svc.RestartPolicy, RestartPolicyAlways, RestartPolicyOnFailure, RestartPolicyNever), subPath(path, "restart_policy")...)
+	}
+
+	if svc.Update != nil {
+		upath := subPath(path, "update")
+
+		if svc.Update.MaxSurge != "" {
+			res.Update.MaxSurge = svc.Update.MaxSurge
+		}
+
+		if svc.Update.MaxUnavailable != "" {
+			res.Update.MaxUnavailable = svc.Update.MaxUnavailable
+		}
+
+		surge, surgeErr := parseRolloutLimit(res.Update.MaxSurge)
+		if surgeErr != nil {
+			errs.add(ErrCodeInvalidValue, surgeErr, subPath(upath, "max_surge")...)
+		}
+
+		unavailable, unavailableErr := parseRolloutLimit(res.Update.MaxUnavailable)
+		if unavailableErr != nil {
+			errs.add(ErrCodeInvalidValue, unavailableErr, subPath(upath, "max_unavailable")...)
+		}
+
+		if surgeErr == nil && unavailableErr == nil && surge == 0 && unavailable == 0 {
+			errs.add(ErrCodeInvalidValue, fmt.Errorf("%w: max surge and max unavailable cannot both be zero",
+				errLifecycleNotAllowed), upath...)
+		}
+	}
+
+	// failed liveness probe restarts the instance
+	if res.RestartPolicy == RestartPolicyNever && res.Liveness != nil {
+		errs.add(ErrCodeConflict, fmt.Errorf("%w: liveness probe restarts instances, which restart policy %q does not allow",
+			errLifecycleNotAllowed, RestartPolicyNever), subPath(path, "health", "liveness")...)
+	}
+
+	if err := errs.err(); err != nil {
+		return ServiceLifecycle{}, err
+	}
+
+	return res, nil
+}
+
+func (p *v2Probe) asProbe(errs *errorList, path []string, liveness bool) *Probe {
+	res := &Probe{
+		InitialDelay:     p.InitialDelay,
+		Period:           p.Period,
+		Timeout:          p.Timeout,
+		SuccessThreshold: p.SuccessThreshold,
+		FailureThreshold: p.FailureThreshold,
+	}
+
+	var kinds int
+
+	if p.HTTP != nil {
+		kinds++
+
+		if !strings.HasPrefix(p.HTTP.Path, "/") {
+			errs.add(ErrCodeInvalidValue, fmt.Errorf("%w: http probe path must be absolute", errLifecycleNotAllowed),
+				subPath(path, "http", "path")...)
+		}
+
+		validateProbePort(errs, subPath(path, "http", "port"), p.HTTP.Port)
+
+		res.HTTP = &HTTPProbe{Path: p.HTTP.Path, Port: p.HTTP.Port}
+	}
+
+	if p.TCP != nil {
+		kinds++
+
+		validateProbePort(errs, subPath(path, "tcp", "port"), p.TCP.Port)
+
+		res.TCP = &TCPProbe{Port: p.TCP.Port}
+	}
+
+	if p.Exec != nil {
+		kinds++
+
+		if len(p.Exec.Command) == 0 {
+			errs.add(ErrCodeInvalidValue, fmt.Errorf("%w: exec probe must have command", errLifecycleNotAllowed),
+				subPath(path, "exec", "command")...)
+		}
+
+		res.Exec = &ExecProbe{Command: p.Exec.Command}
+	}
+
+	if kinds != 1 {
+		errs.add(ErrCodeInvalidValue, fmt.Errorf("%w: probe must be exactly one of http, tcp or exec", errLifecycleNotAllowed), path...)
+	}
+
+	if res.InitialDelay == 0 {
+		res.InitialDelay = defaultProbeInitialDelay
+	} else if res.InitialDelay > upperLimitProbeInitialDelay {
+		errs.add(ErrCodeInvalidValue, fmt.Errorf("%w: initial delay cannot be greater than %d s",
+			errLifecycleNotAllowed, upperLimitProbeInitialDelay), subPath(path, "initial_delay")...)
+	}
+
+	if res.Period == 0 {
+		res.Period = defaultProbePeriod
+	} else if res.Period > upperLimitProbePeriod {
+		errs.add(ErrCodeInvalidValue, fmt.Errorf("%w: period cannot be greater than %d s",
+			errLifecycleNotAllowed, upperLimitProbePeriod), subPath(path, "period")...)
+	}
+
+	if res.Timeout == 0 {
+		res.Timeout = defaultProbeTimeout
+	} else if res.Timeout > upperLimitProbeTimeout {
+		errs.add(ErrCodeInvalidValue, fmt.Errorf("%w: timeout cannot be greater than %d s",
+			errLifecycleNotAllowed, upperLimitProbeTimeout), subPath(path, "timeout")...)
+	} else if res.Timeout > res.Period {
+		errs.add(ErrCodeInvalidValue, fmt.Errorf("%w: timeout cannot be greater than period",
+			errLifecycleNotAllowed), subPath(path, "timeout")...)
+	}
+
+	if res.SuccessThreshold == 0 {
+		res.SuccessThreshold = defaultProbeSuccessThreshold
+	} else if res.SuccessThreshold > upperLimitProbeThreshold {
+		errs.add(ErrCodeInvalidValue, fmt.Errorf("%w: success threshold cannot be greater than %d",
+			errLifecycleNotAllowed, upperLimitProbeThreshold), subPath(path, "success_threshold")...)
+	} else if liveness && res.SuccessThreshold != 1 {
+		errs.add(ErrCodeInvalidValue, fmt.Errorf("%w: success threshold of liveness probe must be 1",
+			errLifecycleNotAllowed), subPath(path, "success_threshold")...)
+	}
+
+	if res.FailureThreshold == 0 {
+		res.FailureThreshold = defaultProbeFailureThreshold
+	} else if res.FailureThreshold > upperLimitProbeThreshold {
+		errs.add(ErrCodeInvalidValue, fmt.Errorf("%w: failure threshold cannot be greater than %d",
+			errLifecycleNotAllowed, upperLimitProbeThreshold), subPath(path, "failure_threshold")...)
+	}
+
+	return res
+}
+
+func validateProbePort(errs *errorList, path []string, port uint32) {
+	if port == 0 || port > 65535 {
+		errs.add(ErrCodeInvalidValue, fmt.Errorf("%w: probe port must be within 1-65535", errLifecycleNotAllowed), path...)
+	}
+}
+
+// parseRolloutLimit parses rolling update limit, either instance count or percentage of service count
+func parseRolloutLimit(val string) (uint64, error) {
+	if pct, isPct := strings.CutSuffix(val, "%"); isPct {
+		res, err := strconv.ParseUint(pct, 10, 8)
+		if err != nil || res > 100 {
+			return 0, fmt.Errorf("%w: invalid percentage %q, expected 0%%-100%%", errLifecycleNotAllowed, val)
+		}
+
+		return res, nil
+	}
+
+	res, err := strconv.ParseUint(val, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: invalid value %q, expected instance count or percentage", errLifecycleNotAllowed, val)
+	}
+
+	if res > upperLimitRollingUpdateInstances {
+		return 0, fmt.Errorf("%w: cannot be greater than %d instances", errLifecycleNotAllowed, upperLimitRollingUpdateInstances)
+	}
+
+	return res, nil
+}
+
+// serviceLifecycles returns lifecycle of each deployed service, or nil if SDL version does not support them
+func (sdl *v2_1) serviceLifecycles() (map[string]ServiceLifecycle, error) {
+	if sdl.ver.LT(lifecycleVersion) {
+		return nil, nil
+	}
+
+	res := make(map[string]ServiceLifecycle)
+
+	var errs Errors
+
+	for _, svcName := range sdl.Deployments.svcNames() {
+		svc, exists := sdl.Services[svcName]
+		if !exists {
+			continue
+		}
+
+		lifecycle, err := svc.lifecycle(svcName)
+		if err != nil {
+			errs = append(errs, AsErrors(err)...)
+			continue
+		}
+
+		res[svcName] = lifecycle
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return res, nil
+}
@@ -0,0 +1,225 @@
+package sdl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLifecycle(t *testing.T) {
+	obj, err := ReadFile("_testdata/v2.2-lifecycle.yaml")
+	require.NoError(t, err)
+
+	lifecycles, err := Lifecycle(obj)
+	require.NoError(t, err)
+
+	web, exists := lifecycles.Service("westcoast", "web")
+	require.True(t, exists)
+	require.Equal(t, ServiceLifecycle{
+		Readiness: &Probe{
+			HTTP:             &HTTPProbe{Path: "/healthz", Port: 80},
+			InitialDelay:     5,
+			Period:           defaultProbePeriod,
+			Timeout:          defaultProbeTimeout,
+			SuccessThreshold: defaultProbeSuccessThreshold,
+			FailureThreshold: defaultProbeFailureThreshold,
+		},
+		Liveness: &Probe{
+			TCP:              &TCPProbe{Port: 80},
+			Period:           30,
+			Timeout:          5,
+			SuccessThreshold: defaultProbeSuccessThreshold,
+			FailureThreshold: defaultProbeFailureThreshold,
+		},
+		ShutdownTimeout: 60,
+		RestartPolicy:   RestartPolicyAlways,
+		Update:          RollingUpdate{MaxSurge: "1", MaxUnavailable: "0"},
+	}, web)
+
+	worker, exists := lifecycles.Service("westcoast", "worker")
+	require.True(t, exists)
+	require.Equal(t, ServiceLifecycle{
+		ShutdownTimeout: defaultShutdownTimeout,
+		RestartPolicy:   RestartPolicyOnFailure,
+		Update:          RollingUpdate{MaxSurge: defaultRollingUpdateMaxSurge, MaxUnavailable: defaultRollingUpdateMaxUnavailable},
+	}, worker)
+
+	// every service of the manifest has lifecycle
+	mani, err := obj.Manifest()
+	require.NoError(t, err)
+
+	for _, group := range mani {
+		for _, svc := range group.Services {
+			_, exists := lifecycles.Service(group.Name, svc.Name)
+			require.True(t, exists, svc.Name)
+		}
+	}
+}
+
+func TestLifecycleBeforeV2_2(t *testing.T) {
+	obj, err := ReadFile("_testdata/v2.1-simple.yaml")
+	require.NoError(t, err)
+
+	lifecycles, err := Lifecycle(obj)
+	require.NoError(t, err)
+	require.Empty(t, lifecycles)
+
+	for _, version := range []string{"2.0", "2.1"} {
+		_, err = Read([]byte(`---
+version: "` + version + `"
+services:
+  web:
+    image: nginx
+    restart_policy: never
+profiles:
+  compute:
+    web:
+      resources:
+        cpu:
+          units: "100m"
+        memory:
+          size: "128Mi"
+        storage:
+          size: "1Gi"
+  placement:
+    westcoast:
+      pricing:
+        web:
+          denom: uakt
+          amount: 50
+deployment:
+  web:
+    westcoast:
+      profile: web
+      count: 1
+`))
+
+		errs := AsErrors(err)
+		require.Len(t, errs, 1, version)
+		require.ErrorIs(t, errs[0], errLifecycleVersion)
+		require.Equal(t, "services.web.restart_policy", errs[0].Path)
+		require.Equal(t, 6, errs[0].Line)
+	}
+}
+
+func TestLifecycleInvalid(t *testing.T) {
+	_, err := Read([]byte(`---
+version: "2.2"
+services:
+  web:
+    image: nginx
+    health:
+      readiness:
+        http:
+          path: healthz
+          port: 80
+        timeout: 20
+      liveness:
+        tcp:
+          port: 80
+        exec:
+          command:
+            - true
+        success_threshold: 2
+    shutdown_timeout: 7200
+    restart_policy: sometimes
+    update:
+      max_surge: 0
+      max_unavailable: "0%"
+profiles:
+  compute:
+    web:
+      resources:
+        cpu:
+          units: "100m"
+        memory:
+          size: "128Mi"
+        storage:
+          size: "1Gi"
+  placement:
+    westcoast:
+      pricing:
+        web:
+          denom: uakt
+          amount: 50
+deployment:
+  web:
+    westcoast:
+      profile: web
+      count: 1
+`))
+
+	errs := AsErrors(err)
+
+	paths := make([]string, 0, len(errs))
+	for _, e := range errs {
+		require.ErrorIs(t, e, errLifecycleNotAllowed)
+		paths = append(paths, e.Path)
+	}
+
+	require.Equal(t, []string{
+		"services.web.health.readiness.http.path",
+		"services.web.health.readiness.timeout",
+		"services.web.health.liveness",
+		"services.web.health.liveness.success_threshold",
+		"services.web.shutdown_timeout",
+		"services.web.restart_policy",
+		"services.web.update",
+	}, paths)
+}
+
+func TestLifecycleRollout(t *testing.T) {
+	for val, valid := range map[string]bool{
+		"0":    true,
+		"3":    true,
+		"25%":  true,
+		"100%": true,
+		"101%": false,
+		"-1":   false,
+		"5000": false,
+		"abc":  false,
+		"%":    false,
+	} {
+		_, err := parseRolloutLimit(val)
+		require.Equal(t, valid, err == nil, val)
+	}
+}
+
+func TestLifecycleExportAndDiff(t *testing.T) {
+	obj, err := ReadFile("_testdata/v2.2-lifecycle.yaml")
+	require.NoError(t, err)
+
+	buf, err := Marshal(obj)
+	require.NoError(t, err)
+
+	exported, err := Read(buf)
+	require.NoError(t, err, string(buf))
+
+	expected, err := Lifecycle(obj)
+	require.NoError(t, err)
+
+	lifecycles, err := Lifecycle(exported)
+	require.NoError(t, err)
+	require.Equal(t, expected, lifecycles)
+
+	changed := readModified(t, "_testdata/v2.2-lifecycle.yaml", "shutdown_timeout: 60", "shutdown_timeout: 90")
+
+	diff, err := Compare(obj, changed)
+	require.NoError(t, err)
+	require.False(t, diff.ChangesGroups())
+	require.Equal(t, Diff{{
+		Path:  "westcoast.services.web.shutdown_timeout",
+		Kind:  ChangeModified,
+		Scope: ScopeLifecycle,
+		Old:   "60",
+		New:   "90",
+	}}, diff)
+
+	// manifest carries no lifecycle, deployment version does not change
+	fromVersion, err := obj.Version()
+	require.NoError(t, err)
+
+	toVersion, err := changed.Version()
+	require.NoError(t, err)
+	require.Equal(t, fromVersion, toVersion)
+}
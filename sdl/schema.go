@@ -0,0 +1,232 @@
+package sdl
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	jsonSchemaDraft = "http://json-schema.org/draft-07/schema#"
+)
+
+// schema is a JSON Schema object
+type schema map[string]interface{}
+
+var schemaVersions = map[string]bool{
+	"2.0": true,
+	"2.1": true,
+	"2.2": true,
+}
+
+// JSONSchema returns JSON Schema of SDL of given version, suitable for editor validation and completion.
+// The schema describes document structure only, semantic rules such as references between sections
+// are checked by Read.
+func JSONSchema(version string) ([]byte, error) {
+	if !schemaVersions[version] {
+		return nil, fmt.Errorf("%w: unsupported version %q", errSDLInvalid, version)
+	}
+
+	str := schema{"type": "string"}
+	strList := schema{"type": "array", "items": str}
+	uint32Val := schema{"type": "integer", "minimum": 0, "maximum": 4294967295}
+	// quantities are accepted either as numbers or strings with unit suffix
+	quantity := schema{"type": []string{"string", "number"}}
+
+	mapOf := func(item schema) schema {
+		return schema{"type": "object", "additionalProperties": item}
+	}
+
+	object := func(props schema, required ...string) schema {
+		res := schema{"type": "object", "properties": props}
+		if len(required) > 0 {
+			res["required"] = required
+		}
+
+		return res
+	}
+
+	// closed objects are the ones reader rejects unknown fields of
+	closed := func(props schema, required ...string) schema {
+		res := object(props, required...)
+		res["additionalProperties"] = false
+
+		return res
+	}
+
+	httpOptions := object(schema{
+		"max_body_size": uint32Val,
+		"read_timeout":  uint32Val,
+		"send_timeout":  uint32Val,
+		"next_tries":    uint32Val,
+		"next_timeout":  uint32Val,
+		"next_cases": schema{
+			"type": "array",
+			"items": schema{
+				"enum": []string{
+					nextCaseError, nextCaseTimeout, nextCase500, nextCase502, nextCase503,
+					nextCase504, nextCase403, nextCase404, nextCase400, nextCaseOff,
+				},
+			},
+		},
+	})
+
+	expose := object(schema{
+		"port":  uint32Val,
+		"as":    uint32Val,
+		"proto": schema{"enum": []string{"tcp", "TCP", "udp", "UDP"}},
+		"to": schema{
+			"type": "array",
+			"items": object(schema{
+				"service":      str,
+				"global":       schema{"type": "boolean"},
+				"ip":           str,
+				"http_options": httpOptions,
+			}),
+		},
+		"accept":       strList,
+		"http_options": httpOptions,
+	}, "port")
+
+	service := object(schema{
+		"image":   str,
+		"command": strList,
+		"args":    strList,
+		"env":     strList,
+		"expose":  schema{"type": "array", "items": expose},
+		"dependencies": schema{
+			"type":  "array",
+			"items": object(schema{"service": str}),
+		},
+		"params": object(schema{
+			"storage": mapOf(object(schema{
+				"mount":    str,
+				"readOnly": schema{"type": "boolean"},
+			})),
+		}),
+		"credentials": object(schema{
+			"host":     str,
+			"email":    str,
+			"username": str,
+			"password": str,
+		}, "host", "username", "password"),
+	}, "image")
+
+	if version == "2.2" {
+		props := service["properties"].(schema)
+
+		probe := object(schema{
+			"http": object(schema{
+				"path": str,
+				"port": uint32Val,
+			}, "path", "port"),
+			"tcp":  object(schema{"port": uint32Val}, "port"),
+			"exec": object(schema{"command": strList}, "command"),
+
+			"initial_delay":     uint32Val,
+			"period":            uint32Val,
+			"timeout":           uint32Val,
+			"success_threshold": uint32Val,
+			"failure_threshold": uint32Val,
+		})
+
+		props["health"] = object(schema{
+			"readiness": probe,
+			"liveness":  probe,
+		})
+		props["shutdown_timeout"] = uint32Val
+		props["restart_policy"] = schema{"enum": []string{RestartPolicyAlways, RestartPolicyOnFailure, RestartPolicyNever}}
+		props["update"] = object(schema{
+			"max_surge":       schema{"type": []string{"string", "integer"}},
+			"max_unavailable": schema{"type": []string{"string", "integer"}},
+		})
+	}
+
+	attributes := mapOf(schema{"type": []string{"string", "number", "boolean"}})
+
+	storageAttrs := closed(schema{
+		"persistent": schema{"type": []string{"boolean", "string"}},
+		"class":      str,
+	})
+
+	storage := object(schema{
+		"name":       str,
+		"size":       quantity,
+		"attributes": storageAttrs,
+	}, "size")
+
+	gpuModel := object(schema{
+		"model":     str,
+		"ram":       str,
+		"interface": schema{"enum": []string{"pcie", "sxm"}},
+	}, "model")
+
+	resources := object(schema{
+		"cpu": object(schema{
+			"units":      quantity,
+			"attributes": closed(schema{"arch": str}),
+		}, "units"),
+		"memory": object(schema{"size": quantity}, "size"),
+		"gpu": object(schema{
+			"units": quantity,
+			"attributes": closed(schema{
+				"vendor": closed(schema{
+					"nvidia": schema{
+						"type":  []string{"array", "null"},
+						"items": gpuModel,
+					},
+				}),
+			}),
+		}, "units"),
+		"storage": schema{
+			"oneOf": []schema{
+				storage,
+				{"type": "array", "items": storage},
+			},
+		},
+	}, "cpu", "memory", "storage")
+
+	placement := object(schema{
+		"attributes": attributes,
+		"signedBy": object(schema{
+			"allOf": strList,
+			"anyOf": strList,
+		}),
+		"pricing": mapOf(object(schema{
+			"denom":  str,
+			"amount": schema{"type": []string{"string", "number"}},
+		}, "denom", "amount")),
+		"auction": object(schema{
+			"window": schema{"type": "integer", "minimum": 1},
+		}, "window"),
+	}, "pricing")
+
+	deployment := mapOf(mapOf(object(schema{
+		"profile": str,
+		"count":   uint32Val,
+	}, "profile", "count")))
+
+	variable := object(schema{
+		"type":    schema{"enum": []string{variableTypeString, variableTypeInt, variableTypeDecimal, variableTypeBool}},
+		"default": schema{"type": []string{"string", "number", "boolean"}},
+	}, "type")
+
+	res := closed(schema{
+		sdlVersionField:   schema{"type": "string", "enum": []string{version}},
+		sdlIncludeField:   strList,
+		sdlVariablesField: mapOf(variable),
+		"services":        mapOf(service),
+		"profiles": object(schema{
+			"compute":   mapOf(object(schema{"resources": resources}, "resources")),
+			"placement": mapOf(placement),
+		}),
+		"deployment": deployment,
+		"endpoints": mapOf(object(schema{
+			"kind": schema{"enum": []string{endpointKindIP}},
+		}, "kind")),
+	}, sdlVersionField)
+
+	res["$schema"] = jsonSchemaDraft
+	res["title"] = fmt.Sprintf("Akash SDL v%s", version)
+
+	return json.MarshalIndent(res, "", "  ")
+}
@@ -3,7 +3,6 @@ package sdl
 import (
 	"errors"
 	"fmt"
-	"os"
 
 	"github.com/blang/semver/v4"
 	"gopkg.in/yaml.v3"
@@ -21,9 +20,12 @@ var (
 	errSDLInvalidNoVersion = fmt.Errorf("%w: no version found", errSDLInvalid)
 )
 
-// SDL is the interface which wraps Validate, Deployment and Manifest methods
+// SDL is the interface which wraps Validate, Deployment and Manifest methods.
+// DeploymentAuctions returns bidding window, in blocks, of deployment groups which placement
+// opts into reverse auction mode, keyed by group name.
 type SDL interface {
 	DeploymentGroups() (dtypes.GroupSpecs, error)
+	DeploymentAuctions() (map[string]int64, error)
 	Manifest() (manifest.Manifest, error)
 	Version() ([]byte, error)
 	validate() error
@@ -39,20 +41,14 @@ type sdl struct {
 func (s *sdl) UnmarshalYAML(node *yaml.Node) error {
 	var result sdl
 
-	foundVersion := false
-	for idx := range node.Content {
-		if node.Content[idx].Value == sdlVersionField {
-			var err error
-			if result.Ver, err = semver.ParseTolerant(node.Content[idx+1].Value); err != nil {
-				return err
-			}
-			foundVersion = true
-			break
-		}
+	versionIdx := mappingKeyIndex(node, sdlVersionField)
+	if versionIdx < 0 {
+		return errSDLInvalidNoVersion
 	}
 
-	if !foundVersion {
-		return errSDLInvalidNoVersion
+	var err error
+	if result.Ver, err = semver.ParseTolerant(node.Content[versionIdx+1].Value); err != nil {
+		return newNodeError(node.Content[versionIdx+1], ErrCodeInvalidValue, fmt.Errorf("%w: version: %s", errSDLInvalid, err.Error()))
 	}
 
 	// nolint: gocritic
@@ -71,7 +67,8 @@ func (s *sdl) UnmarshalYAML(node *yaml.Node) error {
 
 		result.data = &decoded
 	} else {
-		return fmt.Errorf("%w: config: unsupported version %q", errSDLInvalid, result.Ver)
+		return newNodeError(node.Content[versionIdx+1], ErrCodeInvalidValue,
+			fmt.Errorf("%w: config: unsupported version %q", errSDLInvalid, result.Ver))
 	}
 
 	*s = result
@@ -79,22 +76,73 @@ func (s *sdl) UnmarshalYAML(node *yaml.Node) error {
 	return nil
 }
 
-// ReadFile read from given path and returns SDL instance
-func ReadFile(path string) (SDL, error) {
-	buf, err := os.ReadFile(path)
+// ReadFile read from given path and returns SDL instance.
+// Files listed in include are resolved relative to the including file and merged into it.
+// Problems found in SDL are reported as Error, or Errors if there are many of them.
+func ReadFile(path string, opts ...Option) (SDL, error) {
+	doc, files, err := readFileNode(path)
 	if err != nil {
 		return nil, err
 	}
-	return Read(buf)
+
+	obj, err := readNode(doc, opts...)
+	if err != nil {
+		return nil, resolveErrors(err, doc, files, path)
+	}
+
+	return obj, nil
 }
 
-// Read reads buffer data and returns SDL instance
-func Read(buf []byte) (SDL, error) {
-	obj := &sdl{}
-	if err := yaml.Unmarshal(buf, obj); err != nil {
+// Read reads buffer data and returns SDL instance.
+// Problems found in SDL are reported as Error, or Errors if there are many of them.
+func Read(buf []byte, opts ...Option) (SDL, error) {
+	doc := &yaml.Node{}
+	if err := yaml.Unmarshal(buf, doc); err != nil {
 		return nil, err
 	}
 
+	// includes are resolved relative to the including file, an empty list has nothing to resolve
+	if root := documentMapping(doc); root != nil {
+		if idx := mappingKeyIndex(root, sdlIncludeField); idx >= 0 {
+			var includes []string
+			if err := root.Content[idx+1].Decode(&includes); err != nil {
+				return nil, fmt.Errorf("%w: %s", errSDLInclude, err.Error())
+			}
+
+			if len(includes) > 0 {
+				return nil, errSDLIncludeNoFile
+			}
+		}
+	}
+
+	obj, err := readNode(doc, opts...)
+	if err != nil {
+		return nil, resolveErrors(err, doc, nil, "")
+	}
+
+	return obj, nil
+}
+
+func readNode(doc *yaml.Node, opts ...Option) (SDL, error) {
+	var ropts readOptions
+	for _, opt := range opts {
+		opt(&ropts)
+	}
+
+	// variables are substituted before SDL is decoded, so group building and validation see final values
+	if root := documentMapping(doc); root != nil {
+		if err := interpolateVariables(root, ropts); err != nil {
+			return nil, err
+		}
+	}
+
+	obj := &sdl{}
+	if doc.Kind != 0 {
+		if err := doc.Decode(obj); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := obj.validate(); err != nil {
 		return nil, err
 	}
@@ -110,7 +158,7 @@ func Read(buf []byte) (SDL, error) {
 	}
 
 	if err := dtypes.ValidateDeploymentGroups(vgroups); err != nil {
-		return nil, err
+		return nil, newPathError(ErrCodeGroup, err, "deployment")
 	}
 
 	m, err := obj.Manifest()
@@ -119,7 +167,7 @@ func Read(buf []byte) (SDL, error) {
 	}
 
 	if err := m.Validate(); err != nil {
-		return nil, err
+		return nil, newPathError(ErrCodeManifest, err, "services")
 	}
 
 	return obj, nil
@@ -142,6 +190,14 @@ func (s *sdl) DeploymentGroups() (dtypes.GroupSpecs, error) {
 	return s.data.DeploymentGroups()
 }
 
+func (s *sdl) DeploymentAuctions() (map[string]int64, error) {
+	if s.data == nil {
+		return map[string]int64{}, errUninitializedConfig
+	}
+
+	return s.data.DeploymentAuctions()
+}
+
 func (s *sdl) Manifest() (manifest.Manifest, error) {
 	if s.data == nil {
 		return manifest.Manifest{}, errUninitializedConfig
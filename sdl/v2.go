@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/blang/semver/v4"
 	"gopkg.in/yaml.v3"
 
 	manifest "github.com/akash-network/akash-api/go/manifest/v2beta2"
@@ -169,6 +170,17 @@ type v2ServiceParams struct {
 	Storage map[string]v2ServiceStorageParams `yaml:"storage,omitempty"`
 }
 
+// storageNames stable ordered names of volumes service has parameters for
+func (p *v2ServiceParams) storageNames() []string {
+	names := make([]string, 0, len(p.Storage))
+	for name := range p.Storage {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
 type v2Service struct {
 	Image        string
 	Command      []string              `yaml:",omitempty"`
@@ -178,6 +190,12 @@ type v2Service struct {
 	Dependencies []v2Dependency        `yaml:",omitempty"`
 	Params       *v2ServiceParams      `yaml:",omitempty"`
 	Credentials  *v2ServiceCredentials `yaml:",omitempty"`
+
+	// lifecycle options, since version 2.2
+	Health          *v2ServiceHealth `yaml:"health,omitempty"`
+	ShutdownTimeout *uint32          `yaml:"shutdown_timeout,omitempty"`
+	RestartPolicy   string           `yaml:"restart_policy,omitempty"`
+	Update          *v2ServiceUpdate `yaml:"update,omitempty"`
 }
 
 type v2ServiceCredentials struct {
@@ -219,6 +237,7 @@ type v2ProfilePlacement struct {
 	Attributes v2PlacementAttributes `yaml:"attributes"`
 	SignedBy   types.SignedBy        `yaml:"signedBy"`
 	Pricing    v2PlacementPricing    `yaml:"pricing"`
+	Auction    *v2PlacementAuction   `yaml:"auction,omitempty"`
 }
 
 type v2profiles struct {
@@ -230,6 +249,10 @@ func (sdl *v2) DeploymentGroups() (dtypes.GroupSpecs, error) {
 	return sdl.result.dgroups, nil
 }
 
+func (sdl *v2) DeploymentAuctions() (map[string]int64, error) {
+	return v2DeploymentAuctions(sdl.Profiles.Placement, sdl.result.dgroups), nil
+}
+
 func (sdl *v2) Manifest() (manifest.Manifest, error) {
 	return manifest.Manifest(sdl.result.mgroups), nil
 }
@@ -260,7 +283,7 @@ loop:
 			// version is already verified
 			continue loop
 		default:
-			return fmt.Errorf("sdl: unexpected field %s", node.Content[i].Value)
+			return newNodeError(node.Content[i], ErrCodeUnexpectedField, fmt.Errorf("sdl: unexpected field %s", node.Content[i].Value))
 		}
 
 		if err := node.Content[i+1].Decode(val); err != nil {
@@ -278,27 +301,33 @@ loop:
 }
 
 func (sdl *v2) validate() error {
-	for endpointName, endpoint := range sdl.Endpoints {
+	var errs errorList
+
+	for _, endpointName := range v2EndpointNames(sdl.Endpoints) {
+		endpoint := sdl.Endpoints[endpointName]
+
 		if !endpointNameValidationRegex.MatchString(endpointName) {
-			return fmt.Errorf(
+			errs.add(ErrCodeInvalidName, fmt.Errorf(
 				"%w: endpoint named %q is not a valid name",
 				errSDLInvalid,
 				endpointName,
-			)
+			), "endpoints", endpointName)
 		}
 
 		if len(endpoint.Kind) == 0 {
-			return fmt.Errorf("%w: endpoint named %q has no kind", errSDLInvalid, endpointName)
+			errs.add(ErrCodeInvalidValue, fmt.Errorf("%w: endpoint named %q has no kind", errSDLInvalid, endpointName),
+				"endpoints", endpointName)
+			continue
 		}
 
 		// Validate endpoint kind, there is only one allowed value for now
 		if endpoint.Kind != endpointKindIP {
-			return fmt.Errorf(
+			errs.add(ErrCodeInvalidValue, fmt.Errorf(
 				"%w: endpoint named %q, unknown kind %q",
 				errSDLInvalid,
 				endpointName,
 				endpoint.Kind,
-			)
+			), "endpoints", endpointName, "kind")
 		}
 	}
 
@@ -312,117 +341,131 @@ func (sdl *v2) validate() error {
 
 			compute, ok := sdl.Profiles.Compute[svcdepl.Profile]
 			if !ok {
-				return fmt.Errorf(
+				errs.add(ErrCodeUndefined, fmt.Errorf(
 					"%w: %v.%v: no compute profile named %v",
 					errSDLInvalid,
 					svcName,
 					placementName,
 					svcdepl.Profile,
-				)
+				), "deployment", svcName, placementName, "profile")
 			}
 
 			infra, ok := sdl.Profiles.Placement[placementName]
 			if !ok {
-				return fmt.Errorf(
+				errs.add(ErrCodeUndefined, fmt.Errorf(
 					"%w: %v.%v: no placement profile named %v",
 					errSDLInvalid,
 					svcName,
 					placementName,
 					placementName,
-				)
-			}
-
-			if _, ok := infra.Pricing[svcdepl.Profile]; !ok {
-				return fmt.Errorf(
+				), "deployment", svcName, placementName)
+			} else if _, ok := infra.Pricing[svcdepl.Profile]; !ok {
+				errs.add(ErrCodeUndefined, fmt.Errorf(
 					"%w: %v.%v: no pricing for profile %v",
 					errSDLInvalid,
 					svcName,
 					placementName,
 					svcdepl.Profile,
-				)
+				), "profiles", "placement", placementName, "pricing")
 			}
 
 			svc, ok := sdl.Services[svcName]
 			if !ok {
-				return fmt.Errorf(
+				errs.add(ErrCodeUndefined, fmt.Errorf(
 					"%w: %v.%v: no service profile named %v",
 					errSDLInvalid,
 					svcName,
 					placementName,
 					svcName,
-				)
+				), "deployment", svcName)
+				continue
 			}
 
+			svc.validateLifecycleVersion(&errs, semver.MustParse("2.0.0"), svcName)
+
 			if svc.Credentials != nil {
 				if err := svc.Credentials.validate(); err != nil {
-					return fmt.Errorf(
+					errs.add(ErrCodeInvalidValue, fmt.Errorf(
 						"%w: %v.%v: %v",
 						errSDLInvalid,
 						svcName,
 						placementName,
 						err,
-					)
+					), "services", svcName, "credentials")
 				}
 			}
 
-			for _, serviceExpose := range svc.Expose {
-				for _, to := range serviceExpose.To {
+			for exposeIdx, serviceExpose := range svc.Expose {
+				for toIdx, to := range serviceExpose.To {
 					// Check to see if an IP endpoint is also specified
-					if len(to.IP) != 0 {
-						if !to.Global {
-							return fmt.Errorf(
-								"%w: error on %q if an IP is declared the directive must be declared as global",
-								errSDLInvalid,
-								svcName,
-							)
-						}
-						endpoint, endpointExists := sdl.Endpoints[to.IP]
-						if !endpointExists {
-							return fmt.Errorf(
-								"%w: error on service %q no endpoint named %q exists",
-								errSDLInvalid,
-								svcName,
-								to.IP,
-							)
-						}
+					if len(to.IP) == 0 {
+						continue
+					}
 
-						if endpoint.Kind != endpointKindIP {
-							return fmt.Errorf(
-								"%w: error on service %q endpoint %q has type %q, should be %q",
-								errSDLInvalid,
-								svcName,
-								to.IP,
-								endpoint.Kind,
-								endpointKindIP,
-							)
-						}
+					toPath := []string{"services", svcName, "expose", pathIndex(exposeIdx), "to", pathIndex(toIdx)}
 
-						endpointsUsed[to.IP] = struct{}{}
+					if !to.Global {
+						errs.add(ErrCodeInvalidValue, fmt.Errorf(
+							"%w: error on %q if an IP is declared the directive must be declared as global",
+							errSDLInvalid,
+							svcName,
+						), toPath...)
+						continue
+					}
 
-						// Endpoint exists. Now check for port collisions across a single endpoint, port, & protocol
-						portKey := fmt.Sprintf(
-							"%s-%d-%s",
+					endpoint, endpointExists := sdl.Endpoints[to.IP]
+					if !endpointExists {
+						errs.add(ErrCodeUndefined, fmt.Errorf(
+							"%w: error on service %q no endpoint named %q exists",
+							errSDLInvalid,
+							svcName,
 							to.IP,
-							serviceExpose.As,
+						), append(toPath, "ip")...)
+						continue
+					}
+
+					endpointsUsed[to.IP] = struct{}{}
+
+					if endpoint.Kind != endpointKindIP {
+						errs.add(ErrCodeInvalidValue, fmt.Errorf(
+							"%w: error on service %q endpoint %q has type %q, should be %q",
+							errSDLInvalid,
+							svcName,
+							to.IP,
+							endpoint.Kind,
+							endpointKindIP,
+						), append(toPath, "ip")...)
+						continue
+					}
+
+					// Endpoint exists. Now check for port collisions across a single endpoint, port, & protocol
+					portKey := fmt.Sprintf(
+						"%s-%d-%s",
+						to.IP,
+						serviceExpose.As,
+						serviceExpose.Proto,
+					)
+					otherServiceName, inUse := portsUsed[portKey]
+					if inUse {
+						errs.add(ErrCodeConflict, fmt.Errorf(
+							"%w: IP endpoint %q port: %d protocol: %s specified by service %q already in use by %q",
+							errSDLInvalid,
+							to.IP,
+							serviceExpose.Port,
 							serviceExpose.Proto,
-						)
-						otherServiceName, inUse := portsUsed[portKey]
-						if inUse {
-							return fmt.Errorf(
-								"%w: IP endpoint %q port: %d protocol: %s specified by service %q already in use by %q",
-								errSDLInvalid,
-								to.IP,
-								serviceExpose.Port,
-								serviceExpose.Proto,
-								svcName,
-								otherServiceName,
-							)
-						}
-						portsUsed[portKey] = svcName
+							svcName,
+							otherServiceName,
+						), append(toPath, "ip")...)
+						continue
 					}
+					portsUsed[portKey] = svcName
 				}
 			}
 
+			if compute.Resources == nil {
+				continue
+			}
+
 			// validate storage's attributes and parameters
 			volumes := make(map[string]v2ResourceStorage)
 			for _, volume := range compute.Resources.Storage {
@@ -442,23 +485,27 @@ func (sdl *v2) validate() error {
 			mounts := make(map[string]string)
 
 			if svc.Params != nil {
-				for name, params := range svc.Params.Storage {
+				for _, name := range svc.Params.storageNames() {
+					params := svc.Params.Storage[name]
+					paramsPath := []string{"services", svcName, "params", "storage", name}
+
 					if _, exists := volumes[name]; !exists {
-						return fmt.Errorf(
+						errs.add(ErrCodeUndefined, fmt.Errorf(
 							"%w: service \"%s\" references to no-existing compute volume named \"%s\"",
 							errSDLInvalid,
 							svcName,
 							name,
-						)
+						), paramsPath...)
+						continue
 					}
 
 					if !path.IsAbs(params.Mount) {
-						return fmt.Errorf(
+						errs.add(ErrCodeInvalidValue, fmt.Errorf(
 							"%w: invalid value for \"service.%s.params.%s.mount\" parameter. expected absolute path",
 							errSDLInvalid,
 							svcName,
 							name,
-						)
+						), append(paramsPath, "mount")...)
 					}
 
 					attr[StorageAttributeMount] = params.Mount
@@ -467,15 +514,16 @@ func (sdl *v2) validate() error {
 					mount := attr[StorageAttributeMount]
 					if vlname, exists := mounts[mount]; exists {
 						if mount == "" {
-							return errStorageMultipleRootEphemeral
+							errs.add(ErrCodeConflict, errStorageMultipleRootEphemeral, paramsPath...)
+						} else {
+							errs.add(ErrCodeConflict, fmt.Errorf(
+								"%w: mount %q already in use by volume %q",
+								errStorageDupMountPoint,
+								mount,
+								vlname,
+							), append(paramsPath, "mount")...)
 						}
-
-						return fmt.Errorf(
-							"%w: mount %q already in use by volume %q",
-							errStorageDupMountPoint,
-							mount,
-							vlname,
-						)
+						continue
 					}
 
 					mounts[mount] = name
@@ -490,30 +538,30 @@ func (sdl *v2) validate() error {
 				persistent, _ := strconv.ParseBool(attr[StorageAttributePersistent])
 
 				if persistent && attr[StorageAttributeMount] == "" {
-					return fmt.Errorf(
+					errs.add(ErrCodeInvalidValue, fmt.Errorf(
 						"%w: compute.storage.%s has persistent=true which requires service.%s.params.storage.%s to have mount",
 						errSDLInvalid,
 						name,
 						svcName,
 						name,
-					)
+					), "services", svcName, "params", "storage", name)
 				}
 			}
 		}
 	}
 
-	for endpointName := range sdl.Endpoints {
+	for _, endpointName := range v2EndpointNames(sdl.Endpoints) {
 		_, inUse := endpointsUsed[endpointName]
 		if !inUse {
-			return fmt.Errorf(
+			errs.add(ErrCodeUnused, fmt.Errorf(
 				"%w: endpoint %q declared but never used",
 				errSDLInvalid,
 				endpointName,
-			)
+			), "endpoints", endpointName)
 		}
 	}
 
-	return nil
+	return errs.err()
 }
 
 func (sdl *v2) computeEndpointSequenceNumbers() map[string]uint32 {
@@ -571,6 +619,17 @@ func (sdl v2Deployment) placementNames() []string {
 	return names
 }
 
+// v2EndpointNames stable ordered endpoint names
+func v2EndpointNames(m map[string]v2Endpoint) []string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
 func v2DeploymentPlacementNames(m v2Deployment) []string {
 	names := make([]string, 0, len(m))
 	for name := range m {
@@ -0,0 +1,251 @@
+package sdl
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	sdlVariablesField = "variables"
+
+	variableTypeString  = "string"
+	variableTypeInt     = "int"
+	variableTypeDecimal = "decimal"
+	variableTypeBool    = "bool"
+)
+
+var (
+	errSDLVariable          = fmt.Errorf("%w: variable", errSDLInvalid)
+	errSDLVariableUndefined = fmt.Errorf("%w: undefined", errSDLVariable)
+	errSDLVariableNoValue   = fmt.Errorf("%w: no value", errSDLVariable)
+	errSDLVariableType      = fmt.Errorf("%w: invalid value", errSDLVariable)
+
+	variableNameRegex   = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	variableInterpRegex = regexp.MustCompile(`\$\$|\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+)
+
+// Option configures how SDL is read
+type Option func(*readOptions)
+
+type readOptions struct {
+	vars      map[string]string
+	lookupEnv func(string) (string, bool)
+}
+
+// WithVariables sets values of variables declared by SDL. They take precedence over environment and defaults.
+func WithVariables(vars map[string]string) Option {
+	return func(opts *readOptions) {
+		opts.vars = vars
+	}
+}
+
+// WithEnvironment sets lookup of variables declared by SDL which have no value set by WithVariables,
+// for example os.LookupEnv. Environment takes precedence over defaults.
+func WithEnvironment(lookup func(string) (string, bool)) Option {
+	return func(opts *readOptions) {
+		opts.lookupEnv = lookup
+	}
+}
+
+// sdlVariable is declaration of a variable in the variables section
+type sdlVariable struct {
+	Type    string  `yaml:"type"`
+	Default *string `yaml:"default"`
+}
+
+// interpolateVariables resolves variables declared in the variables section of root
+// and substitutes ${NAME} references in service images and env, deployment counts and pricing.
+// $$ yields a literal $. Variables section is removed from root.
+func interpolateVariables(root *yaml.Node, opts readOptions) error {
+	decls := make(map[string]sdlVariable)
+
+	var section *yaml.Node
+
+	// documents without variables section are taken as is
+	idx := mappingKeyIndex(root, sdlVariablesField)
+	if idx >= 0 {
+		section = root.Content[idx+1]
+
+		if err := section.Decode(&decls); err != nil {
+			return newNodeError(root.Content[idx], ErrCodeVariable, fmt.Errorf("%w: %s", errSDLVariable, err.Error()))
+		}
+
+		root.Content = append(root.Content[:idx], root.Content[idx+2:]...)
+	}
+
+	overrides := make([]string, 0, len(opts.vars))
+	for name := range opts.vars {
+		overrides = append(overrides, name)
+	}
+	sort.Strings(overrides)
+
+	for _, name := range overrides {
+		if _, declared := decls[name]; !declared {
+			return fmt.Errorf("%w: %q is not declared", errSDLVariableUndefined, name)
+		}
+	}
+
+	if idx < 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(decls))
+	for name := range decls {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	values := make(map[string]string, len(decls))
+
+	for _, name := range names {
+		decl := decls[name]
+		declNode := section.Content[mappingKeyIndex(section, name)]
+
+		if !variableNameRegex.MatchString(name) {
+			return newNodeError(declNode, ErrCodeInvalidName, fmt.Errorf("%w: %q is not a valid name", errSDLVariable, name))
+		}
+
+		val, ok := opts.vars[name]
+		if !ok && opts.lookupEnv != nil {
+			val, ok = opts.lookupEnv(name)
+		}
+		if !ok && decl.Default != nil {
+			val, ok = *decl.Default, true
+		}
+		if !ok {
+			return newNodeError(declNode, ErrCodeVariable, fmt.Errorf("%w: %q", errSDLVariableNoValue, name))
+		}
+
+		if err := validateVariableValue(decl.Type, val); err != nil {
+			return newNodeError(declNode, ErrCodeVariable, fmt.Errorf("%w: %q: %s", errSDLVariableType, name, err.Error()))
+		}
+
+		values[name] = val
+	}
+
+	interpolate := func(node *yaml.Node, path string) error {
+		return interpolateScalar(node, path, values)
+	}
+
+	err := forEachMapEntry(mappingValue(root, "services"), func(svc string, node *yaml.Node) error {
+		if err := interpolate(mappingValue(node, "image"), "services."+svc+".image"); err != nil {
+			return err
+		}
+
+		if env := mappingValue(node, "env"); env != nil && env.Kind == yaml.SequenceNode {
+			for idx, item := range env.Content {
+				if err := interpolate(item, fmt.Sprintf("services.%s.env[%d]", svc, idx)); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = forEachMapEntry(mappingValue(root, "deployment"), func(svc string, node *yaml.Node) error {
+		return forEachMapEntry(node, func(placement string, node *yaml.Node) error {
+			return interpolate(mappingValue(node, "count"), "deployment."+svc+"."+placement+".count")
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	var placements *yaml.Node
+	if profiles := mappingValue(root, "profiles"); profiles != nil {
+		placements = mappingValue(profiles, "placement")
+	}
+
+	return forEachMapEntry(placements, func(placement string, node *yaml.Node) error {
+		return forEachMapEntry(mappingValue(node, "pricing"), func(profile string, node *yaml.Node) error {
+			path := "profiles.placement." + placement + ".pricing." + profile
+
+			if err := interpolate(mappingValue(node, "amount"), path+".amount"); err != nil {
+				return err
+			}
+
+			return interpolate(mappingValue(node, "denom"), path+".denom")
+		})
+	})
+}
+
+func validateVariableValue(vtype string, val string) error {
+	var err error
+
+	switch vtype {
+	case "", variableTypeString:
+	case variableTypeInt:
+		_, err = strconv.ParseInt(val, 10, 64)
+	case variableTypeDecimal:
+		_, err = sdk.NewDecFromStr(val)
+	case variableTypeBool:
+		if _, valid := unifyStringAsBool(val); !valid {
+			err = fmt.Errorf("%q is not a bool", val)
+		}
+	default:
+		err = fmt.Errorf("unknown type %q", vtype)
+	}
+
+	return err
+}
+
+func interpolateScalar(node *yaml.Node, path string, values map[string]string) error {
+	if node == nil || node.Kind != yaml.ScalarNode {
+		return nil
+	}
+
+	var err error
+
+	res := variableInterpRegex.ReplaceAllStringFunc(node.Value, func(match string) string {
+		if match == "$$" {
+			return "$"
+		}
+
+		name := match[2 : len(match)-1]
+
+		val, exists := values[name]
+		if !exists && err == nil {
+			err = fmt.Errorf("%w: %s: %q is not declared", errSDLVariableUndefined, path, name)
+		}
+
+		return val
+	})
+
+	if err != nil {
+		return newNodeError(node, ErrCodeVariable, err)
+	}
+
+	if res != node.Value {
+		node.Value = res
+
+		// let decoder resolve type of the substituted value, e.g. int for counts
+		if node.Style == 0 {
+			node.Tag = ""
+		}
+	}
+
+	return nil
+}
+
+func forEachMapEntry(node *yaml.Node, fn func(string, *yaml.Node) error) error {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if err := fn(node.Content[i].Value, node.Content[i+1]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
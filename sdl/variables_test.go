@@ -0,0 +1,65 @@
+package sdl
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSDLVariablesDefaults(t *testing.T) {
+	_, err := ReadFile("./_testdata/v2.1-variables.yaml")
+	require.ErrorIs(t, err, errSDLVariableNoValue)
+
+	obj, err := ReadFile("./_testdata/v2.1-variables.yaml", WithVariables(map[string]string{"MODE": "staging"}))
+	require.NoError(t, err)
+
+	groups, err := obj.DeploymentGroups()
+	require.NoError(t, err)
+	require.Len(t, groups, 1)
+	require.Equal(t, uint32(2), groups[0].Resources[0].Count)
+	require.Equal(t, sdk.NewDecCoin("uakt", sdk.NewInt(50)), groups[0].Resources[0].Price)
+
+	mani, err := obj.Manifest()
+	require.NoError(t, err)
+	require.Equal(t, "nginx:1.25", mani[0].Services[0].Image)
+	require.Equal(t, []string{"MODE=staging", "COST=$5"}, mani[0].Services[0].Env)
+}
+
+func TestSDLVariablesOverrides(t *testing.T) {
+	env := map[string]string{
+		"MODE":     "production",
+		"REPLICAS": "3",
+	}
+
+	obj, err := ReadFile("./_testdata/v2.1-variables.yaml",
+		WithVariables(map[string]string{"REPLICAS": "4", "TAG": "1.26"}),
+		WithEnvironment(func(name string) (string, bool) {
+			val, ok := env[name]
+			return val, ok
+		}))
+	require.NoError(t, err)
+
+	groups, err := obj.DeploymentGroups()
+	require.NoError(t, err)
+	require.Equal(t, uint32(4), groups[0].Resources[0].Count)
+
+	mani, err := obj.Manifest()
+	require.NoError(t, err)
+	require.Equal(t, "nginx:1.26", mani[0].Services[0].Image)
+	require.Equal(t, "MODE=production", mani[0].Services[0].Env[0])
+}
+
+func TestSDLVariablesInvalid(t *testing.T) {
+	_, err := ReadFile("./_testdata/v2.1-variables.yaml",
+		WithVariables(map[string]string{"MODE": "staging", "REPLICAS": "two"}))
+	require.ErrorIs(t, err, errSDLVariableType)
+
+	_, err = ReadFile("./_testdata/v2.1-variables.yaml",
+		WithVariables(map[string]string{"MODE": "staging", "UNKNOWN": "1"}))
+	require.ErrorIs(t, err, errSDLVariableUndefined)
+
+	// documents without variables section are not interpolated
+	_, err = ReadFile("./_testdata/v2.1-simple.yaml", WithVariables(map[string]string{"MODE": "staging"}))
+	require.ErrorIs(t, err, errSDLVariableUndefined)
+}
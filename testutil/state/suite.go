@@ -90,7 +90,7 @@ func SetupTestSuiteWithKeepers(t testing.TB, keepers Keepers) *TestSuite {
 	app := app.Setup(false)
 
 	if keepers.Audit == nil {
-		keepers.Audit = akeeper.NewKeeper(atypes.ModuleCdc, app.GetKey(atypes.StoreKey))
+		keepers.Audit = akeeper.NewKeeper(atypes.ModuleCdc, app.GetKey(atypes.StoreKey), app.GetSubspace(atypes.ModuleName))
 	}
 
 	if keepers.Take == nil {
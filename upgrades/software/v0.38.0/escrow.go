@@ -0,0 +1,41 @@
+// Package v0_38_0
+// nolint revive
+package v0_38_0
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmodule "github.com/cosmos/cosmos-sdk/types/module"
+
+	utypes "github.com/akash-network/node/upgrades/types"
+)
+
+type escrowMigrations struct {
+	utypes.Migrator
+}
+
+type escrowIndexer interface {
+	ReindexAccounts(sdk.Context)
+}
+
+func newEscrowMigration(m utypes.Migrator) utypes.Migration {
+	return escrowMigrations{Migrator: m}
+}
+
+func (m escrowMigrations) GetHandler() sdkmodule.MigrationHandler {
+	return m.handler
+}
+
+// handler migrates escrow from version 2 to 3.
+// builds index of open accounts keyed by projected depletion height
+func (m escrowMigrations) handler(ctx sdk.Context) error {
+	indexer, valid := m.Migrator.(escrowIndexer)
+	if !valid {
+		return fmt.Errorf("escrow migration: unexpected migrator type %T", m.Migrator)
+	}
+
+	indexer.ReindexAccounts(ctx)
+
+	return nil
+}
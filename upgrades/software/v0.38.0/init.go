@@ -0,0 +1,16 @@
+// Package v0_38_0
+// nolint revive
+package v0_38_0
+
+import (
+	ev1beta3 "github.com/akash-network/akash-api/go/node/escrow/v1beta3"
+	mv1beta4 "github.com/akash-network/akash-api/go/node/market/v1beta4"
+
+	utypes "github.com/akash-network/node/upgrades/types"
+)
+
+func init() {
+	utypes.RegisterUpgrade(UpgradeName, initUpgrade)
+	utypes.RegisterMigration(ev1beta3.ModuleName, 2, newEscrowMigration)
+	utypes.RegisterMigration(mv1beta4.ModuleName, 5, newMarketMigration)
+}
@@ -0,0 +1,44 @@
+// Package v0_38_0
+// nolint revive
+package v0_38_0
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmodule "github.com/cosmos/cosmos-sdk/types/module"
+
+	utypes "github.com/akash-network/node/upgrades/types"
+)
+
+type marketMigrations struct {
+	utypes.Migrator
+}
+
+type marketIndexer interface {
+	ReindexExpiry(sdk.Context)
+	ReindexActiveLeases(sdk.Context)
+}
+
+func newMarketMigration(m utypes.Migrator) utypes.Migration {
+	return marketMigrations{Migrator: m}
+}
+
+func (m marketMigrations) GetHandler() sdkmodule.MigrationHandler {
+	return m.handler
+}
+
+// handler migrates market from version 5 to 6.
+// builds index of open orders and bids keyed by creation height
+// and index of active leases keyed by provider
+func (m marketMigrations) handler(ctx sdk.Context) error {
+	indexer, valid := m.Migrator.(marketIndexer)
+	if !valid {
+		return fmt.Errorf("market migration: unexpected migrator type %T", m.Migrator)
+	}
+
+	indexer.ReindexExpiry(ctx)
+	indexer.ReindexActiveLeases(ctx)
+
+	return nil
+}
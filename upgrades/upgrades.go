@@ -1,6 +1,8 @@
 package upgrades
 
 import (
+	// nolint: revive
+	_ "github.com/akash-network/node/upgrades/software/v0.38.0"
 	// nolint: revive
 	_ "github.com/akash-network/node/upgrades/software/v0.36.0"
 	// nolint: revive
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"context"
+	"fmt"
 
 	sdkclient "github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -11,6 +12,8 @@ import (
 	types "github.com/akash-network/akash-api/go/node/audit/v1beta3"
 
 	aclient "github.com/akash-network/node/client"
+	"github.com/akash-network/node/x/audit/query"
+	av1beta4 "github.com/akash-network/node/x/audit/types/v1beta4"
 )
 
 func GetQueryCmd() *cobra.Command {
@@ -24,6 +27,9 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		cmdGetProviders(),
 		cmdGetProvider(),
+		cmdGetHistory(),
+		cmdGetAuditors(),
+		cmdGetAuditor(),
 	)
 
 	return cmd
@@ -126,3 +132,97 @@ func cmdGetProvider() *cobra.Command {
 
 	return cmd
 }
+
+func cmdGetHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history [owner address]",
+		Short: "Query audit history of provider",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := sdkclient.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := av1beta4.NewQueryClient(cctx).AuditHistory(cmd.Context(), &av1beta4.QueryAuditHistoryRequest{
+				Owner:      owner.String(),
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return cctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "history")
+
+	return cmd
+}
+
+func cmdGetAuditors() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auditors",
+		Short: "Query registered auditors",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cctx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.StoreKey, query.AuditorsPath()), nil)
+			if err != nil {
+				return err
+			}
+
+			return cctx.PrintBytes(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func cmdGetAuditor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auditor [address]",
+		Short: "Query registered auditor, its approval and delegated keys",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cctx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.StoreKey, query.AuditorPath(id)), nil)
+			if err != nil {
+				return err
+			}
+
+			return cctx.PrintBytes(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
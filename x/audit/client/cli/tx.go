@@ -17,6 +17,11 @@ import (
 	atypes "github.com/akash-network/akash-api/go/node/types/v1beta3"
 
 	aclient "github.com/akash-network/node/client"
+	av1beta4 "github.com/akash-network/node/x/audit/types/v1beta4"
+)
+
+const (
+	FlagWebsite = "website"
 )
 
 // GetTxCmd returns the transaction commands for audit module
@@ -30,6 +35,7 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		cmdAttributes(),
+		cmdAuditor(),
 	)
 
 	return cmd
@@ -171,6 +177,156 @@ func cmdDeleteProviderAttributes() *cobra.Command {
 	return cmd
 }
 
+func cmdAuditor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auditor",
+		Short: "Manage auditor registration and delegated keys",
+	}
+
+	cmd.AddCommand(
+		cmdRegisterAuditor(),
+		cmdDeregisterAuditor(),
+		cmdAuditorDelegate(),
+	)
+
+	return cmd
+}
+
+func cmdRegisterAuditor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register [name] [deposit]",
+		Short: "Register auditor bonding deposit in escrow",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			deposit, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			website, err := cmd.Flags().GetString(FlagWebsite)
+			if err != nil {
+				return err
+			}
+
+			return broadcastAuditorMsg(cmd, func(from sdk.AccAddress) sdk.Msg {
+				return av1beta4.NewMsgRegisterAuditor(from, args[0], website, deposit)
+			})
+		},
+	}
+
+	setCmdProviderFlags(cmd)
+	cmd.Flags().String(FlagWebsite, "", "auditor website")
+
+	return cmd
+}
+
+func cmdDeregisterAuditor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deregister",
+		Short: "Deregister auditor and return its deposit",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return broadcastAuditorMsg(cmd, func(from sdk.AccAddress) sdk.Msg {
+				return av1beta4.NewMsgDeregisterAuditor(from)
+			})
+		},
+	}
+
+	setCmdProviderFlags(cmd)
+
+	return cmd
+}
+
+func cmdAuditorDelegate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegate",
+		Short: "Manage keys signing provider attributes on behalf of auditor",
+	}
+
+	cmd.AddCommand(
+		cmdCreateAuditorDelegate(),
+		cmdDeleteAuditorDelegate(),
+	)
+
+	return cmd
+}
+
+func cmdCreateAuditorDelegate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create [delegate]",
+		Short: "Authorize delegate to sign provider attributes",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			delegate, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			return broadcastAuditorMsg(cmd, func(from sdk.AccAddress) sdk.Msg {
+				return av1beta4.NewMsgCreateAuditorDelegate(from, delegate)
+			})
+		},
+	}
+
+	setCmdProviderFlags(cmd)
+
+	return cmd
+}
+
+func cmdDeleteAuditorDelegate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete [delegate]",
+		Short: "Revoke delegate",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			delegate, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			return broadcastAuditorMsg(cmd, func(from sdk.AccAddress) sdk.Msg {
+				return av1beta4.NewMsgDeleteAuditorDelegate(from, delegate)
+			})
+		},
+	}
+
+	setCmdProviderFlags(cmd)
+
+	return cmd
+}
+
+func broadcastAuditorMsg(cmd *cobra.Command, newMsg func(sdk.AccAddress) sdk.Msg) error {
+	ctx := cmd.Context()
+
+	cctx, err := sdkclient.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	opts, err := cltypes.ClientOptionsFromFlags(cmd.Flags())
+	if err != nil {
+		return err
+	}
+
+	cl, err := aclient.DiscoverClient(ctx, cctx, opts...)
+	if err != nil {
+		return err
+	}
+
+	msg := newMsg(cctx.GetFromAddress())
+
+	if err = msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	resp, err := cl.Tx().Broadcast(ctx, []sdk.Msg{msg})
+	if err != nil {
+		return err
+	}
+
+	return cl.PrintMessage(resp)
+}
+
 func setCmdProviderFlags(cmd *cobra.Command) {
 	flags.AddTxFlagsToCmd(cmd)
 
@@ -2,41 +2,183 @@ package audit
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/akash-network/node/x/audit/keeper"
+	av1beta4 "github.com/akash-network/node/x/audit/types/v1beta4"
 
 	types "github.com/akash-network/akash-api/go/node/audit/v1beta3"
 )
 
 // ValidateGenesis does validation check of the Genesis and returns error incase of failure
-func ValidateGenesis(data *types.GenesisState) error {
+func ValidateGenesis(data *av1beta4.GenesisState) error {
+	for idx, attr := range data.Attributes {
+		if err := validateProviderID(attr.Owner, attr.Auditor); err != nil {
+			return fmt.Errorf("%w: attributes (idx %v)", err, idx)
+		}
+	}
+
+	for idx, terms := range data.Terms {
+		if err := validateProviderID(terms.Owner, terms.Auditor); err != nil {
+			return fmt.Errorf("%w: audit terms (idx %v)", err, idx)
+		}
+	}
+
+	for idx, entry := range data.History {
+		if err := validateProviderID(entry.Owner, entry.Auditor); err != nil {
+			return fmt.Errorf("%w: audit history (idx %v)", err, idx)
+		}
+
+		if entry.Action != av1beta4.AuditActionSigned && entry.Action != av1beta4.AuditActionDeleted {
+			return fmt.Errorf("invalid audit history action %q (idx %v)", entry.Action, idx)
+		}
+	}
+
+	if err := data.RegistryParams.Validate(); err != nil {
+		return fmt.Errorf("%w: registry params", err)
+	}
+
+	auditors := make(map[string]bool, len(data.Auditors))
+	for idx, auditor := range data.Auditors {
+		if err := auditor.ValidateBasic(); err != nil {
+			return fmt.Errorf("%w: auditor (idx %v)", err, idx)
+		}
+
+		auditors[auditor.Address] = true
+	}
+
+	for idx, val := range data.Delegates {
+		if err := validateAuditorDelegate(val.Auditor, val.Delegate); err != nil {
+			return fmt.Errorf("%w: auditor delegate (idx %v)", err, idx)
+		}
+
+		if !auditors[val.Auditor] {
+			return fmt.Errorf("auditor delegate references unknown auditor %q (idx %v)", val.Auditor, idx)
+		}
+	}
+
+	return nil
+}
+
+func validateAuditorDelegate(auditor, delegate string) error {
+	if _, err := sdk.AccAddressFromBech32(auditor); err != nil {
+		return fmt.Errorf("%w: auditor %q", err, auditor)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(delegate); err != nil {
+		return fmt.Errorf("%w: delegate %q", err, delegate)
+	}
+
+	return nil
+}
+
+func validateProviderID(owner, auditor string) error {
+	if _, err := sdk.AccAddressFromBech32(owner); err != nil {
+		return fmt.Errorf("%w: owner %q", err, owner)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(auditor); err != nil {
+		return fmt.Errorf("%w: auditor %q", err, auditor)
+	}
+
 	return nil
 }
 
 // InitGenesis initiate genesis state and return updated validator details
-func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState) []abci.ValidatorUpdate {
+func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *av1beta4.GenesisState) []abci.ValidatorUpdate {
+	for _, attr := range data.Attributes {
+		if err := keeper.SetAuditedAttributes(ctx, attr); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, terms := range data.Terms {
+		keeper.SetAuditTerms(ctx, types.ProviderID{
+			Owner:   sdk.MustAccAddressFromBech32(terms.Owner),
+			Auditor: sdk.MustAccAddressFromBech32(terms.Auditor),
+		}, terms.Terms)
+	}
+
+	for _, entry := range data.History {
+		if err := keeper.AppendAuditHistory(ctx, entry); err != nil {
+			panic(err)
+		}
+	}
+
+	keeper.SetRegistryParams(ctx, data.RegistryParams)
+
+	for _, auditor := range data.Auditors {
+		keeper.SetAuditor(ctx, auditor)
+	}
+
+	for _, val := range data.Delegates {
+		keeper.SetAuditorDelegate(ctx, val)
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
 // ExportGenesis returns genesis state as raw bytes for the provider module
-func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
-	return &types.GenesisState{}
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *av1beta4.GenesisState {
+	var attributes []types.AuditedAttributes
+	k.WithProviders(ctx, func(prov types.Provider) bool {
+		attributes = append(attributes, types.AuditedAttributes{
+			Owner:      prov.Owner,
+			Auditor:    prov.Auditor,
+			Attributes: prov.Attributes,
+		})
+		return false
+	})
+
+	var terms []av1beta4.ProviderAuditTerms
+	k.WithAuditTerms(ctx, func(val av1beta4.ProviderAuditTerms) bool {
+		terms = append(terms, val)
+		return false
+	})
+
+	var history []av1beta4.AuditHistoryEntry
+	k.WithAllAuditHistory(ctx, func(entry av1beta4.AuditHistoryEntry) bool {
+		history = append(history, entry)
+		return false
+	})
+
+	var auditors []av1beta4.Auditor
+	var delegates []av1beta4.AuditorDelegate
+	k.WithAuditors(ctx, func(auditor av1beta4.Auditor) bool {
+		auditors = append(auditors, auditor)
+
+		k.WithAuditorDelegates(ctx, sdk.MustAccAddressFromBech32(auditor.Address), func(val av1beta4.AuditorDelegate) bool {
+			delegates = append(delegates, val)
+			return false
+		})
+
+		return false
+	})
+
+	return &av1beta4.GenesisState{
+		Attributes:     attributes,
+		Terms:          terms,
+		History:        history,
+		RegistryParams: k.GetRegistryParams(ctx),
+		Auditors:       auditors,
+		Delegates:      delegates,
+	}
 }
 
 // DefaultGenesisState returns default genesis state as raw bytes for the provider
 // module.
-func DefaultGenesisState() *types.GenesisState {
-	return &types.GenesisState{}
+func DefaultGenesisState() *av1beta4.GenesisState {
+	return &av1beta4.GenesisState{}
 }
 
 // GetGenesisStateFromAppState returns x/audit GenesisState given raw application
 // genesis state.
-func GetGenesisStateFromAppState(cdc codec.JSONCodec, appState map[string]json.RawMessage) *types.GenesisState {
-	var genesisState types.GenesisState
+func GetGenesisStateFromAppState(cdc codec.JSONCodec, appState map[string]json.RawMessage) *av1beta4.GenesisState {
+	var genesisState av1beta4.GenesisState
 
 	if appState[ModuleName] != nil {
 		cdc.MustUnmarshalJSON(appState[ModuleName], &genesisState)
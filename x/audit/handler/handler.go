@@ -7,11 +7,13 @@ import (
 	types "github.com/akash-network/akash-api/go/node/audit/v1beta3"
 
 	"github.com/akash-network/node/x/audit/keeper"
+	av1beta4 "github.com/akash-network/node/x/audit/types/v1beta4"
 )
 
 // NewHandler returns a handler for "provider" type messages.
-func NewHandler(keeper keeper.Keeper) sdk.Handler {
+func NewHandler(keeper keeper.IKeeper, ekeeper EscrowKeeper) sdk.Handler {
 	ms := NewMsgServerImpl(keeper)
+	ns := NewNodeServer(keeper, ekeeper)
 
 	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
 		switch msg := msg.(type) {
@@ -21,6 +23,18 @@ func NewHandler(keeper keeper.Keeper) sdk.Handler {
 		case *types.MsgDeleteProviderAttributes:
 			res, err := ms.DeleteProviderAttributes(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *av1beta4.MsgRegisterAuditor:
+			res, err := ns.RegisterAuditor(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *av1beta4.MsgDeregisterAuditor:
+			res, err := ns.DeregisterAuditor(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *av1beta4.MsgCreateAuditorDelegate:
+			res, err := ns.CreateAuditorDelegate(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *av1beta4.MsgDeleteAuditorDelegate:
+			res, err := ns.DeleteAuditorDelegate(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		}
 
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message type: %T", msg)
@@ -5,10 +5,12 @@ import (
 	"sort"
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdktestdata "github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/stretchr/testify/require"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
@@ -18,6 +20,7 @@ import (
 	"github.com/akash-network/node/testutil"
 	"github.com/akash-network/node/x/audit/handler"
 	"github.com/akash-network/node/x/audit/keeper"
+	av1beta4 "github.com/akash-network/node/x/audit/types/v1beta4"
 )
 
 type testSuite struct {
@@ -34,19 +37,24 @@ func setupTestSuite(t *testing.T) *testSuite {
 	}
 
 	aKey := sdk.NewTransientStoreKey(types.StoreKey)
+	pKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	pTKey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
 
 	db := dbm.NewMemDB()
 	suite.ms = store.NewCommitMultiStore(db)
 	suite.ms.MountStoreWithDB(aKey, sdk.StoreTypeIAVL, db)
+	suite.ms.MountStoreWithDB(pKey, sdk.StoreTypeIAVL, db)
+	suite.ms.MountStoreWithDB(pTKey, sdk.StoreTypeTransient, db)
 
 	err := suite.ms.LoadLatestVersion()
 	require.NoError(t, err)
 
 	suite.ctx = sdk.NewContext(suite.ms, tmproto.Header{}, true, testutil.Logger(t))
 
-	suite.keeper = keeper.NewKeeper(types.ModuleCdc, aKey)
+	pspace := paramstypes.NewSubspace(types.ModuleCdc, codec.NewLegacyAmino(), pKey, pTKey, types.ModuleName)
+	suite.keeper = keeper.NewKeeper(types.ModuleCdc, aKey, pspace)
 
-	suite.handler = handler.NewHandler(suite.keeper)
+	suite.handler = handler.NewHandler(suite.keeper, nil)
 
 	return suite
 }
@@ -80,6 +88,25 @@ func TestProviderSignNew(t *testing.T) {
 	require.Equal(t, prov, msgSignProviderAttributesToResponse(msg))
 }
 
+func TestProviderSignUnapprovedAuditor(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	suite.keeper.SetRegistryParams(suite.ctx, av1beta4.RegistryParams{RequireApprovedAuditors: true})
+
+	msg := &types.MsgSignProviderAttributes{
+		Owner:      testutil.AccAddress(t).String(),
+		Auditor:    testutil.AccAddress(t).String(),
+		Attributes: testutil.Attributes(t),
+	}
+
+	res, err := suite.handler(suite.ctx, msg)
+	require.Nil(t, res)
+	require.ErrorIs(t, err, keeper.ErrAuditorNotApproved)
+
+	_, exists := suite.keeper.GetProviderAttributes(suite.ctx, sdk.MustAccAddressFromBech32(msg.Owner))
+	require.False(t, exists)
+}
+
 func TestProviderSignAndUpdate(t *testing.T) {
 	suite := setupTestSuite(t)
 
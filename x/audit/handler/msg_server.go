@@ -8,15 +8,17 @@ import (
 	types "github.com/akash-network/akash-api/go/node/audit/v1beta3"
 
 	"github.com/akash-network/node/x/audit/keeper"
+	av1beta4 "github.com/akash-network/node/x/audit/types/v1beta4"
 )
 
 type msgServer struct {
-	keeper keeper.Keeper
+	keeper keeper.IKeeper
+	escrow EscrowKeeper
 }
 
 // NewMsgServerImpl returns an implementation of the market MsgServer interface
 // for the provided Keeper.
-func NewMsgServerImpl(k keeper.Keeper) types.MsgServer {
+func NewMsgServerImpl(k keeper.IKeeper) types.MsgServer {
 	return &msgServer{keeper: k}
 }
 
@@ -26,14 +28,28 @@ var _ types.MsgServer = msgServer{}
 func (ms msgServer) SignProviderAttributes(goCtx context.Context, msg *types.MsgSignProviderAttributes) (*types.MsgSignProviderAttributesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := SignProviderAttributesWithTerms(ctx, ms.keeper, msg, av1beta4.AuditTerms{}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSignProviderAttributesResponse{}, nil
+}
+
+// SignProviderAttributesWithTerms signs provider attributes with version and expiry assigned by the auditor.
+// MsgSignProviderAttributes carries no terms yet, msg server signs with zero terms: next version, no expiry.
+func SignProviderAttributesWithTerms(ctx sdk.Context, k keeper.IKeeper, msg *types.MsgSignProviderAttributes, terms av1beta4.AuditTerms) error {
 	auditor, err := sdk.AccAddressFromBech32(msg.Auditor)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	var owner sdk.AccAddress
 	if owner, err = sdk.AccAddressFromBech32(msg.Owner); err != nil {
-		return nil, err
+		return err
+	}
+
+	if err = k.CanSignProviderAttributes(ctx, auditor); err != nil {
+		return err
 	}
 
 	provID := types.ProviderID{
@@ -41,11 +57,7 @@ func (ms msgServer) SignProviderAttributes(goCtx context.Context, msg *types.Msg
 		Auditor: auditor,
 	}
 
-	if err = ms.keeper.CreateOrUpdateProviderAttributes(ctx, provID, msg.Attributes); err != nil {
-		return nil, err
-	}
-
-	return &types.MsgSignProviderAttributesResponse{}, nil
+	return k.CreateOrUpdateProviderAttributesWithTerms(ctx, provID, msg.Attributes, terms)
 }
 
 // DeleteProviderAttributes defines a method that deletes provider attributes
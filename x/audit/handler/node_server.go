@@ -0,0 +1,21 @@
+package handler
+
+import (
+	"github.com/akash-network/node/x/audit/keeper"
+	av1beta4 "github.com/akash-network/node/x/audit/types/v1beta4"
+)
+
+var _ av1beta4.MsgServer = nodeMsgServer{}
+
+// nodeMsgServer serves audit messages defined by node on top of akash-api ones
+type nodeMsgServer struct {
+	msgServer
+}
+
+// NewNodeServer returns an implementation of the node audit MsgServer interface
+// for the provided Keeper.
+func NewNodeServer(k keeper.IKeeper, ekeeper EscrowKeeper) av1beta4.MsgServer {
+	return &nodeMsgServer{
+		msgServer: msgServer{keeper: k, escrow: ekeeper},
+	}
+}
@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	etypes "github.com/akash-network/akash-api/go/node/escrow/v1beta3"
+
+	"github.com/akash-network/node/x/audit/keeper"
+	av1beta4 "github.com/akash-network/node/x/audit/types/v1beta4"
+)
+
+// EscrowKeeper holds deposits bonded by registered auditors
+type EscrowKeeper interface {
+	AccountCreate(ctx sdk.Context, id etypes.AccountID, owner, depositor sdk.AccAddress, deposit sdk.Coin) error
+	AccountClose(ctx sdk.Context, id etypes.AccountID) error
+}
+
+// RegisterAuditor adds auditor to the auditor registry and bonds its deposit in escrow
+func (ms nodeMsgServer) RegisterAuditor(goCtx context.Context, msg *av1beta4.MsgRegisterAuditor) (*av1beta4.MsgRegisterAuditorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.keeper.CreateAuditor(ctx, msg.Auditor()); err != nil {
+		return nil, err
+	}
+
+	owner := sdk.MustAccAddressFromBech32(msg.Address)
+
+	if err := ms.escrow.AccountCreate(ctx, keeper.EscrowAccountForAuditor(owner), owner, owner, msg.Deposit); err != nil {
+		return nil, err
+	}
+
+	return &av1beta4.MsgRegisterAuditorResponse{}, nil
+}
+
+// DeregisterAuditor removes auditor and its delegates from the auditor registry and returns its deposit.
+// Attributes signed by the auditor are kept.
+func (ms nodeMsgServer) DeregisterAuditor(goCtx context.Context, msg *av1beta4.MsgDeregisterAuditor) (*av1beta4.MsgDeregisterAuditorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	auditor, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ms.keeper.DeleteAuditor(ctx, auditor); err != nil {
+		return nil, err
+	}
+
+	if err := ms.escrow.AccountClose(ctx, keeper.EscrowAccountForAuditor(auditor)); err != nil {
+		return nil, err
+	}
+
+	return &av1beta4.MsgDeregisterAuditorResponse{}, nil
+}
+
+// CreateAuditorDelegate authorizes key to sign provider attributes on behalf of auditor
+func (ms nodeMsgServer) CreateAuditorDelegate(goCtx context.Context, msg *av1beta4.MsgCreateAuditorDelegate) (*av1beta4.MsgCreateAuditorDelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	auditor, _ := sdk.AccAddressFromBech32(msg.Auditor)
+	delegate, _ := sdk.AccAddressFromBech32(msg.Delegate)
+
+	if err := ms.keeper.CreateAuditorDelegate(ctx, auditor, delegate); err != nil {
+		return nil, err
+	}
+
+	return &av1beta4.MsgCreateAuditorDelegateResponse{}, nil
+}
+
+// DeleteAuditorDelegate revokes key signing on behalf of auditor
+func (ms nodeMsgServer) DeleteAuditorDelegate(goCtx context.Context, msg *av1beta4.MsgDeleteAuditorDelegate) (*av1beta4.MsgDeleteAuditorDelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	auditor, _ := sdk.AccAddressFromBech32(msg.Auditor)
+	delegate, _ := sdk.AccAddressFromBech32(msg.Delegate)
+
+	if err := ms.keeper.DeleteAuditorDelegate(ctx, auditor, delegate); err != nil {
+		return nil, err
+	}
+
+	return &av1beta4.MsgDeleteAuditorDelegateResponse{}, nil
+}
@@ -0,0 +1,78 @@
+package handler_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/akash-network/node/testutil"
+	"github.com/akash-network/node/testutil/state"
+	"github.com/akash-network/node/x/audit/handler"
+	"github.com/akash-network/node/x/audit/keeper"
+	av1beta4 "github.com/akash-network/node/x/audit/types/v1beta4"
+)
+
+func TestAuditorRegisterAndDeregister(t *testing.T) {
+	ssuite := state.SetupTestSuite(t)
+	ctx := ssuite.Context()
+	h := handler.NewHandler(ssuite.AuditKeeper(), ssuite.EscrowKeeper())
+
+	addr := testutil.AccAddress(t)
+	deposit := sdk.NewInt64Coin(testutil.CoinDenom, 100)
+
+	res, err := h(ctx, av1beta4.NewMsgRegisterAuditor(addr, "auditor", "https://auditor.example", deposit))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	auditor, found := ssuite.AuditKeeper().GetAuditor(ctx, addr)
+	require.True(t, found)
+	require.Equal(t, deposit, auditor.Deposit)
+
+	acc, err := ssuite.EscrowKeeper().GetAccount(ctx, keeper.EscrowAccountForAuditor(addr))
+	require.NoError(t, err)
+	require.Equal(t, deposit.Amount, acc.Balance.Amount.TruncateInt())
+
+	_, err = h(ctx, av1beta4.NewMsgRegisterAuditor(addr, "auditor", "", deposit))
+	require.ErrorIs(t, err, keeper.ErrAuditorExists)
+
+	res, err = h(ctx, av1beta4.NewMsgDeregisterAuditor(addr))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	_, found = ssuite.AuditKeeper().GetAuditor(ctx, addr)
+	require.False(t, found)
+
+	_, err = h(ctx, av1beta4.NewMsgDeregisterAuditor(addr))
+	require.ErrorIs(t, err, keeper.ErrAuditorNotFound)
+}
+
+func TestAuditorDelegate(t *testing.T) {
+	ssuite := state.SetupTestSuite(t)
+	ctx := ssuite.Context()
+	h := handler.NewHandler(ssuite.AuditKeeper(), ssuite.EscrowKeeper())
+
+	addr := testutil.AccAddress(t)
+	delegate := testutil.AccAddress(t)
+
+	_, err := h(ctx, av1beta4.NewMsgCreateAuditorDelegate(addr, delegate))
+	require.ErrorIs(t, err, keeper.ErrAuditorNotFound)
+
+	_, err = h(ctx, av1beta4.NewMsgRegisterAuditor(addr, "auditor", "", sdk.NewInt64Coin(testutil.CoinDenom, 100)))
+	require.NoError(t, err)
+
+	res, err := h(ctx, av1beta4.NewMsgCreateAuditorDelegate(addr, delegate))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	val, found := ssuite.AuditKeeper().GetAuditorDelegate(ctx, delegate)
+	require.True(t, found)
+	require.Equal(t, addr.String(), val.Auditor)
+
+	res, err = h(ctx, av1beta4.NewMsgDeleteAuditorDelegate(addr, delegate))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	_, found = ssuite.AuditKeeper().GetAuditorDelegate(ctx, delegate)
+	require.False(t, found)
+}
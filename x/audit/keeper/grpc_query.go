@@ -3,12 +3,15 @@ package keeper
 import (
 	"context"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	types "github.com/akash-network/akash-api/go/node/audit/v1beta3"
+
+	av1beta4 "github.com/akash-network/node/x/audit/types/v1beta4"
 )
 
 // Querier is used as Keeper will have duplicate methods if used directly, and gRPC names take precedence over keeper
@@ -16,7 +19,10 @@ type Querier struct {
 	Keeper
 }
 
-var _ types.QueryServer = Querier{}
+var (
+	_ types.QueryServer    = Querier{}
+	_ av1beta4.QueryServer = Querier{}
+)
 
 func (q Querier) AllProvidersAttributes(
 	c context.Context,
@@ -29,19 +35,9 @@ func (q Querier) AllProvidersAttributes(
 	var providers types.Providers
 	ctx := sdk.UnwrapSDKContext(c)
 
-	store := ctx.KVStore(q.skey)
-
-	pageRes, err := sdkquery.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
-		var provider types.Provider
-
-		err := q.cdc.Unmarshal(value, &provider)
-		if err != nil {
-			return err
-		}
+	store := prefix.NewStore(ctx.KVStore(q.skey), types.PrefixProviderID())
 
-		providers = append(providers, provider)
-		return nil
-	})
+	pageRes, err := sdkquery.FilteredPaginate(store, req.Pagination, q.unexpiredProviders(ctx, &providers))
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -98,11 +94,13 @@ func (q Querier) ProviderAuditorAttributes(
 
 	ctx := sdk.UnwrapSDKContext(c)
 
-	provider, found := q.GetProviderByAuditor(ctx, types.ProviderID{
+	id := types.ProviderID{
 		Owner:   owner,
 		Auditor: auditor,
-	})
-	if !found {
+	}
+
+	provider, found := q.GetProviderByAuditor(ctx, id)
+	if !found || q.auditTermsExpired(ctx, id) {
 		return nil, types.ErrProviderNotFound
 	}
 
@@ -123,25 +121,78 @@ func (q Querier) AuditorAttributes(
 	var providers types.Providers
 	ctx := sdk.UnwrapSDKContext(c)
 
-	store := ctx.KVStore(q.skey)
+	store := prefix.NewStore(ctx.KVStore(q.skey), types.PrefixProviderID())
+
+	pageRes, err := sdkquery.FilteredPaginate(store, req.Pagination, q.unexpiredProviders(ctx, &providers))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryProvidersResponse{
+		Providers:  providers,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (q Querier) AuditHistory(
+	c context.Context,
+	req *av1beta4.QueryAuditHistoryRequest,
+) (*av1beta4.QueryAuditHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, types.ErrInvalidAddress
+	}
+
+	var entries []av1beta4.AuditHistoryEntry
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(q.skey), auditHistoryPrefixFor(owner))
 
 	pageRes, err := sdkquery.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
-		var provider types.Provider
+		var entry av1beta4.AuditHistoryEntry
 
-		err := q.cdc.Unmarshal(value, &provider)
+		err := q.cdc.Unmarshal(value, &entry)
 		if err != nil {
 			return err
 		}
 
-		providers = append(providers, provider)
+		entries = append(entries, entry)
 		return nil
 	})
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &types.QueryProvidersResponse{
-		Providers:  providers,
+	return &av1beta4.QueryAuditHistoryResponse{
+		Entries:    entries,
 		Pagination: pageRes,
 	}, nil
 }
+
+// unexpiredProviders returns pagination callback collecting signed attributes which audit terms did not expire
+func (q Querier) unexpiredProviders(ctx sdk.Context, providers *types.Providers) func([]byte, []byte, bool) (bool, error) {
+	return func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var provider types.Provider
+
+		err := q.cdc.Unmarshal(value, &provider)
+		if err != nil {
+			return false, err
+		}
+
+		owner, oerr := sdk.AccAddressFromBech32(provider.Owner)
+		auditor, aerr := sdk.AccAddressFromBech32(provider.Auditor)
+		if oerr == nil && aerr == nil && q.auditTermsExpired(ctx, types.ProviderID{Owner: owner, Auditor: auditor}) {
+			return false, nil
+		}
+
+		if accumulate {
+			*providers = append(*providers, provider)
+		}
+
+		return true, nil
+	}
+}
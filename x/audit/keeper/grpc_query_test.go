@@ -15,6 +15,7 @@ import (
 	"github.com/akash-network/node/app"
 	"github.com/akash-network/node/testutil"
 	"github.com/akash-network/node/x/audit/keeper"
+	av1beta4 "github.com/akash-network/node/x/audit/types/v1beta4"
 )
 
 type grpcTestSuite struct {
@@ -23,7 +24,8 @@ type grpcTestSuite struct {
 	ctx    sdk.Context
 	keeper keeper.Keeper
 
-	queryClient types.QueryClient
+	queryClient     types.QueryClient
+	nodeQueryClient av1beta4.QueryClient
 }
 
 func setupTest(t *testing.T) *grpcTestSuite {
@@ -37,7 +39,9 @@ func setupTest(t *testing.T) *grpcTestSuite {
 
 	queryHelper := baseapp.NewQueryServerTestHelper(suite.ctx, suite.app.InterfaceRegistry())
 	types.RegisterQueryServer(queryHelper, querier)
+	av1beta4.RegisterQueryServer(queryHelper, querier)
 	suite.queryClient = types.NewQueryClient(queryHelper)
+	suite.nodeQueryClient = av1beta4.NewQueryClient(queryHelper)
 
 	return suite
 }
@@ -163,3 +167,68 @@ func TestGRPCQueryProviders(t *testing.T) {
 		})
 	}
 }
+
+func TestGRPCQueryProvidersExpired(t *testing.T) {
+	suite := setupTest(t)
+	suite.ctx = suite.ctx.WithBlockHeight(10)
+
+	id1, provider := testutil.AuditedProvider(t)
+	err := suite.keeper.CreateOrUpdateProviderAttributesWithTerms(suite.ctx, id1, provider.Attributes, av1beta4.AuditTerms{ExpiresAtHeight: 20})
+	require.NoError(t, err)
+
+	id2, provider2 := testutil.AuditedProvider(t)
+	err = suite.keeper.CreateOrUpdateProviderAttributes(suite.ctx, id2, provider2.Attributes)
+	require.NoError(t, err)
+
+	// query helper serves requests at its own context, query at expiry height directly
+	querier := keeper.Querier{Keeper: suite.keeper}
+	ctx := sdk.WrapSDKContext(suite.ctx.WithBlockHeight(20))
+
+	res, err := querier.AllProvidersAttributes(ctx, &types.QueryAllProvidersAttributesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.Providers{provider2}, res.Providers)
+
+	res, err = querier.AuditorAttributes(ctx, &types.QueryAuditorAttributesRequest{
+		Pagination: &sdkquery.PageRequest{Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Equal(t, types.Providers{provider2}, res.Providers)
+
+	_, err = querier.ProviderAuditorAttributes(ctx, &types.QueryProviderAuditorRequest{
+		Owner:   id1.Owner.String(),
+		Auditor: id1.Auditor.String(),
+	})
+	require.Error(t, err)
+}
+
+func TestGRPCQueryAuditHistory(t *testing.T) {
+	suite := setupTest(t)
+
+	id, provider := testutil.AuditedProvider(t)
+	for i := 0; i < 3; i++ {
+		err := suite.keeper.CreateOrUpdateProviderAttributes(suite.ctx, id, provider.Attributes)
+		require.NoError(t, err)
+	}
+
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	_, err := suite.nodeQueryClient.AuditHistory(ctx, &av1beta4.QueryAuditHistoryRequest{Owner: "invalid"})
+	require.Error(t, err)
+
+	res, err := suite.nodeQueryClient.AuditHistory(ctx, &av1beta4.QueryAuditHistoryRequest{
+		Owner:      id.Owner.String(),
+		Pagination: &sdkquery.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 2)
+	require.Equal(t, uint64(3), res.Pagination.Total)
+	require.Equal(t, uint64(1), res.Entries[0].Terms.Version)
+
+	res, err = suite.nodeQueryClient.AuditHistory(ctx, &av1beta4.QueryAuditHistoryRequest{
+		Owner:      id.Owner.String(),
+		Pagination: &sdkquery.PageRequest{Key: res.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 1)
+	require.Equal(t, uint64(3), res.Entries[0].Terms.Version)
+}
@@ -6,11 +6,14 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	types "github.com/akash-network/akash-api/go/node/audit/v1beta3"
 
 	akashtypes "github.com/akash-network/akash-api/go/node/types/v1beta3"
 	atypes "github.com/akash-network/akash-api/go/node/types/v1beta3"
+
+	av1beta4 "github.com/akash-network/node/x/audit/types/v1beta4"
 )
 
 // TODO: use interfaces for keepers, queriers
@@ -19,19 +22,47 @@ type IKeeper interface {
 	GetProviderAttributes(ctx sdk.Context, id sdk.Address) (types.Providers, bool)
 	CreateOrUpdateProviderAttributes(ctx sdk.Context, id types.ProviderID, attr akashtypes.Attributes) error
 	DeleteProviderAttributes(ctx sdk.Context, id types.ProviderID, keys []string) error
+	SetAuditedAttributes(ctx sdk.Context, attr types.AuditedAttributes) error
 	WithProviders(ctx sdk.Context, fn func(types.Provider) bool)
 	WithProvider(ctx sdk.Context, id sdk.Address, fn func(types.Provider) bool)
+	GetAuditTerms(ctx sdk.Context, id types.ProviderID) (av1beta4.AuditTerms, bool)
+	SetAuditTerms(ctx sdk.Context, id types.ProviderID, terms av1beta4.AuditTerms)
+	WithAuditTerms(ctx sdk.Context, fn func(av1beta4.ProviderAuditTerms) bool)
+	CreateOrUpdateProviderAttributesWithTerms(ctx sdk.Context, id types.ProviderID, attr akashtypes.Attributes, terms av1beta4.AuditTerms) error
+	WithAuditHistory(ctx sdk.Context, owner sdk.Address, fn func(av1beta4.AuditHistoryEntry) bool)
+	WithAllAuditHistory(ctx sdk.Context, fn func(av1beta4.AuditHistoryEntry) bool)
+	AppendAuditHistory(ctx sdk.Context, entry av1beta4.AuditHistoryEntry) error
+	GetProviderAttributesDelegated(ctx sdk.Context, id sdk.Address) (types.Providers, bool)
+	GetRegistryParams(ctx sdk.Context) av1beta4.RegistryParams
+	SetRegistryParams(ctx sdk.Context, params av1beta4.RegistryParams)
+	GetAuditor(ctx sdk.Context, id sdk.Address) (av1beta4.Auditor, bool)
+	CreateAuditor(ctx sdk.Context, auditor av1beta4.Auditor) error
+	SetAuditor(ctx sdk.Context, auditor av1beta4.Auditor)
+	DeleteAuditor(ctx sdk.Context, id sdk.Address) error
+	WithAuditors(ctx sdk.Context, fn func(av1beta4.Auditor) bool)
+	IsAuditorApproved(ctx sdk.Context, id sdk.Address) bool
+	CreateAuditorDelegate(ctx sdk.Context, auditor, delegate sdk.Address) error
+	SetAuditorDelegate(ctx sdk.Context, val av1beta4.AuditorDelegate)
+	DeleteAuditorDelegate(ctx sdk.Context, auditor, delegate sdk.Address) error
+	GetAuditorDelegate(ctx sdk.Context, delegate sdk.Address) (av1beta4.AuditorDelegate, bool)
+	WithAuditorDelegates(ctx sdk.Context, auditor sdk.Address, fn func(av1beta4.AuditorDelegate) bool)
+	CanSignProviderAttributes(ctx sdk.Context, signer sdk.Address) error
 }
 
 // Keeper of the provider store
 type Keeper struct {
-	skey sdk.StoreKey
-	cdc  codec.BinaryCodec
+	skey   sdk.StoreKey
+	cdc    codec.BinaryCodec
+	pspace paramtypes.Subspace
 }
 
 // NewKeeper creates and returns an instance for Market keeper
-func NewKeeper(cdc codec.BinaryCodec, skey sdk.StoreKey) Keeper {
-	return Keeper{cdc: cdc, skey: skey}
+func NewKeeper(cdc codec.BinaryCodec, skey sdk.StoreKey, pspace paramtypes.Subspace) Keeper {
+	if !pspace.HasKeyTable() {
+		pspace = pspace.WithKeyTable(ParamKeyTable())
+	}
+
+	return Keeper{cdc: cdc, skey: skey, pspace: pspace}
 }
 
 // Codec returns keeper codec
@@ -59,7 +90,8 @@ func (k Keeper) GetProviderByAuditor(ctx sdk.Context, id types.ProviderID) (type
 	return val, true
 }
 
-// GetProviderAttributes returns a provider with given auditor and owner id's
+// GetProviderAttributes returns attributes of provider with given owner id signed by all auditors.
+// Attributes which audit terms expired are not returned.
 func (k Keeper) GetProviderAttributes(ctx sdk.Context, id sdk.Address) (types.Providers, bool) {
 	store := ctx.KVStore(k.skey)
 
@@ -73,6 +105,12 @@ func (k Keeper) GetProviderAttributes(ctx sdk.Context, id sdk.Address) (types.Pr
 	for ; iter.Valid(); iter.Next() {
 		var val types.Provider
 		k.cdc.MustUnmarshal(iter.Value(), &val)
+
+		auditor, err := sdk.AccAddressFromBech32(val.Auditor)
+		if err == nil && k.auditTermsExpired(ctx, types.ProviderID{Owner: sdk.AccAddress(id.Bytes()), Auditor: auditor}) {
+			continue
+		}
+
 		attr = append(attr, val)
 	}
 
@@ -85,8 +123,13 @@ func (k Keeper) GetProviderAttributes(ctx sdk.Context, id sdk.Address) (types.Pr
 
 // CreateOrUpdateProviderAttributes update signed provider attributes.
 // creates new if key does not exist
-// if key exists, existing values for matching pairs will be replaced
+// if key exists, existing values for matching pairs will be replaced.
+// Signed set gets next version and no expiry, see CreateOrUpdateProviderAttributesWithTerms
 func (k Keeper) CreateOrUpdateProviderAttributes(ctx sdk.Context, id types.ProviderID, attr akashtypes.Attributes) error {
+	return k.CreateOrUpdateProviderAttributesWithTerms(ctx, id, attr, av1beta4.AuditTerms{})
+}
+
+func (k Keeper) setProviderAttributes(ctx sdk.Context, id types.ProviderID, attr akashtypes.Attributes) error {
 	store := ctx.KVStore(k.skey)
 	key := providerKey(id)
 
@@ -136,6 +179,29 @@ func (k Keeper) CreateOrUpdateProviderAttributes(ctx sdk.Context, id types.Provi
 	return nil
 }
 
+// SetAuditedAttributes stores attributes signed by auditor for provider as is, replacing existing ones
+func (k Keeper) SetAuditedAttributes(ctx sdk.Context, attr types.AuditedAttributes) error {
+	owner, err := sdk.AccAddressFromBech32(attr.Owner)
+	if err != nil {
+		return err
+	}
+
+	auditor, err := sdk.AccAddressFromBech32(attr.Auditor)
+	if err != nil {
+		return err
+	}
+
+	prov := types.Provider{
+		Owner:      attr.Owner,
+		Auditor:    attr.Auditor,
+		Attributes: attr.Attributes,
+	}
+
+	ctx.KVStore(k.skey).Set(providerKey(types.ProviderID{Owner: owner, Auditor: auditor}), k.cdc.MustMarshal(&prov))
+
+	return nil
+}
+
 func (k Keeper) DeleteProviderAttributes(ctx sdk.Context, id types.ProviderID, keys []string) error {
 	store := ctx.KVStore(k.skey)
 	key := providerKey(id)
@@ -145,6 +211,12 @@ func (k Keeper) DeleteProviderAttributes(ctx sdk.Context, id types.ProviderID, k
 		return types.ErrProviderNotFound
 	}
 
+	defer func() {
+		if !store.Has(key) {
+			store.Delete(auditTermsKey(id))
+		}
+	}()
+
 	if keys == nil {
 		store.Delete(key)
 	} else {
@@ -195,6 +267,13 @@ func (k Keeper) DeleteProviderAttributes(ctx sdk.Context, id types.ProviderID, k
 		}
 	}
 
+	terms, _ := k.GetAuditTerms(ctx, id)
+	k.appendAuditHistory(ctx, id, av1beta4.AuditHistoryEntry{
+		Action: av1beta4.AuditActionDeleted,
+		Keys:   keys,
+		Terms:  terms,
+	})
+
 	ctx.EventManager().EmitEvent(
 		types.NewEventTrustedAuditorDeleted(id.Owner, id.Auditor).ToSDKEvent(),
 	)
@@ -205,7 +284,7 @@ func (k Keeper) DeleteProviderAttributes(ctx sdk.Context, id types.ProviderID, k
 // WithProviders iterates all signed provider's attributes
 func (k Keeper) WithProviders(ctx sdk.Context, fn func(types.Provider) bool) {
 	store := ctx.KVStore(k.skey)
-	iter := store.Iterator(nil, nil)
+	iter := sdk.KVStorePrefixIterator(store, types.PrefixProviderID())
 
 	defer func() {
 		_ = iter.Close()
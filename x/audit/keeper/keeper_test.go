@@ -6,8 +6,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/rand"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -16,7 +18,9 @@ import (
 	types "github.com/akash-network/akash-api/go/node/audit/v1beta3"
 
 	"github.com/akash-network/node/testutil"
+	"github.com/akash-network/node/x/audit"
 	"github.com/akash-network/node/x/audit/keeper"
+	av1beta4 "github.com/akash-network/node/x/audit/types/v1beta4"
 )
 
 func TestProviderCreate(t *testing.T) {
@@ -144,6 +148,175 @@ func TestProviderDeleteNonExisting(t *testing.T) {
 	require.EqualError(t, err, types.ErrProviderNotFound.Error())
 }
 
+func TestProviderAuditTermsVersion(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	id, prov := testutil.AuditedProvider(t)
+
+	err := k.CreateOrUpdateProviderAttributes(ctx, id, prov.Attributes)
+	require.NoError(t, err)
+
+	terms, found := k.GetAuditTerms(ctx, id)
+	require.True(t, found)
+	require.Equal(t, uint64(1), terms.Version)
+
+	err = k.CreateOrUpdateProviderAttributesWithTerms(ctx, id, prov.Attributes, av1beta4.AuditTerms{Version: 5})
+	require.NoError(t, err)
+
+	err = k.CreateOrUpdateProviderAttributesWithTerms(ctx, id, prov.Attributes, av1beta4.AuditTerms{Version: 5})
+	require.ErrorIs(t, err, keeper.ErrAuditVersion)
+
+	err = k.CreateOrUpdateProviderAttributes(ctx, id, prov.Attributes)
+	require.NoError(t, err)
+
+	terms, _ = k.GetAuditTerms(ctx, id)
+	require.Equal(t, uint64(6), terms.Version)
+}
+
+func TestProviderAuditTermsExpiry(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	ctx = ctx.WithBlockHeight(10)
+
+	id, prov := testutil.AuditedProvider(t)
+	other, oprov := testutil.AuditedProvider(t)
+	other.Owner = id.Owner
+	oprov.Owner = prov.Owner
+
+	err := k.CreateOrUpdateProviderAttributesWithTerms(ctx, id, prov.Attributes, av1beta4.AuditTerms{ExpiresAtHeight: 10})
+	require.ErrorIs(t, err, keeper.ErrAuditExpiry)
+
+	err = k.CreateOrUpdateProviderAttributesWithTerms(ctx, id, prov.Attributes, av1beta4.AuditTerms{ExpiresAtHeight: 20})
+	require.NoError(t, err)
+
+	expiresAt := ctx.BlockTime().Add(time.Hour)
+	err = k.CreateOrUpdateProviderAttributesWithTerms(ctx, other, oprov.Attributes, av1beta4.AuditTerms{ExpiresAt: &expiresAt})
+	require.NoError(t, err)
+
+	found, _ := k.GetProviderAttributes(ctx, id.Owner)
+	require.Len(t, found, 2)
+
+	// expired by height
+	found, _ = k.GetProviderAttributes(ctx.WithBlockHeight(20), id.Owner)
+	require.Equal(t, types.Providers{oprov}, found)
+
+	// expired by time
+	found, _ = k.GetProviderAttributes(ctx.WithBlockTime(expiresAt), id.Owner)
+	require.Equal(t, types.Providers{prov}, found)
+
+	_, ok := k.GetProviderAttributes(ctx.WithBlockHeight(20).WithBlockTime(expiresAt), id.Owner)
+	require.False(t, ok)
+
+	// signing again renews attributes
+	ctx = ctx.WithBlockHeight(20)
+	err = k.CreateOrUpdateProviderAttributes(ctx, id, prov.Attributes)
+	require.NoError(t, err)
+
+	found, _ = k.GetProviderAttributes(ctx, id.Owner)
+	require.Len(t, found, 2)
+}
+
+func TestProviderAuditHistory(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	id, prov := testutil.AuditedProvider(t)
+
+	err := k.CreateOrUpdateProviderAttributes(ctx, id, prov.Attributes)
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	err = k.DeleteProviderAttributes(ctx, id, nil)
+	require.NoError(t, err)
+
+	var history []av1beta4.AuditHistoryEntry
+	k.WithAuditHistory(ctx, id.Owner, func(entry av1beta4.AuditHistoryEntry) bool {
+		history = append(history, entry)
+		return false
+	})
+
+	require.Len(t, history, 2)
+
+	require.Equal(t, av1beta4.AuditActionSigned, history[0].Action)
+	require.Equal(t, id.Auditor.String(), history[0].Auditor)
+	require.Equal(t, prov.Attributes, history[0].Attributes)
+	require.Equal(t, uint64(1), history[0].Terms.Version)
+
+	require.Equal(t, av1beta4.AuditActionDeleted, history[1].Action)
+	require.Equal(t, ctx.BlockHeight(), history[1].Height)
+
+	_, found := k.GetAuditTerms(ctx, id)
+	require.False(t, found)
+}
+
+func TestProviderAuditHistoryPruned(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	id, prov := testutil.AuditedProvider(t)
+
+	for i := 0; i < keeper.MaxAuditHistory+5; i++ {
+		err := k.CreateOrUpdateProviderAttributes(ctx, id, prov.Attributes)
+		require.NoError(t, err)
+	}
+
+	var history []av1beta4.AuditHistoryEntry
+	k.WithAuditHistory(ctx, id.Owner, func(entry av1beta4.AuditHistoryEntry) bool {
+		history = append(history, entry)
+		return false
+	})
+
+	require.Len(t, history, keeper.MaxAuditHistory)
+	require.Equal(t, uint64(6), history[0].Terms.Version)
+	require.Equal(t, uint64(keeper.MaxAuditHistory+5), history[len(history)-1].Terms.Version)
+}
+
+func TestAuditGenesis(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	ctx = ctx.WithBlockHeight(10)
+
+	id, prov := testutil.AuditedProvider(t)
+	// keep provider signed once one of its attributes is deleted
+	prov.Attributes = append(prov.Attributes, testutil.Attribute(t))
+
+	err := k.CreateOrUpdateProviderAttributesWithTerms(ctx, id, prov.Attributes, av1beta4.AuditTerms{ExpiresAtHeight: 20})
+	require.NoError(t, err)
+
+	err = k.DeleteProviderAttributes(ctx, id, []string{prov.Attributes[0].Key})
+	require.NoError(t, err)
+
+	auditor := newAuditor(t, 100)
+	k.SetRegistryParams(ctx, av1beta4.RegistryParams{
+		MinAuditorDeposit: sdk.NewInt64Coin(testutil.CoinDenom, 100),
+		ApprovedAuditors:  []string{auditor.Address},
+	})
+
+	err = k.CreateAuditor(ctx, auditor)
+	require.NoError(t, err)
+
+	err = k.CreateAuditorDelegate(ctx, sdk.MustAccAddressFromBech32(auditor.Address), testutil.AccAddress(t))
+	require.NoError(t, err)
+
+	gs := audit.ExportGenesis(ctx, k)
+	require.NoError(t, audit.ValidateGenesis(gs))
+	require.Len(t, gs.Attributes, 1)
+	require.Len(t, gs.Terms, 1)
+	require.Len(t, gs.History, 2)
+	require.Len(t, gs.Auditors, 1)
+	require.Len(t, gs.Delegates, 1)
+
+	nctx, nk := setupKeeper(t)
+	nctx = nctx.WithBlockHeight(10)
+	audit.InitGenesis(nctx, nk, gs)
+
+	require.Equal(t, gs, audit.ExportGenesis(nctx, nk))
+
+	// imported terms keep expiring
+	_, found := nk.GetProviderAttributes(nctx.WithBlockHeight(20), id.Owner)
+	require.False(t, found)
+
+	gs.History[0].Action = "unknown"
+	require.Error(t, audit.ValidateGenesis(gs))
+
+	gs.History[0].Action = av1beta4.AuditActionSigned
+	gs.Auditors = nil
+	require.Error(t, audit.ValidateGenesis(gs))
+}
+
 func TestKeeperCoder(t *testing.T) {
 	_, keeper := setupKeeper(t)
 	codec := keeper.Codec()
@@ -153,11 +326,16 @@ func TestKeeperCoder(t *testing.T) {
 func setupKeeper(t testing.TB) (sdk.Context, keeper.Keeper) {
 	t.Helper()
 	key := sdk.NewKVStoreKey(types.StoreKey)
+	pKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	pTKey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
 	db := dbm.NewMemDB()
 	ms := store.NewCommitMultiStore(db)
 	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(pKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(pTKey, sdk.StoreTypeTransient, db)
 	err := ms.LoadLatestVersion()
 	require.NoError(t, err)
 	ctx := sdk.NewContext(ms, tmproto.Header{Time: time.Unix(0, 0)}, false, testutil.Logger(t))
-	return ctx, keeper.NewKeeper(types.ModuleCdc, key)
+	pspace := paramstypes.NewSubspace(types.ModuleCdc, codec.NewLegacyAmino(), pKey, pTKey, types.ModuleName)
+	return ctx, keeper.NewKeeper(types.ModuleCdc, key, pspace)
 }
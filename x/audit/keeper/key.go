@@ -31,3 +31,57 @@ func providerPrefix(id sdk.Address) []byte {
 
 	return buf.Bytes()
 }
+
+// auditTermsPrefix holds version and expiry of attributes signed by an auditor.
+// It is local to this module and not part of the akash-api store layout.
+func auditTermsPrefix() []byte {
+	return []byte{0x02}
+}
+
+// auditHistoryPrefix holds log of attributes signed and deleted by auditors, per provider
+func auditHistoryPrefix() []byte {
+	return []byte{0x03}
+}
+
+func auditTermsKey(id types.ProviderID) []byte {
+	return append(auditTermsPrefix(), providerKey(id)[len(types.PrefixProviderID()):]...)
+}
+
+func auditHistoryPrefixFor(owner sdk.Address) []byte {
+	return append(auditHistoryPrefix(), address.MustLengthPrefix(owner.Bytes())...)
+}
+
+func auditHistoryKey(owner sdk.Address, seq uint64) []byte {
+	return append(auditHistoryPrefixFor(owner), sdk.Uint64ToBigEndian(seq)...)
+}
+
+// auditorPrefix holds auditors registered in the auditor registry
+func auditorPrefix() []byte {
+	return []byte{0x04}
+}
+
+// auditorDelegatePrefix maps keys authorized to sign on behalf of an auditor to the auditor
+func auditorDelegatePrefix() []byte {
+	return []byte{0x05}
+}
+
+// auditorDelegatesPrefix indexes delegated keys by the auditor which authorized them
+func auditorDelegatesPrefix() []byte {
+	return []byte{0x06}
+}
+
+func auditorKey(id sdk.Address) []byte {
+	return append(auditorPrefix(), address.MustLengthPrefix(id.Bytes())...)
+}
+
+func auditorDelegateKey(delegate sdk.Address) []byte {
+	return append(auditorDelegatePrefix(), address.MustLengthPrefix(delegate.Bytes())...)
+}
+
+func auditorDelegatesPrefixFor(parent sdk.Address) []byte {
+	return append(auditorDelegatesPrefix(), address.MustLengthPrefix(parent.Bytes())...)
+}
+
+func auditorDelegatesKey(parent, delegate sdk.Address) []byte {
+	return append(auditorDelegatesPrefixFor(parent), address.MustLengthPrefix(delegate.Bytes())...)
+}
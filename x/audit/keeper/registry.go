@@ -0,0 +1,328 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	types "github.com/akash-network/akash-api/go/node/audit/v1beta3"
+	etypes "github.com/akash-network/akash-api/go/node/escrow/v1beta3"
+
+	av1beta4 "github.com/akash-network/node/x/audit/types/v1beta4"
+)
+
+const (
+	auditorEscrowScope = "auditor"
+)
+
+var (
+	ErrAuditorExists        = errors.New("auditor already registered")
+	ErrAuditorNotFound      = errors.New("auditor not registered")
+	ErrAuditorDeposit       = errors.New("auditor deposit below minimum")
+	ErrAuditorNotApproved   = errors.New("auditor is not approved by governance")
+	ErrAuditorDelegate      = errors.New("invalid auditor delegate")
+	ErrAuditorDelegateFound = errors.New("key already signs on behalf of an auditor")
+	ErrAuditorDelegateNone  = errors.New("key does not sign on behalf of the auditor")
+)
+
+// ParamKeyTable returns key table of audit params
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().
+		RegisterParamSet(&av1beta4.RegistryParams{})
+}
+
+// EscrowAccountForAuditor returns escrow account holding deposit of given auditor
+func EscrowAccountForAuditor(id sdk.Address) etypes.AccountID {
+	return etypes.AccountID{
+		Scope: auditorEscrowScope,
+		XID:   id.String(),
+	}
+}
+
+// GetRegistryParams returns auditor registry params. Params never set default to zero.
+func (k Keeper) GetRegistryParams(ctx sdk.Context) av1beta4.RegistryParams {
+	var params av1beta4.RegistryParams
+
+	for _, pair := range params.ParamSetPairs() {
+		k.pspace.GetIfExists(ctx, pair.Key, pair.Value)
+	}
+
+	return params
+}
+
+// SetRegistryParams sets auditor registry params
+func (k Keeper) SetRegistryParams(ctx sdk.Context, params av1beta4.RegistryParams) {
+	k.pspace.SetParamSet(ctx, &params)
+}
+
+// GetAuditor returns registered auditor with given address
+func (k Keeper) GetAuditor(ctx sdk.Context, id sdk.Address) (av1beta4.Auditor, bool) {
+	buf := ctx.KVStore(k.skey).Get(auditorKey(id))
+	if buf == nil {
+		return av1beta4.Auditor{}, false
+	}
+
+	var auditor av1beta4.Auditor
+	k.cdc.MustUnmarshal(buf, &auditor)
+
+	return auditor, true
+}
+
+// CreateAuditor registers auditor. Deposit must be bonded by the caller.
+func (k Keeper) CreateAuditor(ctx sdk.Context, auditor av1beta4.Auditor) error {
+	auditor.RegisteredAt = ctx.BlockHeight()
+
+	if err := auditor.ValidateBasic(); err != nil {
+		return err
+	}
+
+	id, _ := sdk.AccAddressFromBech32(auditor.Address)
+
+	if _, found := k.GetAuditor(ctx, id); found {
+		return ErrAuditorExists
+	}
+
+	if _, found := k.GetAuditorDelegate(ctx, id); found {
+		return ErrAuditorDelegateFound
+	}
+
+	if min := k.GetRegistryParams(ctx).MinAuditorDeposit; min.Denom != "" {
+		if auditor.Deposit.Denom != min.Denom || auditor.Deposit.IsLT(min) {
+			return fmt.Errorf("%w: %s", ErrAuditorDeposit, min)
+		}
+	}
+
+	k.SetAuditor(ctx, auditor)
+
+	ctx.EventManager().EmitEvent(
+		av1beta4.NewEventAuditorRegistered(id).
+			ToSDKEvent(),
+	)
+
+	return nil
+}
+
+// SetAuditor stores auditor record as is
+func (k Keeper) SetAuditor(ctx sdk.Context, auditor av1beta4.Auditor) {
+	id := sdk.MustAccAddressFromBech32(auditor.Address)
+	ctx.KVStore(k.skey).Set(auditorKey(id), k.cdc.MustMarshal(&auditor))
+}
+
+// DeleteAuditor removes auditor and keys delegated by it from the registry.
+// Attributes signed by the auditor are kept. Deposit must be returned by the caller.
+func (k Keeper) DeleteAuditor(ctx sdk.Context, id sdk.Address) error {
+	if _, found := k.GetAuditor(ctx, id); !found {
+		return ErrAuditorNotFound
+	}
+
+	var delegates []av1beta4.AuditorDelegate
+	k.WithAuditorDelegates(ctx, id, func(delegate av1beta4.AuditorDelegate) bool {
+		delegates = append(delegates, delegate)
+		return false
+	})
+
+	for _, delegate := range delegates {
+		if err := k.DeleteAuditorDelegate(ctx, id, sdk.MustAccAddressFromBech32(delegate.Delegate)); err != nil {
+			return err
+		}
+	}
+
+	ctx.KVStore(k.skey).Delete(auditorKey(id))
+
+	ctx.EventManager().EmitEvent(
+		av1beta4.NewEventAuditorDeregistered(id).
+			ToSDKEvent(),
+	)
+
+	return nil
+}
+
+// WithAuditors iterates all registered auditors
+func (k Keeper) WithAuditors(ctx sdk.Context, fn func(av1beta4.Auditor) bool) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.skey), auditorPrefix())
+	defer func() {
+		_ = iter.Close()
+	}()
+
+	for ; iter.Valid(); iter.Next() {
+		var auditor av1beta4.Auditor
+		k.cdc.MustUnmarshal(iter.Value(), &auditor)
+
+		if stop := fn(auditor); stop {
+			break
+		}
+	}
+}
+
+// IsAuditorApproved returns true if auditor is registered and listed in RegistryParams.ApprovedAuditors
+func (k Keeper) IsAuditorApproved(ctx sdk.Context, id sdk.Address) bool {
+	if _, found := k.GetAuditor(ctx, id); !found {
+		return false
+	}
+
+	for _, addr := range k.GetRegistryParams(ctx).ApprovedAuditors {
+		if addr == id.String() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CreateAuditorDelegate authorizes delegate to sign provider attributes on behalf of registered auditor.
+// A key signs on behalf of at most one auditor and registered auditors can not be delegates.
+func (k Keeper) CreateAuditorDelegate(ctx sdk.Context, auditor, delegate sdk.Address) error {
+	if auditor.Equals(delegate) {
+		return fmt.Errorf("%w: auditor can not delegate to itself", ErrAuditorDelegate)
+	}
+
+	if _, found := k.GetAuditor(ctx, auditor); !found {
+		return ErrAuditorNotFound
+	}
+
+	if _, found := k.GetAuditor(ctx, delegate); found {
+		return fmt.Errorf("%w: delegate is a registered auditor", ErrAuditorDelegate)
+	}
+
+	if _, found := k.GetAuditorDelegate(ctx, delegate); found {
+		return ErrAuditorDelegateFound
+	}
+
+	k.SetAuditorDelegate(ctx, av1beta4.AuditorDelegate{
+		Auditor:   auditor.String(),
+		Delegate:  delegate.String(),
+		CreatedAt: ctx.BlockHeight(),
+	})
+
+	ctx.EventManager().EmitEvent(
+		av1beta4.NewEventAuditorDelegateCreated(auditor, delegate).
+			ToSDKEvent(),
+	)
+
+	return nil
+}
+
+// SetAuditorDelegate stores delegate record as is, indexing it by its auditor
+func (k Keeper) SetAuditorDelegate(ctx sdk.Context, val av1beta4.AuditorDelegate) {
+	auditor := sdk.MustAccAddressFromBech32(val.Auditor)
+	delegate := sdk.MustAccAddressFromBech32(val.Delegate)
+
+	store := ctx.KVStore(k.skey)
+	store.Set(auditorDelegateKey(delegate), k.cdc.MustMarshal(&val))
+	store.Set(auditorDelegatesKey(auditor, delegate), delegate.Bytes())
+}
+
+// DeleteAuditorDelegate revokes delegate of auditor. Attributes delegate signed stop
+// satisfying requirements signed by the auditor.
+func (k Keeper) DeleteAuditorDelegate(ctx sdk.Context, auditor, delegate sdk.Address) error {
+	current, found := k.GetAuditorDelegate(ctx, delegate)
+	if !found || current.Auditor != auditor.String() {
+		return ErrAuditorDelegateNone
+	}
+
+	store := ctx.KVStore(k.skey)
+	store.Delete(auditorDelegateKey(delegate))
+	store.Delete(auditorDelegatesKey(auditor, delegate))
+
+	ctx.EventManager().EmitEvent(
+		av1beta4.NewEventAuditorDelegateDeleted(auditor, delegate).
+			ToSDKEvent(),
+	)
+
+	return nil
+}
+
+// GetAuditorDelegate returns auditor given key signs on behalf of
+func (k Keeper) GetAuditorDelegate(ctx sdk.Context, delegate sdk.Address) (av1beta4.AuditorDelegate, bool) {
+	buf := ctx.KVStore(k.skey).Get(auditorDelegateKey(delegate))
+	if buf == nil {
+		return av1beta4.AuditorDelegate{}, false
+	}
+
+	var val av1beta4.AuditorDelegate
+	k.cdc.MustUnmarshal(buf, &val)
+
+	return val, true
+}
+
+// WithAuditorDelegates iterates keys signing on behalf of given auditor
+func (k Keeper) WithAuditorDelegates(ctx sdk.Context, auditor sdk.Address, fn func(av1beta4.AuditorDelegate) bool) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.skey), auditorDelegatesPrefixFor(auditor))
+	defer func() {
+		_ = iter.Close()
+	}()
+
+	for ; iter.Valid(); iter.Next() {
+		delegate, found := k.GetAuditorDelegate(ctx, sdk.AccAddress(iter.Value()))
+		if !found {
+			continue
+		}
+
+		if stop := fn(delegate); stop {
+			break
+		}
+	}
+}
+
+// CanSignProviderAttributes checks signer may sign provider attributes. Any address may sign unless
+// RegistryParams.RequireApprovedAuditors is set, then signer must be an approved auditor or its delegate.
+func (k Keeper) CanSignProviderAttributes(ctx sdk.Context, signer sdk.Address) error {
+	if !k.GetRegistryParams(ctx).RequireApprovedAuditors {
+		return nil
+	}
+
+	if delegate, found := k.GetAuditorDelegate(ctx, signer); found {
+		signer = sdk.MustAccAddressFromBech32(delegate.Auditor)
+	}
+
+	if !k.IsAuditorApproved(ctx, signer) {
+		return ErrAuditorNotApproved
+	}
+
+	return nil
+}
+
+// GetProviderAttributesDelegated returns provider attributes the same way GetProviderAttributes does,
+// and in addition attributes signed by delegates attributed to the auditor which authorized them,
+// merged with attributes that auditor signed itself. Result is meant for matching SignedBy requirements.
+func (k Keeper) GetProviderAttributesDelegated(ctx sdk.Context, id sdk.Address) (types.Providers, bool) {
+	attr, found := k.GetProviderAttributes(ctx, id)
+	if !found {
+		return nil, false
+	}
+
+	parents := make(map[string]int)
+	for idx, prov := range attr {
+		parents[prov.Auditor] = idx
+	}
+
+	for _, prov := range attr {
+		signer, err := sdk.AccAddressFromBech32(prov.Auditor)
+		if err != nil {
+			continue
+		}
+
+		delegate, found := k.GetAuditorDelegate(ctx, signer)
+		if !found {
+			continue
+		}
+
+		if idx, exists := parents[delegate.Auditor]; exists {
+			merged := attr[idx]
+			merged.Attributes = append(merged.Attributes[:len(merged.Attributes):len(merged.Attributes)], prov.Attributes...)
+			attr[idx] = merged
+			continue
+		}
+
+		parents[delegate.Auditor] = len(attr)
+		attr = append(attr, types.Provider{
+			Owner:      prov.Owner,
+			Auditor:    delegate.Auditor,
+			Attributes: prov.Attributes,
+		})
+	}
+
+	return attr, true
+}
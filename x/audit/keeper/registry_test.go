@@ -0,0 +1,172 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	types "github.com/akash-network/akash-api/go/node/audit/v1beta3"
+	akashtypes "github.com/akash-network/akash-api/go/node/types/v1beta3"
+
+	"github.com/akash-network/node/testutil"
+	"github.com/akash-network/node/x/audit/keeper"
+	av1beta4 "github.com/akash-network/node/x/audit/types/v1beta4"
+)
+
+func newAuditor(t testing.TB, deposit int64) av1beta4.Auditor {
+	t.Helper()
+
+	return av1beta4.Auditor{
+		Address: testutil.AccAddress(t).String(),
+		Name:    "auditor",
+		Website: "https://auditor.example",
+		Deposit: sdk.NewInt64Coin(testutil.CoinDenom, deposit),
+	}
+}
+
+func TestAuditorRegistry(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	ctx = ctx.WithBlockHeight(10)
+
+	k.SetRegistryParams(ctx, av1beta4.RegistryParams{
+		MinAuditorDeposit: sdk.NewInt64Coin(testutil.CoinDenom, 100),
+	})
+
+	err := k.CreateAuditor(ctx, newAuditor(t, 99))
+	require.ErrorIs(t, err, keeper.ErrAuditorDeposit)
+
+	invalid := newAuditor(t, 100)
+	invalid.Website = "ftp://auditor.example"
+	err = k.CreateAuditor(ctx, invalid)
+	require.ErrorIs(t, err, av1beta4.ErrAuditorInvalid)
+
+	auditor := newAuditor(t, 100)
+	err = k.CreateAuditor(ctx, auditor)
+	require.NoError(t, err)
+
+	err = k.CreateAuditor(ctx, auditor)
+	require.ErrorIs(t, err, keeper.ErrAuditorExists)
+
+	id := sdk.MustAccAddressFromBech32(auditor.Address)
+
+	found, ok := k.GetAuditor(ctx, id)
+	require.True(t, ok)
+	require.Equal(t, int64(10), found.RegisteredAt)
+	require.False(t, k.IsAuditorApproved(ctx, id))
+
+	params := k.GetRegistryParams(ctx)
+	params.ApprovedAuditors = []string{auditor.Address}
+	k.SetRegistryParams(ctx, params)
+	require.True(t, k.IsAuditorApproved(ctx, id))
+
+	count := 0
+	k.WithAuditors(ctx, func(av1beta4.Auditor) bool {
+		count++
+		return false
+	})
+	require.Equal(t, 1, count)
+
+	err = k.DeleteAuditor(ctx, id)
+	require.NoError(t, err)
+	require.False(t, k.IsAuditorApproved(ctx, id))
+
+	err = k.DeleteAuditor(ctx, id)
+	require.ErrorIs(t, err, keeper.ErrAuditorNotFound)
+}
+
+func TestAuditorRequireApproved(t *testing.T) {
+	ctx, k := setupKeeper(t)
+
+	auditor := newAuditor(t, 100)
+	id := sdk.MustAccAddressFromBech32(auditor.Address)
+	delegate := testutil.AccAddress(t)
+
+	require.NoError(t, k.CreateAuditor(ctx, auditor))
+	require.NoError(t, k.CreateAuditorDelegate(ctx, id, delegate))
+
+	// any address signs while approval is not required
+	require.NoError(t, k.CanSignProviderAttributes(ctx, testutil.AccAddress(t)))
+
+	k.SetRegistryParams(ctx, av1beta4.RegistryParams{RequireApprovedAuditors: true})
+	require.ErrorIs(t, k.CanSignProviderAttributes(ctx, id), keeper.ErrAuditorNotApproved)
+	require.ErrorIs(t, k.CanSignProviderAttributes(ctx, delegate), keeper.ErrAuditorNotApproved)
+
+	k.SetRegistryParams(ctx, av1beta4.RegistryParams{
+		ApprovedAuditors:        []string{auditor.Address},
+		RequireApprovedAuditors: true,
+	})
+	require.NoError(t, k.CanSignProviderAttributes(ctx, id))
+	require.NoError(t, k.CanSignProviderAttributes(ctx, delegate))
+	require.ErrorIs(t, k.CanSignProviderAttributes(ctx, testutil.AccAddress(t)), keeper.ErrAuditorNotApproved)
+}
+
+func TestAuditorDelegate(t *testing.T) {
+	ctx, k := setupKeeper(t)
+
+	parent := newAuditor(t, 100)
+	other := newAuditor(t, 100)
+	require.NoError(t, k.CreateAuditor(ctx, parent))
+	require.NoError(t, k.CreateAuditor(ctx, other))
+
+	pid := sdk.MustAccAddressFromBech32(parent.Address)
+	oid := sdk.MustAccAddressFromBech32(other.Address)
+	delegate := testutil.AccAddress(t)
+
+	require.ErrorIs(t, k.CreateAuditorDelegate(ctx, pid, pid), keeper.ErrAuditorDelegate)
+	require.ErrorIs(t, k.CreateAuditorDelegate(ctx, pid, oid), keeper.ErrAuditorDelegate)
+	require.ErrorIs(t, k.CreateAuditorDelegate(ctx, testutil.AccAddress(t), delegate), keeper.ErrAuditorNotFound)
+
+	require.NoError(t, k.CreateAuditorDelegate(ctx, pid, delegate))
+	require.ErrorIs(t, k.CreateAuditorDelegate(ctx, oid, delegate), keeper.ErrAuditorDelegateFound)
+
+	err := k.CreateAuditor(ctx, av1beta4.Auditor{
+		Address: delegate.String(),
+		Name:    "delegate",
+		Deposit: sdk.NewInt64Coin(testutil.CoinDenom, 100),
+	})
+	require.ErrorIs(t, err, keeper.ErrAuditorDelegateFound)
+
+	owner := testutil.AccAddress(t)
+	own := akashtypes.Attributes{{Key: "region", Value: "us-west"}}
+	delegated := akashtypes.Attributes{{Key: "tier", Value: "datacenter"}}
+
+	require.NoError(t, k.CreateOrUpdateProviderAttributes(ctx, types.ProviderID{Owner: owner, Auditor: pid}, own))
+	require.NoError(t, k.CreateOrUpdateProviderAttributes(ctx, types.ProviderID{Owner: owner, Auditor: delegate}, delegated))
+
+	attr, found := k.GetProviderAttributesDelegated(ctx, owner)
+	require.True(t, found)
+	require.Len(t, attr, 2)
+
+	for _, prov := range attr {
+		switch prov.Auditor {
+		case parent.Address:
+			require.Equal(t, append(own, delegated...), prov.Attributes)
+		case delegate.String():
+			require.Equal(t, delegated, prov.Attributes)
+		default:
+			t.Fatalf("unexpected auditor %s", prov.Auditor)
+		}
+	}
+
+	// stored attributes are not changed
+	stored, _ := k.GetProviderByAuditor(ctx, types.ProviderID{Owner: owner, Auditor: pid})
+	require.Equal(t, own, stored.Attributes)
+
+	require.ErrorIs(t, k.DeleteAuditorDelegate(ctx, oid, delegate), keeper.ErrAuditorDelegateNone)
+
+	// deleting auditor revokes its delegates
+	require.NoError(t, k.DeleteAuditor(ctx, pid))
+
+	_, found = k.GetAuditorDelegate(ctx, delegate)
+	require.False(t, found)
+
+	attr, _ = k.GetProviderAttributesDelegated(ctx, owner)
+	require.Len(t, attr, 2)
+
+	for _, prov := range attr {
+		if prov.Auditor == parent.Address {
+			require.Equal(t, own, prov.Attributes)
+		}
+	}
+}
@@ -0,0 +1,171 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	types "github.com/akash-network/akash-api/go/node/audit/v1beta3"
+	akashtypes "github.com/akash-network/akash-api/go/node/types/v1beta3"
+
+	av1beta4 "github.com/akash-network/node/x/audit/types/v1beta4"
+)
+
+// MaxAuditHistory is the number of most recent audit history entries kept per provider.
+// Older entries are pruned as new ones are appended.
+const MaxAuditHistory = 100
+
+var (
+	ErrAuditVersion = errors.New("audit version must be greater than the current one")
+	ErrAuditExpiry  = errors.New("audit expiry must be in the future")
+)
+
+// GetAuditTerms returns terms of attributes signed by auditor for provider of given id.
+// Attributes signed before terms were tracked have zero version and never expire.
+// Expired attributes are kept but not returned by GetProviderAttributes, so they stop
+// counting towards placement requirements until auditor signs them again.
+func (k Keeper) GetAuditTerms(ctx sdk.Context, id types.ProviderID) (av1beta4.AuditTerms, bool) {
+	buf := ctx.KVStore(k.skey).Get(auditTermsKey(id))
+	if buf == nil {
+		return av1beta4.AuditTerms{}, false
+	}
+
+	var terms av1beta4.AuditTerms
+	k.cdc.MustUnmarshal(buf, &terms)
+
+	return terms, true
+}
+
+// SetAuditTerms sets terms of attributes signed by auditor for provider of given id
+func (k Keeper) SetAuditTerms(ctx sdk.Context, id types.ProviderID, terms av1beta4.AuditTerms) {
+	ctx.KVStore(k.skey).Set(auditTermsKey(id), k.cdc.MustMarshal(&terms))
+}
+
+// WithAuditTerms iterates terms of all signed provider's attributes
+func (k Keeper) WithAuditTerms(ctx sdk.Context, fn func(av1beta4.ProviderAuditTerms) bool) {
+	k.WithProviders(ctx, func(prov types.Provider) bool {
+		id := types.ProviderID{
+			Owner:   sdk.MustAccAddressFromBech32(prov.Owner),
+			Auditor: sdk.MustAccAddressFromBech32(prov.Auditor),
+		}
+
+		terms, found := k.GetAuditTerms(ctx, id)
+		if !found {
+			return false
+		}
+
+		return fn(av1beta4.ProviderAuditTerms{
+			Owner:   prov.Owner,
+			Auditor: prov.Auditor,
+			Terms:   terms,
+		})
+	})
+}
+
+// CreateOrUpdateProviderAttributesWithTerms signs provider attributes same way CreateOrUpdateProviderAttributes does
+// and sets version and expiry of the whole signed set. Zero terms version is set to one above the current version.
+func (k Keeper) CreateOrUpdateProviderAttributesWithTerms(ctx sdk.Context, id types.ProviderID, attr akashtypes.Attributes, terms av1beta4.AuditTerms) error {
+	current, _ := k.GetAuditTerms(ctx, id)
+
+	if terms.Version == 0 {
+		terms.Version = current.Version + 1
+	} else if terms.Version <= current.Version {
+		return fmt.Errorf("%w: current %d, received %d", ErrAuditVersion, current.Version, terms.Version)
+	}
+
+	if terms.HasExpiry() && terms.Expired(ctx) {
+		return ErrAuditExpiry
+	}
+
+	if err := k.setProviderAttributes(ctx, id, attr); err != nil {
+		return err
+	}
+
+	k.SetAuditTerms(ctx, id, terms)
+	k.appendAuditHistory(ctx, id, av1beta4.AuditHistoryEntry{
+		Action:     av1beta4.AuditActionSigned,
+		Attributes: attr,
+		Terms:      terms,
+	})
+
+	return nil
+}
+
+// WithAuditHistory iterates audit history of provider, oldest first
+func (k Keeper) WithAuditHistory(ctx sdk.Context, owner sdk.Address, fn func(av1beta4.AuditHistoryEntry) bool) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.skey), auditHistoryPrefixFor(owner))
+	defer func() {
+		_ = iter.Close()
+	}()
+
+	for ; iter.Valid(); iter.Next() {
+		var entry av1beta4.AuditHistoryEntry
+		k.cdc.MustUnmarshal(iter.Value(), &entry)
+
+		if stop := fn(entry); stop {
+			break
+		}
+	}
+}
+
+// WithAllAuditHistory iterates audit history of all providers
+func (k Keeper) WithAllAuditHistory(ctx sdk.Context, fn func(av1beta4.AuditHistoryEntry) bool) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.skey), auditHistoryPrefix())
+	defer func() {
+		_ = iter.Close()
+	}()
+
+	for ; iter.Valid(); iter.Next() {
+		var entry av1beta4.AuditHistoryEntry
+		k.cdc.MustUnmarshal(iter.Value(), &entry)
+
+		if stop := fn(entry); stop {
+			break
+		}
+	}
+}
+
+// AppendAuditHistory appends entry to audit history of its provider as is,
+// pruning entries beyond MaxAuditHistory
+func (k Keeper) AppendAuditHistory(ctx sdk.Context, entry av1beta4.AuditHistoryEntry) error {
+	owner, err := sdk.AccAddressFromBech32(entry.Owner)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.skey)
+	prefix := auditHistoryPrefixFor(owner)
+
+	seq := uint64(0)
+
+	iter := sdk.KVStoreReversePrefixIterator(store, prefix)
+	if iter.Valid() {
+		seq = sdk.BigEndianToUint64(iter.Key()[len(prefix):]) + 1
+	}
+	_ = iter.Close()
+
+	store.Set(auditHistoryKey(owner, seq), k.cdc.MustMarshal(&entry))
+
+	if seq >= MaxAuditHistory {
+		store.Delete(auditHistoryKey(owner, seq-MaxAuditHistory))
+	}
+
+	return nil
+}
+
+func (k Keeper) auditTermsExpired(ctx sdk.Context, id types.ProviderID) bool {
+	terms, found := k.GetAuditTerms(ctx, id)
+	return found && terms.Expired(ctx)
+}
+
+func (k Keeper) appendAuditHistory(ctx sdk.Context, id types.ProviderID, entry av1beta4.AuditHistoryEntry) {
+	entry.Owner = id.Owner.String()
+	entry.Auditor = id.Auditor.String()
+	entry.Height = ctx.BlockHeight()
+	entry.Time = ctx.BlockTime().UTC()
+
+	if err := k.AppendAuditHistory(ctx, entry); err != nil {
+		panic(err)
+	}
+}
@@ -29,6 +29,8 @@ import (
 	"github.com/akash-network/node/x/audit/client/rest"
 	"github.com/akash-network/node/x/audit/handler"
 	"github.com/akash-network/node/x/audit/keeper"
+	"github.com/akash-network/node/x/audit/query"
+	av1beta4 "github.com/akash-network/node/x/audit/types/v1beta4"
 	pkeeper "github.com/akash-network/node/x/provider/keeper"
 )
 
@@ -51,11 +53,13 @@ func (AppModuleBasic) Name() string {
 // RegisterLegacyAminoCodec registers the provider module's types for the given codec.
 func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	types.RegisterLegacyAminoCodec(cdc)
+	av1beta4.RegisterLegacyAminoCodec(cdc)
 }
 
 // RegisterInterfaces registers the module's interface types
 func (b AppModuleBasic) RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	types.RegisterInterfaces(registry)
+	av1beta4.RegisterInterfaces(registry)
 	v1beta2types.RegisterInterfaces(registry)
 	v1beta1types.RegisterInterfaces(registry)
 }
@@ -74,7 +78,7 @@ func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingCo
 		return nil
 	}
 
-	var data types.GenesisState
+	var data av1beta4.GenesisState
 
 	err := cdc.UnmarshalJSON(bz, &data)
 	if err != nil {
@@ -123,14 +127,16 @@ func (AppModuleBasic) GetQueryClient(clientCtx client.Context) types.QueryClient
 // AppModule implements an application module for the audit module.
 type AppModule struct {
 	AppModuleBasic
-	keeper keeper.Keeper
+	keeper  keeper.Keeper
+	ekeeper handler.EscrowKeeper
 }
 
 // NewAppModule creates a new AppModule object
-func NewAppModule(cdc codec.Codec, k keeper.Keeper) AppModule {
+func NewAppModule(cdc codec.Codec, k keeper.Keeper, ekeeper handler.EscrowKeeper) AppModule {
 	return AppModule{
 		AppModuleBasic: AppModuleBasic{cdc: cdc},
 		keeper:         k,
+		ekeeper:        ekeeper,
 	}
 }
 
@@ -144,24 +150,26 @@ func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {}
 
 // Route returns the message routing key for the audit module.
 func (am AppModule) Route() sdk.Route {
-	return sdk.NewRoute(types.RouterKey, handler.NewHandler(am.keeper))
+	return sdk.NewRoute(types.RouterKey, handler.NewHandler(am.keeper, am.ekeeper))
 }
 
 // QuerierRoute returns the audit module's querier route name.
 func (am AppModule) QuerierRoute() string {
-	return ""
+	return types.ModuleName
 }
 
 // LegacyQuerierHandler returns the sdk.Querier for audit module
-func (am AppModule) LegacyQuerierHandler(_ *codec.LegacyAmino) sdk.Querier {
-	return nil
+func (am AppModule) LegacyQuerierHandler(legacyQuerierCdc *codec.LegacyAmino) sdk.Querier {
+	return query.NewQuerier(am.keeper, legacyQuerierCdc)
 }
 
 // RegisterServices registers the module's services
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), handler.NewMsgServerImpl(am.keeper))
+	av1beta4.RegisterMsgServer(cfg.MsgServer(), handler.NewNodeServer(am.keeper, am.ekeeper))
 	querier := keeper.Querier{Keeper: am.keeper}
 	types.RegisterQueryServer(cfg.QueryServer(), querier)
+	av1beta4.RegisterQueryServer(cfg.QueryServer(), querier)
 
 	utypes.ModuleMigrations(ModuleName, am.keeper, func(name string, forVersion uint64, handler module.MigrationHandler) {
 		if err := cfg.RegisterMigration(name, forVersion, handler); err != nil {
@@ -175,6 +183,7 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 func (am AppModule) RegisterQueryService(server grpc.Server) {
 	querier := keeper.Querier{Keeper: am.keeper}
 	types.RegisterQueryServer(server, querier)
+	av1beta4.RegisterQueryServer(server, querier)
 }
 
 // BeginBlock performs no-op
@@ -189,7 +198,7 @@ func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.Val
 // InitGenesis performs genesis initialization for the audit module. It returns
 // no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
-	var genesisState types.GenesisState
+	var genesisState av1beta4.GenesisState
 	cdc.MustUnmarshalJSON(data, &genesisState)
 	return InitGenesis(ctx, am.keeper, &genesisState)
 }
@@ -0,0 +1,27 @@
+package query
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	auditorsPath = "auditors"
+	auditorPath  = "auditor"
+)
+
+var (
+	ErrInvalidPath = errors.New("query: invalid path")
+)
+
+// AuditorsPath returns path of registered auditors for queries
+func AuditorsPath() string {
+	return auditorsPath
+}
+
+// AuditorPath returns path of given registered auditor for queries
+func AuditorPath(id sdk.AccAddress) string {
+	return fmt.Sprintf("%s/%s", auditorPath, id)
+}
@@ -0,0 +1,77 @@
+package query
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	types "github.com/akash-network/akash-api/go/node/audit/v1beta3"
+
+	"github.com/akash-network/node/x/audit/keeper"
+	av1beta4 "github.com/akash-network/node/x/audit/types/v1beta4"
+)
+
+func NewQuerier(keeper keeper.IKeeper, cdc *codec.LegacyAmino) sdk.Querier {
+	return func(ctx sdk.Context, path []string, _ abci.RequestQuery) ([]byte, error) {
+		if len(path) == 0 {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "empty %s query path", types.ModuleName)
+		}
+
+		switch path[0] {
+		case auditorsPath:
+			return queryAuditors(ctx, keeper, cdc)
+		case auditorPath:
+			return queryAuditor(ctx, path[1:], keeper, cdc)
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint: %s", types.ModuleName, path[0])
+		}
+	}
+}
+
+func queryAuditors(ctx sdk.Context, k keeper.IKeeper, cdc *codec.LegacyAmino) ([]byte, error) {
+	auditors := make(Auditors, 0)
+
+	k.WithAuditors(ctx, func(auditor av1beta4.Auditor) bool {
+		auditors = append(auditors, auditorInfo(ctx, k, auditor))
+		return false
+	})
+
+	return codec.MarshalJSONIndent(cdc, auditors)
+}
+
+func queryAuditor(ctx sdk.Context, path []string, k keeper.IKeeper, cdc *codec.LegacyAmino) ([]byte, error) {
+	if len(path) != 1 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, ErrInvalidPath.Error())
+	}
+
+	id, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	auditor, found := k.GetAuditor(ctx, id)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrNotFound, keeper.ErrAuditorNotFound.Error())
+	}
+
+	return codec.MarshalJSONIndent(cdc, auditorInfo(ctx, k, auditor))
+}
+
+func auditorInfo(ctx sdk.Context, k keeper.IKeeper, auditor av1beta4.Auditor) Auditor {
+	id := sdk.MustAccAddressFromBech32(auditor.Address)
+
+	info := Auditor{
+		Auditor:   auditor,
+		Approved:  k.IsAuditorApproved(ctx, id),
+		Delegates: make([]av1beta4.AuditorDelegate, 0),
+	}
+
+	k.WithAuditorDelegates(ctx, id, func(delegate av1beta4.AuditorDelegate) bool {
+		info.Delegates = append(info.Delegates, delegate)
+		return false
+	})
+
+	return info
+}
@@ -0,0 +1,15 @@
+package query
+
+import (
+	av1beta4 "github.com/akash-network/node/x/audit/types/v1beta4"
+)
+
+// Auditor is a registered auditor with its governance approval and delegated keys
+type Auditor struct {
+	av1beta4.Auditor
+	Approved  bool                       `json:"approved"`
+	Delegates []av1beta4.AuditorDelegate `json:"delegates"`
+}
+
+// Auditors - Slice of Auditor Struct
+type Auditors []Auditor
@@ -0,0 +1,1061 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: akash/audit/v1beta4/auditor.proto
+
+package v1beta4
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Auditor is a registered auditor. Deposit is bonded in escrow account of the auditor
+// and returned when the auditor deregisters.
+type Auditor struct {
+	Address      string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address" yaml:"address"`
+	Name         string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name" yaml:"name"`
+	Website      string     `protobuf:"bytes,3,opt,name=website,proto3" json:"website" yaml:"website"`
+	Deposit      types.Coin `protobuf:"bytes,4,opt,name=deposit,proto3" json:"deposit" yaml:"deposit"`
+	RegisteredAt int64      `protobuf:"varint,5,opt,name=registered_at,json=registeredAt,proto3" json:"registered_at" yaml:"registered_at"`
+}
+
+func (m *Auditor) Reset()         { *m = Auditor{} }
+func (m *Auditor) String() string { return proto.CompactTextString(m) }
+func (*Auditor) ProtoMessage()    {}
+func (*Auditor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c847d949fba2c573, []int{0}
+}
+func (m *Auditor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Auditor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Auditor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Auditor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Auditor.Merge(m, src)
+}
+func (m *Auditor) XXX_Size() int {
+	return m.Size()
+}
+func (m *Auditor) XXX_DiscardUnknown() {
+	xxx_messageInfo_Auditor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Auditor proto.InternalMessageInfo
+
+func (m *Auditor) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Auditor) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Auditor) GetWebsite() string {
+	if m != nil {
+		return m.Website
+	}
+	return ""
+}
+
+func (m *Auditor) GetDeposit() types.Coin {
+	if m != nil {
+		return m.Deposit
+	}
+	return types.Coin{}
+}
+
+func (m *Auditor) GetRegisteredAt() int64 {
+	if m != nil {
+		return m.RegisteredAt
+	}
+	return 0
+}
+
+// AuditorDelegate is a key auditor authorized to sign provider attributes on its behalf
+type AuditorDelegate struct {
+	Auditor   string `protobuf:"bytes,1,opt,name=auditor,proto3" json:"auditor" yaml:"auditor"`
+	Delegate  string `protobuf:"bytes,2,opt,name=delegate,proto3" json:"delegate" yaml:"delegate"`
+	CreatedAt int64  `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at" yaml:"created_at"`
+}
+
+func (m *AuditorDelegate) Reset()         { *m = AuditorDelegate{} }
+func (m *AuditorDelegate) String() string { return proto.CompactTextString(m) }
+func (*AuditorDelegate) ProtoMessage()    {}
+func (*AuditorDelegate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c847d949fba2c573, []int{1}
+}
+func (m *AuditorDelegate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuditorDelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuditorDelegate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuditorDelegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditorDelegate.Merge(m, src)
+}
+func (m *AuditorDelegate) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuditorDelegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditorDelegate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditorDelegate proto.InternalMessageInfo
+
+func (m *AuditorDelegate) GetAuditor() string {
+	if m != nil {
+		return m.Auditor
+	}
+	return ""
+}
+
+func (m *AuditorDelegate) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
+	}
+	return ""
+}
+
+func (m *AuditorDelegate) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+// RegistryParams holds minimum deposit bonded by registering auditors and auditors approved by governance.
+// With require_approved_auditors set only approved auditors and their delegates may sign provider attributes.
+type RegistryParams struct {
+	MinAuditorDeposit       types.Coin `protobuf:"bytes,1,opt,name=min_auditor_deposit,json=minAuditorDeposit,proto3" json:"min_auditor_deposit" yaml:"min_auditor_deposit"`
+	ApprovedAuditors        []string   `protobuf:"bytes,2,rep,name=approved_auditors,json=approvedAuditors,proto3" json:"approved_auditors" yaml:"approved_auditors"`
+	RequireApprovedAuditors bool       `protobuf:"varint,3,opt,name=require_approved_auditors,json=requireApprovedAuditors,proto3" json:"require_approved_auditors" yaml:"require_approved_auditors"`
+}
+
+func (m *RegistryParams) Reset()         { *m = RegistryParams{} }
+func (m *RegistryParams) String() string { return proto.CompactTextString(m) }
+func (*RegistryParams) ProtoMessage()    {}
+func (*RegistryParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c847d949fba2c573, []int{2}
+}
+func (m *RegistryParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegistryParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegistryParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegistryParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegistryParams.Merge(m, src)
+}
+func (m *RegistryParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegistryParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegistryParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegistryParams proto.InternalMessageInfo
+
+func (m *RegistryParams) GetMinAuditorDeposit() types.Coin {
+	if m != nil {
+		return m.MinAuditorDeposit
+	}
+	return types.Coin{}
+}
+
+func (m *RegistryParams) GetApprovedAuditors() []string {
+	if m != nil {
+		return m.ApprovedAuditors
+	}
+	return nil
+}
+
+func (m *RegistryParams) GetRequireApprovedAuditors() bool {
+	if m != nil {
+		return m.RequireApprovedAuditors
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*Auditor)(nil), "akash.audit.v1beta4.Auditor")
+	proto.RegisterType((*AuditorDelegate)(nil), "akash.audit.v1beta4.AuditorDelegate")
+	proto.RegisterType((*RegistryParams)(nil), "akash.audit.v1beta4.RegistryParams")
+}
+
+func init() { proto.RegisterFile("akash/audit/v1beta4/auditor.proto", fileDescriptor_c847d949fba2c573) }
+
+var fileDescriptor_c847d949fba2c573 = []byte{
+	// 569 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0xe3, 0xa4, 0x90, 0xe6, 0x0a, 0x2d, 0x71, 0x2b, 0xd5, 0x8d, 0x84, 0x2f, 0x3d, 0x96,
+	0x20, 0x84, 0xad, 0x50, 0x24, 0x04, 0x4c, 0x31, 0xac, 0x20, 0xe4, 0x81, 0x81, 0x81, 0xe8, 0x12,
+	0x9f, 0x52, 0xab, 0xb5, 0x2f, 0xdc, 0x5d, 0x5a, 0x32, 0xb0, 0xf1, 0x07, 0xf0, 0x67, 0x55, 0x62,
+	0xc9, 0xc8, 0x74, 0x42, 0xc9, 0xe6, 0xd1, 0x03, 0x33, 0xf2, 0xdd, 0x39, 0x69, 0xfa, 0x43, 0xdd,
+	0xf2, 0x3e, 0xdf, 0x77, 0xef, 0xdd, 0xfb, 0xbe, 0xf8, 0xc0, 0x21, 0x3e, 0xc1, 0xfc, 0xd8, 0xc7,
+	0x93, 0x28, 0x16, 0xfe, 0x59, 0x77, 0x40, 0x04, 0x7e, 0xa9, 0x23, 0xca, 0xbc, 0x31, 0xa3, 0x82,
+	0xda, 0xbb, 0x2a, 0xc5, 0x53, 0xd0, 0x33, 0x29, 0xad, 0xbd, 0x11, 0x1d, 0x51, 0xa5, 0xfb, 0xc5,
+	0x2f, 0x9d, 0xda, 0x72, 0x87, 0x94, 0x27, 0x94, 0xfb, 0x03, 0xcc, 0x89, 0xa9, 0xd6, 0xf5, 0x87,
+	0x34, 0x4e, 0xb5, 0x8e, 0x66, 0x55, 0x50, 0xef, 0xe9, 0xe2, 0xf6, 0x2b, 0x50, 0xc7, 0x51, 0xc4,
+	0x08, 0xe7, 0x8e, 0xd5, 0xb6, 0x3a, 0x8d, 0xe0, 0x71, 0x26, 0x61, 0x89, 0x72, 0x09, 0xb7, 0xa7,
+	0x38, 0x39, 0x7d, 0x83, 0x0c, 0x40, 0x61, 0x29, 0xd9, 0xcf, 0xc0, 0x46, 0x8a, 0x13, 0xe2, 0x54,
+	0xd5, 0xa9, 0xfd, 0x4c, 0x42, 0x15, 0xe7, 0x12, 0x6e, 0xe9, 0x23, 0x45, 0x84, 0x42, 0x05, 0x8b,
+	0x2e, 0xe7, 0x64, 0xc0, 0x63, 0x41, 0x9c, 0xda, 0xaa, 0x8b, 0x41, 0xab, 0x2e, 0x06, 0xa0, 0xb0,
+	0x94, 0xec, 0xcf, 0xa0, 0x1e, 0x91, 0x31, 0xe5, 0xb1, 0x70, 0x36, 0xda, 0x56, 0x67, 0xeb, 0xc5,
+	0x81, 0xa7, 0x87, 0xf3, 0x8a, 0xe1, 0x8c, 0x0f, 0x5d, 0xef, 0x1d, 0x8d, 0xd3, 0xe0, 0xf0, 0x42,
+	0xc2, 0x4a, 0x51, 0xd7, 0x9c, 0x58, 0xd5, 0x35, 0x00, 0x85, 0xa5, 0x64, 0x7f, 0x04, 0x0f, 0x19,
+	0x19, 0xc5, 0x5c, 0x10, 0x46, 0xa2, 0x3e, 0x16, 0xce, 0xbd, 0xb6, 0xd5, 0xa9, 0x05, 0x4f, 0x33,
+	0x09, 0xd7, 0x85, 0x5c, 0xc2, 0x3d, 0x5d, 0x64, 0x0d, 0xa3, 0xf0, 0xc1, 0x2a, 0xee, 0x09, 0xf4,
+	0xdb, 0x02, 0x3b, 0xc6, 0xd2, 0xf7, 0xe4, 0x94, 0x8c, 0xb0, 0x50, 0x43, 0x9b, 0x15, 0xae, 0x59,
+	0xab, 0xd1, 0x25, 0x6b, 0x35, 0x28, 0xac, 0x35, 0x3b, 0x79, 0x0b, 0x36, 0x23, 0x53, 0xc4, 0xd8,
+	0x0b, 0x33, 0x09, 0x97, 0x2c, 0x97, 0x70, 0xa7, 0x9c, 0x4b, 0x13, 0x14, 0x2e, 0x45, 0x3b, 0x00,
+	0x60, 0xc8, 0x08, 0x16, 0x7a, 0xac, 0x9a, 0x1a, 0xeb, 0x49, 0x26, 0xe1, 0x25, 0x9a, 0x4b, 0xd8,
+	0xd4, 0x05, 0x56, 0x0c, 0x85, 0x0d, 0x13, 0xf4, 0x04, 0xfa, 0x57, 0x05, 0xdb, 0xa1, 0x1a, 0x8f,
+	0x4d, 0x3f, 0x61, 0x86, 0x13, 0x6e, 0xff, 0xb4, 0xc0, 0x6e, 0x12, 0xa7, 0x7d, 0x73, 0xc7, 0x7e,
+	0xb9, 0x15, 0xeb, 0xae, 0xad, 0xbc, 0x36, 0x5b, 0xb9, 0xe9, 0x74, 0x2e, 0x61, 0x4b, 0x5f, 0xe4,
+	0x06, 0x11, 0x85, 0xcd, 0x24, 0x4e, 0x97, 0xa6, 0xea, 0xbd, 0x7d, 0x05, 0x4d, 0x3c, 0x1e, 0x33,
+	0x7a, 0x56, 0x5c, 0x5a, 0x4b, 0xdc, 0xa9, 0xb6, 0x6b, 0x9d, 0x46, 0xd0, 0xcd, 0x24, 0xbc, 0x2e,
+	0xe6, 0x12, 0x3a, 0xc6, 0xe7, 0xab, 0x12, 0x0a, 0x1f, 0x95, 0xcc, 0x74, 0xe1, 0xf6, 0x0f, 0x70,
+	0xc0, 0xc8, 0xb7, 0x49, 0xcc, 0x48, 0xff, 0x7a, 0x9f, 0xc2, 0xcc, 0xcd, 0xa0, 0x97, 0x49, 0x78,
+	0x7b, 0x52, 0x2e, 0x61, 0xbb, 0xfc, 0xbf, 0xdc, 0x92, 0x82, 0xc2, 0x7d, 0xa3, 0xf5, 0xae, 0xb4,
+	0x0f, 0x3e, 0x5c, 0xcc, 0x5d, 0x6b, 0x36, 0x77, 0xad, 0xbf, 0x73, 0xd7, 0xfa, 0xb5, 0x70, 0x2b,
+	0xb3, 0x85, 0x5b, 0xf9, 0xb3, 0x70, 0x2b, 0x5f, 0x8e, 0x46, 0xb1, 0x38, 0x9e, 0x0c, 0xbc, 0x21,
+	0x4d, 0x7c, 0xf5, 0x12, 0x3c, 0x4f, 0x89, 0x38, 0xa7, 0xec, 0xc4, 0x4f, 0x69, 0x44, 0xfc, 0xef,
+	0xe6, 0xed, 0x10, 0xd3, 0x31, 0xe1, 0xe5, 0x0b, 0x32, 0xb8, 0xaf, 0xbe, 0xf7, 0xa3, 0xff, 0x03,
+	0x00, 0x12, 0xe0, 0x41, 0xe3, 0x5f, 0x04, 0x00, 0x00,
+}
+
+func (m *Auditor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Auditor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Auditor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RegisteredAt != 0 {
+		i = encodeVarintAuditor(dAtA, i, uint64(m.RegisteredAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuditor(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Website) > 0 {
+		i -= len(m.Website)
+		copy(dAtA[i:], m.Website)
+		i = encodeVarintAuditor(dAtA, i, uint64(len(m.Website)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAuditor(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAuditor(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuditorDelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuditorDelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuditorDelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreatedAt != 0 {
+		i = encodeVarintAuditor(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintAuditor(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Auditor) > 0 {
+		i -= len(m.Auditor)
+		copy(dAtA[i:], m.Auditor)
+		i = encodeVarintAuditor(dAtA, i, uint64(len(m.Auditor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegistryParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegistryParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegistryParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RequireApprovedAuditors {
+		i--
+		if m.RequireApprovedAuditors {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ApprovedAuditors) > 0 {
+		for iNdEx := len(m.ApprovedAuditors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ApprovedAuditors[iNdEx])
+			copy(dAtA[i:], m.ApprovedAuditors[iNdEx])
+			i = encodeVarintAuditor(dAtA, i, uint64(len(m.ApprovedAuditors[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.MinAuditorDeposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuditor(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuditor(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuditor(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Auditor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAuditor(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAuditor(uint64(l))
+	}
+	l = len(m.Website)
+	if l > 0 {
+		n += 1 + l + sovAuditor(uint64(l))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovAuditor(uint64(l))
+	if m.RegisteredAt != 0 {
+		n += 1 + sovAuditor(uint64(m.RegisteredAt))
+	}
+	return n
+}
+
+func (m *AuditorDelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Auditor)
+	if l > 0 {
+		n += 1 + l + sovAuditor(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovAuditor(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovAuditor(uint64(m.CreatedAt))
+	}
+	return n
+}
+
+func (m *RegistryParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinAuditorDeposit.Size()
+	n += 1 + l + sovAuditor(uint64(l))
+	if len(m.ApprovedAuditors) > 0 {
+		for _, s := range m.ApprovedAuditors {
+			l = len(s)
+			n += 1 + l + sovAuditor(uint64(l))
+		}
+	}
+	if m.RequireApprovedAuditors {
+		n += 2
+	}
+	return n
+}
+
+func sovAuditor(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuditor(x uint64) (n int) {
+	return sovAuditor(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Auditor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuditor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Auditor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Auditor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuditor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuditor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuditor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuditor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuditor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuditor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Website = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuditor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuditor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredAt", wireType)
+			}
+			m.RegisteredAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegisteredAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuditor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuditor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuditorDelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuditor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuditorDelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuditorDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auditor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuditor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuditor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Auditor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuditor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuditor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuditor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuditor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegistryParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuditor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegistryParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegistryParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAuditorDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuditor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuditor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinAuditorDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedAuditors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuditor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuditor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApprovedAuditors = append(m.ApprovedAuditors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireApprovedAuditors", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireApprovedAuditors = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuditor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuditor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuditor(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuditor
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuditor
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuditor
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuditor
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuditor
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuditor
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuditor        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuditor          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuditor = fmt.Errorf("proto: unexpected end of group")
+)
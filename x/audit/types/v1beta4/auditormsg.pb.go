@@ -0,0 +1,1588 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: akash/audit/v1beta4/auditormsg.proto
+
+package v1beta4
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgRegisterAuditor adds signer to the auditor registry, bonding its deposit in escrow
+type MsgRegisterAuditor struct {
+	Address string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address" yaml:"address"`
+	Name    string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name" yaml:"name"`
+	Website string     `protobuf:"bytes,3,opt,name=website,proto3" json:"website" yaml:"website"`
+	Deposit types.Coin `protobuf:"bytes,4,opt,name=deposit,proto3" json:"deposit" yaml:"deposit"`
+}
+
+func (m *MsgRegisterAuditor) Reset()         { *m = MsgRegisterAuditor{} }
+func (m *MsgRegisterAuditor) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterAuditor) ProtoMessage()    {}
+func (*MsgRegisterAuditor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_512b90a78d29222b, []int{0}
+}
+func (m *MsgRegisterAuditor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterAuditor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterAuditor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterAuditor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterAuditor.Merge(m, src)
+}
+func (m *MsgRegisterAuditor) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterAuditor) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterAuditor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterAuditor proto.InternalMessageInfo
+
+func (m *MsgRegisterAuditor) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgRegisterAuditor) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgRegisterAuditor) GetWebsite() string {
+	if m != nil {
+		return m.Website
+	}
+	return ""
+}
+
+func (m *MsgRegisterAuditor) GetDeposit() types.Coin {
+	if m != nil {
+		return m.Deposit
+	}
+	return types.Coin{}
+}
+
+// MsgRegisterAuditorResponse defines the Msg/RegisterAuditor response type.
+type MsgRegisterAuditorResponse struct {
+}
+
+func (m *MsgRegisterAuditorResponse) Reset()         { *m = MsgRegisterAuditorResponse{} }
+func (m *MsgRegisterAuditorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterAuditorResponse) ProtoMessage()    {}
+func (*MsgRegisterAuditorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_512b90a78d29222b, []int{1}
+}
+func (m *MsgRegisterAuditorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterAuditorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterAuditorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterAuditorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterAuditorResponse.Merge(m, src)
+}
+func (m *MsgRegisterAuditorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterAuditorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterAuditorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterAuditorResponse proto.InternalMessageInfo
+
+// MsgDeregisterAuditor removes signer and its delegates from the auditor registry, returning its deposit
+type MsgDeregisterAuditor struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address" yaml:"address"`
+}
+
+func (m *MsgDeregisterAuditor) Reset()         { *m = MsgDeregisterAuditor{} }
+func (m *MsgDeregisterAuditor) String() string { return proto.CompactTextString(m) }
+func (*MsgDeregisterAuditor) ProtoMessage()    {}
+func (*MsgDeregisterAuditor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_512b90a78d29222b, []int{2}
+}
+func (m *MsgDeregisterAuditor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeregisterAuditor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeregisterAuditor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeregisterAuditor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeregisterAuditor.Merge(m, src)
+}
+func (m *MsgDeregisterAuditor) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeregisterAuditor) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeregisterAuditor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeregisterAuditor proto.InternalMessageInfo
+
+func (m *MsgDeregisterAuditor) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// MsgDeregisterAuditorResponse defines the Msg/DeregisterAuditor response type.
+type MsgDeregisterAuditorResponse struct {
+}
+
+func (m *MsgDeregisterAuditorResponse) Reset()         { *m = MsgDeregisterAuditorResponse{} }
+func (m *MsgDeregisterAuditorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeregisterAuditorResponse) ProtoMessage()    {}
+func (*MsgDeregisterAuditorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_512b90a78d29222b, []int{3}
+}
+func (m *MsgDeregisterAuditorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeregisterAuditorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeregisterAuditorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeregisterAuditorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeregisterAuditorResponse.Merge(m, src)
+}
+func (m *MsgDeregisterAuditorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeregisterAuditorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeregisterAuditorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeregisterAuditorResponse proto.InternalMessageInfo
+
+// MsgCreateAuditorDelegate authorizes delegate to sign provider attributes on behalf of auditor
+type MsgCreateAuditorDelegate struct {
+	Auditor  string `protobuf:"bytes,1,opt,name=auditor,proto3" json:"auditor" yaml:"auditor"`
+	Delegate string `protobuf:"bytes,2,opt,name=delegate,proto3" json:"delegate" yaml:"delegate"`
+}
+
+func (m *MsgCreateAuditorDelegate) Reset()         { *m = MsgCreateAuditorDelegate{} }
+func (m *MsgCreateAuditorDelegate) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateAuditorDelegate) ProtoMessage()    {}
+func (*MsgCreateAuditorDelegate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_512b90a78d29222b, []int{4}
+}
+func (m *MsgCreateAuditorDelegate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateAuditorDelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateAuditorDelegate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateAuditorDelegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateAuditorDelegate.Merge(m, src)
+}
+func (m *MsgCreateAuditorDelegate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateAuditorDelegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateAuditorDelegate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateAuditorDelegate proto.InternalMessageInfo
+
+func (m *MsgCreateAuditorDelegate) GetAuditor() string {
+	if m != nil {
+		return m.Auditor
+	}
+	return ""
+}
+
+func (m *MsgCreateAuditorDelegate) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
+	}
+	return ""
+}
+
+// MsgCreateAuditorDelegateResponse defines the Msg/CreateAuditorDelegate response type.
+type MsgCreateAuditorDelegateResponse struct {
+}
+
+func (m *MsgCreateAuditorDelegateResponse) Reset()         { *m = MsgCreateAuditorDelegateResponse{} }
+func (m *MsgCreateAuditorDelegateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateAuditorDelegateResponse) ProtoMessage()    {}
+func (*MsgCreateAuditorDelegateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_512b90a78d29222b, []int{5}
+}
+func (m *MsgCreateAuditorDelegateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateAuditorDelegateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateAuditorDelegateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateAuditorDelegateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateAuditorDelegateResponse.Merge(m, src)
+}
+func (m *MsgCreateAuditorDelegateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateAuditorDelegateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateAuditorDelegateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateAuditorDelegateResponse proto.InternalMessageInfo
+
+// MsgDeleteAuditorDelegate revokes delegate of auditor
+type MsgDeleteAuditorDelegate struct {
+	Auditor  string `protobuf:"bytes,1,opt,name=auditor,proto3" json:"auditor" yaml:"auditor"`
+	Delegate string `protobuf:"bytes,2,opt,name=delegate,proto3" json:"delegate" yaml:"delegate"`
+}
+
+func (m *MsgDeleteAuditorDelegate) Reset()         { *m = MsgDeleteAuditorDelegate{} }
+func (m *MsgDeleteAuditorDelegate) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteAuditorDelegate) ProtoMessage()    {}
+func (*MsgDeleteAuditorDelegate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_512b90a78d29222b, []int{6}
+}
+func (m *MsgDeleteAuditorDelegate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteAuditorDelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteAuditorDelegate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeleteAuditorDelegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteAuditorDelegate.Merge(m, src)
+}
+func (m *MsgDeleteAuditorDelegate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteAuditorDelegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteAuditorDelegate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteAuditorDelegate proto.InternalMessageInfo
+
+func (m *MsgDeleteAuditorDelegate) GetAuditor() string {
+	if m != nil {
+		return m.Auditor
+	}
+	return ""
+}
+
+func (m *MsgDeleteAuditorDelegate) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
+	}
+	return ""
+}
+
+// MsgDeleteAuditorDelegateResponse defines the Msg/DeleteAuditorDelegate response type.
+type MsgDeleteAuditorDelegateResponse struct {
+}
+
+func (m *MsgDeleteAuditorDelegateResponse) Reset()         { *m = MsgDeleteAuditorDelegateResponse{} }
+func (m *MsgDeleteAuditorDelegateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteAuditorDelegateResponse) ProtoMessage()    {}
+func (*MsgDeleteAuditorDelegateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_512b90a78d29222b, []int{7}
+}
+func (m *MsgDeleteAuditorDelegateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteAuditorDelegateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteAuditorDelegateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeleteAuditorDelegateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteAuditorDelegateResponse.Merge(m, src)
+}
+func (m *MsgDeleteAuditorDelegateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteAuditorDelegateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteAuditorDelegateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteAuditorDelegateResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgRegisterAuditor)(nil), "akash.audit.v1beta4.MsgRegisterAuditor")
+	proto.RegisterType((*MsgRegisterAuditorResponse)(nil), "akash.audit.v1beta4.MsgRegisterAuditorResponse")
+	proto.RegisterType((*MsgDeregisterAuditor)(nil), "akash.audit.v1beta4.MsgDeregisterAuditor")
+	proto.RegisterType((*MsgDeregisterAuditorResponse)(nil), "akash.audit.v1beta4.MsgDeregisterAuditorResponse")
+	proto.RegisterType((*MsgCreateAuditorDelegate)(nil), "akash.audit.v1beta4.MsgCreateAuditorDelegate")
+	proto.RegisterType((*MsgCreateAuditorDelegateResponse)(nil), "akash.audit.v1beta4.MsgCreateAuditorDelegateResponse")
+	proto.RegisterType((*MsgDeleteAuditorDelegate)(nil), "akash.audit.v1beta4.MsgDeleteAuditorDelegate")
+	proto.RegisterType((*MsgDeleteAuditorDelegateResponse)(nil), "akash.audit.v1beta4.MsgDeleteAuditorDelegateResponse")
+}
+
+func init() {
+	proto.RegisterFile("akash/audit/v1beta4/auditormsg.proto", fileDescriptor_512b90a78d29222b)
+}
+
+var fileDescriptor_512b90a78d29222b = []byte{
+	// 450 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x93, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0xed, 0x12, 0x51, 0xb8, 0x4a, 0x20, 0x99, 0x4a, 0x98, 0xa8, 0xf8, 0xc2, 0x89, 0xa1,
+	0x12, 0xc2, 0x56, 0x28, 0x12, 0x52, 0x99, 0x48, 0xbb, 0x66, 0xb1, 0x04, 0x03, 0xdb, 0x39, 0x7e,
+	0xba, 0x5a, 0xad, 0x7d, 0x91, 0xdf, 0x95, 0xd2, 0xff, 0x02, 0x16, 0x66, 0xfe, 0x9c, 0x8e, 0x1d,
+	0x99, 0x2c, 0x94, 0x2c, 0x28, 0x63, 0xfe, 0x02, 0xe4, 0xbb, 0xe7, 0x84, 0x1f, 0x61, 0x63, 0xe8,
+	0x96, 0xfb, 0x7c, 0xef, 0xbd, 0xef, 0xf7, 0x5e, 0xfc, 0xd8, 0x53, 0x79, 0x2a, 0xf1, 0x24, 0x91,
+	0xe7, 0x79, 0x61, 0x92, 0x0f, 0xc3, 0x0c, 0x8c, 0x7c, 0xe9, 0x4e, 0xba, 0x2e, 0x51, 0xc5, 0xd3,
+	0x5a, 0x1b, 0x1d, 0x3c, 0xb0, 0xb7, 0x62, 0xcb, 0x63, 0xba, 0xd5, 0xdf, 0x55, 0x5a, 0x69, 0xab,
+	0x27, 0xed, 0x2f, 0x77, 0xb5, 0x1f, 0x4d, 0x34, 0x96, 0x1a, 0x93, 0x4c, 0x22, 0x50, 0xc3, 0x61,
+	0x32, 0xd1, 0x45, 0xe5, 0x74, 0xf1, 0x79, 0x8b, 0x05, 0x63, 0x54, 0x29, 0xa8, 0x02, 0x0d, 0xd4,
+	0x6f, 0x9c, 0x55, 0xf0, 0x8a, 0x6d, 0xcb, 0x3c, 0xaf, 0x01, 0x31, 0xf4, 0x07, 0xfe, 0xfe, 0xdd,
+	0xd1, 0xe3, 0x45, 0xc3, 0x3b, 0xb4, 0x6c, 0xf8, 0xbd, 0x4b, 0x59, 0x9e, 0x1d, 0x0a, 0x02, 0x22,
+	0xed, 0xa4, 0xe0, 0x19, 0xeb, 0x55, 0xb2, 0x84, 0x70, 0xcb, 0x56, 0x3d, 0x5c, 0x34, 0xdc, 0x9e,
+	0x97, 0x0d, 0xdf, 0x71, 0x25, 0xed, 0x49, 0xa4, 0x16, 0xb6, 0x2e, 0x17, 0x90, 0x61, 0x61, 0x20,
+	0xbc, 0xb5, 0x76, 0x21, 0xb4, 0x76, 0x21, 0x20, 0xd2, 0x4e, 0x0a, 0xde, 0xb1, 0xed, 0x1c, 0xa6,
+	0x1a, 0x0b, 0x13, 0xf6, 0x06, 0xfe, 0xfe, 0xce, 0x8b, 0x47, 0xb1, 0x7b, 0x67, 0xdc, 0xbe, 0x93,
+	0x46, 0x32, 0x8c, 0x8f, 0x74, 0x51, 0x8d, 0x9e, 0x5c, 0x35, 0xdc, 0x6b, 0xfb, 0x52, 0xc5, 0xba,
+	0x2f, 0x01, 0x91, 0x76, 0xd2, 0x61, 0xef, 0xc7, 0x57, 0xee, 0x89, 0x3d, 0xd6, 0xff, 0x7b, 0x24,
+	0x29, 0xe0, 0x54, 0x57, 0x08, 0xe2, 0x2d, 0xdb, 0x1d, 0xa3, 0x3a, 0x86, 0xfa, 0x3f, 0x8d, 0x8c,
+	0x4c, 0x23, 0xb6, 0xb7, 0xa9, 0xed, 0xca, 0xf6, 0x8b, 0xcf, 0xc2, 0x31, 0xaa, 0xa3, 0x1a, 0xa4,
+	0x01, 0x12, 0x8f, 0xe1, 0x0c, 0x94, 0x34, 0x76, 0x90, 0xf4, 0x91, 0xfc, 0xe6, 0xed, 0xd0, 0x2f,
+	0xde, 0x0e, 0xb4, 0xde, 0x14, 0xfa, 0x35, 0xbb, 0x93, 0x53, 0x13, 0xfa, 0xcb, 0xf8, 0xa2, 0xe1,
+	0x2b, 0xb6, 0x6c, 0xf8, 0xfd, 0x6e, 0x56, 0x8e, 0x88, 0x74, 0x25, 0x52, 0x70, 0xc1, 0x06, 0xff,
+	0xca, 0xf5, 0x67, 0xf8, 0x96, 0xdf, 0xc4, 0xf0, 0x1b, 0x73, 0x75, 0xe1, 0x47, 0xe3, 0xab, 0x59,
+	0xe4, 0x5f, 0xcf, 0x22, 0xff, 0xfb, 0x2c, 0xf2, 0x3f, 0xcd, 0x23, 0xef, 0x7a, 0x1e, 0x79, 0xdf,
+	0xe6, 0x91, 0xf7, 0xfe, 0x40, 0x15, 0xe6, 0xe4, 0x3c, 0x8b, 0x27, 0xba, 0x4c, 0xec, 0x4a, 0x3e,
+	0xaf, 0xc0, 0x5c, 0xe8, 0xfa, 0x34, 0xa9, 0x74, 0x0e, 0xc9, 0x47, 0xda, 0x63, 0x73, 0x39, 0x05,
+	0xec, 0xb6, 0x39, 0xbb, 0x6d, 0x17, 0xef, 0xe0, 0xe7, 0x00, 0x1a, 0xd5, 0xfe, 0x62, 0xeb, 0x03,
+	0x00, 0x00,
+}
+
+func (m *MsgRegisterAuditor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterAuditor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterAuditor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuditormsg(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Website) > 0 {
+		i -= len(m.Website)
+		copy(dAtA[i:], m.Website)
+		i = encodeVarintAuditormsg(dAtA, i, uint64(len(m.Website)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAuditormsg(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAuditormsg(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterAuditorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterAuditorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterAuditorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeregisterAuditor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeregisterAuditor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeregisterAuditor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAuditormsg(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeregisterAuditorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeregisterAuditorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeregisterAuditorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateAuditorDelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateAuditorDelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateAuditorDelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintAuditormsg(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Auditor) > 0 {
+		i -= len(m.Auditor)
+		copy(dAtA[i:], m.Auditor)
+		i = encodeVarintAuditormsg(dAtA, i, uint64(len(m.Auditor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateAuditorDelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateAuditorDelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateAuditorDelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteAuditorDelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteAuditorDelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteAuditorDelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintAuditormsg(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Auditor) > 0 {
+		i -= len(m.Auditor)
+		copy(dAtA[i:], m.Auditor)
+		i = encodeVarintAuditormsg(dAtA, i, uint64(len(m.Auditor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteAuditorDelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteAuditorDelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteAuditorDelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuditormsg(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuditormsg(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRegisterAuditor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAuditormsg(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAuditormsg(uint64(l))
+	}
+	l = len(m.Website)
+	if l > 0 {
+		n += 1 + l + sovAuditormsg(uint64(l))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovAuditormsg(uint64(l))
+	return n
+}
+
+func (m *MsgRegisterAuditorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeregisterAuditor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAuditormsg(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeregisterAuditorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateAuditorDelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Auditor)
+	if l > 0 {
+		n += 1 + l + sovAuditormsg(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovAuditormsg(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateAuditorDelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteAuditorDelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Auditor)
+	if l > 0 {
+		n += 1 + l + sovAuditormsg(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovAuditormsg(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteAuditorDelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovAuditormsg(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuditormsg(x uint64) (n int) {
+	return sovAuditormsg(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgRegisterAuditor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuditormsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterAuditor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterAuditor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditormsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuditormsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuditormsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditormsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuditormsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuditormsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditormsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuditormsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuditormsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Website = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditormsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuditormsg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuditormsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuditormsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuditormsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterAuditorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuditormsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterAuditorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterAuditorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuditormsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuditormsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeregisterAuditor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuditormsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeregisterAuditor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeregisterAuditor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditormsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuditormsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuditormsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuditormsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuditormsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeregisterAuditorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuditormsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeregisterAuditorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeregisterAuditorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuditormsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuditormsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateAuditorDelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuditormsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateAuditorDelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateAuditorDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auditor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditormsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuditormsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuditormsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Auditor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditormsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuditormsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuditormsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuditormsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuditormsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateAuditorDelegateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuditormsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateAuditorDelegateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateAuditorDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuditormsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuditormsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteAuditorDelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuditormsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteAuditorDelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteAuditorDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auditor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditormsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuditormsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuditormsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Auditor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditormsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuditormsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuditormsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuditormsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuditormsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteAuditorDelegateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuditormsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteAuditorDelegateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteAuditorDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuditormsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuditormsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuditormsg(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuditormsg
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuditormsg
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuditormsg
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuditormsg
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuditormsg
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuditormsg
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuditormsg        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuditormsg          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuditormsg = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,44 @@
+package v1beta4
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+var (
+	amino = codec.NewLegacyAmino()
+
+	// ModuleCdc references the global x/audit module codec. Note, the codec should
+	// ONLY be used in certain instances of tests and for JSON encoding as Amino is
+	// still used for that purpose.
+	ModuleCdc = codec.NewProtoCodec(cdctypes.NewInterfaceRegistry())
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	cryptocodec.RegisterCrypto(amino)
+	amino.Seal()
+}
+
+// RegisterLegacyAminoCodec register concrete types on codec
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgRegisterAuditor{}, ModuleName+"/"+MsgTypeRegisterAuditor, nil)
+	cdc.RegisterConcrete(&MsgDeregisterAuditor{}, ModuleName+"/"+MsgTypeDeregisterAuditor, nil)
+	cdc.RegisterConcrete(&MsgCreateAuditorDelegate{}, ModuleName+"/"+MsgTypeCreateAuditorDelegate, nil)
+	cdc.RegisterConcrete(&MsgDeleteAuditorDelegate{}, ModuleName+"/"+MsgTypeDeleteAuditorDelegate, nil)
+}
+
+// RegisterInterfaces registers the node specific x/audit interfaces types with the interface registry
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRegisterAuditor{},
+		&MsgDeregisterAuditor{},
+		&MsgCreateAuditorDelegate{},
+		&MsgDeleteAuditorDelegate{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
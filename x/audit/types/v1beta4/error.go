@@ -0,0 +1,14 @@
+package v1beta4
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// error codes continue after range used by akash-api audit types
+const (
+	errAuditorInvalid uint32 = iota + 100
+)
+
+var (
+	ErrAuditorInvalid = sdkerrors.Register(ModuleName, errAuditorInvalid, "invalid auditor")
+)
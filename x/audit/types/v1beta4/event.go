@@ -0,0 +1,189 @@
+package v1beta4
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/akash-network/akash-api/go/sdkutil"
+)
+
+const (
+	EvActionAuditorRegistered      = "auditor-registered"
+	EvActionAuditorDeregistered    = "auditor-deregistered"
+	EvActionAuditorDelegateCreated = "auditor-delegate-created"
+	EvActionAuditorDelegateDeleted = "auditor-delegate-deleted"
+
+	EvAuditorKey  = "auditor"
+	EvDelegateKey = "delegate"
+)
+
+// EventAuditorRegistered struct
+type EventAuditorRegistered struct {
+	Context sdkutil.BaseModuleEvent `json:"context"`
+	Auditor sdk.AccAddress          `json:"auditor"`
+}
+
+func NewEventAuditorRegistered(auditor sdk.Address) EventAuditorRegistered {
+	return EventAuditorRegistered{
+		Context: sdkutil.BaseModuleEvent{
+			Module: ModuleName,
+			Action: EvActionAuditorRegistered,
+		},
+		Auditor: sdk.AccAddress(auditor.Bytes()),
+	}
+}
+
+// ToSDKEvent method creates new sdk event for EventAuditorRegistered struct
+func (ev EventAuditorRegistered) ToSDKEvent() sdk.Event {
+	return sdk.NewEvent(sdkutil.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeyAction, EvActionAuditorRegistered),
+		sdk.NewAttribute(EvAuditorKey, ev.Auditor.String()),
+	)
+}
+
+// EventAuditorDeregistered struct
+type EventAuditorDeregistered struct {
+	Context sdkutil.BaseModuleEvent `json:"context"`
+	Auditor sdk.AccAddress          `json:"auditor"`
+}
+
+func NewEventAuditorDeregistered(auditor sdk.Address) EventAuditorDeregistered {
+	return EventAuditorDeregistered{
+		Context: sdkutil.BaseModuleEvent{
+			Module: ModuleName,
+			Action: EvActionAuditorDeregistered,
+		},
+		Auditor: sdk.AccAddress(auditor.Bytes()),
+	}
+}
+
+// ToSDKEvent method creates new sdk event for EventAuditorDeregistered struct
+func (ev EventAuditorDeregistered) ToSDKEvent() sdk.Event {
+	return sdk.NewEvent(sdkutil.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeyAction, EvActionAuditorDeregistered),
+		sdk.NewAttribute(EvAuditorKey, ev.Auditor.String()),
+	)
+}
+
+// EventAuditorDelegateCreated struct
+type EventAuditorDelegateCreated struct {
+	Context  sdkutil.BaseModuleEvent `json:"context"`
+	Auditor  sdk.AccAddress          `json:"auditor"`
+	Delegate sdk.AccAddress          `json:"delegate"`
+}
+
+func NewEventAuditorDelegateCreated(auditor, delegate sdk.Address) EventAuditorDelegateCreated {
+	return EventAuditorDelegateCreated{
+		Context: sdkutil.BaseModuleEvent{
+			Module: ModuleName,
+			Action: EvActionAuditorDelegateCreated,
+		},
+		Auditor:  sdk.AccAddress(auditor.Bytes()),
+		Delegate: sdk.AccAddress(delegate.Bytes()),
+	}
+}
+
+// ToSDKEvent method creates new sdk event for EventAuditorDelegateCreated struct
+func (ev EventAuditorDelegateCreated) ToSDKEvent() sdk.Event {
+	return sdk.NewEvent(sdkutil.EventTypeMessage,
+		append([]sdk.Attribute{
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, EvActionAuditorDelegateCreated),
+		}, AuditorDelegateEVAttributes(ev.Auditor, ev.Delegate)...)...,
+	)
+}
+
+// EventAuditorDelegateDeleted struct
+type EventAuditorDelegateDeleted struct {
+	Context  sdkutil.BaseModuleEvent `json:"context"`
+	Auditor  sdk.AccAddress          `json:"auditor"`
+	Delegate sdk.AccAddress          `json:"delegate"`
+}
+
+func NewEventAuditorDelegateDeleted(auditor, delegate sdk.Address) EventAuditorDelegateDeleted {
+	return EventAuditorDelegateDeleted{
+		Context: sdkutil.BaseModuleEvent{
+			Module: ModuleName,
+			Action: EvActionAuditorDelegateDeleted,
+		},
+		Auditor:  sdk.AccAddress(auditor.Bytes()),
+		Delegate: sdk.AccAddress(delegate.Bytes()),
+	}
+}
+
+// ToSDKEvent method creates new sdk event for EventAuditorDelegateDeleted struct
+func (ev EventAuditorDelegateDeleted) ToSDKEvent() sdk.Event {
+	return sdk.NewEvent(sdkutil.EventTypeMessage,
+		append([]sdk.Attribute{
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, EvActionAuditorDelegateDeleted),
+		}, AuditorDelegateEVAttributes(ev.Auditor, ev.Delegate)...)...,
+	)
+}
+
+// AuditorDelegateEVAttributes returns event attributes for given auditor and delegate
+func AuditorDelegateEVAttributes(auditor, delegate sdk.AccAddress) []sdk.Attribute {
+	return []sdk.Attribute{
+		sdk.NewAttribute(EvAuditorKey, auditor.String()),
+		sdk.NewAttribute(EvDelegateKey, delegate.String()),
+	}
+}
+
+// ParseEVAuditorDelegate returns auditor and delegate for given event attributes
+func ParseEVAuditorDelegate(attrs []sdk.Attribute) (sdk.AccAddress, sdk.AccAddress, error) {
+	auditor, err := sdkutil.GetAccAddress(attrs, EvAuditorKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	delegate, err := sdkutil.GetAccAddress(attrs, EvDelegateKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return auditor, delegate, nil
+}
+
+// ParseEvent parses event and returns details of event and error if occurred
+func ParseEvent(ev sdkutil.Event) (sdkutil.ModuleEvent, error) {
+	if ev.Type != sdkutil.EventTypeMessage {
+		return nil, sdkutil.ErrUnknownType
+	}
+	if ev.Module != ModuleName {
+		return nil, sdkutil.ErrUnknownModule
+	}
+
+	switch ev.Action {
+	case EvActionAuditorRegistered:
+		auditor, err := sdkutil.GetAccAddress(ev.Attributes, EvAuditorKey)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewEventAuditorRegistered(auditor), nil
+	case EvActionAuditorDeregistered:
+		auditor, err := sdkutil.GetAccAddress(ev.Attributes, EvAuditorKey)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewEventAuditorDeregistered(auditor), nil
+	case EvActionAuditorDelegateCreated:
+		auditor, delegate, err := ParseEVAuditorDelegate(ev.Attributes)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewEventAuditorDelegateCreated(auditor, delegate), nil
+	case EvActionAuditorDelegateDeleted:
+		auditor, delegate, err := ParseEVAuditorDelegate(ev.Attributes)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewEventAuditorDelegateDeleted(auditor, delegate), nil
+	default:
+		return nil, sdkutil.ErrUnknownAction
+	}
+}
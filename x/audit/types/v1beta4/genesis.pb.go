@@ -0,0 +1,649 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: akash/audit/v1beta4/genesis.proto
+
+package v1beta4
+
+import (
+	fmt "fmt"
+	v1beta3 "github.com/akash-network/akash-api/go/node/audit/v1beta3"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the basic genesis state used by audit module.
+// It extends akash.audit.v1beta3.GenesisState with state introduced by node.
+type GenesisState struct {
+	Attributes     []v1beta3.AuditedAttributes `protobuf:"bytes,1,rep,name=attributes,proto3" json:"attributes" yaml:"attributes"`
+	Terms          []ProviderAuditTerms        `protobuf:"bytes,2,rep,name=terms,proto3" json:"terms" yaml:"terms"`
+	History        []AuditHistoryEntry         `protobuf:"bytes,3,rep,name=history,proto3" json:"history" yaml:"history"`
+	RegistryParams RegistryParams              `protobuf:"bytes,4,opt,name=registry_params,json=registryParams,proto3" json:"registry_params" yaml:"registry_params"`
+	Auditors       []Auditor                   `protobuf:"bytes,5,rep,name=auditors,proto3" json:"auditors" yaml:"auditors"`
+	Delegates      []AuditorDelegate           `protobuf:"bytes,6,rep,name=delegates,proto3" json:"delegates" yaml:"delegates"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8765efef2ccff99f, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetAttributes() []v1beta3.AuditedAttributes {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTerms() []ProviderAuditTerms {
+	if m != nil {
+		return m.Terms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHistory() []AuditHistoryEntry {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRegistryParams() RegistryParams {
+	if m != nil {
+		return m.RegistryParams
+	}
+	return RegistryParams{}
+}
+
+func (m *GenesisState) GetAuditors() []Auditor {
+	if m != nil {
+		return m.Auditors
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDelegates() []AuditorDelegate {
+	if m != nil {
+		return m.Delegates
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "akash.audit.v1beta4.GenesisState")
+}
+
+func init() { proto.RegisterFile("akash/audit/v1beta4/genesis.proto", fileDescriptor_8765efef2ccff99f) }
+
+var fileDescriptor_8765efef2ccff99f = []byte{
+	// 461 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x1b, 0x46, 0x07, 0x78, 0xd3, 0x06, 0x01, 0xa1, 0x68, 0x82, 0x78, 0xf3, 0x80, 0xed,
+	0x42, 0xac, 0xad, 0x3d, 0x71, 0x5b, 0x04, 0x82, 0x0b, 0xd2, 0x14, 0x38, 0x21, 0x10, 0x72, 0x16,
+	0x2b, 0xb5, 0xb6, 0xc4, 0x95, 0xed, 0x0e, 0x72, 0xe2, 0x13, 0x20, 0xf1, 0xb1, 0x76, 0xdc, 0x91,
+	0x93, 0x85, 0xda, 0x1b, 0xc7, 0x7c, 0x02, 0x14, 0xdb, 0x4d, 0xc7, 0xda, 0xee, 0xe6, 0xf7, 0xde,
+	0xff, 0xfd, 0x7f, 0xef, 0xc9, 0x0f, 0xec, 0x90, 0x53, 0x22, 0x07, 0x98, 0x8c, 0x32, 0xa6, 0xf0,
+	0xf9, 0x41, 0x4a, 0x15, 0xe9, 0xe3, 0x9c, 0x96, 0x54, 0x32, 0x19, 0x0d, 0x05, 0x57, 0xdc, 0x7f,
+	0x68, 0x24, 0x91, 0x91, 0x44, 0x4e, 0xb2, 0xf5, 0x28, 0xe7, 0x39, 0x37, 0x75, 0xdc, 0xbc, 0xac,
+	0x74, 0x0b, 0xce, 0xbb, 0xf5, 0x6c, 0xe4, 0x04, 0x0b, 0x71, 0x26, 0xe2, 0x62, 0xb9, 0x47, 0x1f,
+	0x2b, 0x2a, 0x0a, 0x37, 0x0f, 0xfa, 0xd9, 0x05, 0xeb, 0x6f, 0xed, 0x84, 0x1f, 0x14, 0x51, 0xd4,
+	0xe7, 0x00, 0x10, 0xa5, 0x04, 0x4b, 0x47, 0x8a, 0xca, 0xc0, 0xdb, 0x5e, 0xd9, 0x5f, 0x3b, 0x7c,
+	0x11, 0xcd, 0x4f, 0xdd, 0x8b, 0x8e, 0x9a, 0x88, 0x66, 0x47, 0xad, 0x3a, 0xde, 0xbb, 0xd0, 0xb0,
+	0xf3, 0x57, 0xc3, 0x2b, 0x0e, 0xb5, 0x86, 0x0f, 0x2a, 0x52, 0x9c, 0xbd, 0x42, 0xb3, 0x1c, 0x4a,
+	0xae, 0x08, 0xfc, 0xcf, 0xa0, 0x6b, 0x06, 0x0a, 0x6e, 0x19, 0xd6, 0xde, 0x02, 0x56, 0x3f, 0x3a,
+	0x16, 0xfc, 0x9c, 0x65, 0x54, 0x18, 0xe6, 0xc7, 0x46, 0x1e, 0x3f, 0x75, 0x30, 0xdb, 0x5d, 0x6b,
+	0xb8, 0x6e, 0x39, 0x26, 0x44, 0x89, 0x4d, 0xfb, 0x27, 0xe0, 0xce, 0x80, 0x49, 0xc5, 0x45, 0x15,
+	0xac, 0x2c, 0xdd, 0xa5, 0x6f, 0x77, 0x79, 0x67, 0x85, 0x6f, 0x4a, 0x25, 0xaa, 0x78, 0xc7, 0xd9,
+	0x4f, 0xdb, 0x6b, 0x0d, 0x37, 0x2c, 0xc0, 0x25, 0x50, 0x32, 0x2d, 0xf9, 0x3f, 0xc0, 0xa6, 0xa0,
+	0x39, 0x93, 0x4a, 0x54, 0x5f, 0x87, 0x44, 0x90, 0x42, 0x06, 0xb7, 0xb7, 0xbd, 0xfd, 0xb5, 0xc3,
+	0xdd, 0x85, 0xb0, 0xc4, 0x69, 0x8f, 0x8d, 0x34, 0x3e, 0x70, 0xa4, 0xeb, 0x1e, 0xb5, 0x86, 0x8f,
+	0x2d, 0xf1, 0x5a, 0x01, 0x25, 0x1b, 0xe2, 0x3f, 0x0b, 0xff, 0x0b, 0xb8, 0xeb, 0xfe, 0x5d, 0x06,
+	0x5d, 0xb3, 0xe6, 0x93, 0xe5, 0x6b, 0x72, 0x11, 0xef, 0x3a, 0x64, 0xdb, 0x55, 0x6b, 0xb8, 0xe9,
+	0xbe, 0xc9, 0x65, 0x50, 0xd2, 0x16, 0x7d, 0x06, 0xee, 0x65, 0xf4, 0x8c, 0xe6, 0xa4, 0x39, 0x89,
+	0x55, 0xe3, 0xff, 0xec, 0x26, 0xff, 0xd7, 0x4e, 0x1c, 0x3f, 0x77, 0x9c, 0x59, 0x7b, 0xad, 0xe1,
+	0x7d, 0x0b, 0x6a, 0x53, 0x28, 0x99, 0x95, 0xe3, 0xf7, 0x17, 0xe3, 0xd0, 0xbb, 0x1c, 0x87, 0xde,
+	0x9f, 0x71, 0xe8, 0xfd, 0x9a, 0x84, 0x9d, 0xcb, 0x49, 0xd8, 0xf9, 0x3d, 0x09, 0x3b, 0x9f, 0x7a,
+	0x39, 0x53, 0x83, 0x51, 0x1a, 0x9d, 0xf0, 0x02, 0x1b, 0xf6, 0xcb, 0x92, 0xaa, 0x6f, 0x5c, 0x9c,
+	0xe2, 0x92, 0x67, 0x14, 0x7f, 0x77, 0x47, 0xae, 0xaa, 0x21, 0x95, 0xd3, 0x53, 0x4f, 0x57, 0xcd,
+	0x95, 0xf7, 0xfe, 0x0d, 0x00, 0xbe, 0x4e, 0x68, 0x54, 0x9a, 0x03, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegates) > 0 {
+		for iNdEx := len(m.Delegates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Auditors) > 0 {
+		for iNdEx := len(m.Auditors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Auditors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.RegistryParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Terms) > 0 {
+		for iNdEx := len(m.Terms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Terms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Attributes) > 0 {
+		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Terms) > 0 {
+		for _, e := range m.Terms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.RegistryParams.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Auditors) > 0 {
+		for _, e := range m.Auditors {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Delegates) > 0 {
+		for _, e := range m.Delegates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes, v1beta3.AuditedAttributes{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Terms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Terms = append(m.Terms, ProviderAuditTerms{})
+			if err := m.Terms[len(m.Terms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, AuditHistoryEntry{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistryParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RegistryParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auditors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Auditors = append(m.Auditors, Auditor{})
+			if err := m.Auditors[len(m.Auditors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegates = append(m.Delegates, AuditorDelegate{})
+			if err := m.Delegates[len(m.Delegates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
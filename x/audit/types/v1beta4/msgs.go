@@ -0,0 +1,162 @@
+package v1beta4
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	MsgTypeRegisterAuditor       = "register-auditor"
+	MsgTypeDeregisterAuditor     = "deregister-auditor"
+	MsgTypeCreateAuditorDelegate = "create-auditor-delegate"
+	MsgTypeDeleteAuditorDelegate = "delete-auditor-delegate"
+)
+
+var (
+	_, _, _, _ sdk.Msg = &MsgRegisterAuditor{}, &MsgDeregisterAuditor{}, &MsgCreateAuditorDelegate{}, &MsgDeleteAuditorDelegate{}
+)
+
+// NewMsgRegisterAuditor creates a new MsgRegisterAuditor instance
+func NewMsgRegisterAuditor(address sdk.AccAddress, name, website string, deposit sdk.Coin) *MsgRegisterAuditor {
+	return &MsgRegisterAuditor{
+		Address: address.String(),
+		Name:    name,
+		Website: website,
+		Deposit: deposit,
+	}
+}
+
+// Auditor returns auditor record carried by the message
+func (msg MsgRegisterAuditor) Auditor() Auditor {
+	return Auditor{
+		Address: msg.Address,
+		Name:    msg.Name,
+		Website: msg.Website,
+		Deposit: msg.Deposit,
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgRegisterAuditor) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface
+func (msg MsgRegisterAuditor) Type() string { return MsgTypeRegisterAuditor }
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRegisterAuditor) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRegisterAuditor) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Address)}
+}
+
+// ValidateBasic does basic validation of auditor record
+func (msg MsgRegisterAuditor) ValidateBasic() error {
+	return msg.Auditor().ValidateBasic()
+}
+
+// NewMsgDeregisterAuditor creates a new MsgDeregisterAuditor instance
+func NewMsgDeregisterAuditor(address sdk.AccAddress) *MsgDeregisterAuditor {
+	return &MsgDeregisterAuditor{
+		Address: address.String(),
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgDeregisterAuditor) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface
+func (msg MsgDeregisterAuditor) Type() string { return MsgTypeDeregisterAuditor }
+
+// GetSignBytes encodes the message for signing
+func (msg MsgDeregisterAuditor) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgDeregisterAuditor) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Address)}
+}
+
+// ValidateBasic does basic validation of auditor address
+func (msg MsgDeregisterAuditor) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "MsgDeregisterAuditor: Invalid Address")
+	}
+
+	return nil
+}
+
+// NewMsgCreateAuditorDelegate creates a new MsgCreateAuditorDelegate instance
+func NewMsgCreateAuditorDelegate(auditor, delegate sdk.AccAddress) *MsgCreateAuditorDelegate {
+	return &MsgCreateAuditorDelegate{
+		Auditor:  auditor.String(),
+		Delegate: delegate.String(),
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgCreateAuditorDelegate) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface
+func (msg MsgCreateAuditorDelegate) Type() string { return MsgTypeCreateAuditorDelegate }
+
+// GetSignBytes encodes the message for signing
+func (msg MsgCreateAuditorDelegate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCreateAuditorDelegate) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Auditor)}
+}
+
+// ValidateBasic does basic validation of auditor and delegate addresses
+func (msg MsgCreateAuditorDelegate) ValidateBasic() error {
+	return validateAuditorDelegate("MsgCreateAuditorDelegate", msg.Auditor, msg.Delegate)
+}
+
+// NewMsgDeleteAuditorDelegate creates a new MsgDeleteAuditorDelegate instance
+func NewMsgDeleteAuditorDelegate(auditor, delegate sdk.AccAddress) *MsgDeleteAuditorDelegate {
+	return &MsgDeleteAuditorDelegate{
+		Auditor:  auditor.String(),
+		Delegate: delegate.String(),
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgDeleteAuditorDelegate) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface
+func (msg MsgDeleteAuditorDelegate) Type() string { return MsgTypeDeleteAuditorDelegate }
+
+// GetSignBytes encodes the message for signing
+func (msg MsgDeleteAuditorDelegate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgDeleteAuditorDelegate) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Auditor)}
+}
+
+// ValidateBasic does basic validation of auditor and delegate addresses
+func (msg MsgDeleteAuditorDelegate) ValidateBasic() error {
+	return validateAuditorDelegate("MsgDeleteAuditorDelegate", msg.Auditor, msg.Delegate)
+}
+
+func validateAuditorDelegate(name, auditor, delegate string) error {
+	if _, err := sdk.AccAddressFromBech32(auditor); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("%s: Invalid Auditor Address", name))
+	}
+
+	if _, err := sdk.AccAddressFromBech32(delegate); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("%s: Invalid Delegate Address", name))
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package v1beta4
+
+import (
+	"fmt"
+	"net/url"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+const (
+	keyMinAuditorDeposit       = "MinAuditorDeposit"
+	keyApprovedAuditors        = "ApprovedAuditors"
+	keyRequireApprovedAuditors = "RequireApprovedAuditors"
+
+	maxAuditorNameLength    = 64
+	maxAuditorWebsiteLength = 256
+)
+
+// ValidateBasic checks auditor record is well-formed
+func (a Auditor) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(a.Address); err != nil {
+		return fmt.Errorf("%w: address: %s", ErrAuditorInvalid, err)
+	}
+
+	if a.Name == "" || len(a.Name) > maxAuditorNameLength {
+		return fmt.Errorf("%w: name must be 1 to %d characters", ErrAuditorInvalid, maxAuditorNameLength)
+	}
+
+	if len(a.Website) > maxAuditorWebsiteLength {
+		return fmt.Errorf("%w: website must not exceed %d characters", ErrAuditorInvalid, maxAuditorWebsiteLength)
+	}
+
+	if a.Website != "" {
+		if u, err := url.ParseRequestURI(a.Website); err != nil || (u.Scheme != "https" && u.Scheme != "http") {
+			return fmt.Errorf("%w: website must be http(s) url", ErrAuditorInvalid)
+		}
+	}
+
+	if !a.Deposit.IsValid() || !a.Deposit.IsPositive() {
+		return fmt.Errorf("%w: deposit must be positive", ErrAuditorInvalid)
+	}
+
+	return nil
+}
+
+var _ paramtypes.ParamSet = (*RegistryParams)(nil)
+
+// ParamSetPairs implements paramtypes.ParamSet. Registry params are kept in the audit params
+// subspace and can be changed by parameter change proposals.
+func (p *RegistryParams) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair([]byte(keyMinAuditorDeposit), &p.MinAuditorDeposit, validateMinAuditorDeposit),
+		paramtypes.NewParamSetPair([]byte(keyApprovedAuditors), &p.ApprovedAuditors, validateApprovedAuditors),
+		paramtypes.NewParamSetPair([]byte(keyRequireApprovedAuditors), &p.RequireApprovedAuditors, validateBool),
+	}
+}
+
+func (p RegistryParams) Validate() error {
+	if err := validateMinAuditorDeposit(p.MinAuditorDeposit); err != nil {
+		return err
+	}
+
+	return validateApprovedAuditors(p.ApprovedAuditors)
+}
+
+func validateMinAuditorDeposit(i interface{}) error {
+	val, ok := i.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// zero value disables minimum
+	if val.Denom == "" && (val.Amount.IsNil() || val.Amount.IsZero()) {
+		return nil
+	}
+
+	return val.Validate()
+}
+
+func validateApprovedAuditors(i interface{}) error {
+	val, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for _, addr := range val {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid approved auditor %q: %w", addr, err)
+		}
+	}
+
+	return nil
+}
+
+func validateBool(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -0,0 +1,245 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: akash/audit/v1beta4/service.proto
+
+package v1beta4
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+func init() { proto.RegisterFile("akash/audit/v1beta4/service.proto", fileDescriptor_32a4908f507e8786) }
+
+var fileDescriptor_32a4908f507e8786 = []byte{
+	// 266 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0xd2, 0x31, 0x4e, 0xc3, 0x30,
+	0x14, 0x06, 0xe0, 0x44, 0x95, 0x18, 0xbc, 0x20, 0x8c, 0x58, 0x32, 0x58, 0x42, 0x42, 0x42, 0x0c,
+	0xb5, 0x55, 0x0a, 0x07, 0x00, 0xba, 0x66, 0xe9, 0xc8, 0xe6, 0xb4, 0x4f, 0x6e, 0x14, 0x1a, 0x07,
+	0xbf, 0xd7, 0x02, 0x03, 0x77, 0xe0, 0x58, 0x8c, 0x1d, 0x19, 0x51, 0x72, 0x11, 0x84, 0x9b, 0x22,
+	0xd4, 0x3a, 0x52, 0x3b, 0x26, 0xfe, 0xde, 0xff, 0x2f, 0x3f, 0x3b, 0xd7, 0x85, 0xc6, 0x99, 0xd2,
+	0x8b, 0x69, 0x4e, 0x6a, 0x39, 0xc8, 0x80, 0xf4, 0x8d, 0x42, 0x70, 0xcb, 0x7c, 0x02, 0xb2, 0x72,
+	0x96, 0x2c, 0x3f, 0xf5, 0x44, 0x7a, 0x22, 0x5b, 0x92, 0x5c, 0x84, 0xee, 0xfc, 0x97, 0x75, 0x73,
+	0x34, 0xeb, 0xd3, 0xeb, 0xa6, 0xc7, 0x7a, 0x29, 0x1a, 0x5e, 0xb0, 0xe3, 0x31, 0x98, 0x1c, 0x09,
+	0xdc, 0xdd, 0xda, 0xf0, 0x4b, 0x19, 0x88, 0x95, 0x29, 0x9a, 0x2d, 0x98, 0xa8, 0x3d, 0xe1, 0x18,
+	0xb0, 0xb2, 0x25, 0x02, 0x7f, 0x66, 0x27, 0x23, 0x70, 0x5b, 0x75, 0x57, 0x5d, 0x29, 0x3b, 0x34,
+	0x19, 0xec, 0x4d, 0xff, 0x2a, 0xdf, 0xd9, 0xd9, 0x83, 0x03, 0x4d, 0xd0, 0x3e, 0x8c, 0xe0, 0x09,
+	0x8c, 0x26, 0xe0, 0xfd, 0xae, 0xac, 0x20, 0x4f, 0x6e, 0x0f, 0xe2, 0xff, 0xeb, 0x7f, 0xff, 0x1d,
+	0x50, 0x1f, 0xe4, 0xdd, 0xf5, 0x41, 0xbe, 0xa9, 0xbf, 0x4f, 0x3f, 0x6b, 0x11, 0xaf, 0x6a, 0x11,
+	0x7f, 0xd7, 0x22, 0xfe, 0x68, 0x44, 0xb4, 0x6a, 0x44, 0xf4, 0xd5, 0x88, 0xe8, 0x71, 0x68, 0x72,
+	0x9a, 0x2d, 0x32, 0x39, 0xb1, 0x73, 0xe5, 0xa3, 0xfb, 0x25, 0xd0, 0x8b, 0x75, 0x85, 0x2a, 0xed,
+	0x14, 0xd4, 0x6b, 0xbb, 0x1f, 0x7a, 0xab, 0x00, 0x37, 0x2b, 0xca, 0x8e, 0xfc, 0x76, 0x86, 0x3f,
+	0x03, 0x00, 0xae, 0xeb, 0x07, 0x75, 0x9b, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// RegisterAuditor adds auditor to the auditor registry.
+	RegisterAuditor(ctx context.Context, in *MsgRegisterAuditor, opts ...grpc.CallOption) (*MsgRegisterAuditorResponse, error)
+	// DeregisterAuditor removes auditor from the auditor registry.
+	DeregisterAuditor(ctx context.Context, in *MsgDeregisterAuditor, opts ...grpc.CallOption) (*MsgDeregisterAuditorResponse, error)
+	// CreateAuditorDelegate authorizes key to sign provider attributes on behalf of auditor.
+	CreateAuditorDelegate(ctx context.Context, in *MsgCreateAuditorDelegate, opts ...grpc.CallOption) (*MsgCreateAuditorDelegateResponse, error)
+	// DeleteAuditorDelegate revokes key signing on behalf of auditor.
+	DeleteAuditorDelegate(ctx context.Context, in *MsgDeleteAuditorDelegate, opts ...grpc.CallOption) (*MsgDeleteAuditorDelegateResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) RegisterAuditor(ctx context.Context, in *MsgRegisterAuditor, opts ...grpc.CallOption) (*MsgRegisterAuditorResponse, error) {
+	out := new(MsgRegisterAuditorResponse)
+	err := c.cc.Invoke(ctx, "/akash.audit.v1beta4.Msg/RegisterAuditor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeregisterAuditor(ctx context.Context, in *MsgDeregisterAuditor, opts ...grpc.CallOption) (*MsgDeregisterAuditorResponse, error) {
+	out := new(MsgDeregisterAuditorResponse)
+	err := c.cc.Invoke(ctx, "/akash.audit.v1beta4.Msg/DeregisterAuditor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateAuditorDelegate(ctx context.Context, in *MsgCreateAuditorDelegate, opts ...grpc.CallOption) (*MsgCreateAuditorDelegateResponse, error) {
+	out := new(MsgCreateAuditorDelegateResponse)
+	err := c.cc.Invoke(ctx, "/akash.audit.v1beta4.Msg/CreateAuditorDelegate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteAuditorDelegate(ctx context.Context, in *MsgDeleteAuditorDelegate, opts ...grpc.CallOption) (*MsgDeleteAuditorDelegateResponse, error) {
+	out := new(MsgDeleteAuditorDelegateResponse)
+	err := c.cc.Invoke(ctx, "/akash.audit.v1beta4.Msg/DeleteAuditorDelegate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// RegisterAuditor adds auditor to the auditor registry.
+	RegisterAuditor(context.Context, *MsgRegisterAuditor) (*MsgRegisterAuditorResponse, error)
+	// DeregisterAuditor removes auditor from the auditor registry.
+	DeregisterAuditor(context.Context, *MsgDeregisterAuditor) (*MsgDeregisterAuditorResponse, error)
+	// CreateAuditorDelegate authorizes key to sign provider attributes on behalf of auditor.
+	CreateAuditorDelegate(context.Context, *MsgCreateAuditorDelegate) (*MsgCreateAuditorDelegateResponse, error)
+	// DeleteAuditorDelegate revokes key signing on behalf of auditor.
+	DeleteAuditorDelegate(context.Context, *MsgDeleteAuditorDelegate) (*MsgDeleteAuditorDelegateResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) RegisterAuditor(ctx context.Context, req *MsgRegisterAuditor) (*MsgRegisterAuditorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterAuditor not implemented")
+}
+func (*UnimplementedMsgServer) DeregisterAuditor(ctx context.Context, req *MsgDeregisterAuditor) (*MsgDeregisterAuditorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeregisterAuditor not implemented")
+}
+func (*UnimplementedMsgServer) CreateAuditorDelegate(ctx context.Context, req *MsgCreateAuditorDelegate) (*MsgCreateAuditorDelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAuditorDelegate not implemented")
+}
+func (*UnimplementedMsgServer) DeleteAuditorDelegate(ctx context.Context, req *MsgDeleteAuditorDelegate) (*MsgDeleteAuditorDelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAuditorDelegate not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_RegisterAuditor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterAuditor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterAuditor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/akash.audit.v1beta4.Msg/RegisterAuditor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterAuditor(ctx, req.(*MsgRegisterAuditor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeregisterAuditor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeregisterAuditor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeregisterAuditor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/akash.audit.v1beta4.Msg/DeregisterAuditor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeregisterAuditor(ctx, req.(*MsgDeregisterAuditor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateAuditorDelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateAuditorDelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateAuditorDelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/akash.audit.v1beta4.Msg/CreateAuditorDelegate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateAuditorDelegate(ctx, req.(*MsgCreateAuditorDelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteAuditorDelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteAuditorDelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteAuditorDelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/akash.audit.v1beta4.Msg/DeleteAuditorDelegate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteAuditorDelegate(ctx, req.(*MsgDeleteAuditorDelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "akash.audit.v1beta4.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterAuditor",
+			Handler:    _Msg_RegisterAuditor_Handler,
+		},
+		{
+			MethodName: "DeregisterAuditor",
+			Handler:    _Msg_DeregisterAuditor_Handler,
+		},
+		{
+			MethodName: "CreateAuditorDelegate",
+			Handler:    _Msg_CreateAuditorDelegate_Handler,
+		},
+		{
+			MethodName: "DeleteAuditorDelegate",
+			Handler:    _Msg_DeleteAuditorDelegate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "akash/audit/v1beta4/service.proto",
+}
@@ -0,0 +1,24 @@
+package v1beta4
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	AuditActionSigned  = "signed"
+	AuditActionDeleted = "deleted"
+)
+
+// Expired returns true if terms expired at or before current block
+func (t AuditTerms) Expired(ctx sdk.Context) bool {
+	if t.ExpiresAtHeight > 0 && ctx.BlockHeight() >= t.ExpiresAtHeight {
+		return true
+	}
+
+	return t.ExpiresAt != nil && !ctx.BlockTime().Before(*t.ExpiresAt)
+}
+
+// HasExpiry returns true if terms expire at some height or time
+func (t AuditTerms) HasExpiry() bool {
+	return t.ExpiresAtHeight != 0 || t.ExpiresAt != nil
+}
@@ -0,0 +1,1234 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: akash/audit/v1beta4/terms.proto
+
+package v1beta4
+
+import (
+	fmt "fmt"
+	github_com_akash_network_akash_api_go_node_types_v1beta3 "github.com/akash-network/akash-api/go/node/types/v1beta3"
+	v1beta3 "github.com/akash-network/akash-api/go/node/types/v1beta3"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AuditTerms holds version auditor assigned to attributes it signed for a provider, and when the signature expires.
+// Zero expires_at_height and unset expires_at never expire.
+type AuditTerms struct {
+	Version         uint64     `protobuf:"varint,1,opt,name=version,proto3" json:"version" yaml:"version"`
+	ExpiresAtHeight int64      `protobuf:"varint,2,opt,name=expires_at_height,json=expiresAtHeight,proto3" json:"expires_at_height,omitempty" yaml:"expires_at_height,omitempty"`
+	ExpiresAt       *time.Time `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty" yaml:"expires_at,omitempty"`
+}
+
+func (m *AuditTerms) Reset()         { *m = AuditTerms{} }
+func (m *AuditTerms) String() string { return proto.CompactTextString(m) }
+func (*AuditTerms) ProtoMessage()    {}
+func (*AuditTerms) Descriptor() ([]byte, []int) {
+	return fileDescriptor_184b1ca6ae77eae3, []int{0}
+}
+func (m *AuditTerms) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuditTerms) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuditTerms.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuditTerms) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditTerms.Merge(m, src)
+}
+func (m *AuditTerms) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuditTerms) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditTerms.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditTerms proto.InternalMessageInfo
+
+func (m *AuditTerms) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *AuditTerms) GetExpiresAtHeight() int64 {
+	if m != nil {
+		return m.ExpiresAtHeight
+	}
+	return 0
+}
+
+func (m *AuditTerms) GetExpiresAt() *time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+// ProviderAuditTerms holds terms of attributes signed by auditor for provider
+type ProviderAuditTerms struct {
+	Owner   string     `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner" yaml:"owner"`
+	Auditor string     `protobuf:"bytes,2,opt,name=auditor,proto3" json:"auditor" yaml:"auditor"`
+	Terms   AuditTerms `protobuf:"bytes,3,opt,name=terms,proto3" json:"terms" yaml:"terms"`
+}
+
+func (m *ProviderAuditTerms) Reset()         { *m = ProviderAuditTerms{} }
+func (m *ProviderAuditTerms) String() string { return proto.CompactTextString(m) }
+func (*ProviderAuditTerms) ProtoMessage()    {}
+func (*ProviderAuditTerms) Descriptor() ([]byte, []int) {
+	return fileDescriptor_184b1ca6ae77eae3, []int{1}
+}
+func (m *ProviderAuditTerms) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderAuditTerms) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderAuditTerms.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProviderAuditTerms) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderAuditTerms.Merge(m, src)
+}
+func (m *ProviderAuditTerms) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderAuditTerms) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderAuditTerms.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderAuditTerms proto.InternalMessageInfo
+
+func (m *ProviderAuditTerms) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *ProviderAuditTerms) GetAuditor() string {
+	if m != nil {
+		return m.Auditor
+	}
+	return ""
+}
+
+func (m *ProviderAuditTerms) GetTerms() AuditTerms {
+	if m != nil {
+		return m.Terms
+	}
+	return AuditTerms{}
+}
+
+// AuditHistoryEntry records attributes signed or deleted by an auditor for provider
+type AuditHistoryEntry struct {
+	Owner      string                                                              `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner" yaml:"owner"`
+	Auditor    string                                                              `protobuf:"bytes,2,opt,name=auditor,proto3" json:"auditor" yaml:"auditor"`
+	Action     string                                                              `protobuf:"bytes,3,opt,name=action,proto3" json:"action" yaml:"action"`
+	Attributes github_com_akash_network_akash_api_go_node_types_v1beta3.Attributes `protobuf:"bytes,4,rep,name=attributes,proto3,castrepeated=github.com/akash-network/akash-api/go/node/types/v1beta3.Attributes" json:"attributes,omitempty" yaml:"attributes,omitempty"`
+	Keys       []string                                                            `protobuf:"bytes,5,rep,name=keys,proto3" json:"keys,omitempty" yaml:"keys,omitempty"`
+	Terms      AuditTerms                                                          `protobuf:"bytes,6,opt,name=terms,proto3" json:"terms" yaml:"terms"`
+	Height     int64                                                               `protobuf:"varint,7,opt,name=height,proto3" json:"height" yaml:"height"`
+	Time       time.Time                                                           `protobuf:"bytes,8,opt,name=time,proto3,stdtime" json:"time" yaml:"time"`
+}
+
+func (m *AuditHistoryEntry) Reset()         { *m = AuditHistoryEntry{} }
+func (m *AuditHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*AuditHistoryEntry) ProtoMessage()    {}
+func (*AuditHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_184b1ca6ae77eae3, []int{2}
+}
+func (m *AuditHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuditHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuditHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuditHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditHistoryEntry.Merge(m, src)
+}
+func (m *AuditHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuditHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditHistoryEntry proto.InternalMessageInfo
+
+func (m *AuditHistoryEntry) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *AuditHistoryEntry) GetAuditor() string {
+	if m != nil {
+		return m.Auditor
+	}
+	return ""
+}
+
+func (m *AuditHistoryEntry) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *AuditHistoryEntry) GetAttributes() github_com_akash_network_akash_api_go_node_types_v1beta3.Attributes {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+func (m *AuditHistoryEntry) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *AuditHistoryEntry) GetTerms() AuditTerms {
+	if m != nil {
+		return m.Terms
+	}
+	return AuditTerms{}
+}
+
+func (m *AuditHistoryEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *AuditHistoryEntry) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*AuditTerms)(nil), "akash.audit.v1beta4.AuditTerms")
+	proto.RegisterType((*ProviderAuditTerms)(nil), "akash.audit.v1beta4.ProviderAuditTerms")
+	proto.RegisterType((*AuditHistoryEntry)(nil), "akash.audit.v1beta4.AuditHistoryEntry")
+}
+
+func init() { proto.RegisterFile("akash/audit/v1beta4/terms.proto", fileDescriptor_184b1ca6ae77eae3) }
+
+var fileDescriptor_184b1ca6ae77eae3 = []byte{
+	// 636 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xb1, 0x6e, 0xd4, 0x4c,
+	0x10, 0x3e, 0xe7, 0xee, 0x92, 0xff, 0x36, 0x3f, 0x41, 0x31, 0x41, 0x32, 0x89, 0xe2, 0x3d, 0x6d,
+	0x75, 0x12, 0xe0, 0x15, 0xb9, 0x48, 0x48, 0x50, 0xa0, 0x18, 0x45, 0x4a, 0x83, 0x40, 0x56, 0x2a,
+	0x9a, 0xc8, 0x4e, 0x16, 0xdf, 0x2a, 0xf1, 0xad, 0x59, 0xef, 0x5d, 0xe2, 0x96, 0x27, 0x48, 0xc1,
+	0x53, 0x50, 0xf1, 0x0c, 0x54, 0x29, 0x53, 0x50, 0x50, 0x6d, 0x50, 0xd2, 0xb9, 0xbc, 0x27, 0x40,
+	0xde, 0x5d, 0xc7, 0x17, 0x72, 0x40, 0x83, 0xe8, 0x3c, 0xdf, 0x37, 0xe3, 0xf9, 0xfc, 0xcd, 0x8c,
+	0x01, 0x0c, 0x0f, 0xc3, 0x6c, 0x80, 0xc3, 0xd1, 0x01, 0x15, 0x78, 0xfc, 0x24, 0x22, 0x22, 0xdc,
+	0xc4, 0x82, 0xf0, 0x24, 0xf3, 0x52, 0xce, 0x04, 0xb3, 0xef, 0xa9, 0x04, 0x4f, 0x25, 0x78, 0x26,
+	0x61, 0x75, 0x25, 0x66, 0x31, 0x53, 0x3c, 0x2e, 0x9f, 0x74, 0xea, 0x2a, 0x8c, 0x19, 0x8b, 0x8f,
+	0x08, 0x56, 0x51, 0x34, 0x7a, 0x87, 0x05, 0x4d, 0x48, 0x26, 0xc2, 0x24, 0x35, 0x09, 0x48, 0x37,
+	0x8b, 0xc2, 0x8c, 0x98, 0x5e, 0x7d, 0x1c, 0x0a, 0xc1, 0x69, 0x34, 0x12, 0x44, 0xe7, 0xa0, 0xcf,
+	0x73, 0x00, 0x6c, 0x95, 0xcd, 0x76, 0x4b, 0x11, 0xf6, 0x53, 0xb0, 0x30, 0x26, 0x3c, 0xa3, 0x6c,
+	0xe8, 0x58, 0x5d, 0xab, 0xd7, 0xf2, 0xd7, 0x0b, 0x09, 0x2b, 0x68, 0x22, 0xe1, 0x52, 0x1e, 0x26,
+	0x47, 0xcf, 0x90, 0x01, 0x50, 0x50, 0x51, 0xf6, 0x7b, 0xb0, 0x4c, 0x4e, 0x52, 0xca, 0x49, 0xb6,
+	0x17, 0x8a, 0xbd, 0x01, 0xa1, 0xf1, 0x40, 0x38, 0x73, 0x5d, 0xab, 0xd7, 0xf4, 0xb7, 0x0b, 0x09,
+	0xd7, 0x6e, 0x91, 0x8f, 0x58, 0x42, 0x05, 0x49, 0x52, 0x91, 0x4f, 0x24, 0x44, 0xfa, 0xb5, 0xbf,
+	0x49, 0x42, 0xc1, 0x5d, 0xc3, 0x6e, 0x89, 0x1d, 0xc5, 0xd9, 0x63, 0x00, 0xea, 0x02, 0xa7, 0xd9,
+	0xb5, 0x7a, 0x8b, 0x1b, 0xab, 0x9e, 0x36, 0xc5, 0xab, 0x4c, 0xf1, 0x76, 0x2b, 0x53, 0xfc, 0xe7,
+	0x85, 0x84, 0x2b, 0x75, 0xc5, 0x0d, 0x01, 0x6b, 0x3f, 0x0b, 0x98, 0xea, 0x7c, 0x7a, 0x01, 0xad,
+	0xa0, 0x73, 0xdd, 0x1d, 0x7d, 0xb5, 0x80, 0xfd, 0x86, 0xb3, 0x31, 0x3d, 0x20, 0x7c, 0xca, 0x3a,
+	0x0c, 0xda, 0xec, 0x78, 0x48, 0xb8, 0x32, 0xae, 0xe3, 0x3f, 0x28, 0x24, 0xd4, 0xc0, 0x44, 0xc2,
+	0xff, 0xf5, 0xeb, 0x55, 0x88, 0x02, 0x0d, 0x97, 0x5e, 0xab, 0x31, 0x33, 0xae, 0x8c, 0xea, 0x68,
+	0xaf, 0x0d, 0x54, 0x7b, 0x6d, 0x00, 0x14, 0x54, 0x94, 0xbd, 0x0b, 0xda, 0x6a, 0x65, 0xcc, 0x37,
+	0x43, 0x6f, 0xc6, 0xce, 0x78, 0xb5, 0x32, 0x7f, 0xfd, 0x4c, 0xc2, 0x46, 0x29, 0x47, 0x55, 0xd5,
+	0x72, 0x54, 0x88, 0x02, 0x0d, 0xa3, 0x8f, 0x6d, 0xb0, 0xac, 0x8a, 0x76, 0x68, 0x26, 0x18, 0xcf,
+	0xb7, 0x87, 0x82, 0xe7, 0xff, 0xf0, 0xab, 0xfa, 0x60, 0x3e, 0xdc, 0x17, 0xe5, 0xe6, 0x35, 0x55,
+	0xdd, 0x5a, 0x21, 0xa1, 0x41, 0x26, 0x12, 0xde, 0x31, 0x65, 0x2a, 0x46, 0x81, 0x21, 0xec, 0x2f,
+	0x16, 0x00, 0xd7, 0x2b, 0x9d, 0x39, 0xad, 0x6e, 0xb3, 0xb7, 0xb8, 0xb1, 0x6e, 0x0c, 0x29, 0x17,
+	0xdf, 0xf8, 0xd1, 0xf7, 0xb6, 0xaa, 0x2c, 0xff, 0x83, 0x65, 0xfc, 0x58, 0xa9, 0x2b, 0x67, 0x2d,
+	0xc3, 0x2c, 0x16, 0x7d, 0xba, 0x80, 0x2f, 0x63, 0x2a, 0x06, 0xa3, 0xc8, 0xdb, 0x67, 0x09, 0x56,
+	0x8d, 0x1e, 0x0f, 0x89, 0x38, 0x66, 0xfc, 0xd0, 0x44, 0x61, 0x4a, 0x71, 0xcc, 0xf0, 0x90, 0x1d,
+	0x10, 0x2c, 0xf2, 0x94, 0x64, 0xf8, 0x96, 0x86, 0x2c, 0x98, 0x52, 0x6d, 0xbf, 0x00, 0xad, 0x43,
+	0x92, 0x67, 0x4e, 0xbb, 0xdb, 0xec, 0x75, 0xfc, 0x87, 0x85, 0x84, 0x4b, 0x65, 0x7c, 0x43, 0xd3,
+	0x7d, 0xad, 0xe9, 0x26, 0x8e, 0x02, 0x55, 0x58, 0x2f, 0xc4, 0xfc, 0x5f, 0x5c, 0x88, 0x72, 0x20,
+	0xe6, 0x8e, 0x17, 0xd4, 0x1d, 0xab, 0x81, 0x68, 0xa4, 0x1e, 0x88, 0x8e, 0x51, 0x60, 0x08, 0xfb,
+	0x35, 0x68, 0x95, 0xbf, 0x21, 0xe7, 0xbf, 0x3f, 0x9e, 0x23, 0x34, 0x22, 0x54, 0xfe, 0x44, 0xc2,
+	0x45, 0xa3, 0x81, 0x26, 0x44, 0x9f, 0x9c, 0x22, 0xfc, 0x57, 0x67, 0x97, 0xae, 0x75, 0x7e, 0xe9,
+	0x5a, 0xdf, 0x2f, 0x5d, 0xeb, 0xf4, 0xca, 0x6d, 0x9c, 0x5f, 0xb9, 0x8d, 0x6f, 0x57, 0x6e, 0xe3,
+	0x6d, 0xff, 0x97, 0x73, 0x50, 0xd6, 0x9f, 0x98, 0xbf, 0xec, 0xf4, 0x08, 0x36, 0xa3, 0x79, 0xa5,
+	0xa4, 0xff, 0x63, 0x00, 0xb5, 0x18, 0x44, 0xd2, 0x89, 0x05, 0x00, 0x00,
+}
+
+func (m *AuditTerms) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuditTerms) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuditTerms) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAt != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintTerms(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ExpiresAtHeight != 0 {
+		i = encodeVarintTerms(dAtA, i, uint64(m.ExpiresAtHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Version != 0 {
+		i = encodeVarintTerms(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderAuditTerms) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderAuditTerms) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderAuditTerms) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Terms.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTerms(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Auditor) > 0 {
+		i -= len(m.Auditor)
+		copy(dAtA[i:], m.Auditor)
+		i = encodeVarintTerms(dAtA, i, uint64(len(m.Auditor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTerms(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuditHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuditHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuditHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTerms(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x42
+	if m.Height != 0 {
+		i = encodeVarintTerms(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.Terms.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTerms(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintTerms(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Attributes) > 0 {
+		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTerms(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintTerms(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Auditor) > 0 {
+		i -= len(m.Auditor)
+		copy(dAtA[i:], m.Auditor)
+		i = encodeVarintTerms(dAtA, i, uint64(len(m.Auditor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTerms(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTerms(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTerms(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AuditTerms) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovTerms(uint64(m.Version))
+	}
+	if m.ExpiresAtHeight != 0 {
+		n += 1 + sovTerms(uint64(m.ExpiresAtHeight))
+	}
+	if m.ExpiresAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovTerms(uint64(l))
+	}
+	return n
+}
+
+func (m *ProviderAuditTerms) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTerms(uint64(l))
+	}
+	l = len(m.Auditor)
+	if l > 0 {
+		n += 1 + l + sovTerms(uint64(l))
+	}
+	l = m.Terms.Size()
+	n += 1 + l + sovTerms(uint64(l))
+	return n
+}
+
+func (m *AuditHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTerms(uint64(l))
+	}
+	l = len(m.Auditor)
+	if l > 0 {
+		n += 1 + l + sovTerms(uint64(l))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovTerms(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.Size()
+			n += 1 + l + sovTerms(uint64(l))
+		}
+	}
+	if len(m.Keys) > 0 {
+		for _, s := range m.Keys {
+			l = len(s)
+			n += 1 + l + sovTerms(uint64(l))
+		}
+	}
+	l = m.Terms.Size()
+	n += 1 + l + sovTerms(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovTerms(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovTerms(uint64(l))
+	return n
+}
+
+func sovTerms(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTerms(x uint64) (n int) {
+	return sovTerms(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AuditTerms) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTerms
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuditTerms: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuditTerms: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTerms
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAtHeight", wireType)
+			}
+			m.ExpiresAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTerms
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTerms
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTerms
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTerms
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTerms(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTerms
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProviderAuditTerms) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTerms
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderAuditTerms: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderAuditTerms: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTerms
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTerms
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTerms
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auditor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTerms
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTerms
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTerms
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Auditor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Terms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTerms
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTerms
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTerms
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Terms.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTerms(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTerms
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuditHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTerms
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuditHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuditHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTerms
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTerms
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTerms
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auditor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTerms
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTerms
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTerms
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Auditor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTerms
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTerms
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTerms
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTerms
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTerms
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTerms
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes, v1beta3.Attribute{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTerms
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTerms
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTerms
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Terms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTerms
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTerms
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTerms
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Terms.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTerms
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTerms
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTerms
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTerms
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTerms(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTerms
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTerms(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTerms
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTerms
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTerms
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTerms
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTerms
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTerms
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTerms        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTerms          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTerms = fmt.Errorf("proto: unexpected end of group")
+)
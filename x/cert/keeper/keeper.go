@@ -5,6 +5,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	types "github.com/akash-network/akash-api/go/node/cert/v1beta3"
+
+	cv1beta4 "github.com/akash-network/node/x/cert/types/v1beta4"
 )
 
 // Keeper of the provider store
@@ -73,6 +75,11 @@ func (k keeper) CreateCertificate(ctx sdk.Context, owner sdk.Address, crt []byte
 
 	store.Set(key, k.cdc.MustMarshal(&val))
 
+	ctx.EventManager().EmitEvent(
+		cv1beta4.NewEventCertificateCreated(sdk.AccAddress(owner.Bytes()), cert.SerialNumber.String()).
+			ToSDKEvent(),
+	)
+
 	return nil
 }
 
@@ -96,6 +103,11 @@ func (k keeper) RevokeCertificate(ctx sdk.Context, id types.CertID) error {
 
 	store.Set(key, k.cdc.MustMarshal(&cert))
 
+	ctx.EventManager().EmitEvent(
+		cv1beta4.NewEventCertificateRevoked(sdk.AccAddress(id.Owner.Bytes()), id.Serial.String()).
+			ToSDKEvent(),
+	)
+
 	return nil
 }
 
@@ -7,13 +7,16 @@ import (
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
 	types "github.com/akash-network/akash-api/go/node/cert/v1beta3"
+	"github.com/akash-network/akash-api/go/sdkutil"
 
 	"github.com/akash-network/node/testutil"
 	"github.com/akash-network/node/x/cert/keeper"
+	cv1beta4 "github.com/akash-network/node/x/cert/types/v1beta4"
 )
 
 func TestCertKeeperCreate(t *testing.T) {
@@ -199,3 +202,34 @@ func setupKeeper(t testing.TB) (sdk.Context, keeper.Keeper) {
 	ctx := sdk.NewContext(ms, tmproto.Header{Time: time.Unix(0, 0)}, false, testutil.Logger(t))
 	return ctx, keeper.NewKeeper(types.ModuleCdc, key)
 }
+
+func TestCertKeeperEvents(t *testing.T) {
+	ctx, ckeeper := setupKeeper(t)
+	owner := testutil.AccAddress(t)
+	cert := testutil.Certificate(t, owner)
+
+	err := ckeeper.CreateCertificate(ctx, owner, cert.PEM.Cert, cert.PEM.Pub)
+	require.NoError(t, err)
+
+	err = ckeeper.RevokeCertificate(ctx, types.CertID{
+		Owner:  owner,
+		Serial: cert.Serial,
+	})
+	require.NoError(t, err)
+
+	var events []sdkutil.ModuleEvent
+	for _, ev := range ctx.EventManager().Events() {
+		sev, err := sdkutil.ParseEvent(sdk.StringifyEvent(abci.Event(ev)))
+		require.NoError(t, err)
+
+		mev, err := cv1beta4.ParseEvent(sev)
+		if err == nil {
+			events = append(events, mev)
+		}
+	}
+
+	require.Equal(t, []sdkutil.ModuleEvent{
+		cv1beta4.NewEventCertificateCreated(owner, cert.Serial.String()),
+		cv1beta4.NewEventCertificateRevoked(owner, cert.Serial.String()),
+	}, events)
+}
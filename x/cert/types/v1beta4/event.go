@@ -0,0 +1,125 @@
+package v1beta4
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v1beta3 "github.com/akash-network/akash-api/go/node/cert/v1beta3"
+	"github.com/akash-network/akash-api/go/sdkutil"
+)
+
+const (
+	EvActionCertificateCreated = "certificate-created"
+	EvActionCertificateRevoked = "certificate-revoked"
+
+	EvOwnerKey  = "owner"
+	EvSerialKey = "serial"
+)
+
+// EventCertificateCreated struct
+type EventCertificateCreated struct {
+	Context sdkutil.BaseModuleEvent `json:"context"`
+	Owner   sdk.AccAddress          `json:"owner"`
+	Serial  string                  `json:"serial"`
+}
+
+func NewEventCertificateCreated(owner sdk.AccAddress, serial string) EventCertificateCreated {
+	return EventCertificateCreated{
+		Context: sdkutil.BaseModuleEvent{
+			Module: ModuleName,
+			Action: EvActionCertificateCreated,
+		},
+		Owner:  owner,
+		Serial: serial,
+	}
+}
+
+// ToSDKEvent method creates new sdk event for EventCertificateCreated struct
+func (ev EventCertificateCreated) ToSDKEvent() sdk.Event {
+	return sdk.NewEvent(sdkutil.EventTypeMessage,
+		append([]sdk.Attribute{
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, EvActionCertificateCreated),
+		}, CertificateEVAttributes(ev.Owner, ev.Serial)...)...,
+	)
+}
+
+// EventCertificateRevoked struct
+type EventCertificateRevoked struct {
+	Context sdkutil.BaseModuleEvent `json:"context"`
+	Owner   sdk.AccAddress          `json:"owner"`
+	Serial  string                  `json:"serial"`
+}
+
+func NewEventCertificateRevoked(owner sdk.AccAddress, serial string) EventCertificateRevoked {
+	return EventCertificateRevoked{
+		Context: sdkutil.BaseModuleEvent{
+			Module: ModuleName,
+			Action: EvActionCertificateRevoked,
+		},
+		Owner:  owner,
+		Serial: serial,
+	}
+}
+
+// ToSDKEvent method creates new sdk event for EventCertificateRevoked struct
+func (ev EventCertificateRevoked) ToSDKEvent() sdk.Event {
+	return sdk.NewEvent(sdkutil.EventTypeMessage,
+		append([]sdk.Attribute{
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, EvActionCertificateRevoked),
+		}, CertificateEVAttributes(ev.Owner, ev.Serial)...)...,
+	)
+}
+
+// CertificateEVAttributes returns event attributes for given certificate
+func CertificateEVAttributes(owner sdk.AccAddress, serial string) []sdk.Attribute {
+	return []sdk.Attribute{
+		sdk.NewAttribute(EvOwnerKey, owner.String()),
+		sdk.NewAttribute(EvSerialKey, serial),
+	}
+}
+
+// ParseEVCertificate returns certificate owner and serial for given event attributes
+func ParseEVCertificate(attrs []sdk.Attribute) (sdk.AccAddress, string, error) {
+	owner, err := sdkutil.GetAccAddress(attrs, EvOwnerKey)
+	if err != nil {
+		return sdk.AccAddress{}, "", err
+	}
+
+	serial, err := sdkutil.GetString(attrs, EvSerialKey)
+	if err != nil {
+		return sdk.AccAddress{}, "", err
+	}
+
+	if _, valid := new(big.Int).SetString(serial, 10); !valid {
+		return sdk.AccAddress{}, "", v1beta3.ErrInvalidSerialNumber
+	}
+
+	return owner, serial, nil
+}
+
+// ParseEvent parses event and returns details of event and error if occurred
+func ParseEvent(ev sdkutil.Event) (sdkutil.ModuleEvent, error) {
+	if ev.Type != sdkutil.EventTypeMessage {
+		return nil, sdkutil.ErrUnknownType
+	}
+	if ev.Module != ModuleName {
+		return nil, sdkutil.ErrUnknownModule
+	}
+
+	owner, serial, err := ParseEVCertificate(ev.Attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	switch ev.Action {
+	case EvActionCertificateCreated:
+		return NewEventCertificateCreated(owner, serial), nil
+	case EvActionCertificateRevoked:
+		return NewEventCertificateRevoked(owner, serial), nil
+	default:
+		return nil, sdkutil.ErrUnknownAction
+	}
+}
@@ -108,6 +108,9 @@ func (s *IntegrationTestSuite) TestDeployment() {
 	deploymentPath2, err := filepath.Abs("../../testdata/deployment-v2.yaml")
 	s.Require().NoError(err)
 
+	deploymentPath3, err := filepath.Abs("../../testdata/deployment-image-update.yaml")
+	s.Require().NoError(err)
+
 	// create deployment
 	_, err = cli.TxCreateDeploymentExec(
 		val.ClientCtx,
@@ -157,7 +160,7 @@ func (s *IntegrationTestSuite) TestDeployment() {
 	s.Require().NoError(err)
 	s.Require().Len(out.Deployments, 1)
 
-	// test updating deployment
+	// test updating deployment with changed group spec
 	_, err = cli.TxUpdateDeploymentExec(
 		val.ClientCtx,
 		val.Address,
@@ -168,6 +171,19 @@ func (s *IntegrationTestSuite) TestDeployment() {
 		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
 		fmt.Sprintf("--gas=%d", flags.DefaultGasLimit),
 	)
+	s.Require().ErrorContains(err, "groups are different than existing deployment")
+
+	// test updating deployment
+	_, err = cli.TxUpdateDeploymentExec(
+		val.ClientCtx,
+		val.Address,
+		deploymentPath3,
+		fmt.Sprintf("--dseq=%v", createdDep.Deployment.DeploymentID.DSeq),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+		fmt.Sprintf("--gas=%d", flags.DefaultGasLimit),
+	)
 	s.Require().NoError(err)
 
 	s.Require().NoError(s.network.WaitForNextBlock())
@@ -2,6 +2,8 @@ package cli
 
 import (
 	"errors"
+	"fmt"
+	"os"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -9,15 +11,21 @@ import (
 	"github.com/spf13/pflag"
 
 	types "github.com/akash-network/akash-api/go/node/deployment/v1beta3"
+
+	"github.com/akash-network/node/sdl"
 )
 
 const (
 	FlagDepositorAccount = "depositor-account"
 	FlagExpiration       = "expiration"
+	FlagVar              = "var"
+	FlagPreviousSDL      = "previous-sdl"
+	FlagForce            = "force"
 )
 
 var (
 	ErrStateValue     = errors.New("query: invalid state value")
+	ErrVarValue       = errors.New("invalid variable, expected NAME=VALUE")
 	DefaultDeposit, _ = types.DefaultParams().MinDepositFor("uakt")
 )
 
@@ -210,3 +218,31 @@ func DepositorFromFlags(flags *pflag.FlagSet, owner string) (string, error) {
 	_, err = sdk.AccAddressFromBech32(depositorAcc)
 	return depositorAcc, err
 }
+
+// AddSDLVarFlags add flag setting values of variables declared by SDL
+func AddSDLVarFlags(flags *pflag.FlagSet) {
+	flags.StringArray(FlagVar, nil, "Set SDL variable, NAME=VALUE. Takes precedence over environment and variable defaults")
+}
+
+// SDLReadOptionsFromFlags returns options to read SDL with variables set by flags and environment
+func SDLReadOptionsFromFlags(flags *pflag.FlagSet) ([]sdl.Option, error) {
+	vals, err := flags.GetStringArray(FlagVar)
+	if err != nil {
+		return nil, err
+	}
+
+	vars := make(map[string]string, len(vals))
+	for _, val := range vals {
+		name, value, valid := strings.Cut(val, "=")
+		if !valid || strings.TrimSpace(name) == "" {
+			return nil, fmt.Errorf("%w: %q", ErrVarValue, val)
+		}
+
+		vars[strings.TrimSpace(name)] = value
+	}
+
+	return []sdl.Option{
+		sdl.WithVariables(vars),
+		sdl.WithEnvironment(os.LookupEnv),
+	}, nil
+}
@@ -1,15 +1,16 @@
 package cli
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
-	"reflect"
 	"strings"
 	"time"
 
 	cltypes "github.com/akash-network/akash-api/go/node/client/types"
 	"github.com/spf13/cobra"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
 	tmrpc "github.com/tendermint/tendermint/rpc/core/types"
 
 	sdkclient "github.com/cosmos/cosmos-sdk/client"
@@ -24,11 +25,13 @@ import (
 	"github.com/akash-network/node/cmd/common"
 	"github.com/akash-network/node/sdl"
 	cutils "github.com/akash-network/node/x/cert/utils"
+	dv1beta4 "github.com/akash-network/node/x/deployment/types/v1beta4"
 )
 
 var (
 	errDeploymentUpdate              = errors.New("deployment update failed")
-	errDeploymentUpdateGroupsChanged = fmt.Errorf("%w: groups are different than existing deployment, you cannot update groups", errDeploymentUpdate)
+	errDeploymentUpdateGroupsChanged = fmt.Errorf("%w: groups are different than existing deployment, use --%s to update them", errDeploymentUpdate, FlagForce)
+	errDeploymentInvalid             = errors.New("deployment is invalid")
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -42,9 +45,12 @@ func GetTxCmd(key string) *cobra.Command {
 	cmd.AddCommand(
 		cmdCreate(key),
 		cmdUpdate(key),
+		cmdValidate(key),
 		cmdDeposit(key),
 		cmdClose(key),
 		cmdGroup(key),
+		cmdAutoRefill(key),
+		cmdTransfer(key),
 		cmdAuthz(),
 	)
 	return cmd
@@ -83,7 +89,12 @@ func cmdCreate(key string) *cobra.Command {
 				return err
 			}
 
-			sdlManifest, err := sdl.ReadFile(args[0])
+			sdlOpts, err := SDLReadOptionsFromFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			sdlManifest, err := sdl.ReadFile(args[0], sdlOpts...)
 			if err != nil {
 				return err
 			}
@@ -145,7 +156,29 @@ func cmdCreate(key string) *cobra.Command {
 				return err
 			}
 
-			resp, err := cl.Tx().Broadcast(ctx, []sdk.Msg{msg})
+			msgs := []sdk.Msg{msg}
+
+			auctions, err := sdlManifest.DeploymentAuctions()
+			if err != nil {
+				return err
+			}
+
+			// groups which placement opts into reverse auction are switched to it within the same transaction
+			for idx, group := range groups {
+				window, ok := auctions[group.Name]
+				if !ok {
+					continue
+				}
+
+				amsg := dv1beta4.NewMsgSetGroupAuction(types.MakeGroupID(id, uint32(idx+1)), window)
+				if err := amsg.ValidateBasic(); err != nil {
+					return err
+				}
+
+				msgs = append(msgs, amsg)
+			}
+
+			resp, err := cl.Tx().Broadcast(ctx, msgs)
 			if err != nil {
 				return err
 			}
@@ -157,6 +190,7 @@ func cmdCreate(key string) *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	AddDeploymentIDFlags(cmd.Flags())
 	AddDepositorFlag(cmd.Flags())
+	AddSDLVarFlags(cmd.Flags())
 	common.AddDepositFlags(cmd.Flags())
 
 	return cmd
@@ -294,7 +328,12 @@ func cmdUpdate(key string) *cobra.Command {
 				return err
 			}
 
-			sdlManifest, err := sdl.ReadFile(args[0])
+			sdlOpts, err := SDLReadOptionsFromFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			sdlManifest, err := sdl.ReadFile(args[0], sdlOpts...)
 			if err != nil {
 				return err
 			}
@@ -317,25 +356,74 @@ func cmdUpdate(key string) *cobra.Command {
 				return err
 			}
 
-			// do not send the transaction if the groups have changed
-			existingGroups := existingDeployment.GetGroups()
-			if len(existingGroups) != len(groups) {
-				return errDeploymentUpdateGroupsChanged
+			// closed groups are not updated
+			existingGroups := make(types.GroupSpecs, 0, len(existingDeployment.GetGroups()))
+			for i := range existingDeployment.Groups {
+				if existingDeployment.Groups[i].ValidateClosable() != nil {
+					continue
+				}
+				existingGroups = append(existingGroups, &existingDeployment.Groups[i].GroupSpec)
 			}
 
-			for i, existingGroup := range existingGroups {
-				if reflect.DeepEqual(groups[i], existingGroup.GroupSpec) {
-					return errDeploymentUpdateGroupsChanged
+			diff := sdl.CompareGroups(existingGroups, groups)
+
+			// manifest of the deployment is not kept on chain, it can only be compared with the previous SDL
+			prevFile, err := cmd.Flags().GetString(FlagPreviousSDL)
+			if err != nil {
+				return err
+			}
+
+			if prevFile != "" {
+				prevManifest, err := sdl.ReadFile(prevFile, sdlOpts...)
+				if err != nil {
+					return err
+				}
+
+				mdiff, err := sdl.Compare(prevManifest, sdlManifest)
+				if err != nil {
+					return err
+				}
+
+				// groups are compared against the chain, which is authoritative
+				for _, change := range mdiff {
+					if change.Scope == sdl.ScopeManifest {
+						diff = append(diff, change)
+					}
 				}
 			}
 
-			warnIfGroupVolumesExceeds(cctx, groups)
+			if !diff.Empty() {
+				_ = cctx.PrintString(diff.String() + "\n")
+			}
 
-			msg := &types.MsgUpdateDeployment{
+			var msg sdk.Msg = &types.MsgUpdateDeployment{
 				ID:      id,
 				Version: version,
 			}
 
+			// group changes close leases of changed and removed groups, they must be confirmed
+			if diff.ChangesGroups() {
+				force, err := cmd.Flags().GetBool(FlagForce)
+				if err != nil {
+					return err
+				}
+
+				if !force {
+					return errDeploymentUpdateGroupsChanged
+				}
+
+				_ = cctx.PrintString("warning: leases of changed and removed groups are closed, their orders are created again\n")
+
+				specs := make([]types.GroupSpec, 0, len(groups))
+				for _, group := range groups {
+					specs = append(specs, *group)
+				}
+
+				msg = dv1beta4.NewMsgUpdateDeploymentGroups(id, specs, version)
+			}
+
+			warnIfGroupVolumesExceeds(cctx, groups)
+
 			resp, err := cl.Tx().Broadcast(ctx, []sdk.Msg{msg})
 			if err != nil {
 				return err
@@ -347,6 +435,93 @@ func cmdUpdate(key string) *cobra.Command {
 
 	flags.AddTxFlagsToCmd(cmd)
 	AddDeploymentIDFlags(cmd.Flags())
+	AddSDLVarFlags(cmd.Flags())
+	cmd.Flags().String(FlagPreviousSDL, "", "SDL the deployment has been created or last updated with, to show changes of services")
+	cmd.Flags().Bool(FlagForce, false, "Update groups as well when they differ from the deployment, closing leases of changed and removed groups")
+
+	return cmd
+}
+
+// sdlValidationResult is output of validate command in json format
+type sdlValidationResult struct {
+	File   string     `json:"file"`
+	Valid  bool       `json:"valid"`
+	Errors sdl.Errors `json:"errors"`
+}
+
+func cmdValidate(key string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate [sdl-file]",
+		Short: fmt.Sprintf("validate %s SDL offline and report all problems found", key),
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			output, err := cmd.Flags().GetString(tmcli.OutputFlag)
+			if err != nil {
+				return err
+			}
+
+			if output != "text" && output != "json" {
+				return fmt.Errorf("invalid output format %q, expected text|json", output)
+			}
+
+			sdlOpts, err := SDLReadOptionsFromFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			_, err = sdl.ReadFile(args[0], sdlOpts...)
+			errs := sdl.AsErrors(err)
+
+			for _, e := range errs {
+				if e.File == "" {
+					e.File = args[0]
+				}
+			}
+
+			out := cmd.OutOrStdout()
+
+			if output == "json" {
+				res := sdlValidationResult{
+					File:   args[0],
+					Valid:  len(errs) == 0,
+					Errors: errs,
+				}
+
+				if res.Errors == nil {
+					res.Errors = sdl.Errors{}
+				}
+
+				data, err := json.MarshalIndent(res, "", "  ")
+				if err != nil {
+					return err
+				}
+
+				_, _ = fmt.Fprintln(out, string(data))
+			} else {
+				for _, e := range errs {
+					if e.Path != "" {
+						_, _ = fmt.Fprintf(out, "%s [%s] (%s)\n", e.Error(), e.Code, e.Path)
+					} else {
+						_, _ = fmt.Fprintf(out, "%s [%s]\n", e.Error(), e.Code)
+					}
+				}
+
+				if len(errs) == 0 {
+					_, _ = fmt.Fprintf(out, "%s: valid\n", args[0])
+				}
+			}
+
+			if len(errs) > 0 {
+				cmd.SilenceUsage = true
+				return fmt.Errorf("%w: %d problem(s) found", errDeploymentInvalid, len(errs))
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringP(tmcli.OutputFlag, "o", "text", "Output format (text|json)")
+	AddSDLVarFlags(cmd.Flags())
 
 	return cmd
 }
@@ -525,6 +700,282 @@ func cmdGroupStart(_ string) *cobra.Command {
 	return cmd
 }
 
+func cmdAutoRefill(key string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auto-refill",
+		Short: fmt.Sprintf("Manage auto refill policy of %s escrow account", key),
+	}
+
+	cmd.AddCommand(
+		cmdAutoRefillSet(key),
+		cmdAutoRefillDelete(key),
+	)
+
+	return cmd
+}
+
+func cmdAutoRefillSet(key string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set <threshold> <amount>",
+		Short: fmt.Sprintf("Top up %s escrow account with amount once its balance drops below threshold", key),
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			cctx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			opts, err := cltypes.ClientOptionsFromFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			cl, err := aclient.DiscoverClient(ctx, cctx, opts...)
+			if err != nil {
+				return err
+			}
+
+			id, err := DeploymentIDFromFlags(cmd.Flags(), WithOwner(cctx.FromAddress))
+			if err != nil {
+				return err
+			}
+
+			threshold, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			funder, err := DepositorFromFlags(cmd.Flags(), id.Owner)
+			if err != nil {
+				return err
+			}
+
+			msg := dv1beta4.NewMsgSetAutoRefill(id, threshold, amount, funder)
+
+			resp, err := cl.Tx().Broadcast(ctx, []sdk.Msg{msg})
+			if err != nil {
+				return err
+			}
+
+			return cl.PrintMessage(resp)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	AddDeploymentIDFlags(cmd.Flags())
+	AddDepositorFlag(cmd.Flags())
+
+	return cmd
+}
+
+func cmdAutoRefillDelete(key string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: fmt.Sprintf("Remove auto refill policy of %s escrow account", key),
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			cctx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			opts, err := cltypes.ClientOptionsFromFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			cl, err := aclient.DiscoverClient(ctx, cctx, opts...)
+			if err != nil {
+				return err
+			}
+
+			id, err := DeploymentIDFromFlags(cmd.Flags(), WithOwner(cctx.FromAddress))
+			if err != nil {
+				return err
+			}
+
+			msg := dv1beta4.NewMsgDeleteAutoRefill(id)
+
+			resp, err := cl.Tx().Broadcast(ctx, []sdk.Msg{msg})
+			if err != nil {
+				return err
+			}
+
+			return cl.PrintMessage(resp)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	AddDeploymentIDFlags(cmd.Flags())
+
+	return cmd
+}
+
+func cmdTransfer(key string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer",
+		Short: fmt.Sprintf("Transfer ownership of %s to another account", key),
+	}
+
+	cmd.AddCommand(
+		cmdTransferPropose(key),
+		cmdTransferCancel(key),
+		cmdTransferAccept(key),
+	)
+
+	return cmd
+}
+
+func cmdTransferPropose(key string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose <new-owner>",
+		Short: fmt.Sprintf("Propose transfer of %s to new owner, which takes place once new owner accepts it", key),
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			cctx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			opts, err := cltypes.ClientOptionsFromFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			cl, err := aclient.DiscoverClient(ctx, cctx, opts...)
+			if err != nil {
+				return err
+			}
+
+			id, err := DeploymentIDFromFlagsForOwner(cmd.Flags(), cctx.FromAddress)
+			if err != nil {
+				return err
+			}
+
+			newOwner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := dv1beta4.NewMsgProposeDeploymentTransfer(id, newOwner)
+
+			resp, err := cl.Tx().Broadcast(ctx, []sdk.Msg{msg})
+			if err != nil {
+				return err
+			}
+
+			return cl.PrintMessage(resp)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	AddDeploymentIDFlags(cmd.Flags(), DeploymentIDOptionNoOwner(true))
+
+	return cmd
+}
+
+func cmdTransferCancel(key string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel",
+		Short: fmt.Sprintf("Cancel pending transfer of %s", key),
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			cctx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			opts, err := cltypes.ClientOptionsFromFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			cl, err := aclient.DiscoverClient(ctx, cctx, opts...)
+			if err != nil {
+				return err
+			}
+
+			id, err := DeploymentIDFromFlagsForOwner(cmd.Flags(), cctx.FromAddress)
+			if err != nil {
+				return err
+			}
+
+			msg := dv1beta4.NewMsgCancelDeploymentTransfer(id)
+
+			resp, err := cl.Tx().Broadcast(ctx, []sdk.Msg{msg})
+			if err != nil {
+				return err
+			}
+
+			return cl.PrintMessage(resp)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	AddDeploymentIDFlags(cmd.Flags(), DeploymentIDOptionNoOwner(true))
+
+	return cmd
+}
+
+func cmdTransferAccept(key string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept",
+		Short: fmt.Sprintf("Accept transfer of %s proposed by its current owner", key),
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			cctx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			opts, err := cltypes.ClientOptionsFromFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			cl, err := aclient.DiscoverClient(ctx, cctx, opts...)
+			if err != nil {
+				return err
+			}
+
+			id, err := DeploymentIDFromFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := dv1beta4.NewMsgAcceptDeploymentTransfer(id, cctx.FromAddress)
+
+			resp, err := cl.Tx().Broadcast(ctx, []sdk.Msg{msg})
+			if err != nil {
+				return err
+			}
+
+			return cl.PrintMessage(resp)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	AddDeploymentIDFlags(cmd.Flags())
+	MarkReqDeploymentIDFlags(cmd)
+
+	return cmd
+}
+
 func cmdAuthz() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "authz",
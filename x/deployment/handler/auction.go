@@ -0,0 +1,30 @@
+package handler
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	types "github.com/akash-network/akash-api/go/node/deployment/v1beta3"
+
+	dv1beta4 "github.com/akash-network/node/x/deployment/types/v1beta4"
+)
+
+func (ms nodeMsgServer) SetGroupAuction(goCtx context.Context, msg *dv1beta4.MsgSetGroupAuction) (*dv1beta4.MsgSetGroupAuctionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	group, found := ms.deployment.GetGroup(ctx, msg.ID)
+	if !found {
+		return nil, types.ErrGroupNotFound
+	}
+
+	if err := group.ValidateClosable(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.market.SetGroupAuction(ctx, msg.ID, msg.Window); err != nil {
+		return nil, err
+	}
+
+	return &dv1beta4.MsgSetGroupAuctionResponse{}, nil
+}
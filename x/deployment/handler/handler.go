@@ -7,11 +7,13 @@ import (
 	types "github.com/akash-network/akash-api/go/node/deployment/v1beta3"
 
 	"github.com/akash-network/node/x/deployment/keeper"
+	dv1beta4 "github.com/akash-network/node/x/deployment/types/v1beta4"
 )
 
 // NewHandler returns a handler for "deployment" type messages
 func NewHandler(keeper keeper.IKeeper, mkeeper MarketKeeper, ekeeper EscrowKeeper, authzKeeper AuthzKeeper) sdk.Handler {
 	ms := NewServer(keeper, mkeeper, ekeeper, authzKeeper)
+	ns := NewNodeServer(keeper, mkeeper, ekeeper, authzKeeper)
 
 	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
 		switch msg := msg.(type) {
@@ -43,6 +45,34 @@ func NewHandler(keeper keeper.IKeeper, mkeeper MarketKeeper, ekeeper EscrowKeepe
 			res, err := ms.StartGroup(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *dv1beta4.MsgSetAutoRefill:
+			res, err := ns.SetAutoRefill(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *dv1beta4.MsgDeleteAutoRefill:
+			res, err := ns.DeleteAutoRefill(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *dv1beta4.MsgSetGroupAuction:
+			res, err := ns.SetGroupAuction(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *dv1beta4.MsgUpdateDeploymentGroups:
+			res, err := ns.UpdateDeploymentGroups(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *dv1beta4.MsgProposeDeploymentTransfer:
+			res, err := ns.ProposeDeploymentTransfer(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *dv1beta4.MsgCancelDeploymentTransfer:
+			res, err := ns.CancelDeploymentTransfer(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *dv1beta4.MsgAcceptDeploymentTransfer:
+			res, err := ns.AcceptDeploymentTransfer(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			return nil, sdkerrors.ErrUnknownRequest
 		}
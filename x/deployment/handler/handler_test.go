@@ -3,6 +3,7 @@ package handler_test
 import (
 	"crypto/sha256"
 	"errors"
+	"math"
 	"testing"
 	"time"
 
@@ -18,12 +19,16 @@ import (
 	"github.com/stretchr/testify/require"
 
 	types "github.com/akash-network/akash-api/go/node/deployment/v1beta3"
+	mtypes "github.com/akash-network/akash-api/go/node/market/v1beta4"
 
 	"github.com/akash-network/node/testutil"
 	cmocks "github.com/akash-network/node/testutil/cosmos/mocks"
 	"github.com/akash-network/node/testutil/state"
 	"github.com/akash-network/node/x/deployment/keeper"
+	dv1beta4 "github.com/akash-network/node/x/deployment/types/v1beta4"
+	ev1beta4 "github.com/akash-network/node/x/escrow/types/v1beta4"
 	mkeeper "github.com/akash-network/node/x/market/keeper"
+	mv1beta5 "github.com/akash-network/node/x/market/types/v1beta5"
 )
 
 type testSuite struct {
@@ -441,6 +446,109 @@ func TestFundedDeployment(t *testing.T) {
 	require.Equal(t, sdk.NewDecCoin(msg.Deposit.Denom, sdk.ZeroInt()), acc.Funds)
 }
 
+func TestDeploymentAutoRefill(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	deployment, groups := suite.createDeployment()
+
+	policy := dv1beta4.NewMsgSetAutoRefill(deployment.ID(),
+		sdk.NewInt64Coin("uakt", 100), sdk.NewInt64Coin("uakt", 500), deployment.ID().Owner)
+
+	// deployment must exist
+	_, err := suite.handler(suite.ctx, policy)
+	require.ErrorIs(t, err, types.ErrDeploymentNotFound)
+
+	msg := &types.MsgCreateDeployment{
+		ID:        deployment.ID(),
+		Groups:    []types.GroupSpec{groups[0].GroupSpec},
+		Deposit:   suite.defaultDeposit,
+		Depositor: deployment.ID().Owner,
+	}
+
+	_, err = suite.handler(suite.ctx, msg)
+	require.NoError(t, err)
+
+	res, err := suite.handler(suite.ctx, policy)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	eID := types.EscrowAccountForDeployment(deployment.ID())
+
+	stored, err := suite.EscrowKeeper().GetAccountAutoRefill(suite.ctx, eID)
+	require.NoError(t, err)
+	require.Equal(t, policy.Policy(), stored)
+
+	res, err = suite.handler(suite.ctx, dv1beta4.NewMsgDeleteAutoRefill(deployment.ID()))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	_, err = suite.EscrowKeeper().GetAccountAutoRefill(suite.ctx, eID)
+	require.ErrorIs(t, err, ev1beta4.ErrAutoRefillNotFound)
+
+	_, err = suite.handler(suite.ctx, dv1beta4.NewMsgDeleteAutoRefill(deployment.ID()))
+	require.ErrorIs(t, err, ev1beta4.ErrAutoRefillNotFound)
+}
+
+func TestSetGroupAuction(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	deployment, groups := suite.createDeployment()
+	gid := types.MakeGroupID(deployment.ID(), 1)
+
+	// group must exist
+	_, err := suite.handler(suite.ctx, dv1beta4.NewMsgSetGroupAuction(gid, 10))
+	require.ErrorIs(t, err, types.ErrGroupNotFound)
+
+	msg := &types.MsgCreateDeployment{
+		ID:        deployment.ID(),
+		Groups:    []types.GroupSpec{groups[0].GroupSpec},
+		Deposit:   suite.defaultDeposit,
+		Depositor: deployment.ID().Owner,
+	}
+
+	_, err = suite.handler(suite.ctx, msg)
+	require.NoError(t, err)
+
+	res, err := suite.handler(suite.ctx, dv1beta4.NewMsgSetGroupAuction(gid, 10))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	window, found := suite.mkeeper.GetGroupAuction(suite.ctx, gid)
+	require.True(t, found)
+	require.Equal(t, int64(10), window)
+
+	// pausing the group keeps it in auction mode, order created on start is auctioned
+	_, err = suite.handler(suite.ctx, &types.MsgPauseGroup{ID: gid})
+	require.NoError(t, err)
+
+	_, err = suite.handler(suite.ctx, &types.MsgStartGroup{ID: gid})
+	require.NoError(t, err)
+
+	_, found = suite.mkeeper.GetGroupAuction(suite.ctx, gid)
+	require.True(t, found)
+	require.Contains(t, suite.orderAuctions(), mtypes.MakeOrderID(gid, 2))
+
+	_, err = suite.handler(suite.ctx, &types.MsgCloseGroup{ID: gid})
+	require.NoError(t, err)
+
+	_, found = suite.mkeeper.GetGroupAuction(suite.ctx, gid)
+	require.False(t, found)
+
+	_, err = suite.handler(suite.ctx, dv1beta4.NewMsgSetGroupAuction(gid, 10))
+	require.ErrorIs(t, err, types.ErrGroupClosed)
+}
+
+// orderAuctions returns ids of orders in reverse auction mode
+func (st *testSuite) orderAuctions() []mtypes.OrderID {
+	var res []mtypes.OrderID
+	st.mkeeper.WithOrderAuctionsClosing(st.ctx, math.MaxInt64, func(auction mv1beta5.OrderAuction) bool {
+		res = append(res, auction.OrderID)
+		return false
+	})
+
+	return res
+}
+
 func (st *testSuite) createDeployment() (types.Deployment, []types.Group) {
 	st.t.Helper()
 
@@ -463,3 +571,268 @@ func (st *testSuite) createDeployment() (types.Deployment, []types.Group) {
 
 	return deployment, groups
 }
+
+func TestUpdateDeploymentGroups(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	deployment, groups := suite.createDeployment()
+
+	msg := &types.MsgCreateDeployment{
+		ID:        deployment.ID(),
+		Groups:    []types.GroupSpec{groups[0].GroupSpec},
+		Version:   testutil.DefaultDeploymentVersion[:],
+		Deposit:   suite.defaultDeposit,
+		Depositor: deployment.ID().Owner,
+	}
+
+	_, err := suite.handler(suite.ctx, msg)
+	require.NoError(t, err)
+
+	gid := types.MakeGroupID(deployment.ID(), 1)
+
+	// resize existing group and add a new one
+	resized := groups[0].GroupSpec
+	resized.Resources = append(types.ResourceUnits{}, resized.Resources...)
+	resized.Resources[0].Count = 4
+
+	added := testutil.GroupSpec(t)
+	added.Resources = types.ResourceUnits{
+		{
+			Resources: testutil.ResourceUnits(t),
+			Count:     1,
+			Price:     sdk.NewDecCoin(resized.Price().Denom, sdk.NewInt(1)),
+		},
+	}
+
+	version := sha256.Sum256(testutil.DefaultDeploymentVersion[:])
+
+	_, err = suite.handler(suite.ctx, dv1beta4.NewMsgUpdateDeploymentGroups(deployment.ID(), []types.GroupSpec{resized, added}, version[:]))
+	require.NoError(t, err)
+
+	group, found := suite.dkeeper.GetGroup(suite.ctx, gid)
+	require.True(t, found)
+	require.Equal(t, types.GroupOpen, group.State)
+	require.Equal(t, uint32(4), group.GroupSpec.Resources[0].Count)
+
+	order, found := suite.mkeeper.GetOrder(suite.ctx, mtypes.MakeOrderID(gid, 1))
+	require.True(t, found)
+	require.Equal(t, mtypes.OrderClosed, order.State)
+
+	order, found = suite.mkeeper.GetOrder(suite.ctx, mtypes.MakeOrderID(gid, 2))
+	require.True(t, found)
+	require.Equal(t, mtypes.OrderOpen, order.State)
+	require.Equal(t, resized, order.Spec)
+
+	agid := types.MakeGroupID(deployment.ID(), 2)
+
+	group, found = suite.dkeeper.GetGroup(suite.ctx, agid)
+	require.True(t, found)
+	require.Equal(t, types.GroupOpen, group.State)
+
+	order, found = suite.mkeeper.GetOrder(suite.ctx, mtypes.MakeOrderID(agid, 1))
+	require.True(t, found)
+	require.Equal(t, mtypes.OrderOpen, order.State)
+
+	// remove the first group
+	version = sha256.Sum256(version[:])
+
+	_, err = suite.handler(suite.ctx, dv1beta4.NewMsgUpdateDeploymentGroups(deployment.ID(), []types.GroupSpec{added}, version[:]))
+	require.NoError(t, err)
+
+	group, found = suite.dkeeper.GetGroup(suite.ctx, gid)
+	require.True(t, found)
+	require.Equal(t, types.GroupClosed, group.State)
+
+	order, found = suite.mkeeper.GetOrder(suite.ctx, mtypes.MakeOrderID(gid, 2))
+	require.True(t, found)
+	require.Equal(t, mtypes.OrderClosed, order.State)
+
+	order, found = suite.mkeeper.GetOrder(suite.ctx, mtypes.MakeOrderID(agid, 1))
+	require.True(t, found)
+	require.Equal(t, mtypes.OrderOpen, order.State)
+
+	d, found := suite.dkeeper.GetDeployment(suite.ctx, deployment.ID())
+	require.True(t, found)
+	require.Equal(t, version[:], d.Version)
+
+	// groups must be priced in escrow account denomination
+	other := added
+	other.Resources = types.ResourceUnits{
+		{
+			Resources: testutil.ResourceUnits(t),
+			Count:     1,
+			Price:     sdk.NewDecCoin("othertoken", sdk.NewInt(1)),
+		},
+	}
+
+	version = sha256.Sum256(version[:])
+
+	_, err = suite.handler(suite.ctx, dv1beta4.NewMsgUpdateDeploymentGroups(deployment.ID(), []types.GroupSpec{other}, version[:]))
+	require.ErrorIs(t, err, types.ErrInvalidGroups)
+
+	// without open groups specs are checked against denominations deployments can be funded with
+	_, err = suite.handler(suite.ctx, &types.MsgCloseGroup{ID: agid})
+	require.NoError(t, err)
+
+	_, err = suite.handler(suite.ctx, dv1beta4.NewMsgUpdateDeploymentGroups(deployment.ID(), []types.GroupSpec{other}, version[:]))
+	require.ErrorIs(t, err, types.ErrInvalidGroups)
+}
+
+func TestUpdateDeploymentGroupsAuction(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	deployment, groups := suite.createDeployment()
+
+	msg := &types.MsgCreateDeployment{
+		ID:        deployment.ID(),
+		Groups:    []types.GroupSpec{groups[0].GroupSpec},
+		Version:   testutil.DefaultDeploymentVersion[:],
+		Deposit:   suite.defaultDeposit,
+		Depositor: deployment.ID().Owner,
+	}
+
+	_, err := suite.handler(suite.ctx, msg)
+	require.NoError(t, err)
+
+	gid := types.MakeGroupID(deployment.ID(), 1)
+
+	_, err = suite.handler(suite.ctx, dv1beta4.NewMsgSetGroupAuction(gid, 10))
+	require.NoError(t, err)
+
+	resized := groups[0].GroupSpec
+	resized.Resources = append(types.ResourceUnits{}, resized.Resources...)
+	resized.Resources[0].Count = 4
+
+	version := sha256.Sum256(testutil.DefaultDeploymentVersion[:])
+
+	_, err = suite.handler(suite.ctx, dv1beta4.NewMsgUpdateDeploymentGroups(deployment.ID(), []types.GroupSpec{resized}, version[:]))
+	require.NoError(t, err)
+
+	order, found := suite.mkeeper.GetOrder(suite.ctx, mtypes.MakeOrderID(gid, 2))
+	require.True(t, found)
+	require.Equal(t, mtypes.OrderOpen, order.State)
+
+	window, found := suite.mkeeper.GetGroupAuction(suite.ctx, gid)
+	require.True(t, found)
+	require.Equal(t, int64(10), window)
+	require.Contains(t, suite.orderAuctions(), order.ID())
+}
+
+func TestTransferDeployment(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	deployment, groups := suite.createDeployment()
+
+	msg := &types.MsgCreateDeployment{
+		ID:        deployment.ID(),
+		Groups:    []types.GroupSpec{groups[0].GroupSpec},
+		Version:   testutil.DefaultDeploymentVersion[:],
+		Deposit:   suite.defaultDeposit,
+		Depositor: deployment.ID().Owner,
+	}
+
+	_, err := suite.handler(suite.ctx, msg)
+	require.NoError(t, err)
+
+	oid := mtypes.MakeOrderID(types.MakeGroupID(deployment.ID(), 1), 1)
+	order, found := suite.mkeeper.GetOrder(suite.ctx, oid)
+	require.True(t, found)
+
+	provider := testutil.AccAddress(t)
+	price := sdk.NewDecCoin(suite.defaultDeposit.Denom, sdk.NewInt(1))
+
+	bid, err := suite.mkeeper.CreateBid(suite.ctx, oid, provider, price, nil)
+	require.NoError(t, err)
+
+	suite.mkeeper.CreateLease(suite.ctx, bid)
+	suite.mkeeper.OnBidMatched(suite.ctx, bid)
+	suite.mkeeper.OnOrderMatched(suite.ctx, order)
+
+	lid := mtypes.MakeLeaseID(bid.ID())
+	require.NoError(t, suite.EscrowKeeper().PaymentCreate(suite.ctx,
+		types.EscrowAccountForDeployment(deployment.ID()), mtypes.EscrowPaymentForLease(lid), provider, price))
+
+	newOwner := testutil.AccAddress(t)
+
+	// transfer must be proposed first
+	_, err = suite.handler(suite.ctx, dv1beta4.NewMsgAcceptDeploymentTransfer(deployment.ID(), newOwner))
+	require.ErrorIs(t, err, dv1beta4.ErrDeploymentTransferNotFound)
+
+	// only the owner can propose and cancel it
+	err = handler.ProposeDeploymentTransfer(suite.ctx, suite.dkeeper, newOwner, deployment.ID(), newOwner)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = suite.handler(suite.ctx, dv1beta4.NewMsgProposeDeploymentTransfer(deployment.ID(), newOwner))
+	require.NoError(t, err)
+
+	err = handler.CancelDeploymentTransfer(suite.ctx, suite.dkeeper, newOwner, deployment.ID())
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = suite.handler(suite.ctx, dv1beta4.NewMsgCancelDeploymentTransfer(deployment.ID()))
+	require.NoError(t, err)
+
+	_, found = suite.dkeeper.GetDeploymentTransfer(suite.ctx, deployment.ID())
+	require.False(t, found)
+
+	_, err = suite.handler(suite.ctx, dv1beta4.NewMsgProposeDeploymentTransfer(deployment.ID(), newOwner))
+	require.NoError(t, err)
+
+	transfer, found := suite.dkeeper.GetDeploymentTransfer(suite.ctx, deployment.ID())
+	require.True(t, found)
+	require.Equal(t, newOwner.String(), transfer.NewOwner)
+
+	// only the new owner can accept it
+	_, err = suite.handler(suite.ctx, dv1beta4.NewMsgAcceptDeploymentTransfer(deployment.ID(), testutil.AccAddress(t)))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = suite.handler(suite.ctx, dv1beta4.NewMsgAcceptDeploymentTransfer(deployment.ID(), newOwner))
+	require.NoError(t, err)
+
+	did := types.DeploymentID{Owner: newOwner.String(), DSeq: deployment.ID().DSeq}
+
+	_, found = suite.dkeeper.GetDeployment(suite.ctx, deployment.ID())
+	require.False(t, found)
+
+	_, found = suite.dkeeper.GetDeploymentTransfer(suite.ctx, deployment.ID())
+	require.False(t, found)
+
+	d, found := suite.dkeeper.GetDeployment(suite.ctx, did)
+	require.True(t, found)
+	require.Equal(t, types.DeploymentActive, d.State)
+
+	group, found := suite.dkeeper.GetGroup(suite.ctx, types.MakeGroupID(did, 1))
+	require.True(t, found)
+	require.Equal(t, types.GroupOpen, group.State)
+
+	_, found = suite.mkeeper.GetOrder(suite.ctx, oid)
+	require.False(t, found)
+
+	noid := mtypes.MakeOrderID(types.MakeGroupID(did, 1), 1)
+	order, found = suite.mkeeper.GetOrder(suite.ctx, noid)
+	require.True(t, found)
+	require.Equal(t, mtypes.OrderActive, order.State)
+
+	nbid := mtypes.MakeBidID(noid, provider)
+	_, found = suite.mkeeper.GetBid(suite.ctx, nbid)
+	require.True(t, found)
+
+	nlid := mtypes.MakeLeaseID(nbid)
+	lease, found := suite.mkeeper.GetLease(suite.ctx, nlid)
+	require.True(t, found)
+	require.Equal(t, mtypes.LeaseActive, lease.State)
+
+	_, found = suite.mkeeper.GetLease(suite.ctx, lid)
+	require.False(t, found)
+
+	_, err = suite.EscrowKeeper().GetAccount(suite.ctx, types.EscrowAccountForDeployment(deployment.ID()))
+	require.Error(t, err)
+
+	account, err := suite.EscrowKeeper().GetAccount(suite.ctx, types.EscrowAccountForDeployment(did))
+	require.NoError(t, err)
+	require.Equal(t, newOwner.String(), account.Owner)
+	require.False(t, account.HasDepositor())
+
+	payment, err := suite.EscrowKeeper().GetPayment(suite.ctx, account.ID, mtypes.EscrowPaymentForLease(nlid))
+	require.NoError(t, err)
+	require.Equal(t, provider.String(), payment.Owner)
+}
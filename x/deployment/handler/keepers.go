@@ -10,19 +10,28 @@ import (
 	etypes "github.com/akash-network/akash-api/go/node/escrow/v1beta3"
 
 	mtypes "github.com/akash-network/akash-api/go/node/market/v1beta4"
+
+	ev1beta4 "github.com/akash-network/node/x/escrow/types/v1beta4"
 )
 
 // MarketKeeper Interface includes market methods
 type MarketKeeper interface {
 	CreateOrder(ctx sdk.Context, id types.GroupID, spec types.GroupSpec) (mtypes.Order, error)
 	OnGroupClosed(ctx sdk.Context, id types.GroupID)
+	OnDeploymentTransferred(ctx sdk.Context, id types.DeploymentID, owner sdk.AccAddress) error
+	SetGroupAuction(ctx sdk.Context, id types.GroupID, window int64) error
+	DeleteGroupAuction(ctx sdk.Context, id types.GroupID)
 }
 
 type EscrowKeeper interface {
 	AccountCreate(ctx sdk.Context, id etypes.AccountID, owner, depositor sdk.AccAddress, deposit sdk.Coin) error
 	AccountDeposit(ctx sdk.Context, id etypes.AccountID, depositor sdk.AccAddress, amount sdk.Coin) error
 	AccountClose(ctx sdk.Context, id etypes.AccountID) error
+	AccountTransfer(ctx sdk.Context, id, to etypes.AccountID, owner sdk.AccAddress) error
 	GetAccount(ctx sdk.Context, id etypes.AccountID) (etypes.Account, error)
+	SetAccountAutoRefill(ctx sdk.Context, id etypes.AccountID, policy ev1beta4.AutoRefill) error
+	GetAccountAutoRefill(ctx sdk.Context, id etypes.AccountID) (ev1beta4.AutoRefill, error)
+	DeleteAccountAutoRefill(ctx sdk.Context, id etypes.AccountID)
 }
 
 //go:generate mockery --name AuthzKeeper --output ./mocks
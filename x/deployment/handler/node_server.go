@@ -0,0 +1,21 @@
+package handler
+
+import (
+	"github.com/akash-network/node/x/deployment/keeper"
+	dv1beta4 "github.com/akash-network/node/x/deployment/types/v1beta4"
+)
+
+var _ dv1beta4.MsgServer = nodeMsgServer{}
+
+// nodeMsgServer serves deployment messages defined by node on top of akash-api ones
+type nodeMsgServer struct {
+	msgServer
+}
+
+// NewNodeServer returns an implementation of the node deployment MsgServer interface
+// for the provided Keeper.
+func NewNodeServer(k keeper.IKeeper, mkeeper MarketKeeper, ekeeper EscrowKeeper, authzKeeper AuthzKeeper) dv1beta4.MsgServer {
+	return &nodeMsgServer{
+		msgServer: msgServer{deployment: k, market: mkeeper, escrow: ekeeper, authzKeeper: authzKeeper},
+	}
+}
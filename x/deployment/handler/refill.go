@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	types "github.com/akash-network/akash-api/go/node/deployment/v1beta3"
+
+	dv1beta4 "github.com/akash-network/node/x/deployment/types/v1beta4"
+)
+
+func (ms nodeMsgServer) SetAutoRefill(goCtx context.Context, msg *dv1beta4.MsgSetAutoRefill) (*dv1beta4.MsgSetAutoRefillResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	deployment, found := ms.deployment.GetDeployment(ctx, msg.ID)
+	if !found {
+		return nil, types.ErrDeploymentNotFound
+	}
+
+	if deployment.State != types.DeploymentActive {
+		return nil, types.ErrDeploymentClosed
+	}
+
+	if err := ms.escrow.SetAccountAutoRefill(ctx, types.EscrowAccountForDeployment(msg.ID), msg.Policy()); err != nil {
+		return nil, err
+	}
+
+	return &dv1beta4.MsgSetAutoRefillResponse{}, nil
+}
+
+func (ms nodeMsgServer) DeleteAutoRefill(goCtx context.Context, msg *dv1beta4.MsgDeleteAutoRefill) (*dv1beta4.MsgDeleteAutoRefillResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := ms.deployment.GetDeployment(ctx, msg.ID); !found {
+		return nil, types.ErrDeploymentNotFound
+	}
+
+	eID := types.EscrowAccountForDeployment(msg.ID)
+
+	if _, err := ms.escrow.GetAccountAutoRefill(ctx, eID); err != nil {
+		return nil, err
+	}
+
+	ms.escrow.DeleteAccountAutoRefill(ctx, eID)
+
+	return &dv1beta4.MsgDeleteAutoRefillResponse{}, nil
+}
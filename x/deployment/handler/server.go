@@ -1,7 +1,6 @@
 package handler
 
 import (
-	"bytes"
 	"context"
 	"fmt"
 
@@ -113,6 +112,11 @@ func (ms msgServer) authorizeDeposit(ctx sdk.Context, owner, depositor sdk.AccAd
 		return sdkerrors.ErrUnauthorized.Wrap("authorization not found")
 	}
 
+	// spend limit covers single denomination
+	if dauthz, valid := authorization.(*types.DepositDeploymentAuthorization); valid && dauthz.SpendLimit.Denom != deposit.Denom {
+		return sdkerrors.ErrUnauthorized.Wrapf("authorization spend limit is in %s", dauthz.SpendLimit.Denom)
+	}
+
 	resp, err := authorization.Accept(ctx, msg)
 	if err != nil {
 		return err
@@ -146,6 +150,11 @@ func (ms msgServer) DepositDeployment(goCtx context.Context, msg *types.MsgDepos
 		return &types.MsgDepositDeploymentResponse{}, types.ErrDeploymentClosed
 	}
 
+	// account may hold several denominations, each of the ones deployments can be funded with
+	if _, err := ms.deployment.GetParams(ctx).MinDepositFor(msg.Amount.Denom); err != nil {
+		return &types.MsgDepositDeploymentResponse{}, err
+	}
+
 	owner, err := sdk.AccAddressFromBech32(deployment.ID().Owner)
 	if err != nil {
 		return &types.MsgDepositDeploymentResponse{}, err
@@ -185,19 +194,9 @@ func (ms msgServer) DepositDeployment(goCtx context.Context, msg *types.MsgDepos
 func (ms msgServer) UpdateDeployment(goCtx context.Context, msg *types.MsgUpdateDeployment) (*types.MsgUpdateDeploymentResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	deployment, found := ms.deployment.GetDeployment(ctx, msg.ID)
-	if !found {
-		return nil, types.ErrDeploymentNotFound
-	}
-
-	// If the deployment is not active, do not allow it to be updated
-	if deployment.State != types.DeploymentActive {
-		return &types.MsgUpdateDeploymentResponse{}, types.ErrDeploymentClosed
-	}
-
-	// If the version is not identical do not allow the update, there is nothing to change in this transaction
-	if bytes.Equal(msg.Version, deployment.Version) {
-		return &types.MsgUpdateDeploymentResponse{}, types.ErrInvalidVersion
+	deployment, err := updatableDeployment(ctx, ms.deployment, msg.ID, msg.Version)
+	if err != nil {
+		return &types.MsgUpdateDeploymentResponse{}, err
 	}
 
 	deployment.Version = msg.Version
@@ -252,6 +251,7 @@ func (ms msgServer) CloseGroup(goCtx context.Context, msg *types.MsgCloseGroup)
 		return nil, err
 	}
 	ms.market.OnGroupClosed(ctx, group.ID())
+	ms.market.DeleteGroupAuction(ctx, group.ID())
 
 	return &types.MsgCloseGroupResponse{}, nil
 }
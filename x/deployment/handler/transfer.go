@@ -0,0 +1,147 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	types "github.com/akash-network/akash-api/go/node/deployment/v1beta3"
+
+	"github.com/akash-network/node/x/deployment/keeper"
+	dv1beta4 "github.com/akash-network/node/x/deployment/types/v1beta4"
+)
+
+func (ms nodeMsgServer) ProposeDeploymentTransfer(goCtx context.Context, msg *dv1beta4.MsgProposeDeploymentTransfer) (*dv1beta4.MsgProposeDeploymentTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	newOwner, err := sdk.AccAddressFromBech32(msg.NewOwner)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrap(err.Error())
+	}
+
+	if err := ProposeDeploymentTransfer(ctx, ms.deployment, msg.GetSigners()[0], msg.ID, newOwner); err != nil {
+		return nil, err
+	}
+
+	return &dv1beta4.MsgProposeDeploymentTransferResponse{}, nil
+}
+
+func (ms nodeMsgServer) CancelDeploymentTransfer(goCtx context.Context, msg *dv1beta4.MsgCancelDeploymentTransfer) (*dv1beta4.MsgCancelDeploymentTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := CancelDeploymentTransfer(ctx, ms.deployment, msg.GetSigners()[0], msg.ID); err != nil {
+		return nil, err
+	}
+
+	return &dv1beta4.MsgCancelDeploymentTransferResponse{}, nil
+}
+
+func (ms nodeMsgServer) AcceptDeploymentTransfer(goCtx context.Context, msg *dv1beta4.MsgAcceptDeploymentTransfer) (*dv1beta4.MsgAcceptDeploymentTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	newOwner, err := sdk.AccAddressFromBech32(msg.NewOwner)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrap(err.Error())
+	}
+
+	if _, err := AcceptDeploymentTransfer(ctx, ms.deployment, ms.market, ms.escrow, msg.ID, newOwner); err != nil {
+		return nil, err
+	}
+
+	return &dv1beta4.MsgAcceptDeploymentTransferResponse{}, nil
+}
+
+// ProposeDeploymentTransfer records transfer of an active deployment to new owner on behalf of its owner.
+// Transfer takes place once new owner accepts it. Pending transfer of the deployment, if any, is replaced.
+func ProposeDeploymentTransfer(ctx sdk.Context, dkeeper keeper.IKeeper, sender sdk.AccAddress, id types.DeploymentID, newOwner sdk.AccAddress) error {
+	if sender.String() != id.Owner {
+		return sdkerrors.ErrUnauthorized.Wrap("transfer must be proposed by the deployment owner")
+	}
+
+	deployment, found := dkeeper.GetDeployment(ctx, id)
+	if !found {
+		return types.ErrDeploymentNotFound
+	}
+
+	if deployment.State != types.DeploymentActive {
+		return types.ErrDeploymentClosed
+	}
+
+	if newOwner.String() == id.Owner {
+		return sdkerrors.ErrInvalidAddress.Wrap("deployment is already owned by given account")
+	}
+
+	dkeeper.SetDeploymentTransfer(ctx, dv1beta4.DeploymentTransfer{
+		ID:         id,
+		NewOwner:   newOwner.String(),
+		ProposedAt: ctx.BlockHeight(),
+	})
+
+	return nil
+}
+
+// CancelDeploymentTransfer drops pending transfer of a deployment on behalf of its owner
+func CancelDeploymentTransfer(ctx sdk.Context, dkeeper keeper.IKeeper, sender sdk.AccAddress, id types.DeploymentID) error {
+	if sender.String() != id.Owner {
+		return sdkerrors.ErrUnauthorized.Wrap("transfer must be canceled by the deployment owner")
+	}
+
+	if _, found := dkeeper.GetDeploymentTransfer(ctx, id); !found {
+		return dv1beta4.ErrDeploymentTransferNotFound
+	}
+
+	dkeeper.DeleteDeploymentTransfer(ctx, id)
+
+	return nil
+}
+
+// AcceptDeploymentTransfer moves deployment with given id under new owner which accepted the pending transfer.
+// Deployment, its groups, orders, bids, leases and escrow account are re-keyed under new owner,
+// deployment sequence stays the same.
+func AcceptDeploymentTransfer(
+	ctx sdk.Context,
+	dkeeper keeper.IKeeper,
+	mkeeper MarketKeeper,
+	ekeeper EscrowKeeper,
+	id types.DeploymentID,
+	newOwner sdk.AccAddress,
+) (types.DeploymentID, error) {
+	transfer, found := dkeeper.GetDeploymentTransfer(ctx, id)
+	if !found {
+		return types.DeploymentID{}, dv1beta4.ErrDeploymentTransferNotFound
+	}
+
+	if transfer.NewOwner != newOwner.String() {
+		return types.DeploymentID{}, sdkerrors.ErrUnauthorized.Wrap("transfer must be accepted by the new owner")
+	}
+
+	deployment, found := dkeeper.GetDeployment(ctx, id)
+	if !found {
+		return types.DeploymentID{}, types.ErrDeploymentNotFound
+	}
+
+	if deployment.State != types.DeploymentActive {
+		return types.DeploymentID{}, types.ErrDeploymentClosed
+	}
+
+	deployment, err := dkeeper.TransferDeployment(ctx, deployment, newOwner)
+	if err != nil {
+		return types.DeploymentID{}, err
+	}
+
+	if err := ekeeper.AccountTransfer(ctx,
+		types.EscrowAccountForDeployment(id),
+		types.EscrowAccountForDeployment(deployment.ID()),
+		newOwner,
+	); err != nil {
+		return types.DeploymentID{}, fmt.Errorf("%w: %s", types.ErrInternal, err.Error())
+	}
+
+	if err := mkeeper.OnDeploymentTransferred(ctx, id, newOwner); err != nil {
+		return types.DeploymentID{}, fmt.Errorf("%w: %s", types.ErrInternal, err.Error())
+	}
+
+	return deployment.ID(), nil
+}
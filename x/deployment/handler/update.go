@@ -0,0 +1,181 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	types "github.com/akash-network/akash-api/go/node/deployment/v1beta3"
+
+	"github.com/akash-network/node/x/deployment/keeper"
+	dv1beta4 "github.com/akash-network/node/x/deployment/types/v1beta4"
+)
+
+func (ms nodeMsgServer) UpdateDeploymentGroups(goCtx context.Context, msg *dv1beta4.MsgUpdateDeploymentGroups) (*dv1beta4.MsgUpdateDeploymentGroupsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := UpdateDeploymentGroups(ctx, ms.deployment, ms.market, msg.ID, msg.Version, msg.Groups); err != nil {
+		return nil, err
+	}
+
+	return &dv1beta4.MsgUpdateDeploymentGroupsResponse{}, nil
+}
+
+// UpdateDeploymentGroups updates version of an active deployment and reconciles its groups with given specs.
+// Groups are matched by name:
+//   - specs without a matching group become new groups with orders of their own
+//   - groups without a matching spec are closed along with their orders, bids and leases
+//   - open groups whose spec changed have their current order closed and a new one created,
+//     paused groups keep the new spec until started
+//
+// Deployment sequence and escrow account stay the same.
+func UpdateDeploymentGroups(
+	ctx sdk.Context,
+	dkeeper keeper.IKeeper,
+	mkeeper MarketKeeper,
+	id types.DeploymentID,
+	version []byte,
+	specs []types.GroupSpec,
+) error {
+	deployment, err := updatableDeployment(ctx, dkeeper, id, version)
+	if err != nil {
+		return err
+	}
+
+	if err := types.ValidateDeploymentGroups(specs); err != nil {
+		return fmt.Errorf("%w: %s", types.ErrInvalidGroups, err.Error())
+	}
+
+	groups := dkeeper.GetGroups(ctx, id)
+
+	gseq := uint32(0)
+	denom := ""
+	active := make(map[string]types.Group, len(groups))
+
+	for _, group := range groups {
+		if group.GroupID.GSeq > gseq {
+			gseq = group.GroupID.GSeq
+		}
+
+		if group.ValidateClosable() != nil {
+			continue
+		}
+
+		denom = group.GroupSpec.Price().Denom
+		active[group.GroupSpec.Name] = group
+	}
+
+	params := dkeeper.GetParams(ctx)
+
+	// groups of deployment are priced in single denomination the deployment can be funded with
+	for _, spec := range specs {
+		if denom != "" && spec.Price().Denom != denom {
+			return fmt.Errorf("%w: group %q denomination must be %s", types.ErrInvalidGroups, spec.Name, denom)
+		}
+
+		if _, err := params.MinDepositFor(spec.Price().Denom); err != nil {
+			return fmt.Errorf("%w: group %q: %s", types.ErrInvalidGroups, spec.Name, err.Error())
+		}
+	}
+
+	deployment.Version = version
+
+	if err := dkeeper.UpdateDeployment(ctx, deployment); err != nil {
+		return fmt.Errorf("%w: %s", types.ErrInternal, err.Error())
+	}
+
+	for _, spec := range specs {
+		group, exists := active[spec.Name]
+		if !exists {
+			gseq++
+
+			group = types.Group{
+				GroupID:   types.MakeGroupID(id, gseq),
+				State:     types.GroupOpen,
+				GroupSpec: spec,
+				CreatedAt: ctx.BlockHeight(),
+			}
+
+			if err := dkeeper.CreateGroup(ctx, group); err != nil {
+				return fmt.Errorf("%w: %s", types.ErrInternal, err.Error())
+			}
+
+			if _, err := mkeeper.CreateOrder(ctx, group.ID(), spec); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		delete(active, spec.Name)
+
+		if groupSpecEqual(group.GroupSpec, spec) {
+			continue
+		}
+
+		if err := dkeeper.UpdateGroupSpec(ctx, group, spec); err != nil {
+			return err
+		}
+
+		if group.State != types.GroupOpen {
+			continue
+		}
+
+		// group stays open, auction it opted into carries over to the new order
+		mkeeper.OnGroupClosed(ctx, group.ID())
+
+		if _, err := mkeeper.CreateOrder(ctx, group.ID(), spec); err != nil {
+			return err
+		}
+	}
+
+	for _, group := range groups {
+		if removed, exists := active[group.GroupSpec.Name]; !exists || removed.GroupID != group.GroupID {
+			continue
+		}
+
+		if err := dkeeper.OnCloseGroup(ctx, group, types.GroupClosed); err != nil {
+			return err
+		}
+		mkeeper.OnGroupClosed(ctx, group.ID())
+		mkeeper.DeleteGroupAuction(ctx, group.ID())
+	}
+
+	return nil
+}
+
+// updatableDeployment returns active deployment with given id if version differs from its current one
+func updatableDeployment(ctx sdk.Context, dkeeper keeper.IKeeper, id types.DeploymentID, version []byte) (types.Deployment, error) {
+	deployment, found := dkeeper.GetDeployment(ctx, id)
+	if !found {
+		return types.Deployment{}, types.ErrDeploymentNotFound
+	}
+
+	// If the deployment is not active, do not allow it to be updated
+	if deployment.State != types.DeploymentActive {
+		return types.Deployment{}, types.ErrDeploymentClosed
+	}
+
+	// If the version is not identical do not allow the update, there is nothing to change in this transaction
+	if bytes.Equal(version, deployment.Version) {
+		return types.Deployment{}, types.ErrInvalidVersion
+	}
+
+	return deployment, nil
+}
+
+func groupSpecEqual(a, b types.GroupSpec) bool {
+	abuf, err := a.Marshal()
+	if err != nil {
+		return false
+	}
+
+	bbuf, err := b.Marshal()
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(abuf, bbuf)
+}
@@ -8,6 +8,8 @@ import (
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	types "github.com/akash-network/akash-api/go/node/deployment/v1beta3"
+
+	dv1beta4 "github.com/akash-network/node/x/deployment/types/v1beta4"
 )
 
 type IKeeper interface {
@@ -17,6 +19,8 @@ type IKeeper interface {
 	GetGroup(ctx sdk.Context, id types.GroupID) (types.Group, bool)
 	GetGroups(ctx sdk.Context, id types.DeploymentID) []types.Group
 	Create(ctx sdk.Context, deployment types.Deployment, groups []types.Group) error
+	CreateGroup(ctx sdk.Context, group types.Group) error
+	UpdateGroupSpec(ctx sdk.Context, group types.Group, spec types.GroupSpec) error
 	UpdateDeployment(ctx sdk.Context, deployment types.Deployment) error
 	CloseDeployment(ctx sdk.Context, deployment types.Deployment)
 	OnCloseGroup(ctx sdk.Context, group types.Group, state types.Group_State) error
@@ -27,6 +31,10 @@ type IKeeper interface {
 	OnLeaseClosed(ctx sdk.Context, id types.GroupID) (types.Group, error)
 	GetParams(ctx sdk.Context) (params types.Params)
 	SetParams(ctx sdk.Context, params types.Params)
+	SetDeploymentTransfer(ctx sdk.Context, transfer dv1beta4.DeploymentTransfer)
+	GetDeploymentTransfer(ctx sdk.Context, id types.DeploymentID) (dv1beta4.DeploymentTransfer, bool)
+	DeleteDeploymentTransfer(ctx sdk.Context, id types.DeploymentID)
+	TransferDeployment(ctx sdk.Context, deployment types.Deployment, owner sdk.AccAddress) (types.Deployment, error)
 	updateDeployment(ctx sdk.Context, obj types.Deployment)
 
 	NewQuerier() Querier
@@ -156,6 +164,39 @@ func (k Keeper) Create(ctx sdk.Context, deployment types.Deployment, groups []ty
 	return nil
 }
 
+// CreateGroup adds a new group to an existing deployment
+func (k Keeper) CreateGroup(ctx sdk.Context, group types.Group) error {
+	store := ctx.KVStore(k.skey)
+
+	if !store.Has(deploymentKey(group.ID().DeploymentID())) {
+		return types.ErrDeploymentNotFound
+	}
+
+	key := groupKey(group.ID())
+
+	if store.Has(key) {
+		return types.ErrInvalidGroupID
+	}
+
+	store.Set(key, k.cdc.MustMarshal(&group))
+
+	return nil
+}
+
+// UpdateGroupSpec replaces spec of an existing group
+func (k Keeper) UpdateGroupSpec(ctx sdk.Context, group types.Group, spec types.GroupSpec) error {
+	store := ctx.KVStore(k.skey)
+
+	if !store.Has(groupKey(group.ID())) {
+		return types.ErrGroupNotFound
+	}
+
+	group.GroupSpec = spec
+	k.updateGroup(ctx, group)
+
+	return nil
+}
+
 // UpdateDeployment updates deployment details
 func (k Keeper) UpdateDeployment(ctx sdk.Context, deployment types.Deployment) error {
 	store := ctx.KVStore(k.skey)
@@ -188,6 +229,8 @@ func (k Keeper) CloseDeployment(ctx sdk.Context, deployment types.Deployment) {
 	}
 
 	deployment.State = types.DeploymentClosed
+	k.DeleteDeploymentTransfer(ctx, deployment.ID())
+
 	ctx.EventManager().EmitEvent(
 		types.NewEventDeploymentClosed(deployment.ID()).
 			ToSDKEvent(),
@@ -302,7 +345,6 @@ func (k Keeper) updateDeployment(ctx sdk.Context, obj types.Deployment) {
 	store.Set(key, k.cdc.MustMarshal(&obj))
 }
 
-// nolint: unused
 func (k Keeper) updateGroup(ctx sdk.Context, group types.Group) {
 	store := ctx.KVStore(k.skey)
 	key := groupKey(group.ID())
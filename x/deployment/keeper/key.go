@@ -75,3 +75,15 @@ func filterToPrefix(prefix []byte, owner string, dseq uint64, gseq uint32) ([]by
 func deploymentPrefixFromFilter(f types.DeploymentFilters) ([]byte, error) {
 	return filterToPrefix(types.DeploymentPrefix(), f.Owner, f.DSeq, 0)
 }
+
+// deploymentTransferPrefix holds pending deployment ownership transfers.
+// It is local to this module and not part of the akash-api store layout.
+func deploymentTransferPrefix() []byte {
+	return []byte{0x03, 0x00}
+}
+
+func deploymentTransferKey(id types.DeploymentID) []byte {
+	buf := bytes.NewBuffer(deploymentTransferPrefix())
+	buf.Write(deploymentKey(id)[len(types.DeploymentPrefix()):])
+	return buf.Bytes()
+}
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	types "github.com/akash-network/akash-api/go/node/deployment/v1beta3"
+
+	dv1beta4 "github.com/akash-network/node/x/deployment/types/v1beta4"
+)
+
+// SetDeploymentTransfer stores pending transfer of a deployment, replacing existing one
+func (k Keeper) SetDeploymentTransfer(ctx sdk.Context, transfer dv1beta4.DeploymentTransfer) {
+	ctx.KVStore(k.skey).Set(deploymentTransferKey(transfer.ID), k.cdc.MustMarshal(&transfer))
+}
+
+// GetDeploymentTransfer returns pending transfer of deployment with given id
+func (k Keeper) GetDeploymentTransfer(ctx sdk.Context, id types.DeploymentID) (dv1beta4.DeploymentTransfer, bool) {
+	buf := ctx.KVStore(k.skey).Get(deploymentTransferKey(id))
+	if buf == nil {
+		return dv1beta4.DeploymentTransfer{}, false
+	}
+
+	var transfer dv1beta4.DeploymentTransfer
+	k.cdc.MustUnmarshal(buf, &transfer)
+
+	return transfer, true
+}
+
+// DeleteDeploymentTransfer removes pending transfer of deployment with given id
+func (k Keeper) DeleteDeploymentTransfer(ctx sdk.Context, id types.DeploymentID) {
+	ctx.KVStore(k.skey).Delete(deploymentTransferKey(id))
+}
+
+// TransferDeployment moves deployment and its groups under given owner keeping deployment sequence.
+// It returns the deployment with its new id. Records of other modules must be moved by the caller.
+func (k Keeper) TransferDeployment(ctx sdk.Context, deployment types.Deployment, owner sdk.AccAddress) (types.Deployment, error) {
+	store := ctx.KVStore(k.skey)
+
+	if !store.Has(deploymentKey(deployment.ID())) {
+		return types.Deployment{}, types.ErrDeploymentNotFound
+	}
+
+	from := deployment.ID()
+	to := types.DeploymentID{
+		Owner: owner.String(),
+		DSeq:  from.DSeq,
+	}
+
+	if store.Has(deploymentKey(to)) {
+		return types.Deployment{}, types.ErrDeploymentExists
+	}
+
+	groups := k.GetGroups(ctx, from)
+
+	store.Delete(deploymentKey(from))
+	k.DeleteDeploymentTransfer(ctx, from)
+
+	deployment.DeploymentID = to
+	k.updateDeployment(ctx, deployment)
+
+	for _, group := range groups {
+		store.Delete(groupKey(group.ID()))
+
+		group.GroupID.Owner = to.Owner
+		k.updateGroup(ctx, group)
+	}
+
+	ctx.EventManager().EmitEvent(
+		dv1beta4.NewEventDeploymentTransferred(to, from.Owner).
+			ToSDKEvent(),
+	)
+
+	return deployment, nil
+}
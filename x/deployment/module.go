@@ -31,6 +31,7 @@ import (
 	"github.com/akash-network/node/x/deployment/handler"
 	"github.com/akash-network/node/x/deployment/keeper"
 	"github.com/akash-network/node/x/deployment/simulation"
+	dv1beta4 "github.com/akash-network/node/x/deployment/types/v1beta4"
 )
 
 // type check to ensure the interface is properly implemented
@@ -53,11 +54,13 @@ func (AppModuleBasic) Name() string {
 // RegisterLegacyAminoCodec registers the deployment module's types for the given codec.
 func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	types.RegisterLegacyAminoCodec(cdc)
+	dv1beta4.RegisterLegacyAminoCodec(cdc)
 }
 
 // RegisterInterfaces registers the module's interface types
 func (b AppModuleBasic) RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	types.RegisterInterfaces(registry)
+	dv1beta4.RegisterInterfaces(registry)
 	v1beta2types.RegisterInterfaces(registry)
 	v1beta1types.RegisterInterfaces(registry)
 }
@@ -154,6 +157,7 @@ func (am AppModule) LegacyQuerierHandler(_ *codec.LegacyAmino) sdk.Querier {
 // RegisterServices registers the module's services
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), handler.NewServer(am.keeper, am.mkeeper, am.ekeeper, am.authzKeeper))
+	dv1beta4.RegisterMsgServer(cfg.MsgServer(), handler.NewNodeServer(am.keeper, am.mkeeper, am.ekeeper, am.authzKeeper))
 	querier := am.keeper.NewQuerier()
 	types.RegisterQueryServer(cfg.QueryServer(), querier)
 
@@ -0,0 +1,488 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: akash/deployment/v1beta4/auctionmsg.proto
+
+package v1beta4
+
+import (
+	fmt "fmt"
+	v1beta3 "github.com/akash-network/akash-api/go/node/deployment/v1beta3"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgSetGroupAuction puts orders of deployment group into reverse auction mode
+type MsgSetGroupAuction struct {
+	ID v1beta3.GroupID `protobuf:"bytes,1,opt,name=id,proto3" json:"id" yaml:"id"`
+	// Window is number of blocks order stays open for bids before lease is
+	// created for the lowest priced one
+	Window int64 `protobuf:"varint,2,opt,name=window,proto3" json:"window" yaml:"window"`
+}
+
+func (m *MsgSetGroupAuction) Reset()         { *m = MsgSetGroupAuction{} }
+func (m *MsgSetGroupAuction) String() string { return proto.CompactTextString(m) }
+func (*MsgSetGroupAuction) ProtoMessage()    {}
+func (*MsgSetGroupAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad141681f4cf6edc, []int{0}
+}
+func (m *MsgSetGroupAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetGroupAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetGroupAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetGroupAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetGroupAuction.Merge(m, src)
+}
+func (m *MsgSetGroupAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetGroupAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetGroupAuction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetGroupAuction proto.InternalMessageInfo
+
+func (m *MsgSetGroupAuction) GetID() v1beta3.GroupID {
+	if m != nil {
+		return m.ID
+	}
+	return v1beta3.GroupID{}
+}
+
+func (m *MsgSetGroupAuction) GetWindow() int64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+// MsgSetGroupAuctionResponse defines the Msg/SetGroupAuction response type.
+type MsgSetGroupAuctionResponse struct {
+}
+
+func (m *MsgSetGroupAuctionResponse) Reset()         { *m = MsgSetGroupAuctionResponse{} }
+func (m *MsgSetGroupAuctionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetGroupAuctionResponse) ProtoMessage()    {}
+func (*MsgSetGroupAuctionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad141681f4cf6edc, []int{1}
+}
+func (m *MsgSetGroupAuctionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetGroupAuctionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetGroupAuctionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetGroupAuctionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetGroupAuctionResponse.Merge(m, src)
+}
+func (m *MsgSetGroupAuctionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetGroupAuctionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetGroupAuctionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetGroupAuctionResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgSetGroupAuction)(nil), "akash.deployment.v1beta4.MsgSetGroupAuction")
+	proto.RegisterType((*MsgSetGroupAuctionResponse)(nil), "akash.deployment.v1beta4.MsgSetGroupAuctionResponse")
+}
+
+func init() {
+	proto.RegisterFile("akash/deployment/v1beta4/auctionmsg.proto", fileDescriptor_ad141681f4cf6edc)
+}
+
+var fileDescriptor_ad141681f4cf6edc = []byte{
+	// 310 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0xb1, 0x4e, 0xc3, 0x30,
+	0x10, 0x86, 0xe3, 0x80, 0x2a, 0x11, 0xc4, 0x12, 0x31, 0x54, 0x05, 0xe2, 0x92, 0x01, 0x95, 0x01,
+	0x5b, 0x10, 0x06, 0xd4, 0x8d, 0xaa, 0x12, 0xea, 0x80, 0x84, 0xc2, 0xc6, 0x96, 0xd6, 0x96, 0x6b,
+	0xb5, 0xf1, 0x45, 0xb5, 0x4b, 0xe9, 0x5b, 0xf0, 0x08, 0xf0, 0x36, 0x1d, 0x3b, 0x32, 0x59, 0x28,
+	0x5d, 0x50, 0xc7, 0x3e, 0x01, 0x6a, 0x52, 0x04, 0x12, 0xea, 0x66, 0xff, 0xf7, 0xdd, 0xfd, 0x77,
+	0xbf, 0x77, 0x9e, 0x0c, 0x12, 0xdd, 0xa7, 0x8c, 0x67, 0x43, 0x98, 0xa6, 0x5c, 0x19, 0xfa, 0x7c,
+	0xd9, 0xe5, 0x26, 0xb9, 0xa6, 0xc9, 0xb8, 0x67, 0x24, 0xa8, 0x54, 0x0b, 0x92, 0x8d, 0xc0, 0x80,
+	0x5f, 0x2d, 0x50, 0xf2, 0x8b, 0x92, 0x0d, 0x5a, 0x3b, 0x14, 0x20, 0xa0, 0x80, 0xe8, 0xfa, 0x55,
+	0xf2, 0xb5, 0xb3, 0x2d, 0xa3, 0x23, 0x2a, 0x46, 0x30, 0xce, 0x24, 0x2b, 0xb9, 0xf0, 0x1d, 0x79,
+	0xfe, 0xbd, 0x16, 0x8f, 0xdc, 0xdc, 0xad, 0xf5, 0xdb, 0xd2, 0xd7, 0x7f, 0xf0, 0x5c, 0xc9, 0xaa,
+	0xa8, 0x8e, 0x1a, 0xfb, 0x57, 0xa7, 0x64, 0x8b, 0x77, 0x44, 0x8a, 0x9e, 0x4e, 0xbb, 0x75, 0x32,
+	0xb3, 0xd8, 0xc9, 0x2d, 0x76, 0x3b, 0xed, 0xa5, 0xc5, 0xae, 0x64, 0x2b, 0x8b, 0xf7, 0xa6, 0x49,
+	0x3a, 0x6c, 0x86, 0x92, 0x85, 0xb1, 0x2b, 0x99, 0x1f, 0x79, 0x95, 0x89, 0x54, 0x0c, 0x26, 0x55,
+	0xb7, 0x8e, 0x1a, 0x3b, 0xad, 0xa3, 0xa5, 0xc5, 0x1b, 0x65, 0x65, 0xf1, 0x41, 0x09, 0x97, 0xff,
+	0x30, 0xde, 0x14, 0x9a, 0xbb, 0x5f, 0x6f, 0xd8, 0x09, 0x8f, 0xbd, 0xda, 0xff, 0x15, 0x63, 0xae,
+	0x33, 0x50, 0x9a, 0xb7, 0xe2, 0x59, 0x1e, 0xa0, 0x79, 0x1e, 0xa0, 0xcf, 0x3c, 0x40, 0xaf, 0x8b,
+	0xc0, 0x99, 0x2f, 0x02, 0xe7, 0x63, 0x11, 0x38, 0x4f, 0x37, 0x42, 0x9a, 0xfe, 0xb8, 0x4b, 0x7a,
+	0x90, 0xd2, 0xe2, 0x84, 0x0b, 0xc5, 0xcd, 0x04, 0x46, 0x03, 0xaa, 0x80, 0x71, 0xfa, 0xf2, 0x37,
+	0x1d, 0x33, 0xcd, 0xb8, 0xfe, 0x89, 0xbf, 0x5b, 0x29, 0xc2, 0x89, 0xbe, 0x07, 0x00, 0xbc, 0xee,
+	0x43, 0x1c, 0xa1, 0x01, 0x00, 0x00,
+}
+
+func (m *MsgSetGroupAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetGroupAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetGroupAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Window != 0 {
+		i = encodeVarintAuctionmsg(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuctionmsg(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetGroupAuctionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetGroupAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetGroupAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuctionmsg(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuctionmsg(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetGroupAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovAuctionmsg(uint64(l))
+	if m.Window != 0 {
+		n += 1 + sovAuctionmsg(uint64(m.Window))
+	}
+	return n
+}
+
+func (m *MsgSetGroupAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovAuctionmsg(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuctionmsg(x uint64) (n int) {
+	return sovAuctionmsg(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetGroupAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuctionmsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetGroupAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetGroupAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctionmsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctionmsg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctionmsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctionmsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuctionmsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuctionmsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetGroupAuctionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuctionmsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetGroupAuctionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetGroupAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuctionmsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuctionmsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuctionmsg(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuctionmsg
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuctionmsg
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuctionmsg
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuctionmsg
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuctionmsg
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuctionmsg
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuctionmsg        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuctionmsg          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuctionmsg = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,50 @@
+package v1beta4
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+var (
+	amino = codec.NewLegacyAmino()
+
+	// ModuleCdc references the global x/deployment module codec. Note, the codec should
+	// ONLY be used in certain instances of tests and for JSON encoding as Amino is
+	// still used for that purpose.
+	ModuleCdc = codec.NewProtoCodec(cdctypes.NewInterfaceRegistry())
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	cryptocodec.RegisterCrypto(amino)
+	amino.Seal()
+}
+
+// RegisterLegacyAminoCodec register concrete types on codec
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgSetAutoRefill{}, ModuleName+"/"+MsgTypeSetAutoRefill, nil)
+	cdc.RegisterConcrete(&MsgDeleteAutoRefill{}, ModuleName+"/"+MsgTypeDeleteAutoRefill, nil)
+	cdc.RegisterConcrete(&MsgSetGroupAuction{}, ModuleName+"/"+MsgTypeSetGroupAuction, nil)
+	cdc.RegisterConcrete(&MsgUpdateDeploymentGroups{}, ModuleName+"/"+MsgTypeUpdateDeploymentGroups, nil)
+	cdc.RegisterConcrete(&MsgProposeDeploymentTransfer{}, ModuleName+"/"+MsgTypeProposeDeploymentTransfer, nil)
+	cdc.RegisterConcrete(&MsgCancelDeploymentTransfer{}, ModuleName+"/"+MsgTypeCancelDeploymentTransfer, nil)
+	cdc.RegisterConcrete(&MsgAcceptDeploymentTransfer{}, ModuleName+"/"+MsgTypeAcceptDeploymentTransfer, nil)
+}
+
+// RegisterInterfaces registers the node specific x/deployment interfaces types with the interface registry
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetAutoRefill{},
+		&MsgDeleteAutoRefill{},
+		&MsgSetGroupAuction{},
+		&MsgUpdateDeploymentGroups{},
+		&MsgProposeDeploymentTransfer{},
+		&MsgCancelDeploymentTransfer{},
+		&MsgAcceptDeploymentTransfer{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
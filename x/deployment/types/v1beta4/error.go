@@ -0,0 +1,14 @@
+package v1beta4
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// error codes continue after range used by akash-api deployment types
+const (
+	errDeploymentTransferNotFound uint32 = iota + 100
+)
+
+var (
+	ErrDeploymentTransferNotFound = sdkerrors.Register(ModuleName, errDeploymentTransferNotFound, "deployment transfer not found")
+)
@@ -0,0 +1,71 @@
+package v1beta4
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v1beta3 "github.com/akash-network/akash-api/go/node/deployment/v1beta3"
+	"github.com/akash-network/akash-api/go/sdkutil"
+)
+
+const (
+	EvActionDeploymentTransferred = "deployment-transferred"
+
+	EvPreviousOwnerKey = "previous-owner"
+)
+
+// EventDeploymentTransferred struct
+type EventDeploymentTransferred struct {
+	Context       sdkutil.BaseModuleEvent `json:"context"`
+	ID            v1beta3.DeploymentID    `json:"id"`
+	PreviousOwner string                  `json:"previous_owner"`
+}
+
+func NewEventDeploymentTransferred(id v1beta3.DeploymentID, previousOwner string) EventDeploymentTransferred {
+	return EventDeploymentTransferred{
+		Context: sdkutil.BaseModuleEvent{
+			Module: ModuleName,
+			Action: EvActionDeploymentTransferred,
+		},
+		ID:            id,
+		PreviousOwner: previousOwner,
+	}
+}
+
+// ToSDKEvent method creates new sdk event for EventDeploymentTransferred struct
+func (ev EventDeploymentTransferred) ToSDKEvent() sdk.Event {
+	return sdk.NewEvent(sdkutil.EventTypeMessage,
+		append(append([]sdk.Attribute{
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, EvActionDeploymentTransferred),
+		}, v1beta3.DeploymentIDEVAttributes(ev.ID)...),
+			sdk.NewAttribute(EvPreviousOwnerKey, ev.PreviousOwner),
+		)...,
+	)
+}
+
+// ParseEvent parses event and returns details of event and error if occurred
+func ParseEvent(ev sdkutil.Event) (sdkutil.ModuleEvent, error) {
+	if ev.Type != sdkutil.EventTypeMessage {
+		return nil, sdkutil.ErrUnknownType
+	}
+	if ev.Module != ModuleName {
+		return nil, sdkutil.ErrUnknownModule
+	}
+
+	switch ev.Action {
+	case EvActionDeploymentTransferred:
+		did, err := v1beta3.ParseEVDeploymentID(ev.Attributes)
+		if err != nil {
+			return nil, err
+		}
+
+		owner, err := sdkutil.GetString(ev.Attributes, EvPreviousOwnerKey)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewEventDeploymentTransferred(did, owner), nil
+	default:
+		return nil, sdkutil.ErrUnknownAction
+	}
+}
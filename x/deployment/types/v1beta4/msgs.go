@@ -0,0 +1,325 @@
+package v1beta4
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	v1beta3 "github.com/akash-network/akash-api/go/node/deployment/v1beta3"
+
+	ev1beta4 "github.com/akash-network/node/x/escrow/types/v1beta4"
+)
+
+const (
+	MsgTypeSetAutoRefill    = "set-auto-refill"
+	MsgTypeDeleteAutoRefill = "delete-auto-refill"
+	MsgTypeSetGroupAuction  = "set-group-auction"
+
+	MsgTypeUpdateDeploymentGroups = "update-deployment-groups"
+
+	MsgTypeProposeDeploymentTransfer = "propose-deployment-transfer"
+	MsgTypeCancelDeploymentTransfer  = "cancel-deployment-transfer"
+	MsgTypeAcceptDeploymentTransfer  = "accept-deployment-transfer"
+)
+
+var (
+	_, _, _, _ sdk.Msg = &MsgSetAutoRefill{}, &MsgDeleteAutoRefill{}, &MsgSetGroupAuction{}, &MsgUpdateDeploymentGroups{}
+	_, _, _    sdk.Msg = &MsgProposeDeploymentTransfer{}, &MsgCancelDeploymentTransfer{}, &MsgAcceptDeploymentTransfer{}
+)
+
+// NewMsgSetAutoRefill creates a new MsgSetAutoRefill instance
+func NewMsgSetAutoRefill(id v1beta3.DeploymentID, threshold, amount sdk.Coin, funder string) *MsgSetAutoRefill {
+	return &MsgSetAutoRefill{
+		ID:        id,
+		Threshold: threshold,
+		Amount:    amount,
+		Funder:    funder,
+	}
+}
+
+// Policy returns auto refill policy carried by the message
+func (msg MsgSetAutoRefill) Policy() ev1beta4.AutoRefill {
+	return ev1beta4.AutoRefill{
+		Threshold: msg.Threshold,
+		Amount:    msg.Amount,
+		Funder:    msg.Funder,
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgSetAutoRefill) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface
+func (msg MsgSetAutoRefill) Type() string { return MsgTypeSetAutoRefill }
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSetAutoRefill) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetAutoRefill) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.ID.Owner)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{owner}
+}
+
+// ValidateBasic does basic validation of deployment id and refill policy
+func (msg MsgSetAutoRefill) ValidateBasic() error {
+	if err := msg.ID.Validate(); err != nil {
+		return err
+	}
+
+	return msg.Policy().ValidateBasic()
+}
+
+// NewMsgDeleteAutoRefill creates a new MsgDeleteAutoRefill instance
+func NewMsgDeleteAutoRefill(id v1beta3.DeploymentID) *MsgDeleteAutoRefill {
+	return &MsgDeleteAutoRefill{
+		ID: id,
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgDeleteAutoRefill) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface
+func (msg MsgDeleteAutoRefill) Type() string { return MsgTypeDeleteAutoRefill }
+
+// GetSignBytes encodes the message for signing
+func (msg MsgDeleteAutoRefill) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgDeleteAutoRefill) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.ID.Owner)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{owner}
+}
+
+// ValidateBasic does basic validation of deployment id
+func (msg MsgDeleteAutoRefill) ValidateBasic() error {
+	return msg.ID.Validate()
+}
+
+// NewMsgSetGroupAuction creates a new MsgSetGroupAuction instance
+func NewMsgSetGroupAuction(id v1beta3.GroupID, window int64) *MsgSetGroupAuction {
+	return &MsgSetGroupAuction{
+		ID:     id,
+		Window: window,
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgSetGroupAuction) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface
+func (msg MsgSetGroupAuction) Type() string { return MsgTypeSetGroupAuction }
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSetGroupAuction) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetGroupAuction) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.ID.Owner)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{owner}
+}
+
+// ValidateBasic does basic validation of group id and auction window
+func (msg MsgSetGroupAuction) ValidateBasic() error {
+	if err := msg.ID.Validate(); err != nil {
+		return err
+	}
+
+	if msg.Window <= 0 {
+		return fmt.Errorf("%w: auction window must be positive", v1beta3.ErrInvalidRequest)
+	}
+
+	return nil
+}
+
+// NewMsgUpdateDeploymentGroups creates a new MsgUpdateDeploymentGroups instance
+func NewMsgUpdateDeploymentGroups(id v1beta3.DeploymentID, groups []v1beta3.GroupSpec, version []byte) *MsgUpdateDeploymentGroups {
+	return &MsgUpdateDeploymentGroups{
+		ID:      id,
+		Groups:  groups,
+		Version: version,
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgUpdateDeploymentGroups) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface
+func (msg MsgUpdateDeploymentGroups) Type() string { return MsgTypeUpdateDeploymentGroups }
+
+// GetSignBytes encodes the message for signing
+func (msg MsgUpdateDeploymentGroups) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgUpdateDeploymentGroups) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.ID.Owner)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{owner}
+}
+
+// ValidateBasic does basic validation of deployment id, version and group specs
+func (msg MsgUpdateDeploymentGroups) ValidateBasic() error {
+	if err := msg.ID.Validate(); err != nil {
+		return err
+	}
+
+	if len(msg.Version) == 0 {
+		return v1beta3.ErrEmptyVersion
+	}
+
+	if len(msg.Version) != v1beta3.ManifestVersionLength {
+		return v1beta3.ErrInvalidVersion
+	}
+
+	if err := v1beta3.ValidateDeploymentGroups(msg.Groups); err != nil {
+		return fmt.Errorf("%w: %s", v1beta3.ErrInvalidGroups, err.Error())
+	}
+
+	return nil
+}
+
+// NewMsgProposeDeploymentTransfer creates a new MsgProposeDeploymentTransfer instance
+func NewMsgProposeDeploymentTransfer(id v1beta3.DeploymentID, newOwner sdk.AccAddress) *MsgProposeDeploymentTransfer {
+	return &MsgProposeDeploymentTransfer{
+		ID:       id,
+		NewOwner: newOwner.String(),
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgProposeDeploymentTransfer) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface
+func (msg MsgProposeDeploymentTransfer) Type() string { return MsgTypeProposeDeploymentTransfer }
+
+// GetSignBytes encodes the message for signing
+func (msg MsgProposeDeploymentTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgProposeDeploymentTransfer) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.ID.Owner)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{owner}
+}
+
+// ValidateBasic does basic validation of deployment id and new owner address
+func (msg MsgProposeDeploymentTransfer) ValidateBasic() error {
+	if err := msg.ID.Validate(); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.NewOwner); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrap("new owner: " + err.Error())
+	}
+
+	if msg.NewOwner == msg.ID.Owner {
+		return sdkerrors.ErrInvalidAddress.Wrap("deployment is already owned by given account")
+	}
+
+	return nil
+}
+
+// NewMsgCancelDeploymentTransfer creates a new MsgCancelDeploymentTransfer instance
+func NewMsgCancelDeploymentTransfer(id v1beta3.DeploymentID) *MsgCancelDeploymentTransfer {
+	return &MsgCancelDeploymentTransfer{
+		ID: id,
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgCancelDeploymentTransfer) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface
+func (msg MsgCancelDeploymentTransfer) Type() string { return MsgTypeCancelDeploymentTransfer }
+
+// GetSignBytes encodes the message for signing
+func (msg MsgCancelDeploymentTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCancelDeploymentTransfer) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.ID.Owner)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{owner}
+}
+
+// ValidateBasic does basic validation of deployment id
+func (msg MsgCancelDeploymentTransfer) ValidateBasic() error {
+	return msg.ID.Validate()
+}
+
+// NewMsgAcceptDeploymentTransfer creates a new MsgAcceptDeploymentTransfer instance
+func NewMsgAcceptDeploymentTransfer(id v1beta3.DeploymentID, newOwner sdk.AccAddress) *MsgAcceptDeploymentTransfer {
+	return &MsgAcceptDeploymentTransfer{
+		ID:       id,
+		NewOwner: newOwner.String(),
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgAcceptDeploymentTransfer) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface
+func (msg MsgAcceptDeploymentTransfer) Type() string { return MsgTypeAcceptDeploymentTransfer }
+
+// GetSignBytes encodes the message for signing
+func (msg MsgAcceptDeploymentTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgAcceptDeploymentTransfer) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.NewOwner)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{owner}
+}
+
+// ValidateBasic does basic validation of deployment id and new owner address
+func (msg MsgAcceptDeploymentTransfer) ValidateBasic() error {
+	if err := msg.ID.Validate(); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.NewOwner); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrap("new owner: " + err.Error())
+	}
+
+	return nil
+}
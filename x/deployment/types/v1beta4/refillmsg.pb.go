@@ -0,0 +1,914 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: akash/deployment/v1beta4/refillmsg.proto
+
+package v1beta4
+
+import (
+	fmt "fmt"
+	v1beta3 "github.com/akash-network/akash-api/go/node/deployment/v1beta3"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgSetAutoRefill registers auto refill policy of deployment escrow account,
+// replacing existing one
+type MsgSetAutoRefill struct {
+	ID v1beta3.DeploymentID `protobuf:"bytes,1,opt,name=id,proto3" json:"id" yaml:"id"`
+	// Threshold is projected balance below which account is topped up
+	Threshold types.Coin `protobuf:"bytes,2,opt,name=threshold,proto3" json:"threshold" yaml:"threshold"`
+	// Amount is deposited by every refill
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount" yaml:"amount"`
+	// Funder pays for refills, either the owner or an account which granted
+	// DepositDeploymentAuthorization to the owner
+	Funder string `protobuf:"bytes,4,opt,name=funder,proto3" json:"funder" yaml:"funder"`
+}
+
+func (m *MsgSetAutoRefill) Reset()         { *m = MsgSetAutoRefill{} }
+func (m *MsgSetAutoRefill) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoRefill) ProtoMessage()    {}
+func (*MsgSetAutoRefill) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb02e6862f502ec3, []int{0}
+}
+func (m *MsgSetAutoRefill) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoRefill) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoRefill.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoRefill) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoRefill.Merge(m, src)
+}
+func (m *MsgSetAutoRefill) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoRefill) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoRefill.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoRefill proto.InternalMessageInfo
+
+func (m *MsgSetAutoRefill) GetID() v1beta3.DeploymentID {
+	if m != nil {
+		return m.ID
+	}
+	return v1beta3.DeploymentID{}
+}
+
+func (m *MsgSetAutoRefill) GetThreshold() types.Coin {
+	if m != nil {
+		return m.Threshold
+	}
+	return types.Coin{}
+}
+
+func (m *MsgSetAutoRefill) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *MsgSetAutoRefill) GetFunder() string {
+	if m != nil {
+		return m.Funder
+	}
+	return ""
+}
+
+// MsgSetAutoRefillResponse defines the Msg/SetAutoRefill response type.
+type MsgSetAutoRefillResponse struct {
+}
+
+func (m *MsgSetAutoRefillResponse) Reset()         { *m = MsgSetAutoRefillResponse{} }
+func (m *MsgSetAutoRefillResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoRefillResponse) ProtoMessage()    {}
+func (*MsgSetAutoRefillResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb02e6862f502ec3, []int{1}
+}
+func (m *MsgSetAutoRefillResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoRefillResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoRefillResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoRefillResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoRefillResponse.Merge(m, src)
+}
+func (m *MsgSetAutoRefillResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoRefillResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoRefillResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoRefillResponse proto.InternalMessageInfo
+
+// MsgDeleteAutoRefill removes auto refill policy of deployment escrow account
+type MsgDeleteAutoRefill struct {
+	ID v1beta3.DeploymentID `protobuf:"bytes,1,opt,name=id,proto3" json:"id" yaml:"id"`
+}
+
+func (m *MsgDeleteAutoRefill) Reset()         { *m = MsgDeleteAutoRefill{} }
+func (m *MsgDeleteAutoRefill) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteAutoRefill) ProtoMessage()    {}
+func (*MsgDeleteAutoRefill) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb02e6862f502ec3, []int{2}
+}
+func (m *MsgDeleteAutoRefill) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteAutoRefill) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteAutoRefill.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeleteAutoRefill) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteAutoRefill.Merge(m, src)
+}
+func (m *MsgDeleteAutoRefill) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteAutoRefill) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteAutoRefill.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteAutoRefill proto.InternalMessageInfo
+
+func (m *MsgDeleteAutoRefill) GetID() v1beta3.DeploymentID {
+	if m != nil {
+		return m.ID
+	}
+	return v1beta3.DeploymentID{}
+}
+
+// MsgDeleteAutoRefillResponse defines the Msg/DeleteAutoRefill response type.
+type MsgDeleteAutoRefillResponse struct {
+}
+
+func (m *MsgDeleteAutoRefillResponse) Reset()         { *m = MsgDeleteAutoRefillResponse{} }
+func (m *MsgDeleteAutoRefillResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteAutoRefillResponse) ProtoMessage()    {}
+func (*MsgDeleteAutoRefillResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb02e6862f502ec3, []int{3}
+}
+func (m *MsgDeleteAutoRefillResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteAutoRefillResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteAutoRefillResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeleteAutoRefillResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteAutoRefillResponse.Merge(m, src)
+}
+func (m *MsgDeleteAutoRefillResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteAutoRefillResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteAutoRefillResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteAutoRefillResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgSetAutoRefill)(nil), "akash.deployment.v1beta4.MsgSetAutoRefill")
+	proto.RegisterType((*MsgSetAutoRefillResponse)(nil), "akash.deployment.v1beta4.MsgSetAutoRefillResponse")
+	proto.RegisterType((*MsgDeleteAutoRefill)(nil), "akash.deployment.v1beta4.MsgDeleteAutoRefill")
+	proto.RegisterType((*MsgDeleteAutoRefillResponse)(nil), "akash.deployment.v1beta4.MsgDeleteAutoRefillResponse")
+}
+
+func init() {
+	proto.RegisterFile("akash/deployment/v1beta4/refillmsg.proto", fileDescriptor_fb02e6862f502ec3)
+}
+
+var fileDescriptor_fb02e6862f502ec3 = []byte{
+	// 421 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x92, 0x31, 0x6f, 0x13, 0x31,
+	0x14, 0xc7, 0xef, 0x8e, 0x2a, 0x52, 0x8c, 0x90, 0xaa, 0x83, 0xe1, 0x48, 0xd5, 0x73, 0x75, 0x12,
+	0x28, 0x0c, 0xd8, 0x0a, 0x61, 0x40, 0xdd, 0x08, 0x59, 0x3a, 0x74, 0x31, 0x62, 0x61, 0x40, 0xba,
+	0xc4, 0xaf, 0x17, 0xab, 0x77, 0x7e, 0xd1, 0xd9, 0x01, 0xf2, 0x2d, 0xf8, 0x08, 0x7c, 0x9c, 0x8e,
+	0x1d, 0x3b, 0x9d, 0x50, 0xb2, 0xa0, 0x8e, 0xf9, 0x04, 0x28, 0x67, 0xb7, 0xa9, 0x10, 0x15, 0x53,
+	0x37, 0xfb, 0xbd, 0xff, 0xff, 0xe7, 0xbf, 0x9f, 0x1e, 0xe9, 0xe7, 0xe7, 0xb9, 0x99, 0x71, 0x09,
+	0xf3, 0x12, 0x97, 0x15, 0x68, 0xcb, 0xbf, 0x0e, 0x26, 0x60, 0xf3, 0xb7, 0xbc, 0x86, 0x33, 0x55,
+	0x96, 0x95, 0x29, 0xd8, 0xbc, 0x46, 0x8b, 0x71, 0xd2, 0x2a, 0xd9, 0x4e, 0xc9, 0xbc, 0xb2, 0xf7,
+	0xac, 0xc0, 0x02, 0x5b, 0x11, 0xdf, 0x9e, 0x9c, 0xbe, 0x97, 0x4e, 0xd1, 0x54, 0x68, 0xf8, 0x24,
+	0x37, 0xe0, 0xa1, 0x03, 0x3e, 0x45, 0xa5, 0x7d, 0xff, 0xd5, 0x3d, 0x2f, 0x0f, 0xef, 0x94, 0x9c,
+	0x34, 0xbb, 0x8a, 0xc8, 0xfe, 0xa9, 0x29, 0x3e, 0x82, 0x7d, 0xbf, 0xb0, 0x28, 0xda, 0x60, 0xf1,
+	0x27, 0x12, 0x29, 0x99, 0x84, 0x47, 0x61, 0xff, 0xf1, 0x9b, 0x97, 0xec, 0x9e, 0x70, 0x43, 0x36,
+	0xbe, 0x2d, 0x9d, 0x8c, 0x47, 0x87, 0x17, 0x0d, 0x0d, 0x56, 0x0d, 0x8d, 0x4e, 0xc6, 0xd7, 0x0d,
+	0x8d, 0x94, 0xdc, 0x34, 0xb4, 0xbb, 0xcc, 0xab, 0xf2, 0x38, 0x53, 0x32, 0x13, 0x91, 0x92, 0xf1,
+	0x17, 0xd2, 0xb5, 0xb3, 0x1a, 0xcc, 0x0c, 0x4b, 0x99, 0x44, 0x2d, 0xfd, 0x39, 0x73, 0x5f, 0x61,
+	0xdb, 0xaf, 0x78, 0xf0, 0x80, 0x7d, 0x40, 0xa5, 0x47, 0x2f, 0xb6, 0xc0, 0xeb, 0x86, 0xee, 0x3c,
+	0x9b, 0x86, 0xee, 0x3b, 0xe2, 0x6d, 0x29, 0x13, 0xbb, 0x76, 0x2c, 0x48, 0x27, 0xaf, 0x70, 0xa1,
+	0x6d, 0xf2, 0xe8, 0x7f, 0x70, 0xea, 0xe1, 0xde, 0xb0, 0x69, 0xe8, 0x13, 0x47, 0x76, 0xf7, 0x4c,
+	0xf8, 0x46, 0x3c, 0x24, 0x9d, 0xb3, 0x85, 0x96, 0x50, 0x27, 0x7b, 0x47, 0x61, 0xbf, 0x3b, 0x3a,
+	0xd8, 0x9a, 0x5c, 0x65, 0x67, 0x72, 0xf7, 0x4c, 0xf8, 0xc6, 0xf1, 0xde, 0xef, 0x9f, 0x34, 0xc8,
+	0x7a, 0x24, 0xf9, 0x7b, 0xb2, 0x02, 0xcc, 0x1c, 0xb5, 0x81, 0xac, 0x26, 0x4f, 0x4f, 0x4d, 0x31,
+	0x86, 0x12, 0x2c, 0x3c, 0xf8, 0xe0, 0x7d, 0x9e, 0x43, 0x72, 0xf0, 0x8f, 0x37, 0x6f, 0x22, 0x8d,
+	0xc4, 0xc5, 0x2a, 0x0d, 0x2f, 0x57, 0x69, 0xf8, 0x6b, 0x95, 0x86, 0x3f, 0xd6, 0x69, 0x70, 0xb9,
+	0x4e, 0x83, 0xab, 0x75, 0x1a, 0x7c, 0x7e, 0x57, 0x28, 0x3b, 0x5b, 0x4c, 0xd8, 0x14, 0x2b, 0xde,
+	0x66, 0x7a, 0xad, 0xc1, 0x7e, 0xc3, 0xfa, 0x9c, 0x6b, 0x94, 0xc0, 0xbf, 0xdf, 0x5d, 0x34, 0xbb,
+	0x9c, 0x83, 0xb9, 0x59, 0xf4, 0x49, 0xa7, 0x5d, 0xb2, 0xe1, 0x9f, 0x01, 0x00, 0x30, 0xea, 0x28,
+	0x1d, 0x0b, 0x03, 0x00, 0x00,
+}
+
+func (m *MsgSetAutoRefill) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoRefill) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoRefill) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Funder) > 0 {
+		i -= len(m.Funder)
+		copy(dAtA[i:], m.Funder)
+		i = encodeVarintRefillmsg(dAtA, i, uint64(len(m.Funder)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRefillmsg(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Threshold.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRefillmsg(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRefillmsg(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoRefillResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoRefillResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoRefillResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteAutoRefill) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteAutoRefill) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteAutoRefill) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRefillmsg(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteAutoRefillResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteAutoRefillResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteAutoRefillResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRefillmsg(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRefillmsg(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetAutoRefill) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovRefillmsg(uint64(l))
+	l = m.Threshold.Size()
+	n += 1 + l + sovRefillmsg(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovRefillmsg(uint64(l))
+	l = len(m.Funder)
+	if l > 0 {
+		n += 1 + l + sovRefillmsg(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetAutoRefillResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteAutoRefill) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovRefillmsg(uint64(l))
+	return n
+}
+
+func (m *MsgDeleteAutoRefillResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovRefillmsg(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRefillmsg(x uint64) (n int) {
+	return sovRefillmsg(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetAutoRefill) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRefillmsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAutoRefill: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAutoRefill: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRefillmsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRefillmsg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRefillmsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRefillmsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRefillmsg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRefillmsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRefillmsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRefillmsg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRefillmsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRefillmsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRefillmsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRefillmsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRefillmsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRefillmsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAutoRefillResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRefillmsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAutoRefillResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAutoRefillResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRefillmsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRefillmsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteAutoRefill) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRefillmsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteAutoRefill: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteAutoRefill: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRefillmsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRefillmsg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRefillmsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRefillmsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRefillmsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteAutoRefillResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRefillmsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteAutoRefillResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteAutoRefillResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRefillmsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRefillmsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRefillmsg(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRefillmsg
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRefillmsg
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRefillmsg
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRefillmsg
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRefillmsg
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRefillmsg
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRefillmsg        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRefillmsg          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRefillmsg = fmt.Errorf("proto: unexpected end of group")
+)
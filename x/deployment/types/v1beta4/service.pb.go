@@ -0,0 +1,368 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: akash/deployment/v1beta4/service.proto
+
+package v1beta4
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+func init() {
+	proto.RegisterFile("akash/deployment/v1beta4/service.proto", fileDescriptor_2013a754c1800268)
+}
+
+var fileDescriptor_2013a754c1800268 = []byte{
+	// 380 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xc1, 0x6a, 0xea, 0x40,
+	0x18, 0x85, 0x0d, 0x17, 0xee, 0x85, 0x81, 0xcb, 0xbd, 0x64, 0x51, 0xec, 0x2c, 0xb2, 0x2c, 0xad,
+	0xd4, 0x84, 0xaa, 0x2d, 0x85, 0x62, 0xc1, 0x56, 0xe8, 0x4a, 0x28, 0xda, 0x6e, 0xba, 0x8b, 0xf1,
+	0x37, 0x06, 0x63, 0x66, 0x98, 0xf9, 0x63, 0xf5, 0x15, 0xba, 0x12, 0xfa, 0x22, 0x7d, 0x8c, 0x2e,
+	0x5d, 0x76, 0x59, 0xf4, 0x45, 0x0a, 0x51, 0x63, 0x2c, 0x4e, 0xaa, 0xd9, 0x7f, 0xdf, 0x39, 0x67,
+	0x32, 0x61, 0xc8, 0x91, 0xdd, 0xb7, 0x65, 0xcf, 0xea, 0x00, 0xf7, 0xd9, 0x78, 0x00, 0x01, 0x5a,
+	0xc3, 0xb3, 0x36, 0xa0, 0x5d, 0xb1, 0x24, 0x88, 0xa1, 0xe7, 0x80, 0xc9, 0x05, 0x43, 0xa6, 0xe7,
+	0x23, 0xce, 0x5c, 0x73, 0xe6, 0x92, 0xa3, 0x27, 0xca, 0x04, 0x3b, 0x74, 0xd0, 0x63, 0xc1, 0x40,
+	0xba, 0x8b, 0x10, 0x7a, 0xac, 0x44, 0x05, 0x74, 0x3d, 0xdf, 0x5f, 0x93, 0x05, 0x25, 0x89, 0xc2,
+	0x0e, 0x64, 0x17, 0xc4, 0x2e, 0xa9, 0x21, 0xef, 0xd8, 0x08, 0x31, 0x59, 0x7a, 0xfb, 0x43, 0x7e,
+	0x35, 0xa4, 0xab, 0x33, 0xf2, 0xb7, 0x05, 0x58, 0x0b, 0x91, 0x35, 0xa3, 0x5e, 0xbd, 0x60, 0xaa,
+	0x8e, 0x67, 0x36, 0xa4, 0xbb, 0xc1, 0xd2, 0xd2, 0xee, 0x6c, 0x13, 0x24, 0x67, 0x81, 0x04, 0x7d,
+	0x44, 0xfe, 0xd7, 0xc1, 0x07, 0x84, 0x44, 0x67, 0x31, 0x35, 0xe7, 0x3b, 0x4e, 0xcf, 0xf7, 0xc2,
+	0xe3, 0xe6, 0x90, 0xfc, 0x6b, 0x01, 0xde, 0x09, 0x16, 0xf2, 0xda, 0xe2, 0x3a, 0xf4, 0xd3, 0x9f,
+	0x0e, 0x90, 0xa4, 0x69, 0x65, 0x1f, 0x3a, 0xae, 0x7d, 0xd1, 0xc8, 0xc1, 0x63, 0xf4, 0xf5, 0xeb,
+	0xb1, 0x17, 0x81, 0x52, 0x2f, 0xa7, 0x06, 0x6e, 0x97, 0xe8, 0x55, 0x06, 0x29, 0x1e, 0xf3, 0xaa,
+	0x91, 0xc3, 0x7b, 0xc1, 0x38, 0x93, 0x09, 0xe6, 0x61, 0xf9, 0x1f, 0xe9, 0x17, 0xa9, 0xd1, 0x4a,
+	0x8f, 0x5e, 0x67, 0xf3, 0xe2, 0x55, 0x13, 0x8d, 0xe4, 0x6f, 0xed, 0xc0, 0x01, 0x7f, 0xcb, 0xa8,
+	0xf4, 0xdb, 0x56, 0x69, 0xb4, 0x9a, 0x49, 0xdb, 0x98, 0x54, 0x73, 0x1c, 0xe0, 0xb8, 0xf7, 0x24,
+	0x95, 0x46, 0xab, 0x99, 0xb4, 0xd5, 0xa4, 0x9b, 0xe6, 0xfb, 0xcc, 0xd0, 0xa6, 0x33, 0x43, 0xfb,
+	0x9c, 0x19, 0xda, 0x64, 0x6e, 0xe4, 0xa6, 0x73, 0x23, 0xf7, 0x31, 0x37, 0x72, 0x4f, 0x97, 0xae,
+	0x87, 0xbd, 0xb0, 0x6d, 0x3a, 0x6c, 0x60, 0x45, 0x15, 0xc5, 0x00, 0xf0, 0x99, 0x89, 0xbe, 0x15,
+	0xb0, 0x0e, 0x58, 0xa3, 0xe4, 0x83, 0x80, 0x63, 0x0e, 0x72, 0xf5, 0x2c, 0xb4, 0x7f, 0x47, 0xaf,
+	0x41, 0xf9, 0x6b, 0x00, 0xf3, 0xa4, 0xbb, 0xfa, 0xfc, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// SetAutoRefill registers auto refill policy of deployment escrow account.
+	SetAutoRefill(ctx context.Context, in *MsgSetAutoRefill, opts ...grpc.CallOption) (*MsgSetAutoRefillResponse, error)
+	// DeleteAutoRefill removes auto refill policy of deployment escrow account.
+	DeleteAutoRefill(ctx context.Context, in *MsgDeleteAutoRefill, opts ...grpc.CallOption) (*MsgDeleteAutoRefillResponse, error)
+	// SetGroupAuction puts orders of deployment group into reverse auction mode.
+	SetGroupAuction(ctx context.Context, in *MsgSetGroupAuction, opts ...grpc.CallOption) (*MsgSetGroupAuctionResponse, error)
+	// UpdateDeploymentGroups updates deployment version along with its groups.
+	UpdateDeploymentGroups(ctx context.Context, in *MsgUpdateDeploymentGroups, opts ...grpc.CallOption) (*MsgUpdateDeploymentGroupsResponse, error)
+	// ProposeDeploymentTransfer proposes transfer of deployment to new owner.
+	ProposeDeploymentTransfer(ctx context.Context, in *MsgProposeDeploymentTransfer, opts ...grpc.CallOption) (*MsgProposeDeploymentTransferResponse, error)
+	// CancelDeploymentTransfer drops pending transfer of deployment.
+	CancelDeploymentTransfer(ctx context.Context, in *MsgCancelDeploymentTransfer, opts ...grpc.CallOption) (*MsgCancelDeploymentTransferResponse, error)
+	// AcceptDeploymentTransfer moves deployment under its new owner.
+	AcceptDeploymentTransfer(ctx context.Context, in *MsgAcceptDeploymentTransfer, opts ...grpc.CallOption) (*MsgAcceptDeploymentTransferResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SetAutoRefill(ctx context.Context, in *MsgSetAutoRefill, opts ...grpc.CallOption) (*MsgSetAutoRefillResponse, error) {
+	out := new(MsgSetAutoRefillResponse)
+	err := c.cc.Invoke(ctx, "/akash.deployment.v1beta4.Msg/SetAutoRefill", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteAutoRefill(ctx context.Context, in *MsgDeleteAutoRefill, opts ...grpc.CallOption) (*MsgDeleteAutoRefillResponse, error) {
+	out := new(MsgDeleteAutoRefillResponse)
+	err := c.cc.Invoke(ctx, "/akash.deployment.v1beta4.Msg/DeleteAutoRefill", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetGroupAuction(ctx context.Context, in *MsgSetGroupAuction, opts ...grpc.CallOption) (*MsgSetGroupAuctionResponse, error) {
+	out := new(MsgSetGroupAuctionResponse)
+	err := c.cc.Invoke(ctx, "/akash.deployment.v1beta4.Msg/SetGroupAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateDeploymentGroups(ctx context.Context, in *MsgUpdateDeploymentGroups, opts ...grpc.CallOption) (*MsgUpdateDeploymentGroupsResponse, error) {
+	out := new(MsgUpdateDeploymentGroupsResponse)
+	err := c.cc.Invoke(ctx, "/akash.deployment.v1beta4.Msg/UpdateDeploymentGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ProposeDeploymentTransfer(ctx context.Context, in *MsgProposeDeploymentTransfer, opts ...grpc.CallOption) (*MsgProposeDeploymentTransferResponse, error) {
+	out := new(MsgProposeDeploymentTransferResponse)
+	err := c.cc.Invoke(ctx, "/akash.deployment.v1beta4.Msg/ProposeDeploymentTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelDeploymentTransfer(ctx context.Context, in *MsgCancelDeploymentTransfer, opts ...grpc.CallOption) (*MsgCancelDeploymentTransferResponse, error) {
+	out := new(MsgCancelDeploymentTransferResponse)
+	err := c.cc.Invoke(ctx, "/akash.deployment.v1beta4.Msg/CancelDeploymentTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptDeploymentTransfer(ctx context.Context, in *MsgAcceptDeploymentTransfer, opts ...grpc.CallOption) (*MsgAcceptDeploymentTransferResponse, error) {
+	out := new(MsgAcceptDeploymentTransferResponse)
+	err := c.cc.Invoke(ctx, "/akash.deployment.v1beta4.Msg/AcceptDeploymentTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// SetAutoRefill registers auto refill policy of deployment escrow account.
+	SetAutoRefill(context.Context, *MsgSetAutoRefill) (*MsgSetAutoRefillResponse, error)
+	// DeleteAutoRefill removes auto refill policy of deployment escrow account.
+	DeleteAutoRefill(context.Context, *MsgDeleteAutoRefill) (*MsgDeleteAutoRefillResponse, error)
+	// SetGroupAuction puts orders of deployment group into reverse auction mode.
+	SetGroupAuction(context.Context, *MsgSetGroupAuction) (*MsgSetGroupAuctionResponse, error)
+	// UpdateDeploymentGroups updates deployment version along with its groups.
+	UpdateDeploymentGroups(context.Context, *MsgUpdateDeploymentGroups) (*MsgUpdateDeploymentGroupsResponse, error)
+	// ProposeDeploymentTransfer proposes transfer of deployment to new owner.
+	ProposeDeploymentTransfer(context.Context, *MsgProposeDeploymentTransfer) (*MsgProposeDeploymentTransferResponse, error)
+	// CancelDeploymentTransfer drops pending transfer of deployment.
+	CancelDeploymentTransfer(context.Context, *MsgCancelDeploymentTransfer) (*MsgCancelDeploymentTransferResponse, error)
+	// AcceptDeploymentTransfer moves deployment under its new owner.
+	AcceptDeploymentTransfer(context.Context, *MsgAcceptDeploymentTransfer) (*MsgAcceptDeploymentTransferResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) SetAutoRefill(ctx context.Context, req *MsgSetAutoRefill) (*MsgSetAutoRefillResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoRefill not implemented")
+}
+func (*UnimplementedMsgServer) DeleteAutoRefill(ctx context.Context, req *MsgDeleteAutoRefill) (*MsgDeleteAutoRefillResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAutoRefill not implemented")
+}
+func (*UnimplementedMsgServer) SetGroupAuction(ctx context.Context, req *MsgSetGroupAuction) (*MsgSetGroupAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGroupAuction not implemented")
+}
+func (*UnimplementedMsgServer) UpdateDeploymentGroups(ctx context.Context, req *MsgUpdateDeploymentGroups) (*MsgUpdateDeploymentGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDeploymentGroups not implemented")
+}
+func (*UnimplementedMsgServer) ProposeDeploymentTransfer(ctx context.Context, req *MsgProposeDeploymentTransfer) (*MsgProposeDeploymentTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeDeploymentTransfer not implemented")
+}
+func (*UnimplementedMsgServer) CancelDeploymentTransfer(ctx context.Context, req *MsgCancelDeploymentTransfer) (*MsgCancelDeploymentTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelDeploymentTransfer not implemented")
+}
+func (*UnimplementedMsgServer) AcceptDeploymentTransfer(ctx context.Context, req *MsgAcceptDeploymentTransfer) (*MsgAcceptDeploymentTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptDeploymentTransfer not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_SetAutoRefill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoRefill)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoRefill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/akash.deployment.v1beta4.Msg/SetAutoRefill",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoRefill(ctx, req.(*MsgSetAutoRefill))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteAutoRefill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteAutoRefill)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteAutoRefill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/akash.deployment.v1beta4.Msg/DeleteAutoRefill",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteAutoRefill(ctx, req.(*MsgDeleteAutoRefill))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetGroupAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetGroupAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetGroupAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/akash.deployment.v1beta4.Msg/SetGroupAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetGroupAuction(ctx, req.(*MsgSetGroupAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateDeploymentGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDeploymentGroups)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDeploymentGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/akash.deployment.v1beta4.Msg/UpdateDeploymentGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDeploymentGroups(ctx, req.(*MsgUpdateDeploymentGroups))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ProposeDeploymentTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposeDeploymentTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProposeDeploymentTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/akash.deployment.v1beta4.Msg/ProposeDeploymentTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposeDeploymentTransfer(ctx, req.(*MsgProposeDeploymentTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelDeploymentTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelDeploymentTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelDeploymentTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/akash.deployment.v1beta4.Msg/CancelDeploymentTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelDeploymentTransfer(ctx, req.(*MsgCancelDeploymentTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptDeploymentTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptDeploymentTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptDeploymentTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/akash.deployment.v1beta4.Msg/AcceptDeploymentTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptDeploymentTransfer(ctx, req.(*MsgAcceptDeploymentTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "akash.deployment.v1beta4.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetAutoRefill",
+			Handler:    _Msg_SetAutoRefill_Handler,
+		},
+		{
+			MethodName: "DeleteAutoRefill",
+			Handler:    _Msg_DeleteAutoRefill_Handler,
+		},
+		{
+			MethodName: "SetGroupAuction",
+			Handler:    _Msg_SetGroupAuction_Handler,
+		},
+		{
+			MethodName: "UpdateDeploymentGroups",
+			Handler:    _Msg_UpdateDeploymentGroups_Handler,
+		},
+		{
+			MethodName: "ProposeDeploymentTransfer",
+			Handler:    _Msg_ProposeDeploymentTransfer_Handler,
+		},
+		{
+			MethodName: "CancelDeploymentTransfer",
+			Handler:    _Msg_CancelDeploymentTransfer_Handler,
+		},
+		{
+			MethodName: "AcceptDeploymentTransfer",
+			Handler:    _Msg_AcceptDeploymentTransfer_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "akash/deployment/v1beta4/service.proto",
+}
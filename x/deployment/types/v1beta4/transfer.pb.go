@@ -0,0 +1,419 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: akash/deployment/v1beta4/transfer.proto
+
+package v1beta4
+
+import (
+	fmt "fmt"
+	v1beta3 "github.com/akash-network/akash-api/go/node/deployment/v1beta3"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DeploymentTransfer is a pending transfer of deployment ownership.
+// Deployment moves to new_owner once new_owner accepts it.
+type DeploymentTransfer struct {
+	ID         v1beta3.DeploymentID `protobuf:"bytes,1,opt,name=id,proto3" json:"id" yaml:"id"`
+	NewOwner   string               `protobuf:"bytes,2,opt,name=new_owner,json=newOwner,proto3" json:"new_owner" yaml:"new_owner"`
+	ProposedAt int64                `protobuf:"varint,3,opt,name=proposed_at,json=proposedAt,proto3" json:"proposed_at" yaml:"proposed_at"`
+}
+
+func (m *DeploymentTransfer) Reset()         { *m = DeploymentTransfer{} }
+func (m *DeploymentTransfer) String() string { return proto.CompactTextString(m) }
+func (*DeploymentTransfer) ProtoMessage()    {}
+func (*DeploymentTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8ff38096ed9e301, []int{0}
+}
+func (m *DeploymentTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeploymentTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeploymentTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeploymentTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeploymentTransfer.Merge(m, src)
+}
+func (m *DeploymentTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeploymentTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeploymentTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeploymentTransfer proto.InternalMessageInfo
+
+func (m *DeploymentTransfer) GetID() v1beta3.DeploymentID {
+	if m != nil {
+		return m.ID
+	}
+	return v1beta3.DeploymentID{}
+}
+
+func (m *DeploymentTransfer) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
+	}
+	return ""
+}
+
+func (m *DeploymentTransfer) GetProposedAt() int64 {
+	if m != nil {
+		return m.ProposedAt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*DeploymentTransfer)(nil), "akash.deployment.v1beta4.DeploymentTransfer")
+}
+
+func init() {
+	proto.RegisterFile("akash/deployment/v1beta4/transfer.proto", fileDescriptor_a8ff38096ed9e301)
+}
+
+var fileDescriptor_a8ff38096ed9e301 = []byte{
+	// 333 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x3f, 0x4b, 0xfb, 0x40,
+	0x18, 0xc7, 0x73, 0xe9, 0x8f, 0x1f, 0x36, 0x5d, 0x24, 0x38, 0x84, 0x82, 0xb9, 0x1a, 0x50, 0xeb,
+	0xe0, 0x1d, 0x5a, 0x07, 0xe9, 0x20, 0x18, 0x8a, 0xd0, 0x49, 0x08, 0xba, 0xb8, 0x94, 0xab, 0x77,
+	0xb6, 0xa1, 0xed, 0x5d, 0xb8, 0x9c, 0xc6, 0xbe, 0x0b, 0x5f, 0x82, 0x2f, 0xa7, 0x63, 0x47, 0xa7,
+	0x43, 0xd2, 0x45, 0x3a, 0x76, 0x73, 0x93, 0x26, 0xfd, 0x93, 0xa5, 0xdb, 0x73, 0x1f, 0x3e, 0xcf,
+	0xf3, 0x70, 0xdf, 0xc7, 0x3a, 0x25, 0x03, 0x12, 0xf7, 0x31, 0x65, 0xd1, 0x50, 0x8c, 0x47, 0x8c,
+	0x2b, 0xfc, 0x76, 0xd1, 0x65, 0x8a, 0x5c, 0x61, 0x25, 0x09, 0x8f, 0x5f, 0x98, 0x44, 0x91, 0x14,
+	0x4a, 0xd8, 0x4e, 0x26, 0xa2, 0xad, 0x88, 0x56, 0x62, 0xf5, 0xa0, 0x27, 0x7a, 0x22, 0x93, 0xf0,
+	0xb2, 0xca, 0xfd, 0xea, 0xd9, 0x8e, 0xc1, 0x8d, 0x02, 0xca, 0x55, 0xef, 0x17, 0x58, 0x76, 0x6b,
+	0x03, 0x1f, 0x56, 0x7b, 0xed, 0x47, 0xcb, 0x0c, 0xa9, 0x03, 0x6a, 0xa0, 0x5e, 0xb9, 0x3c, 0x41,
+	0x3b, 0xd6, 0x37, 0xd0, 0xb6, 0xb3, 0xdd, 0xf2, 0x0f, 0x27, 0x1a, 0x1a, 0xa9, 0x86, 0x66, 0xbb,
+	0x35, 0xd7, 0xd0, 0x0c, 0xe9, 0x42, 0xc3, 0xf2, 0x98, 0x8c, 0x86, 0x4d, 0x2f, 0xa4, 0x5e, 0x60,
+	0x86, 0xd4, 0xbe, 0xb1, 0xca, 0x9c, 0x25, 0x1d, 0x91, 0x70, 0x26, 0x1d, 0xb3, 0x06, 0xea, 0x65,
+	0xff, 0x68, 0xae, 0xe1, 0x16, 0x2e, 0x34, 0xdc, 0xcf, 0x5b, 0x36, 0xc8, 0x0b, 0xf6, 0x38, 0x4b,
+	0xee, 0x97, 0xa5, 0x7d, 0x67, 0x55, 0x22, 0x29, 0x22, 0x11, 0x33, 0xda, 0x21, 0xca, 0x29, 0xd5,
+	0x40, 0xbd, 0xe4, 0x1f, 0xcf, 0x35, 0x2c, 0xe2, 0x85, 0x86, 0x76, 0x3e, 0xa3, 0x00, 0xbd, 0xc0,
+	0x5a, 0xbf, 0x6e, 0x55, 0xf3, 0xdf, 0xcf, 0x27, 0x34, 0xfc, 0x60, 0x92, 0xba, 0x60, 0x9a, 0xba,
+	0xe0, 0x3b, 0x75, 0xc1, 0xc7, 0xcc, 0x35, 0xa6, 0x33, 0xd7, 0xf8, 0x9a, 0xb9, 0xc6, 0xd3, 0x75,
+	0x2f, 0x54, 0xfd, 0xd7, 0x2e, 0x7a, 0x16, 0x23, 0x9c, 0x7d, 0xfe, 0x9c, 0x33, 0x95, 0x08, 0x39,
+	0xc0, 0x5c, 0x50, 0x86, 0xdf, 0x8b, 0xd1, 0xaa, 0x71, 0xc4, 0xe2, 0xf5, 0xe5, 0xba, 0xff, 0xb3,
+	0x58, 0x1b, 0x7f, 0x03, 0x00, 0x75, 0xe2, 0xa1, 0xb3, 0xdc, 0x01, 0x00, 0x00,
+}
+
+func (m *DeploymentTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeploymentTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeploymentTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposedAt != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.ProposedAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTransfer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTransfer(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTransfer(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DeploymentTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovTransfer(uint64(l))
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if m.ProposedAt != 0 {
+		n += 1 + sovTransfer(uint64(m.ProposedAt))
+	}
+	return n
+}
+
+func sovTransfer(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTransfer(x uint64) (n int) {
+	return sovTransfer(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DeploymentTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeploymentTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeploymentTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedAt", wireType)
+			}
+			m.ProposedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTransfer(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTransfer
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTransfer
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTransfer
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTransfer        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTransfer          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTransfer = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,1143 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: akash/deployment/v1beta4/transfermsg.proto
+
+package v1beta4
+
+import (
+	fmt "fmt"
+	v1beta3 "github.com/akash-network/akash-api/go/node/deployment/v1beta3"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgProposeDeploymentTransfer proposes transfer of deployment to new owner,
+// replacing pending transfer of the deployment if any
+type MsgProposeDeploymentTransfer struct {
+	ID       v1beta3.DeploymentID `protobuf:"bytes,1,opt,name=id,proto3" json:"id" yaml:"id"`
+	NewOwner string               `protobuf:"bytes,2,opt,name=new_owner,json=newOwner,proto3" json:"new_owner" yaml:"new_owner"`
+}
+
+func (m *MsgProposeDeploymentTransfer) Reset()         { *m = MsgProposeDeploymentTransfer{} }
+func (m *MsgProposeDeploymentTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeDeploymentTransfer) ProtoMessage()    {}
+func (*MsgProposeDeploymentTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b352a8526a74f4f4, []int{0}
+}
+func (m *MsgProposeDeploymentTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposeDeploymentTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeDeploymentTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgProposeDeploymentTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeDeploymentTransfer.Merge(m, src)
+}
+func (m *MsgProposeDeploymentTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeDeploymentTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeDeploymentTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProposeDeploymentTransfer proto.InternalMessageInfo
+
+func (m *MsgProposeDeploymentTransfer) GetID() v1beta3.DeploymentID {
+	if m != nil {
+		return m.ID
+	}
+	return v1beta3.DeploymentID{}
+}
+
+func (m *MsgProposeDeploymentTransfer) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
+	}
+	return ""
+}
+
+// MsgProposeDeploymentTransferResponse defines the Msg/ProposeDeploymentTransfer response type.
+type MsgProposeDeploymentTransferResponse struct {
+}
+
+func (m *MsgProposeDeploymentTransferResponse) Reset()         { *m = MsgProposeDeploymentTransferResponse{} }
+func (m *MsgProposeDeploymentTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeDeploymentTransferResponse) ProtoMessage()    {}
+func (*MsgProposeDeploymentTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b352a8526a74f4f4, []int{1}
+}
+func (m *MsgProposeDeploymentTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposeDeploymentTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeDeploymentTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgProposeDeploymentTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeDeploymentTransferResponse.Merge(m, src)
+}
+func (m *MsgProposeDeploymentTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeDeploymentTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeDeploymentTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProposeDeploymentTransferResponse proto.InternalMessageInfo
+
+// MsgCancelDeploymentTransfer drops pending transfer of deployment
+type MsgCancelDeploymentTransfer struct {
+	ID v1beta3.DeploymentID `protobuf:"bytes,1,opt,name=id,proto3" json:"id" yaml:"id"`
+}
+
+func (m *MsgCancelDeploymentTransfer) Reset()         { *m = MsgCancelDeploymentTransfer{} }
+func (m *MsgCancelDeploymentTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelDeploymentTransfer) ProtoMessage()    {}
+func (*MsgCancelDeploymentTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b352a8526a74f4f4, []int{2}
+}
+func (m *MsgCancelDeploymentTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelDeploymentTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelDeploymentTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelDeploymentTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelDeploymentTransfer.Merge(m, src)
+}
+func (m *MsgCancelDeploymentTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelDeploymentTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelDeploymentTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelDeploymentTransfer proto.InternalMessageInfo
+
+func (m *MsgCancelDeploymentTransfer) GetID() v1beta3.DeploymentID {
+	if m != nil {
+		return m.ID
+	}
+	return v1beta3.DeploymentID{}
+}
+
+// MsgCancelDeploymentTransferResponse defines the Msg/CancelDeploymentTransfer response type.
+type MsgCancelDeploymentTransferResponse struct {
+}
+
+func (m *MsgCancelDeploymentTransferResponse) Reset()         { *m = MsgCancelDeploymentTransferResponse{} }
+func (m *MsgCancelDeploymentTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelDeploymentTransferResponse) ProtoMessage()    {}
+func (*MsgCancelDeploymentTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b352a8526a74f4f4, []int{3}
+}
+func (m *MsgCancelDeploymentTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelDeploymentTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelDeploymentTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelDeploymentTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelDeploymentTransferResponse.Merge(m, src)
+}
+func (m *MsgCancelDeploymentTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelDeploymentTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelDeploymentTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelDeploymentTransferResponse proto.InternalMessageInfo
+
+// MsgAcceptDeploymentTransfer moves deployment under new owner of its pending transfer
+type MsgAcceptDeploymentTransfer struct {
+	ID       v1beta3.DeploymentID `protobuf:"bytes,1,opt,name=id,proto3" json:"id" yaml:"id"`
+	NewOwner string               `protobuf:"bytes,2,opt,name=new_owner,json=newOwner,proto3" json:"new_owner" yaml:"new_owner"`
+}
+
+func (m *MsgAcceptDeploymentTransfer) Reset()         { *m = MsgAcceptDeploymentTransfer{} }
+func (m *MsgAcceptDeploymentTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptDeploymentTransfer) ProtoMessage()    {}
+func (*MsgAcceptDeploymentTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b352a8526a74f4f4, []int{4}
+}
+func (m *MsgAcceptDeploymentTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptDeploymentTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptDeploymentTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptDeploymentTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptDeploymentTransfer.Merge(m, src)
+}
+func (m *MsgAcceptDeploymentTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptDeploymentTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptDeploymentTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptDeploymentTransfer proto.InternalMessageInfo
+
+func (m *MsgAcceptDeploymentTransfer) GetID() v1beta3.DeploymentID {
+	if m != nil {
+		return m.ID
+	}
+	return v1beta3.DeploymentID{}
+}
+
+func (m *MsgAcceptDeploymentTransfer) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
+	}
+	return ""
+}
+
+// MsgAcceptDeploymentTransferResponse defines the Msg/AcceptDeploymentTransfer response type.
+type MsgAcceptDeploymentTransferResponse struct {
+}
+
+func (m *MsgAcceptDeploymentTransferResponse) Reset()         { *m = MsgAcceptDeploymentTransferResponse{} }
+func (m *MsgAcceptDeploymentTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptDeploymentTransferResponse) ProtoMessage()    {}
+func (*MsgAcceptDeploymentTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b352a8526a74f4f4, []int{5}
+}
+func (m *MsgAcceptDeploymentTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptDeploymentTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptDeploymentTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptDeploymentTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptDeploymentTransferResponse.Merge(m, src)
+}
+func (m *MsgAcceptDeploymentTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptDeploymentTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptDeploymentTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptDeploymentTransferResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgProposeDeploymentTransfer)(nil), "akash.deployment.v1beta4.MsgProposeDeploymentTransfer")
+	proto.RegisterType((*MsgProposeDeploymentTransferResponse)(nil), "akash.deployment.v1beta4.MsgProposeDeploymentTransferResponse")
+	proto.RegisterType((*MsgCancelDeploymentTransfer)(nil), "akash.deployment.v1beta4.MsgCancelDeploymentTransfer")
+	proto.RegisterType((*MsgCancelDeploymentTransferResponse)(nil), "akash.deployment.v1beta4.MsgCancelDeploymentTransferResponse")
+	proto.RegisterType((*MsgAcceptDeploymentTransfer)(nil), "akash.deployment.v1beta4.MsgAcceptDeploymentTransfer")
+	proto.RegisterType((*MsgAcceptDeploymentTransferResponse)(nil), "akash.deployment.v1beta4.MsgAcceptDeploymentTransferResponse")
+}
+
+func init() {
+	proto.RegisterFile("akash/deployment/v1beta4/transfermsg.proto", fileDescriptor_b352a8526a74f4f4)
+}
+
+var fileDescriptor_b352a8526a74f4f4 = []byte{
+	// 368 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x93, 0xcf, 0x4e, 0xea, 0x40,
+	0x14, 0x87, 0x3b, 0xcd, 0xcd, 0xcd, 0xa5, 0x77, 0x63, 0x88, 0x0b, 0x82, 0xda, 0xc1, 0xaa, 0x04,
+	0x4d, 0xec, 0x44, 0x71, 0x61, 0x58, 0x98, 0x88, 0x6c, 0x58, 0x10, 0x4d, 0xa3, 0x1b, 0x37, 0xa6,
+	0xb4, 0xc7, 0xd2, 0x40, 0x67, 0x9a, 0xce, 0x68, 0xc5, 0xa7, 0xf0, 0x11, 0x7c, 0x0e, 0x7d, 0x01,
+	0x96, 0x2c, 0x5d, 0x35, 0xa6, 0x6c, 0x0c, 0x4b, 0x9e, 0xc0, 0xd0, 0xf2, 0x6f, 0x03, 0x4b, 0x13,
+	0x77, 0x27, 0xa7, 0xdf, 0xe9, 0x7c, 0xbf, 0xcc, 0x19, 0xe5, 0xc0, 0x6c, 0x9b, 0xbc, 0x45, 0x6c,
+	0xf0, 0x3b, 0xac, 0xeb, 0x01, 0x15, 0xe4, 0xf1, 0xa8, 0x09, 0xc2, 0x3c, 0x21, 0x22, 0x30, 0x29,
+	0xbf, 0x87, 0xc0, 0xe3, 0x8e, 0xee, 0x07, 0x4c, 0xb0, 0x6c, 0x2e, 0x61, 0xf5, 0x39, 0xab, 0x4f,
+	0xd8, 0xfc, 0xba, 0xc3, 0x1c, 0x96, 0x40, 0x64, 0x5c, 0xa5, 0x7c, 0x7e, 0x7f, 0xc9, 0xbf, 0xcb,
+	0x0b, 0xad, 0x14, 0xd5, 0xde, 0x91, 0xb2, 0xd9, 0xe0, 0xce, 0x55, 0xc0, 0x7c, 0xc6, 0xa1, 0x36,
+	0xfb, 0x7c, 0x3d, 0x91, 0xc8, 0xde, 0x28, 0xb2, 0x6b, 0xe7, 0x50, 0x01, 0x95, 0xfe, 0x1f, 0x17,
+	0xf5, 0x25, 0x22, 0x65, 0x7d, 0x3e, 0x59, 0xaf, 0x55, 0xb7, 0x7a, 0x11, 0x96, 0xe2, 0x08, 0xcb,
+	0xf5, 0xda, 0x30, 0xc2, 0xb2, 0x6b, 0x8f, 0x22, 0x9c, 0xe9, 0x9a, 0x5e, 0xa7, 0xa2, 0xb9, 0xb6,
+	0x66, 0xc8, 0xae, 0x9d, 0x3d, 0x53, 0x32, 0x14, 0xc2, 0x3b, 0x16, 0x52, 0x08, 0x72, 0x72, 0x01,
+	0x95, 0x32, 0xd5, 0xed, 0x61, 0x84, 0xe7, 0xcd, 0x51, 0x84, 0xd7, 0xd2, 0x91, 0x59, 0x4b, 0x33,
+	0xfe, 0x51, 0x08, 0x2f, 0xc7, 0x65, 0xe5, 0xcf, 0xd7, 0x2b, 0x96, 0xb4, 0xa2, 0xb2, 0xbb, 0x4a,
+	0xde, 0x00, 0xee, 0x33, 0xca, 0x41, 0x7b, 0x56, 0x36, 0x1a, 0xdc, 0xb9, 0x30, 0xa9, 0x05, 0x9d,
+	0x1f, 0xcb, 0x38, 0x71, 0xdc, 0x53, 0x76, 0x56, 0x9c, 0x3d, 0x53, 0x7c, 0x43, 0x89, 0xe3, 0xb9,
+	0x65, 0x81, 0x2f, 0x7e, 0xdb, 0x3d, 0xa4, 0x19, 0x97, 0xb9, 0x4f, 0x33, 0x56, 0x8d, 0x5e, 0xac,
+	0xa2, 0x7e, 0xac, 0xa2, 0xcf, 0x58, 0x45, 0x2f, 0x03, 0x55, 0xea, 0x0f, 0x54, 0xe9, 0x63, 0xa0,
+	0x4a, 0xb7, 0xa7, 0x8e, 0x2b, 0x5a, 0x0f, 0x4d, 0xdd, 0x62, 0x1e, 0x49, 0xb2, 0x1d, 0x52, 0x10,
+	0x21, 0x0b, 0xda, 0x84, 0x32, 0x1b, 0xc8, 0xd3, 0xe2, 0x2e, 0x8b, 0xae, 0x0f, 0x7c, 0xfa, 0x5a,
+	0x9a, 0x7f, 0x93, 0x3d, 0x2e, 0x7f, 0x0f, 0x00, 0x86, 0xe8, 0x15, 0x80, 0x50, 0x03, 0x00, 0x00,
+}
+
+func (m *MsgProposeDeploymentTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeDeploymentTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeDeploymentTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTransfermsg(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTransfermsg(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposeDeploymentTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeDeploymentTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeDeploymentTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelDeploymentTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelDeploymentTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelDeploymentTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTransfermsg(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelDeploymentTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelDeploymentTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelDeploymentTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptDeploymentTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptDeploymentTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptDeploymentTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTransfermsg(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTransfermsg(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptDeploymentTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptDeploymentTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptDeploymentTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTransfermsg(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTransfermsg(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgProposeDeploymentTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovTransfermsg(uint64(l))
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTransfermsg(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgProposeDeploymentTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelDeploymentTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovTransfermsg(uint64(l))
+	return n
+}
+
+func (m *MsgCancelDeploymentTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAcceptDeploymentTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovTransfermsg(uint64(l))
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTransfermsg(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcceptDeploymentTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTransfermsg(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTransfermsg(x uint64) (n int) {
+	return sovTransfermsg(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgProposeDeploymentTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfermsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposeDeploymentTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposeDeploymentTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfermsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransfermsg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfermsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfermsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfermsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfermsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfermsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfermsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposeDeploymentTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfermsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposeDeploymentTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposeDeploymentTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfermsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfermsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelDeploymentTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfermsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelDeploymentTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelDeploymentTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfermsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransfermsg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfermsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfermsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfermsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelDeploymentTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfermsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelDeploymentTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelDeploymentTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfermsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfermsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptDeploymentTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfermsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptDeploymentTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptDeploymentTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfermsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransfermsg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfermsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfermsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfermsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfermsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfermsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfermsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptDeploymentTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfermsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptDeploymentTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptDeploymentTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfermsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfermsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTransfermsg(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTransfermsg
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTransfermsg
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTransfermsg
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTransfermsg
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTransfermsg
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTransfermsg
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTransfermsg        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTransfermsg          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTransfermsg = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,569 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: akash/deployment/v1beta4/updatemsg.proto
+
+package v1beta4
+
+import (
+	fmt "fmt"
+	v1beta3 "github.com/akash-network/akash-api/go/node/deployment/v1beta3"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgUpdateDeploymentGroups updates version of deployment and reconciles its groups with given specs
+type MsgUpdateDeploymentGroups struct {
+	ID      v1beta3.DeploymentID `protobuf:"bytes,1,opt,name=id,proto3" json:"id" yaml:"id"`
+	Groups  []v1beta3.GroupSpec  `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups" yaml:"groups"`
+	Version []byte               `protobuf:"bytes,3,opt,name=version,proto3" json:"version" yaml:"version"`
+}
+
+func (m *MsgUpdateDeploymentGroups) Reset()         { *m = MsgUpdateDeploymentGroups{} }
+func (m *MsgUpdateDeploymentGroups) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateDeploymentGroups) ProtoMessage()    {}
+func (*MsgUpdateDeploymentGroups) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a20df7e97f79546c, []int{0}
+}
+func (m *MsgUpdateDeploymentGroups) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateDeploymentGroups) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateDeploymentGroups.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateDeploymentGroups) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateDeploymentGroups.Merge(m, src)
+}
+func (m *MsgUpdateDeploymentGroups) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateDeploymentGroups) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateDeploymentGroups.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateDeploymentGroups proto.InternalMessageInfo
+
+func (m *MsgUpdateDeploymentGroups) GetID() v1beta3.DeploymentID {
+	if m != nil {
+		return m.ID
+	}
+	return v1beta3.DeploymentID{}
+}
+
+func (m *MsgUpdateDeploymentGroups) GetGroups() []v1beta3.GroupSpec {
+	if m != nil {
+		return m.Groups
+	}
+	return nil
+}
+
+func (m *MsgUpdateDeploymentGroups) GetVersion() []byte {
+	if m != nil {
+		return m.Version
+	}
+	return nil
+}
+
+// MsgUpdateDeploymentGroupsResponse defines the Msg/UpdateDeploymentGroups response type.
+type MsgUpdateDeploymentGroupsResponse struct {
+}
+
+func (m *MsgUpdateDeploymentGroupsResponse) Reset()         { *m = MsgUpdateDeploymentGroupsResponse{} }
+func (m *MsgUpdateDeploymentGroupsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateDeploymentGroupsResponse) ProtoMessage()    {}
+func (*MsgUpdateDeploymentGroupsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a20df7e97f79546c, []int{1}
+}
+func (m *MsgUpdateDeploymentGroupsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateDeploymentGroupsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateDeploymentGroupsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateDeploymentGroupsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateDeploymentGroupsResponse.Merge(m, src)
+}
+func (m *MsgUpdateDeploymentGroupsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateDeploymentGroupsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateDeploymentGroupsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateDeploymentGroupsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateDeploymentGroups)(nil), "akash.deployment.v1beta4.MsgUpdateDeploymentGroups")
+	proto.RegisterType((*MsgUpdateDeploymentGroupsResponse)(nil), "akash.deployment.v1beta4.MsgUpdateDeploymentGroupsResponse")
+}
+
+func init() {
+	proto.RegisterFile("akash/deployment/v1beta4/updatemsg.proto", fileDescriptor_a20df7e97f79546c)
+}
+
+var fileDescriptor_a20df7e97f79546c = []byte{
+	// 359 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xb1, 0x6e, 0xea, 0x30,
+	0x18, 0x85, 0x93, 0x70, 0xc5, 0xd5, 0x0d, 0xf7, 0xde, 0x21, 0xea, 0x90, 0x22, 0x11, 0xd3, 0x20,
+	0x55, 0xe9, 0xd0, 0x58, 0x85, 0x4a, 0xad, 0x18, 0x23, 0xa4, 0x8a, 0xa1, 0x4b, 0x2a, 0x96, 0xaa,
+	0x4b, 0xc0, 0x56, 0x88, 0x20, 0xb1, 0x15, 0x1b, 0x5a, 0x9e, 0xa2, 0x7d, 0x84, 0x3e, 0x0e, 0x23,
+	0x63, 0x27, 0xab, 0x0a, 0x4b, 0xc5, 0xc8, 0x13, 0x54, 0x38, 0xa1, 0xb0, 0x64, 0x4b, 0xce, 0xff,
+	0x9d, 0xa3, 0x63, 0xff, 0xd6, 0x9d, 0x60, 0x12, 0xb0, 0x31, 0x44, 0x98, 0x4e, 0xc9, 0x22, 0xc6,
+	0x09, 0x87, 0xf3, 0xab, 0x21, 0xe6, 0xc1, 0x35, 0x9c, 0x51, 0x14, 0x70, 0x1c, 0xb3, 0xd0, 0xa5,
+	0x29, 0xe1, 0xc4, 0x30, 0x25, 0xe9, 0x1e, 0x48, 0xb7, 0x20, 0xeb, 0x27, 0x21, 0x09, 0x89, 0x84,
+	0xe0, 0xee, 0x2b, 0xe7, 0xeb, 0x17, 0x25, 0xc9, 0x9d, 0x23, 0xa9, 0x40, 0x9d, 0x52, 0x34, 0x4c,
+	0xc9, 0x8c, 0x32, 0x8a, 0x47, 0x39, 0x69, 0xbf, 0x6a, 0xfa, 0xe9, 0x3d, 0x0b, 0x07, 0xb2, 0x5b,
+	0xef, 0x87, 0xbf, 0x93, 0x98, 0x31, 0xd0, 0xb5, 0x08, 0x99, 0x6a, 0x53, 0x75, 0x6a, 0xed, 0x73,
+	0xb7, 0xa4, 0x6f, 0xc7, 0x3d, 0xf8, 0xfa, 0x3d, 0xaf, 0xb1, 0x14, 0x40, 0xc9, 0x04, 0xd0, 0xfa,
+	0xbd, 0x8d, 0x00, 0x5a, 0x84, 0xb6, 0x02, 0xfc, 0x59, 0x04, 0xf1, 0xb4, 0x6b, 0x47, 0xc8, 0xf6,
+	0xb5, 0x08, 0x19, 0x4f, 0x7a, 0x35, 0xef, 0x61, 0x6a, 0xcd, 0x8a, 0x53, 0x6b, 0xb7, 0xca, 0xa3,
+	0x65, 0x91, 0x07, 0x8a, 0x47, 0x1e, 0xd8, 0xe5, 0x6e, 0x04, 0x28, 0xac, 0x5b, 0x01, 0xfe, 0xe5,
+	0xa9, 0xf9, 0xbf, 0xed, 0x17, 0x03, 0xe3, 0x46, 0xff, 0x3d, 0xc7, 0x29, 0x8b, 0x48, 0x62, 0x56,
+	0x9a, 0xaa, 0xf3, 0xd7, 0x6b, 0x6c, 0x04, 0xd8, 0x4b, 0x5b, 0x01, 0xfe, 0xe7, 0xb6, 0x42, 0xb0,
+	0xfd, 0xfd, 0xa8, 0xfb, 0xeb, 0xeb, 0x1d, 0x28, 0x76, 0x4b, 0x3f, 0x2b, 0xbd, 0x10, 0x1f, 0x33,
+	0x4a, 0x12, 0x86, 0x3d, 0x7f, 0x99, 0x59, 0xea, 0x2a, 0xb3, 0xd4, 0xcf, 0xcc, 0x52, 0xdf, 0xd6,
+	0x96, 0xb2, 0x5a, 0x5b, 0xca, 0xc7, 0xda, 0x52, 0x1e, 0x6f, 0xc3, 0x88, 0x8f, 0x67, 0x43, 0x77,
+	0x44, 0x62, 0x28, 0x4f, 0x75, 0x99, 0x60, 0xfe, 0x4c, 0xd2, 0x09, 0x4c, 0x08, 0xc2, 0xf0, 0xe5,
+	0x78, 0x29, 0x7c, 0x41, 0x31, 0xdb, 0xbf, 0x8f, 0x61, 0x55, 0x6e, 0xa4, 0xf3, 0x3d, 0x00, 0x95,
+	0x30, 0x75, 0xc0, 0x42, 0x02, 0x00, 0x00,
+}
+
+func (m *MsgUpdateDeploymentGroups) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDeploymentGroups) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDeploymentGroups) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintUpdatemsg(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Groups) > 0 {
+		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Groups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintUpdatemsg(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUpdatemsg(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDeploymentGroupsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDeploymentGroupsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDeploymentGroupsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintUpdatemsg(dAtA []byte, offset int, v uint64) int {
+	offset -= sovUpdatemsg(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateDeploymentGroups) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovUpdatemsg(uint64(l))
+	if len(m.Groups) > 0 {
+		for _, e := range m.Groups {
+			l = e.Size()
+			n += 1 + l + sovUpdatemsg(uint64(l))
+		}
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovUpdatemsg(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateDeploymentGroupsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovUpdatemsg(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozUpdatemsg(x uint64) (n int) {
+	return sovUpdatemsg(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateDeploymentGroups) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpdatemsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDeploymentGroups: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDeploymentGroups: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpdatemsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUpdatemsg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpdatemsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpdatemsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUpdatemsg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpdatemsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Groups = append(m.Groups, v1beta3.GroupSpec{})
+			if err := m.Groups[len(m.Groups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpdatemsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthUpdatemsg
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpdatemsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = append(m.Version[:0], dAtA[iNdEx:postIndex]...)
+			if m.Version == nil {
+				m.Version = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpdatemsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpdatemsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDeploymentGroupsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpdatemsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDeploymentGroupsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDeploymentGroupsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpdatemsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpdatemsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipUpdatemsg(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowUpdatemsg
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowUpdatemsg
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowUpdatemsg
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthUpdatemsg
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupUpdatemsg
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthUpdatemsg
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthUpdatemsg        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowUpdatemsg          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupUpdatemsg = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,43 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	types "github.com/akash-network/akash-api/go/node/escrow/v1beta3"
+)
+
+// AddAccountIDFlags add flags for escrow account id
+func AddAccountIDFlags(flags *pflag.FlagSet) {
+	flags.String("scope", "", "Escrow account scope")
+	flags.String("xid", "", "Escrow account xid")
+}
+
+// MarkReqAccountIDFlags marks flags required for escrow account id
+func MarkReqAccountIDFlags(cmd *cobra.Command) {
+	_ = cmd.MarkFlagRequired("scope")
+	_ = cmd.MarkFlagRequired("xid")
+}
+
+// AccountIDFromFlags returns AccountID with given flags and error if occurred
+func AccountIDFromFlags(flags *pflag.FlagSet) (types.AccountID, error) {
+	var id types.AccountID
+	var err error
+
+	if id.Scope, err = flags.GetString("scope"); err != nil {
+		return id, err
+	}
+
+	if id.XID, err = flags.GetString("xid"); err != nil {
+		return id, err
+	}
+
+	return id, nil
+}
+
+// AddFilterFlags add flags shared by accounts and payments filters
+func AddFilterFlags(flags *pflag.FlagSet) {
+	AddAccountIDFlags(flags)
+	flags.String("owner", "", "account or payment owner address value as filter")
+	flags.String("state", "", "account or payment state value as filter (open|closed|overdrawn)")
+}
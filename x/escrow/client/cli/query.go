@@ -3,6 +3,7 @@ package cli
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"time"
 
 	sdkclient "github.com/cosmos/cosmos-sdk/client"
@@ -19,6 +20,8 @@ import (
 	netutil "github.com/akash-network/node/util/network"
 	"github.com/akash-network/node/x/deployment/client/cli"
 	"github.com/akash-network/node/x/escrow/client/util"
+	"github.com/akash-network/node/x/escrow/query"
+	ev1beta4 "github.com/akash-network/node/x/escrow/types/v1beta4"
 )
 
 func GetQueryCmd() *cobra.Command {
@@ -31,6 +34,12 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		cmdBlocksRemaining(),
+		cmdGetAccounts(),
+		cmdGetPayments(),
+		cmdSettlePreview(),
+		cmdDepleting(),
+		cmdAutoRefill(),
+		cmdAccountBalances(),
 	)
 
 	return cmd
@@ -140,3 +149,252 @@ func cmdBlocksRemaining() *cobra.Command {
 	cli.MarkReqDeploymentIDFlags(cmd)
 	return cmd
 }
+
+func cmdGetAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accounts",
+		Short: "Query for escrow accounts",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := AccountIDFromFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			owner, err := cmd.Flags().GetString("owner")
+			if err != nil {
+				return err
+			}
+
+			state, err := cmd.Flags().GetString("state")
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := sdkclient.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(cctx).Accounts(cmd.Context(), &types.QueryAccountsRequest{
+				Scope:      id.Scope,
+				Xid:        id.XID,
+				Owner:      owner,
+				State:      state,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return cctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "accounts")
+	AddFilterFlags(cmd.Flags())
+
+	return cmd
+}
+
+func cmdGetPayments() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "payments",
+		Short: "Query for escrow payments",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := AccountIDFromFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			pid, err := cmd.Flags().GetString("pid")
+			if err != nil {
+				return err
+			}
+
+			owner, err := cmd.Flags().GetString("owner")
+			if err != nil {
+				return err
+			}
+
+			state, err := cmd.Flags().GetString("state")
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := sdkclient.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(cctx).Payments(cmd.Context(), &types.QueryPaymentsRequest{
+				Scope:      id.Scope,
+				Xid:        id.XID,
+				Id:         pid,
+				Owner:      owner,
+				State:      state,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return cctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "payments")
+	AddFilterFlags(cmd.Flags())
+	cmd.Flags().String("pid", "", "payment id value as filter")
+
+	return cmd
+}
+
+func cmdSettlePreview() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "settle-preview",
+		Short: "Preview settlement of an escrow account at current height without modifying it",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := AccountIDFromFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := ev1beta4.NewQueryClient(cctx).SettlePreview(cmd.Context(), &ev1beta4.QuerySettlePreviewRequest{ID: id})
+			if err != nil {
+				return err
+			}
+
+			return cctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	AddAccountIDFlags(cmd.Flags())
+	MarkReqAccountIDFlags(cmd)
+
+	return cmd
+}
+
+func cmdDepleting() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "depleting",
+		Short: "Query for escrow accounts running out of funds within given number of blocks",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			blocks, err := cmd.Flags().GetInt64("blocks")
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := sdkclient.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := ev1beta4.NewQueryClient(cctx).Depleting(cmd.Context(), &ev1beta4.QueryDepletingRequest{
+				Blocks:     blocks,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return cctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "depleting")
+	cmd.Flags().Int64("blocks", 0, "number of blocks from current height")
+
+	return cmd
+}
+
+func cmdAutoRefill() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auto-refill",
+		Short: "Query auto refill policy of an escrow account",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := AccountIDFromFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cctx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.StoreKey, query.AutoRefillPath(id)), nil)
+			if err != nil {
+				return err
+			}
+
+			return cctx.PrintBytes(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	AddAccountIDFlags(cmd.Flags())
+	MarkReqAccountIDFlags(cmd)
+
+	return cmd
+}
+
+func cmdAccountBalances() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "balances",
+		Short: "Query escrow account balances in all denominations it holds",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := AccountIDFromFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := ev1beta4.NewQueryClient(cctx).AccountBalances(cmd.Context(), &ev1beta4.QueryAccountBalancesRequest{ID: id})
+			if err != nil {
+				return err
+			}
+
+			return cctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	AddAccountIDFlags(cmd.Flags())
+	MarkReqAccountIDFlags(cmd)
+
+	return cmd
+}
@@ -1,6 +1,8 @@
 package util
 
 import (
+	"math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -11,3 +13,18 @@ func LeaseCalcBalanceRemain(balance sdk.Dec, currBlock, settledAt int64, leasePr
 func LeaseCalcBlocksRemain(balance float64, leasePrice sdk.Dec) int64 {
 	return int64(balance / leasePrice.MustFloat64())
 }
+
+// LeaseCalcBlocksCovered returns number of full blocks balance pays for at given block rate,
+// the same way escrow settles accounts
+func LeaseCalcBlocksCovered(balance sdk.Dec, blockRate sdk.Dec) int64 {
+	if !blockRate.IsPositive() {
+		return 0
+	}
+
+	blocks := balance.Quo(blockRate).TruncateInt()
+	if !blocks.IsInt64() {
+		return math.MaxInt64
+	}
+
+	return blocks.Int64()
+}
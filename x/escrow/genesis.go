@@ -11,10 +11,12 @@ import (
 	"github.com/akash-network/node/x/escrow/keeper"
 
 	types "github.com/akash-network/akash-api/go/node/escrow/v1beta3"
+
+	ev1beta4 "github.com/akash-network/node/x/escrow/types/v1beta4"
 )
 
 // ValidateGenesis does validation check of the Genesis and returns error in case of failure
-func ValidateGenesis(data *types.GenesisState) error {
+func ValidateGenesis(data *ev1beta4.GenesisState) error {
 	amap := make(map[types.AccountID]types.Account, len(data.Accounts))
 	pmap := make(map[types.AccountID][]types.FractionalPayment, len(data.Payments))
 
@@ -61,23 +63,73 @@ func ValidateGenesis(data *types.GenesisState) error {
 		pmap[payment.AccountID] = append(pmap[payment.AccountID], payment)
 	}
 
+	rmap := make(map[types.AccountID]bool, len(data.AutoRefills))
+
+	for idx, refill := range data.AutoRefills {
+		if err := refill.Policy.ValidateBasic(); err != nil {
+			return fmt.Errorf("%w: error with auto refill %s (idx %v)", err, refill.ID, idx)
+		}
+
+		if _, found := amap[refill.ID]; !found {
+			return fmt.Errorf("%w: no account for auto refill %s (idx %v)", types.ErrAccountNotFound, refill.ID, idx)
+		}
+
+		if rmap[refill.ID] {
+			return fmt.Errorf("%w: duplicate auto refill %s (idx %v)", ev1beta4.ErrInvalidAutoRefill, refill.ID, idx)
+		}
+
+		rmap[refill.ID] = true
+	}
+
+	bmap := make(map[types.AccountID]bool, len(data.Balances))
+
+	for idx, balances := range data.Balances {
+		if err := balances.ValidateBasic(); err != nil {
+			return fmt.Errorf("%w: error with balances %s (idx %v)", err, balances.ID, idx)
+		}
+
+		account, found := amap[balances.ID]
+		if !found {
+			return fmt.Errorf("%w: no account for balances %s (idx %v)", types.ErrAccountNotFound, balances.ID, idx)
+		}
+
+		for _, balance := range balances.Balances {
+			if balance.Denom() == account.Balance.Denom {
+				return fmt.Errorf("%w: balances %s repeat account denomination (idx %v)", types.ErrInvalidDenomination, balances.ID, idx)
+			}
+		}
+
+		if bmap[balances.ID] {
+			return fmt.Errorf("%w: duplicate balances %s (idx %v)", types.ErrInvalidAccount, balances.ID, idx)
+		}
+
+		bmap[balances.ID] = true
+	}
+
 	return nil
 }
 
 // InitGenesis initiate genesis state and return updated validator details
-func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState) []abci.ValidatorUpdate {
+func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *ev1beta4.GenesisState) []abci.ValidatorUpdate {
 	for idx := range data.Accounts {
 		keeper.SaveAccount(ctx, data.Accounts[idx])
 	}
 	for idx := range data.Payments {
 		keeper.SavePayment(ctx, data.Payments[idx])
 	}
+	for idx := range data.AutoRefills {
+		keeper.SaveAccountAutoRefill(ctx, data.AutoRefills[idx])
+	}
+	for idx := range data.Balances {
+		keeper.SaveAccountBalances(ctx, data.Balances[idx])
+	}
+	keeper.ReindexAccounts(ctx)
 	return []abci.ValidatorUpdate{}
 }
 
 // ExportGenesis returns genesis state as raw bytes for the provider module
-func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
-	state := &types.GenesisState{}
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *ev1beta4.GenesisState {
+	state := &ev1beta4.GenesisState{}
 	k.WithAccounts(ctx, func(obj types.Account) bool {
 		state.Accounts = append(state.Accounts, obj)
 		return false
@@ -86,19 +138,27 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		state.Payments = append(state.Payments, obj)
 		return false
 	})
+	k.WithAutoRefills(ctx, func(obj ev1beta4.AccountAutoRefill) bool {
+		state.AutoRefills = append(state.AutoRefills, obj)
+		return false
+	})
+	k.WithAccountBalances(ctx, func(obj ev1beta4.AccountBalances) bool {
+		state.Balances = append(state.Balances, obj)
+		return false
+	})
 	return state
 }
 
 // DefaultGenesisState returns default genesis state as raw bytes for the provider
 // module.
-func DefaultGenesisState() *types.GenesisState {
-	return &types.GenesisState{}
+func DefaultGenesisState() *ev1beta4.GenesisState {
+	return &ev1beta4.GenesisState{}
 }
 
 // GetGenesisStateFromAppState returns x/escrow GenesisState given raw application
 // genesis state.
-func GetGenesisStateFromAppState(cdc codec.JSONCodec, appState map[string]json.RawMessage) *types.GenesisState {
-	var genesisState types.GenesisState
+func GetGenesisStateFromAppState(cdc codec.JSONCodec, appState map[string]json.RawMessage) *ev1beta4.GenesisState {
+	var genesisState ev1beta4.GenesisState
 
 	if appState[ModuleName] != nil {
 		cdc.MustUnmarshalJSON(appState[ModuleName], &genesisState)
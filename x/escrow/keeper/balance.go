@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	types "github.com/akash-network/akash-api/go/node/escrow/v1beta3"
+
+	ev1beta4 "github.com/akash-network/node/x/escrow/types/v1beta4"
+)
+
+// Escrow account keeps the balance in the denomination it was created with in types.Account.
+// Balances in other denominations are stored aside, see ev1beta4.AccountBalances.
+// Keeper settles each denomination separately with payments priced in it. To reuse single denomination
+// settlement, account is expanded into views: copies of the account each holding balance of one denomination,
+// the account itself being the first one.
+
+// AccountDenoms returns denominations held by the account, the one account was created with first
+func (k *keeper) AccountDenoms(ctx sdk.Context, id types.AccountID) ([]string, error) {
+	account, err := k.GetAccount(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	views := k.accountViews(ctx, account)
+
+	denoms := make([]string, 0, len(views))
+	for _, view := range views {
+		denoms = append(denoms, view.Balance.Denom)
+	}
+
+	return denoms, nil
+}
+
+// GetAccountBalances returns balances of the account in denominations other than the one account was created with
+func (k *keeper) GetAccountBalances(ctx sdk.Context, id types.AccountID) ev1beta4.AccountBalances {
+	obj := ev1beta4.AccountBalances{ID: id}
+
+	if buf := ctx.KVStore(k.skey).Get(balancesKey(id)); buf != nil {
+		k.cdc.MustUnmarshal(buf, &obj)
+	}
+
+	return obj
+}
+
+// SaveAccountBalances stores balances of the account in additional denominations as is
+func (k *keeper) SaveAccountBalances(ctx sdk.Context, obj ev1beta4.AccountBalances) {
+	store := ctx.KVStore(k.skey)
+
+	if len(obj.Balances) == 0 {
+		store.Delete(balancesKey(obj.ID))
+		return
+	}
+
+	store.Set(balancesKey(obj.ID), k.cdc.MustMarshal(&obj))
+}
+
+// WithAccountBalances iterates balances of all accounts holding additional denominations
+func (k *keeper) WithAccountBalances(ctx sdk.Context, fn func(ev1beta4.AccountBalances) bool) {
+	store := ctx.KVStore(k.skey)
+	iter := sdk.KVStorePrefixIterator(store, balancesPrefix())
+
+	defer func() {
+		_ = iter.Close()
+	}()
+
+	for ; iter.Valid(); iter.Next() {
+		var val ev1beta4.AccountBalances
+		k.cdc.MustUnmarshal(iter.Value(), &val)
+		if stop := fn(val); stop {
+			break
+		}
+	}
+}
+
+// accountViews expands account into views, one per denomination it holds
+func (k *keeper) accountViews(ctx sdk.Context, account types.Account) []types.Account {
+	balances := k.GetAccountBalances(ctx, account.ID).Balances
+
+	views := make([]types.Account, 0, len(balances)+1)
+	views = append(views, account)
+
+	for _, balance := range balances {
+		view := account
+		view.Balance = balance.Balance
+		view.Transferred = balance.Transferred
+		view.Funds = balance.Funds
+
+		views = append(views, view)
+	}
+
+	return views
+}
+
+// saveAccountViews stores account from the first view and balances from the rest of them
+func (k *keeper) saveAccountViews(ctx sdk.Context, views []types.Account) {
+	k.saveAccount(ctx, &views[0])
+
+	k.SaveAccountBalances(ctx, ev1beta4.AccountBalances{
+		ID:       views[0].ID,
+		Balances: accountViewBalances(views),
+	})
+}
+
+// accountViewBalances returns balances of all views but the first one
+func accountViewBalances(views []types.Account) ev1beta4.DenomBalances {
+	balances := make(ev1beta4.DenomBalances, 0, len(views)-1)
+
+	for _, view := range views[1:] {
+		balances = append(balances, ev1beta4.DenomBalance{
+			Balance:     view.Balance,
+			Transferred: view.Transferred,
+			Funds:       view.Funds,
+		})
+	}
+
+	return balances
+}
+
+// accountViewIndex returns index of the view holding given denomination
+func accountViewIndex(views []types.Account, denom string) int {
+	for idx := range views {
+		if views[idx].Balance.Denom == denom {
+			return idx
+		}
+	}
+
+	return -1
+}
+
+// accountViewPayments returns indexes of payments priced in denomination of given view
+func accountViewPayments(view types.Account, payments []types.FractionalPayment) []int {
+	var idxs []int
+
+	for idx := range payments {
+		if payments[idx].Rate.Denom == view.Balance.Denom {
+			idxs = append(idxs, idx)
+		}
+	}
+
+	return idxs
+}
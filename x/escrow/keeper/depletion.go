@@ -0,0 +1,171 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	types "github.com/akash-network/akash-api/go/node/escrow/v1beta3"
+)
+
+// DepletingAccount is an open account along with the last height its balance
+// covers all open payments
+type DepletingAccount struct {
+	Account         types.Account `json:"account"`
+	DepletionHeight int64         `json:"depletion_height"`
+}
+
+// WithDepletingAccounts iterates open accounts with projected depletion height lower or equal
+// to given height, ordered by depletion height
+func (k *keeper) WithDepletingAccounts(ctx sdk.Context, height int64, fn func(DepletingAccount) bool) {
+	if height < 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.skey)
+
+	end := sdk.PrefixEndBytes(depletionIndexHeightKey(height))
+	if height == math.MaxInt64 {
+		end = sdk.PrefixEndBytes(depletionIndexPrefix())
+	}
+
+	iter := store.Iterator(depletionIndexPrefix(), end)
+
+	defer func() {
+		_ = iter.Close()
+	}()
+
+	for ; iter.Valid(); iter.Next() {
+		var account types.Account
+		k.cdc.MustUnmarshal(store.Get(accountKey(accountIDFromDepletionIndexKey(iter.Key()))), &account)
+
+		val := DepletingAccount{
+			Account:         account,
+			DepletionHeight: int64(binary.BigEndian.Uint64(iter.Value())),
+		}
+
+		if stop := fn(val); stop {
+			break
+		}
+	}
+}
+
+// ReindexAccounts rebuilds depletion index for all accounts
+func (k *keeper) ReindexAccounts(ctx sdk.Context) {
+	store := ctx.KVStore(k.skey)
+
+	for _, prefix := range [][]byte{depletionIndexPrefix(), accountDepletionPrefix()} {
+		iter := sdk.KVStorePrefixIterator(store, prefix)
+
+		var keys [][]byte
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+
+		_ = iter.Close()
+
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+
+	var accounts []types.Account
+	k.WithAccounts(ctx, func(account types.Account) bool {
+		if account.State == types.AccountOpen {
+			accounts = append(accounts, account)
+		}
+		return false
+	})
+
+	for _, account := range accounts {
+		k.updateDepletionIndex(ctx, account, k.accountOpenPayments(ctx, account.ID))
+	}
+}
+
+// updateDepletionIndex places account in depletion index according to its balances and
+// open payments, the denomination running out first decides. Accounts that are not open or do not have open payments are removed from the index.
+func (k *keeper) updateDepletionIndex(ctx sdk.Context, account types.Account, payments []types.FractionalPayment) {
+	k.removeDepletionIndex(ctx, account.ID)
+
+	if account.State != types.AccountOpen {
+		return
+	}
+
+	height, ok := accountViewsDepletionHeight(k.accountViews(ctx, account), payments)
+	if !ok {
+		return
+	}
+
+	val := make([]byte, 8)
+	binary.BigEndian.PutUint64(val, uint64(height))
+
+	store := ctx.KVStore(k.skey)
+	store.Set(depletionIndexKey(height, account.ID), val)
+	store.Set(accountDepletionKey(account.ID), val)
+}
+
+func (k *keeper) removeDepletionIndex(ctx sdk.Context, id types.AccountID) {
+	store := ctx.KVStore(k.skey)
+	key := accountDepletionKey(id)
+
+	val := store.Get(key)
+	if val == nil {
+		return
+	}
+
+	store.Delete(depletionIndexKey(int64(binary.BigEndian.Uint64(val)), id))
+	store.Delete(key)
+}
+
+// accountDepletionHeight returns the last height account balance covers the block rate
+// of given payments in full. Settling account at any later height overdraws it.
+func accountDepletionHeight(account types.Account, payments []types.FractionalPayment) (int64, bool) {
+	blockRate := sdk.ZeroDec()
+	for _, payment := range payments {
+		if payment.State != types.PaymentOpen {
+			continue
+		}
+		blockRate = blockRate.Add(payment.Rate.Amount)
+	}
+
+	if !blockRate.IsPositive() {
+		return 0, false
+	}
+
+	numFullBlocks := account.TotalBalance().Amount.Quo(blockRate).TruncateInt()
+
+	if !numFullBlocks.IsInt64() || numFullBlocks.Int64() > math.MaxInt64-account.SettledAt {
+		return math.MaxInt64, true
+	}
+
+	return account.SettledAt + numFullBlocks.Int64(), true
+}
+
+// accountViewsDepletionHeight returns the lowest depletion height among views of an account,
+// each view covering payments priced in its denomination
+func accountViewsDepletionHeight(views []types.Account, payments []types.FractionalPayment) (int64, bool) {
+	var height int64
+	found := false
+
+	for _, view := range views {
+		idxs := accountViewPayments(view, payments)
+
+		vpayments := make([]types.FractionalPayment, 0, len(idxs))
+		for _, idx := range idxs {
+			vpayments = append(vpayments, payments[idx])
+		}
+
+		if h, ok := accountDepletionHeight(view, vpayments); ok && (!found || h < height) {
+			height = h
+			found = true
+		}
+	}
+
+	return height, found
+}
+
+func accountIDFromDepletionIndexKey(key []byte) types.AccountID {
+	// skip prefix and height
+	return accountIDFromKeySuffix(key[1+8:])
+}
@@ -0,0 +1,329 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"math"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
+
+	types "github.com/akash-network/akash-api/go/node/escrow/v1beta3"
+
+	ev1beta4 "github.com/akash-network/node/x/escrow/types/v1beta4"
+)
+
+// Querier is used as Keeper will have duplicate methods if used directly, and gRPC names take precedence over keeper
+type Querier struct {
+	Keeper
+}
+
+var (
+	_ types.QueryServer    = Querier{}
+	_ ev1beta4.QueryServer = Querier{}
+)
+
+// Accounts returns escrow accounts based on filters
+func (k Querier) Accounts(c context.Context, req *types.QueryAccountsRequest) (*types.QueryAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	stateVal := types.Account_State(types.Account_State_value[req.State])
+
+	if req.State != "" && stateVal == types.AccountStateInvalid {
+		return nil, status.Error(codes.InvalidArgument, "invalid state value")
+	}
+
+	if req.Xid != "" && req.Scope == "" {
+		return nil, status.Error(codes.InvalidArgument, "xid filter requires scope")
+	}
+
+	if req.Owner != "" {
+		if _, err := sdk.AccAddressFromBech32(req.Owner); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid owner address")
+		}
+	}
+
+	var accounts []types.Account
+	ctx := sdk.UnwrapSDKContext(c)
+
+	searchPrefix := accountPrefixFromFilter(req.Scope, req.Xid)
+	accountStore := prefix.NewStore(ctx.KVStore(k.StoreKey()), searchPrefix)
+
+	pageRes, err := sdkquery.FilteredPaginate(accountStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var account types.Account
+
+		err := k.Codec().Unmarshal(value, &account)
+		if err != nil {
+			return false, err
+		}
+
+		// prefix match on xid may include ids sharing the same leading characters
+		if req.Xid != "" && account.ID.XID != req.Xid {
+			return false, nil
+		}
+
+		if req.Owner != "" && account.Owner != req.Owner {
+			return false, nil
+		}
+
+		if req.State != "" && account.State != stateVal {
+			return false, nil
+		}
+
+		if accumulate {
+			accounts = append(accounts, account)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAccountsResponse{
+		Accounts:   accounts,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Payments returns escrow payments based on filters
+func (k Querier) Payments(c context.Context, req *types.QueryPaymentsRequest) (*types.QueryPaymentsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	stateVal := types.FractionalPayment_State(types.FractionalPayment_State_value[req.State])
+
+	if req.State != "" && stateVal == types.PaymentStateInvalid {
+		return nil, status.Error(codes.InvalidArgument, "invalid state value")
+	}
+
+	if req.Xid != "" && req.Scope == "" {
+		return nil, status.Error(codes.InvalidArgument, "xid filter requires scope")
+	}
+
+	if req.Id != "" && req.Xid == "" {
+		return nil, status.Error(codes.InvalidArgument, "id filter requires scope and xid")
+	}
+
+	if req.Owner != "" {
+		if _, err := sdk.AccAddressFromBech32(req.Owner); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid owner address")
+		}
+	}
+
+	var payments []types.FractionalPayment
+	ctx := sdk.UnwrapSDKContext(c)
+
+	searchPrefix := paymentPrefixFromFilter(req.Scope, req.Xid, req.Id)
+	paymentStore := prefix.NewStore(ctx.KVStore(k.StoreKey()), searchPrefix)
+
+	pageRes, err := sdkquery.FilteredPaginate(paymentStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var payment types.FractionalPayment
+
+		err := k.Codec().Unmarshal(value, &payment)
+		if err != nil {
+			return false, err
+		}
+
+		if req.Xid != "" && payment.AccountID.XID != req.Xid {
+			return false, nil
+		}
+
+		if req.Id != "" && payment.PaymentID != req.Id {
+			return false, nil
+		}
+
+		if req.Owner != "" && payment.Owner != req.Owner {
+			return false, nil
+		}
+
+		if req.State != "" && payment.State != stateVal {
+			return false, nil
+		}
+
+		if accumulate {
+			payments = append(payments, payment)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPaymentsResponse{
+		Payments:   payments,
+		Pagination: pageRes,
+	}, nil
+}
+
+// SettlePreview returns the outcome of settling escrow account at current height
+func (k Querier) SettlePreview(c context.Context, req *ev1beta4.QuerySettlePreviewRequest) (*ev1beta4.QuerySettlePreviewResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := req.ID.ValidateBasic(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	preview, err := k.AccountSettlePreview(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ev1beta4.QuerySettlePreviewResponse{
+		Height:    preview.Height,
+		Account:   preview.Account,
+		Balances:  preview.Balances,
+		Payments:  make([]ev1beta4.PaymentSettlePreview, 0, len(preview.Payments)),
+		Overdrawn: preview.Overdrawn,
+	}
+
+	for _, payment := range preview.Payments {
+		res.Payments = append(res.Payments, ev1beta4.PaymentSettlePreview{
+			Payment: payment.Payment,
+			Amount:  payment.Amount,
+		})
+	}
+
+	return res, nil
+}
+
+// Depleting returns open escrow accounts running out of funds within given number of blocks,
+// ordered by depletion height
+func (k Querier) Depleting(c context.Context, req *ev1beta4.QueryDepletingRequest) (*ev1beta4.QueryDepletingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Blocks < 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid number of blocks")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	height := ctx.BlockHeight()
+	if req.Blocks > math.MaxInt64-height {
+		height = math.MaxInt64
+	} else {
+		height += req.Blocks
+	}
+
+	var accounts []ev1beta4.DepletingAccount
+
+	store := ctx.KVStore(k.StoreKey())
+	indexStore := prefix.NewStore(store, depletionIndexPrefix())
+
+	pageRes, err := sdkquery.FilteredPaginate(indexStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		depletionHeight := int64(binary.BigEndian.Uint64(value))
+		if depletionHeight > height {
+			return false, nil
+		}
+
+		if accumulate {
+			var account types.Account
+
+			err := k.Codec().Unmarshal(store.Get(accountKey(accountIDFromKeySuffix(key[8:]))), &account)
+			if err != nil {
+				return false, err
+			}
+
+			accounts = append(accounts, ev1beta4.DepletingAccount{
+				Account:         account,
+				DepletionHeight: depletionHeight,
+			})
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &ev1beta4.QueryDepletingResponse{
+		Accounts:   accounts,
+		Pagination: pageRes,
+	}, nil
+}
+
+// AccountBalances returns escrow account along with its balances in denominations
+// other than the one account was created with
+func (k Querier) AccountBalances(c context.Context, req *ev1beta4.QueryAccountBalancesRequest) (*ev1beta4.QueryAccountBalancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := req.ID.ValidateBasic(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	account, err := k.GetAccount(ctx, req.ID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &ev1beta4.QueryAccountBalancesResponse{
+		Account:  account,
+		Balances: k.GetAccountBalances(ctx, req.ID).Balances,
+	}, nil
+}
+
+func accountPrefixFromFilter(scope, xid string) []byte {
+	buf := bytes.NewBuffer(types.AccountKeyPrefix())
+
+	if scope == "" {
+		return buf.Bytes()
+	}
+
+	buf.WriteRune('/')
+	buf.WriteString(scope)
+	buf.WriteRune('/')
+
+	if xid == "" {
+		return buf.Bytes()
+	}
+
+	buf.WriteString(xid)
+
+	return buf.Bytes()
+}
+
+func paymentPrefixFromFilter(scope, xid, pid string) []byte {
+	buf := bytes.NewBuffer(types.PaymentKeyPrefix())
+
+	if scope == "" {
+		return buf.Bytes()
+	}
+
+	buf.WriteRune('/')
+	buf.WriteString(scope)
+	buf.WriteRune('/')
+
+	if xid == "" {
+		return buf.Bytes()
+	}
+
+	buf.WriteString(xid)
+	buf.WriteRune('/')
+
+	if pid == "" {
+		return buf.Bytes()
+	}
+
+	buf.WriteString(pid)
+
+	return buf.Bytes()
+}
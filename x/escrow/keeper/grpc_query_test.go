@@ -0,0 +1,370 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
+
+	types "github.com/akash-network/akash-api/go/node/escrow/v1beta3"
+
+	"github.com/akash-network/node/app"
+	"github.com/akash-network/node/testutil"
+	"github.com/akash-network/node/testutil/state"
+	"github.com/akash-network/node/x/escrow/keeper"
+	ev1beta4 "github.com/akash-network/node/x/escrow/types/v1beta4"
+)
+
+type grpcTestSuite struct {
+	t      *testing.T
+	app    *app.AkashApp
+	ctx    sdk.Context
+	keeper keeper.Keeper
+
+	queryClient     types.QueryClient
+	nodeQueryClient ev1beta4.QueryClient
+}
+
+func setupTest(t *testing.T) *grpcTestSuite {
+	ssuite := state.SetupTestSuite(t)
+	suite := &grpcTestSuite{
+		t:      t,
+		app:    ssuite.App(),
+		ctx:    ssuite.Context(),
+		keeper: ssuite.EscrowKeeper(),
+	}
+
+	querier := keeper.NewQuerier(suite.keeper)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.ctx, suite.app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, querier)
+	ev1beta4.RegisterQueryServer(queryHelper, querier)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+	suite.nodeQueryClient = ev1beta4.NewQueryClient(queryHelper)
+
+	return suite
+}
+
+func (suite *grpcTestSuite) createAccount(scope string) (types.AccountID, sdk.AccAddress) {
+	suite.t.Helper()
+
+	id := types.AccountID{
+		Scope: scope,
+		XID:   testutil.Name(suite.t, "acct"),
+	}
+	owner := testutil.AccAddress(suite.t)
+
+	require.NoError(suite.t, suite.keeper.AccountCreate(suite.ctx, id, owner, owner, testutil.AkashCoin(suite.t, 1000)))
+
+	return id, owner
+}
+
+func TestGRPCQueryAccounts(t *testing.T) {
+	suite := setupTest(t)
+
+	id1, owner1 := suite.createAccount("deployment")
+	id2, _ := suite.createAccount("deployment")
+	id3, _ := suite.createAccount("bid")
+
+	require.NoError(t, suite.keeper.AccountClose(suite.ctx, id2))
+
+	testCases := []struct {
+		msg    string
+		req    *types.QueryAccountsRequest
+		expLen int
+		expErr bool
+	}{
+		{
+			"all accounts",
+			&types.QueryAccountsRequest{},
+			3,
+			false,
+		},
+		{
+			"filter by scope",
+			&types.QueryAccountsRequest{Scope: "deployment"},
+			2,
+			false,
+		},
+		{
+			"filter by scope and xid",
+			&types.QueryAccountsRequest{Scope: id3.Scope, Xid: id3.XID},
+			1,
+			false,
+		},
+		{
+			"filter by owner",
+			&types.QueryAccountsRequest{Owner: owner1.String()},
+			1,
+			false,
+		},
+		{
+			"filter by state",
+			&types.QueryAccountsRequest{State: "open"},
+			2,
+			false,
+		},
+		{
+			"filter by scope and state",
+			&types.QueryAccountsRequest{Scope: "deployment", State: "closed"},
+			1,
+			false,
+		},
+		{
+			"with pagination",
+			&types.QueryAccountsRequest{Pagination: &sdkquery.PageRequest{Limit: 1}},
+			1,
+			false,
+		},
+		{
+			"invalid state",
+			&types.QueryAccountsRequest{State: "foo"},
+			0,
+			true,
+		},
+		{
+			"xid without scope",
+			&types.QueryAccountsRequest{Xid: id1.XID},
+			0,
+			true,
+		},
+		{
+			"invalid owner",
+			&types.QueryAccountsRequest{Owner: "foo"},
+			0,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(fmt.Sprintf("Case %s", tc.msg), func(t *testing.T) {
+			ctx := sdk.WrapSDKContext(suite.ctx)
+
+			res, err := suite.queryClient.Accounts(ctx, tc.req)
+
+			if tc.expErr {
+				require.Error(t, err)
+				require.Nil(t, res)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, res)
+			require.Len(t, res.Accounts, tc.expLen)
+		})
+	}
+}
+
+func TestGRPCQueryPayments(t *testing.T) {
+	suite := setupTest(t)
+
+	id1, _ := suite.createAccount("deployment")
+	id2, _ := suite.createAccount("deployment")
+
+	powner1 := testutil.AccAddress(t)
+	powner2 := testutil.AccAddress(t)
+	rate := sdk.NewDecCoinFromCoin(testutil.AkashCoin(t, 1))
+
+	require.NoError(t, suite.keeper.PaymentCreate(suite.ctx, id1, "p1", powner1, rate))
+	require.NoError(t, suite.keeper.PaymentCreate(suite.ctx, id1, "p2", powner2, rate))
+	require.NoError(t, suite.keeper.PaymentCreate(suite.ctx, id2, "p1", powner1, rate))
+
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 10)
+	require.NoError(t, suite.keeper.PaymentClose(suite.ctx, id1, "p2"))
+
+	testCases := []struct {
+		msg    string
+		req    *types.QueryPaymentsRequest
+		expLen int
+		expErr bool
+	}{
+		{
+			"all payments",
+			&types.QueryPaymentsRequest{},
+			3,
+			false,
+		},
+		{
+			"filter by account",
+			&types.QueryPaymentsRequest{Scope: id1.Scope, Xid: id1.XID},
+			2,
+			false,
+		},
+		{
+			"filter by payment id",
+			&types.QueryPaymentsRequest{Scope: id1.Scope, Xid: id1.XID, Id: "p1"},
+			1,
+			false,
+		},
+		{
+			"filter by owner",
+			&types.QueryPaymentsRequest{Owner: powner1.String()},
+			2,
+			false,
+		},
+		{
+			"filter by state",
+			&types.QueryPaymentsRequest{State: "closed"},
+			1,
+			false,
+		},
+		{
+			"invalid state",
+			&types.QueryPaymentsRequest{State: "foo"},
+			0,
+			true,
+		},
+		{
+			"id without account",
+			&types.QueryPaymentsRequest{Id: "p1"},
+			0,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(fmt.Sprintf("Case %s", tc.msg), func(t *testing.T) {
+			ctx := sdk.WrapSDKContext(suite.ctx)
+
+			res, err := suite.queryClient.Payments(ctx, tc.req)
+
+			if tc.expErr {
+				require.Error(t, err)
+				require.Nil(t, res)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, res)
+			require.Len(t, res.Payments, tc.expLen)
+		})
+	}
+}
+
+func TestGRPCQuerySettlePreview(t *testing.T) {
+	suite := setupTest(t)
+
+	id, _ := suite.createAccount("deployment")
+	powner := testutil.AccAddress(t)
+	rate := sdk.NewDecCoinFromCoin(testutil.AkashCoin(t, 10))
+
+	require.NoError(t, suite.keeper.PaymentCreate(suite.ctx, id, "p1", powner, rate))
+
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	_, err := suite.nodeQueryClient.SettlePreview(ctx, &ev1beta4.QuerySettlePreviewRequest{ID: types.AccountID{Scope: "deployment"}})
+	require.Error(t, err)
+
+	_, err = suite.nodeQueryClient.SettlePreview(ctx, &ev1beta4.QuerySettlePreviewRequest{ID: types.AccountID{Scope: "deployment", XID: "unknown"}})
+	require.Error(t, err)
+
+	res, err := suite.nodeQueryClient.SettlePreview(ctx, &ev1beta4.QuerySettlePreviewRequest{ID: id})
+	require.NoError(t, err)
+	require.Equal(t, suite.ctx.BlockHeight(), res.Height)
+	require.Len(t, res.Payments, 1)
+	require.True(t, res.Payments[0].Amount.IsZero())
+
+	// query helper is bound to suite context, query later height through querier directly
+	later := suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 10)
+
+	res, err = keeper.NewQuerier(suite.keeper).SettlePreview(sdk.WrapSDKContext(later), &ev1beta4.QuerySettlePreviewRequest{ID: id})
+	require.NoError(t, err)
+	require.Equal(t, later.BlockHeight(), res.Height)
+	require.False(t, res.Overdrawn)
+	require.Equal(t, testutil.AkashDecCoin(t, 900), res.Account.Balance)
+	require.Len(t, res.Payments, 1)
+	require.Equal(t, testutil.AkashDecCoin(t, 100), res.Payments[0].Amount)
+
+	// preview does not settle the account
+	acct, err := suite.keeper.GetAccount(later, id)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoinFromCoin(testutil.AkashCoin(t, 1000)), acct.Balance)
+}
+
+func TestGRPCQueryDepleting(t *testing.T) {
+	suite := setupTest(t)
+
+	id1, _ := suite.createAccount("deployment")
+	id2, _ := suite.createAccount("deployment")
+	suite.createAccount("deployment")
+
+	powner := testutil.AccAddress(t)
+
+	require.NoError(t, suite.keeper.PaymentCreate(suite.ctx, id1, "p1", powner, sdk.NewDecCoinFromCoin(testutil.AkashCoin(t, 10))))
+	require.NoError(t, suite.keeper.PaymentCreate(suite.ctx, id2, "p1", powner, sdk.NewDecCoinFromCoin(testutil.AkashCoin(t, 20))))
+
+	height := suite.ctx.BlockHeight()
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	_, err := suite.nodeQueryClient.Depleting(ctx, &ev1beta4.QueryDepletingRequest{Blocks: -1})
+	require.Error(t, err)
+
+	res, err := suite.nodeQueryClient.Depleting(ctx, &ev1beta4.QueryDepletingRequest{Blocks: 49})
+	require.NoError(t, err)
+	require.Empty(t, res.Accounts)
+
+	res, err = suite.nodeQueryClient.Depleting(ctx, &ev1beta4.QueryDepletingRequest{Blocks: 50})
+	require.NoError(t, err)
+	require.Len(t, res.Accounts, 1)
+	require.Equal(t, id2, res.Accounts[0].Account.ID)
+	require.Equal(t, height+50, res.Accounts[0].DepletionHeight)
+
+	// accounts without payments never deplete
+	res, err = suite.nodeQueryClient.Depleting(ctx, &ev1beta4.QueryDepletingRequest{Blocks: math.MaxInt64})
+	require.NoError(t, err)
+	require.Len(t, res.Accounts, 2)
+
+	// ordered by depletion height
+	res, err = suite.nodeQueryClient.Depleting(ctx, &ev1beta4.QueryDepletingRequest{
+		Blocks:     100,
+		Pagination: &sdkquery.PageRequest{Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Accounts, 1)
+	require.Equal(t, id2, res.Accounts[0].Account.ID)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	res, err = suite.nodeQueryClient.Depleting(ctx, &ev1beta4.QueryDepletingRequest{
+		Blocks:     100,
+		Pagination: &sdkquery.PageRequest{Key: res.Pagination.NextKey, Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Accounts, 1)
+	require.Equal(t, id1, res.Accounts[0].Account.ID)
+	require.Equal(t, height+100, res.Accounts[0].DepletionHeight)
+}
+
+func TestGRPCQueryAccountBalances(t *testing.T) {
+	suite := setupTest(t)
+
+	const stable = "ibc/stable"
+
+	id, owner := suite.createAccount("deployment")
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	_, err := suite.nodeQueryClient.AccountBalances(ctx, &ev1beta4.QueryAccountBalancesRequest{ID: types.AccountID{Scope: "deployment"}})
+	require.Error(t, err)
+
+	_, err = suite.nodeQueryClient.AccountBalances(ctx, &ev1beta4.QueryAccountBalancesRequest{ID: types.AccountID{Scope: "deployment", XID: "unknown"}})
+	require.Error(t, err)
+
+	res, err := suite.nodeQueryClient.AccountBalances(ctx, &ev1beta4.QueryAccountBalancesRequest{ID: id})
+	require.NoError(t, err)
+	require.Equal(t, id, res.Account.ID)
+	require.Empty(t, res.Balances)
+
+	require.NoError(t, suite.keeper.AccountDeposit(suite.ctx, id, owner, sdk.NewInt64Coin(stable, 100)))
+
+	res, err = suite.nodeQueryClient.AccountBalances(ctx, &ev1beta4.QueryAccountBalancesRequest{ID: id})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoinFromCoin(testutil.AkashCoin(t, 1000)), res.Account.Balance)
+	require.Len(t, res.Balances, 1)
+	require.Equal(t, sdk.NewInt64DecCoin(stable, 100), res.Balances[0].Balance)
+}
@@ -1,28 +1,54 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
 
 	dtypes "github.com/akash-network/akash-api/go/node/deployment/v1beta3"
 	types "github.com/akash-network/akash-api/go/node/escrow/v1beta3"
+	"github.com/akash-network/akash-api/go/sdkutil"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	ev1beta4 "github.com/akash-network/node/x/escrow/types/v1beta4"
 )
 
 type AccountHook func(sdk.Context, types.Account)
 type PaymentHook func(sdk.Context, types.FractionalPayment)
 
+// SettlePreview is the outcome of settling an account at Height, as computed by AccountSettlePreview
+type SettlePreview struct {
+	Height    int64                  `json:"height"`
+	Account   types.Account          `json:"account"`
+	Balances  ev1beta4.DenomBalances `json:"balances,omitempty"`
+	Payments  []PaymentSettlePreview `json:"payments"`
+	Overdrawn bool                   `json:"overdrawn"`
+}
+
+// PaymentSettlePreview holds the payment state after settlement
+// and the amount it would receive from the account
+type PaymentSettlePreview struct {
+	Payment types.FractionalPayment `json:"payment"`
+	Amount  sdk.DecCoin             `json:"amount"`
+}
+
+var ErrInvalidSlashFraction = errors.New("invalid slash fraction")
+
 type Keeper interface {
 	Codec() codec.BinaryCodec
 	StoreKey() sdk.StoreKey
 	AccountCreate(ctx sdk.Context, id types.AccountID, owner, depositor sdk.AccAddress, deposit sdk.Coin) error
 	AccountDeposit(ctx sdk.Context, id types.AccountID, depositor sdk.AccAddress, amount sdk.Coin) error
 	AccountSettle(ctx sdk.Context, id types.AccountID) (bool, error)
+	AccountSettlePreview(ctx sdk.Context, id types.AccountID) (SettlePreview, error)
 	AccountClose(ctx sdk.Context, id types.AccountID) error
+	AccountSlash(ctx sdk.Context, id types.AccountID, fraction sdk.Dec) (sdk.Coins, error)
+	AccountTransfer(ctx sdk.Context, id, to types.AccountID, owner sdk.AccAddress) error
 	PaymentCreate(ctx sdk.Context, id types.AccountID, pid string, owner sdk.AccAddress, rate sdk.DecCoin) error
 	PaymentWithdraw(ctx sdk.Context, id types.AccountID, pid string) error
 	PaymentClose(ctx sdk.Context, id types.AccountID, pid string) error
+	PaymentUpdateRate(ctx sdk.Context, id types.AccountID, pid string, rate sdk.DecCoin) error
 	GetAccount(ctx sdk.Context, id types.AccountID) (types.Account, error)
 	GetPayment(ctx sdk.Context, id types.AccountID, pid string) (types.FractionalPayment, error)
 	AddOnAccountClosedHook(AccountHook) Keeper
@@ -31,6 +57,19 @@ type Keeper interface {
 	WithPayments(sdk.Context, func(types.FractionalPayment) bool)
 	SaveAccount(sdk.Context, types.Account)
 	SavePayment(sdk.Context, types.FractionalPayment)
+	SweepAccounts(ctx sdk.Context, maxIterations, maxSettlements int) int
+	WithDepletingAccounts(ctx sdk.Context, height int64, fn func(DepletingAccount) bool)
+	ReindexAccounts(ctx sdk.Context)
+	SetAccountAutoRefill(ctx sdk.Context, id types.AccountID, policy ev1beta4.AutoRefill) error
+	GetAccountAutoRefill(ctx sdk.Context, id types.AccountID) (ev1beta4.AutoRefill, error)
+	DeleteAccountAutoRefill(ctx sdk.Context, id types.AccountID)
+	SaveAccountAutoRefill(sdk.Context, ev1beta4.AccountAutoRefill)
+	WithAutoRefills(sdk.Context, func(ev1beta4.AccountAutoRefill) bool)
+	AccountDenoms(ctx sdk.Context, id types.AccountID) ([]string, error)
+	GetAccountBalances(ctx sdk.Context, id types.AccountID) ev1beta4.AccountBalances
+	SaveAccountBalances(sdk.Context, ev1beta4.AccountBalances)
+	WithAccountBalances(sdk.Context, func(ev1beta4.AccountBalances) bool)
+	RefillAccounts(ctx sdk.Context, maxIterations int) int
 }
 
 func NewKeeper(cdc codec.BinaryCodec, skey sdk.StoreKey, bkeeper BankKeeper, tkeeper TakeKeeper, dkeeper DistrKeeper, akeeper AuthzKeeper) Keeper {
@@ -129,10 +168,9 @@ func (k *keeper) GetAccountDepositor(ctx sdk.Context, id types.AccountID) (sdk.A
 	return depositor, nil
 }
 
+// AccountDeposit adds amount to the account. Amount may be in any denomination, deposit in denomination
+// other than the one account was created with is kept in separate balance which pays for payments priced in it.
 func (k *keeper) AccountDeposit(ctx sdk.Context, id types.AccountID, depositor sdk.AccAddress, amount sdk.Coin) error {
-	store := ctx.KVStore(k.skey)
-	key := accountKey(id)
-
 	obj, err := k.GetAccount(ctx, id)
 	if err != nil {
 		return err
@@ -160,11 +198,27 @@ func (k *keeper) AccountDeposit(ctx sdk.Context, id types.AccountID, depositor s
 		}
 	}
 
-	if err = k.fetchDepositToAccount(ctx, &obj, owner, depositor, amount); err != nil {
+	views := k.accountViews(ctx, obj)
+
+	// deposit in a new denomination opens balance in it
+	vidx := accountViewIndex(views, amount.Denom)
+	if vidx < 0 {
+		view := obj
+		view.Balance = sdk.NewDecCoin(amount.Denom, sdk.ZeroInt())
+		view.Transferred = sdk.NewDecCoin(amount.Denom, sdk.ZeroInt())
+		view.Funds = sdk.NewDecCoin(amount.Denom, sdk.ZeroInt())
+
+		views = append(views, view)
+		vidx = len(views) - 1
+	}
+
+	if err = k.fetchDepositToAccount(ctx, &views[vidx], owner, depositor, amount); err != nil {
 		return err
 	}
 
-	store.Set(key, k.cdc.MustMarshal(&obj))
+	k.saveAccountViews(ctx, views)
+
+	k.updateDepletionIndex(ctx, views[0], k.accountOpenPayments(ctx, id))
 
 	return nil
 }
@@ -175,6 +229,81 @@ func (k *keeper) AccountSettle(ctx sdk.Context, id types.AccountID) (bool, error
 	return od, err
 }
 
+// AccountSettlePreview computes the result of settling given account at current height
+// without writing anything to the store
+func (k *keeper) AccountSettlePreview(ctx sdk.Context, id types.AccountID) (SettlePreview, error) {
+	account, err := k.GetAccount(ctx, id)
+	if err != nil {
+		return SettlePreview{}, err
+	}
+
+	if account.State != types.AccountOpen {
+		return SettlePreview{}, types.ErrAccountClosed
+	}
+
+	payments := k.accountOpenPayments(ctx, id)
+
+	preview := SettlePreview{
+		Height:   ctx.BlockHeight(),
+		Payments: make([]PaymentSettlePreview, 0, len(payments)),
+	}
+
+	heightDelta := sdk.NewInt(ctx.BlockHeight() - account.SettledAt)
+
+	views := k.accountViews(ctx, account)
+
+	if heightDelta.IsZero() || len(payments) == 0 {
+		preview.Account = account
+		preview.Balances = accountViewBalances(views)
+		for _, payment := range payments {
+			preview.Payments = append(preview.Payments, PaymentSettlePreview{
+				Payment: payment,
+				Amount:  sdk.NewDecCoin(payment.Rate.Denom, sdk.ZeroInt()),
+			})
+		}
+
+		return preview, nil
+	}
+
+	initial := make([]sdk.DecCoin, 0, len(payments))
+
+	for _, payment := range payments {
+		initial = append(initial, payment.Balance)
+	}
+
+	for idx := range views {
+		views[idx].SettledAt = ctx.BlockHeight()
+	}
+
+	views, payments, overdrawn, err := accountSettleViews(views, payments, heightDelta)
+	if err != nil {
+		return SettlePreview{}, err
+	}
+
+	if overdrawn {
+		for idx := range views {
+			views[idx].State = types.AccountOverdrawn
+		}
+
+		for idx := range payments {
+			payments[idx].State = types.PaymentOverdrawn
+		}
+	}
+
+	preview.Account = views[0]
+	preview.Balances = accountViewBalances(views)
+	preview.Overdrawn = overdrawn
+
+	for idx, payment := range payments {
+		preview.Payments = append(preview.Payments, PaymentSettlePreview{
+			Payment: payment,
+			Amount:  payment.Balance.Sub(initial[idx]),
+		})
+	}
+
+	return preview, nil
+}
+
 func (k *keeper) AccountClose(ctx sdk.Context, id types.AccountID) error {
 	// doAccountSettle checks if account is open
 	account, payments, od, err := k.doAccountSettle(ctx, id)
@@ -187,10 +316,18 @@ func (k *keeper) AccountClose(ctx sdk.Context, id types.AccountID) error {
 	}
 
 	account.State = types.AccountClosed
-	if err := k.accountWithdraw(ctx, &account); err != nil {
-		return err
+
+	views := k.accountViews(ctx, account)
+	for idx := range views {
+		if err := k.accountWithdraw(ctx, &views[idx]); err != nil {
+			return err
+		}
 	}
 
+	k.saveAccountViews(ctx, views)
+
+	k.removeDepletionIndex(ctx, id)
+
 	for idx := range payments {
 		payments[idx].State = types.PaymentClosed
 		if err := k.paymentWithdraw(ctx, &payments[idx]); err != nil {
@@ -198,6 +335,8 @@ func (k *keeper) AccountClose(ctx sdk.Context, id types.AccountID) error {
 		}
 	}
 
+	k.emitAccountClosedEvents(ctx, ev1beta4.NewEventAccountClosed(account.ID, account.Owner), payments)
+
 	for _, hook := range k.hooks.onAccountClosed {
 		hook(ctx, account)
 	}
@@ -211,6 +350,51 @@ func (k *keeper) AccountClose(ctx sdk.Context, id types.AccountID) error {
 	return nil
 }
 
+// AccountSlash moves given fraction of owner's balance of an open account to the community pool,
+// in every denomination account holds. Funds deposited via authorization are not slashed.
+// It returns slashed amount.
+func (k *keeper) AccountSlash(ctx sdk.Context, id types.AccountID, fraction sdk.Dec) (sdk.Coins, error) {
+	if fraction.IsNegative() || fraction.GT(sdk.OneDec()) {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidSlashFraction, fraction)
+	}
+
+	account, err := k.GetAccount(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if account.State != types.AccountOpen {
+		return nil, types.ErrAccountClosed
+	}
+
+	views := k.accountViews(ctx, account)
+	slashed := sdk.NewCoins()
+
+	for idx := range views {
+		amount := sdk.NewCoin(views[idx].Balance.Denom, views[idx].Balance.Amount.Mul(fraction).TruncateInt())
+		if amount.IsZero() {
+			continue
+		}
+
+		if err := k.sendFeeToCommunityPool(ctx, amount); err != nil {
+			return nil, err
+		}
+
+		views[idx].Balance = views[idx].Balance.Sub(sdk.NewDecCoinFromCoin(amount))
+		slashed = slashed.Add(amount)
+	}
+
+	if slashed.IsZero() {
+		return slashed, nil
+	}
+
+	k.saveAccountViews(ctx, views)
+
+	k.updateDepletionIndex(ctx, views[0], k.accountOpenPayments(ctx, id))
+
+	return slashed, nil
+}
+
 func (k *keeper) PaymentCreate(ctx sdk.Context, id types.AccountID, pid string, owner sdk.AccAddress, rate sdk.DecCoin) error {
 	account, _, od, err := k.doAccountSettle(ctx, id)
 	if err != nil {
@@ -221,7 +405,7 @@ func (k *keeper) PaymentCreate(ctx sdk.Context, id types.AccountID, pid string,
 		return types.ErrAccountOverdrawn
 	}
 
-	if rate.Denom != account.Balance.Denom {
+	if accountViewIndex(k.accountViews(ctx, account), rate.Denom) < 0 {
 		return types.ErrInvalidDenomination
 	}
 
@@ -248,6 +432,8 @@ func (k *keeper) PaymentCreate(ctx sdk.Context, id types.AccountID, pid string,
 
 	store.Set(key, k.cdc.MustMarshal(obj))
 
+	k.updateDepletionIndex(ctx, account, k.accountOpenPayments(ctx, id))
+
 	return nil
 }
 
@@ -308,6 +494,18 @@ func (k *keeper) PaymentClose(ctx sdk.Context, id types.AccountID, pid string) e
 		return err
 	}
 
+	account, err := k.GetAccount(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	k.updateDepletionIndex(ctx, account, k.accountOpenPayments(ctx, id))
+
+	ctx.EventManager().EmitEvent(
+		ev1beta4.NewEventPaymentClosed(payment.AccountID, payment.PaymentID, payment.Owner, payment.State).
+			ToSDKEvent(),
+	)
+
 	for _, hook := range k.hooks.onPaymentClosed {
 		hook(ctx, payment)
 	}
@@ -315,6 +513,48 @@ func (k *keeper) PaymentClose(ctx sdk.Context, id types.AccountID, pid string) e
 	return nil
 }
 
+// PaymentUpdateRate settles account at the current rate of the payment and then
+// changes payment rate in place, leaving the payment open.
+func (k *keeper) PaymentUpdateRate(ctx sdk.Context, id types.AccountID, pid string, rate sdk.DecCoin) error {
+	payment, err := k.GetPayment(ctx, id, pid)
+	if err != nil {
+		return err
+	}
+
+	if payment.State != types.PaymentOpen {
+		return types.ErrPaymentClosed
+	}
+
+	account, _, od, err := k.doAccountSettle(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if od {
+		return types.ErrAccountOverdrawn
+	}
+
+	if accountViewIndex(k.accountViews(ctx, account), rate.Denom) < 0 {
+		return types.ErrInvalidDenomination
+	}
+
+	if rate.IsZero() {
+		return types.ErrPaymentRateZero
+	}
+
+	payment, err = k.GetPayment(ctx, id, pid)
+	if err != nil {
+		return err
+	}
+
+	payment.Rate = rate
+	k.savePayment(ctx, &payment)
+
+	k.updateDepletionIndex(ctx, account, k.accountOpenPayments(ctx, id))
+
+	return nil
+}
+
 func (k *keeper) AddOnAccountClosedHook(hook AccountHook) Keeper {
 	k.hooks.onAccountClosed = append(k.hooks.onAccountClosed, hook)
 	return k
@@ -426,69 +666,63 @@ func (k *keeper) doAccountSettle(ctx sdk.Context, id types.AccountID) (types.Acc
 		return account, nil, false, nil
 	}
 
-	blockRate := sdk.NewDecCoin(account.Balance.Denom, sdk.ZeroInt())
-
-	for _, payment := range payments {
-		blockRate = blockRate.Add(payment.Rate)
+	views, payments, overdrawn, err := accountSettleViews(k.accountViews(ctx, account), payments, heightDelta)
+	if err != nil {
+		return account, payments, false, err
 	}
 
-	account, payments, overdrawn, amountRemaining := accountSettleFullBlocks(account, payments, heightDelta, blockRate)
-
-	if account.Funds.Amount.IsPositive() {
-		owner := sdk.MustAccAddressFromBech32(account.Owner)
-		depositor := sdk.MustAccAddressFromBech32(account.Depositor)
-
-		msg := &dtypes.MsgDepositDeployment{Amount: sdk.NewCoin(account.Balance.Denom, sdk.NewInt(0))}
-
-		authz, _ := k.authzKeeper.GetCleanAuthorization(ctx, owner, depositor, sdk.MsgTypeURL(msg))
-
-		// if authorization has been revoked or expired it cannot be used anymore
-		// send coins back to the owner
-		if authz == nil {
-			withdrawal := sdk.NewCoin(account.Balance.Denom, account.Funds.Amount.TruncateInt())
-			if err := k.bkeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, sdk.NewCoins(withdrawal)); err != nil {
-				ctx.Logger().Error("account withdraw", "err", err, "id", account.ID)
-				return account, payments, overdrawn, err
+	// all payments made in full
+	if !overdrawn {
+		for idx := range views {
+			if err := k.accountReturnRevokedFunds(ctx, &views[idx]); err != nil {
+				return views[0], payments, false, err
 			}
-
-			account.Funds.Amount = sdk.ZeroDec()
 		}
-	}
 
-	// all payments made in full
-	if !overdrawn {
 		// save objects
-		k.saveAccount(ctx, &account)
+		k.saveAccountViews(ctx, views)
 		for idx := range payments {
 			k.savePayment(ctx, &payments[idx])
 		}
 
+		// returned authz funds move depletion height
+		k.updateDepletionIndex(ctx, views[0], payments)
+
 		// return early
-		return account, payments, false, nil
+		return views[0], payments, false, nil
 	}
 
 	//
 	// overdrawn
 	//
 
-	// distribute weighted by payment block rate
-	account, payments, amountRemaining = accountSettleDistributeWeighted(account, payments, blockRate, amountRemaining)
+	// denominations which still covered their payments are returned to the owner and the depositor
+	for idx := range views {
+		if views[idx].State == types.AccountOverdrawn {
+			continue
+		}
 
-	if amountRemaining.Amount.GT(sdk.NewDec(1)) {
-		return account, payments, false, fmt.Errorf("%w: Invalid settlement: %v remains", types.ErrInvalidSettlement, amountRemaining)
+		views[idx].State = types.AccountOverdrawn
+		if err := k.accountWithdraw(ctx, &views[idx]); err != nil {
+			return views[0], payments, false, err
+		}
 	}
 
 	// save objects
-	account.State = types.AccountOverdrawn
-	k.saveAccount(ctx, &account)
+	k.saveAccountViews(ctx, views)
+	k.removeDepletionIndex(ctx, id)
 	for idx := range payments {
 		payments[idx].State = types.PaymentOverdrawn
 		k.savePayment(ctx, &payments[idx])
 		if err := k.paymentWithdraw(ctx, &payments[idx]); err != nil {
-			return account, payments, false, err
+			return views[0], payments, false, err
 		}
 	}
 
+	account = views[0]
+
+	k.emitAccountClosedEvents(ctx, ev1beta4.NewEventAccountOverdrawn(account.ID, account.Owner), payments)
+
 	// call hooks
 	for _, hook := range k.hooks.onAccountClosed {
 		hook(ctx, account)
@@ -503,6 +737,47 @@ func (k *keeper) doAccountSettle(ctx sdk.Context, id types.AccountID) (types.Acc
 	return account, payments, true, nil
 }
 
+// accountReturnRevokedFunds sends funds deposited via authorization back to the depositor
+// once the authorization has been revoked or expired
+func (k *keeper) accountReturnRevokedFunds(ctx sdk.Context, view *types.Account) error {
+	if !view.Funds.Amount.IsPositive() {
+		return nil
+	}
+
+	owner := sdk.MustAccAddressFromBech32(view.Owner)
+	depositor := sdk.MustAccAddressFromBech32(view.Depositor)
+
+	msg := &dtypes.MsgDepositDeployment{Amount: sdk.NewCoin(view.Balance.Denom, sdk.NewInt(0))}
+
+	authz, _ := k.authzKeeper.GetCleanAuthorization(ctx, owner, depositor, sdk.MsgTypeURL(msg))
+
+	// if authorization has been revoked or expired it cannot be used anymore
+	// send coins back to the owner
+	if authz == nil {
+		withdrawal := sdk.NewCoin(view.Balance.Denom, view.Funds.Amount.TruncateInt())
+		if err := k.bkeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, sdk.NewCoins(withdrawal)); err != nil {
+			ctx.Logger().Error("account withdraw", "err", err, "id", view.ID)
+			return err
+		}
+
+		view.Funds.Amount = sdk.ZeroDec()
+	}
+
+	return nil
+}
+
+// emitAccountClosedEvents emits event of account leaving open state followed by events of its closed payments
+func (k *keeper) emitAccountClosedEvents(ctx sdk.Context, ev sdkutil.ModuleEvent, payments []types.FractionalPayment) {
+	ctx.EventManager().EmitEvent(ev.ToSDKEvent())
+
+	for _, payment := range payments {
+		ctx.EventManager().EmitEvent(
+			ev1beta4.NewEventPaymentClosed(payment.AccountID, payment.PaymentID, payment.Owner, payment.State).
+				ToSDKEvent(),
+		)
+	}
+}
+
 func (k *keeper) saveAccount(ctx sdk.Context, obj *types.Account) {
 	store := ctx.KVStore(k.skey)
 	key := accountKey(obj.ID)
@@ -548,6 +823,8 @@ func (k *keeper) accountOpenPayments(ctx sdk.Context, id types.AccountID) []type
 	return payments
 }
 
+// accountWithdraw sends balance and funds of given account view back to the owner and the depositor.
+// Caller is responsible for saving the view.
 func (k *keeper) accountWithdraw(ctx sdk.Context, obj *types.Account) error {
 	if obj.Balance.Amount.LT(sdk.NewDec(1)) && obj.Funds.Amount.LT(sdk.NewDec(1)) {
 		return nil
@@ -589,7 +866,7 @@ func (k *keeper) accountWithdraw(ctx sdk.Context, obj *types.Account) error {
 		// without asking for renew.
 		authorization, expiration := k.authzKeeper.GetCleanAuthorization(ctx, owner, depositor, sdk.MsgTypeURL(msg))
 		dauthz, valid := authorization.(*dtypes.DepositDeploymentAuthorization)
-		if valid && authorization != nil {
+		if valid && authorization != nil && dauthz.SpendLimit.Denom == withdrawal.Denom {
 			dauthz.SpendLimit = dauthz.SpendLimit.Add(withdrawal)
 			err = k.authzKeeper.SaveGrant(ctx, owner, depositor, dauthz, expiration)
 			if err != nil {
@@ -598,8 +875,6 @@ func (k *keeper) accountWithdraw(ctx sdk.Context, obj *types.Account) error {
 		}
 	}
 
-	k.saveAccount(ctx, obj)
-
 	return nil
 }
 
@@ -639,6 +914,11 @@ func (k *keeper) paymentWithdraw(ctx sdk.Context, obj *types.FractionalPayment)
 
 	k.savePayment(ctx, obj)
 
+	ctx.EventManager().EmitEvent(
+		ev1beta4.NewEventPaymentWithdrawn(obj.AccountID, obj.PaymentID, obj.Owner, total).
+			ToSDKEvent(),
+	)
+
 	return nil
 }
 
@@ -660,6 +940,58 @@ func (k *keeper) sendFeeToCommunityPool(ctx sdk.Context, fee sdk.Coin) error {
 	return nil
 }
 
+// accountSettleViews settles every account view with payments priced in its denomination.
+// View which balance does not cover its payments for heightDelta blocks is distributed among them
+// weighted by payment rate and marked overdrawn, the account is overdrawn once any of its views is.
+func accountSettleViews(
+	views []types.Account,
+	payments []types.FractionalPayment,
+	heightDelta sdk.Int,
+) (
+	[]types.Account,
+	[]types.FractionalPayment,
+	bool,
+	error,
+) {
+	overdrawn := false
+
+	for vidx := range views {
+		idxs := accountViewPayments(views[vidx], payments)
+		if len(idxs) == 0 {
+			continue
+		}
+
+		blockRate := sdk.NewDecCoin(views[vidx].Balance.Denom, sdk.ZeroInt())
+		vpayments := make([]types.FractionalPayment, 0, len(idxs))
+
+		for _, idx := range idxs {
+			blockRate = blockRate.Add(payments[idx].Rate)
+			vpayments = append(vpayments, payments[idx])
+		}
+
+		view, vpayments, od, amountRemaining := accountSettleFullBlocks(views[vidx], vpayments, heightDelta, blockRate)
+
+		if od {
+			// distribute weighted by payment block rate
+			view, vpayments, amountRemaining = accountSettleDistributeWeighted(view, vpayments, blockRate, amountRemaining)
+
+			if amountRemaining.Amount.GT(sdk.NewDec(1)) {
+				return views, payments, false, fmt.Errorf("%w: Invalid settlement: %v remains", types.ErrInvalidSettlement, amountRemaining)
+			}
+
+			view.State = types.AccountOverdrawn
+			overdrawn = true
+		}
+
+		views[vidx] = view
+		for i, idx := range idxs {
+			payments[idx] = vpayments[i]
+		}
+	}
+
+	return views, payments, overdrawn, nil
+}
+
 func accountSettleFullBlocks(
 	account types.Account,
 	payments []types.FractionalPayment,
@@ -1,18 +1,23 @@
 package keeper_test
 
 import (
+	"math"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	dtypes "github.com/akash-network/akash-api/go/node/deployment/v1beta3"
 	types "github.com/akash-network/akash-api/go/node/escrow/v1beta3"
 
 	"github.com/akash-network/node/testutil"
 	"github.com/akash-network/node/testutil/cosmos/mocks"
 	"github.com/akash-network/node/testutil/state"
 	"github.com/akash-network/node/x/escrow/keeper"
+	ev1beta4 "github.com/akash-network/node/x/escrow/types/v1beta4"
 )
 
 func Test_AccountCreate(t *testing.T) {
@@ -226,3 +231,354 @@ func setupKeeper(t testing.TB) (sdk.Context, keeper.Keeper, *mocks.BankKeeper) {
 	ssuite := state.SetupTestSuite(t)
 	return ssuite.Context(), ssuite.EscrowKeeper(), ssuite.BankKeeper()
 }
+
+func Test_AccountSettlePreview(t *testing.T) {
+	ctx, keeper, bkeeper := setupKeeper(t)
+	aid := genAccountID(t)
+	aowner := testutil.AccAddress(t)
+	amt := testutil.AkashCoin(t, 1000)
+	powner := testutil.AccAddress(t)
+	rate := testutil.AkashCoin(t, 10)
+
+	bkeeper.
+		On("SendCoinsFromAccountToModule", ctx, aowner, types.ModuleName, sdk.NewCoins(amt)).
+		Return(nil)
+	assert.NoError(t, keeper.AccountCreate(ctx, aid, aowner, aowner, amt))
+	assert.NoError(t, keeper.PaymentCreate(ctx, aid, "p1", powner, sdk.NewDecCoinFromCoin(rate)))
+	assert.NoError(t, keeper.PaymentCreate(ctx, aid, "p2", powner, sdk.NewDecCoinFromCoin(rate)))
+
+	// enough funds
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+
+	preview, err := keeper.AccountSettlePreview(ctx, aid)
+	require.NoError(t, err)
+	require.False(t, preview.Overdrawn)
+	require.Equal(t, types.AccountOpen, preview.Account.State)
+	require.Equal(t, ctx.BlockHeight(), preview.Account.SettledAt)
+	require.Equal(t, testutil.AkashDecCoin(t, 800), preview.Account.Balance)
+	require.Len(t, preview.Payments, 2)
+	for _, p := range preview.Payments {
+		require.Equal(t, types.PaymentOpen, p.Payment.State)
+		require.Equal(t, testutil.AkashDecCoin(t, 100), p.Amount)
+	}
+
+	// store has not been touched
+	acct, err := keeper.GetAccount(ctx, aid)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoinFromCoin(amt), acct.Balance)
+	require.NotEqual(t, ctx.BlockHeight(), acct.SettledAt)
+
+	// overdrawn
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 100)
+
+	preview, err = keeper.AccountSettlePreview(ctx, aid)
+	require.NoError(t, err)
+	require.True(t, preview.Overdrawn)
+	require.Equal(t, types.AccountOverdrawn, preview.Account.State)
+	require.Equal(t, testutil.AkashDecCoin(t, 0), preview.Account.Balance)
+	for _, p := range preview.Payments {
+		require.Equal(t, types.PaymentOverdrawn, p.Payment.State)
+		require.Equal(t, testutil.AkashDecCoin(t, 500), p.Amount)
+	}
+
+	acct, err = keeper.GetAccount(ctx, aid)
+	require.NoError(t, err)
+	require.Equal(t, types.AccountOpen, acct.State)
+}
+
+func Test_SweepAccounts(t *testing.T) {
+	ctx, keeper, _ := setupKeeper(t)
+	powner := testutil.AccAddress(t)
+	rate := sdk.NewDecCoinFromCoin(testutil.AkashCoin(t, 10))
+
+	// accounts are created with increasing deposits, so they deplete at different heights
+	ids := make([]types.AccountID, 0, 4)
+	for i := int64(1); i <= 4; i++ {
+		aid := genAccountID(t)
+		aowner := testutil.AccAddress(t)
+
+		require.NoError(t, keeper.AccountCreate(ctx, aid, aowner, aowner, testutil.AkashCoin(t, 100*i)))
+		require.NoError(t, keeper.PaymentCreate(ctx, aid, "p1", powner, rate))
+
+		ids = append(ids, aid)
+	}
+
+	// account without payments never depletes
+	idle := genAccountID(t)
+	require.NoError(t, keeper.AccountCreate(ctx, idle, powner, powner, testutil.AkashCoin(t, 1)))
+
+	checkState := func(states ...types.Account_State) {
+		t.Helper()
+		for idx, id := range ids {
+			acct, err := keeper.GetAccount(ctx, id)
+			require.NoError(t, err)
+			require.Equal(t, states[idx], acct.State, "account %d", idx)
+		}
+	}
+
+	// nothing depleted yet
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	require.Equal(t, 0, keeper.SweepAccounts(ctx, 100, 100))
+	checkState(types.AccountOpen, types.AccountOpen, types.AccountOpen, types.AccountOpen)
+
+	// first two accounts depleted
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 15)
+	require.Equal(t, 2, keeper.SweepAccounts(ctx, 100, 100))
+	checkState(types.AccountOverdrawn, types.AccountOverdrawn, types.AccountOpen, types.AccountOpen)
+
+	payment, err := keeper.GetPayment(ctx, ids[0], "p1")
+	require.NoError(t, err)
+	require.Equal(t, types.PaymentOverdrawn, payment.State)
+
+	// settlement budget is respected and sweep resumes on the next call
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 100)
+	require.Equal(t, 1, keeper.SweepAccounts(ctx, 100, 1))
+	require.Equal(t, 1, keeper.SweepAccounts(ctx, 100, 1))
+	require.Equal(t, 0, keeper.SweepAccounts(ctx, 100, 1))
+	checkState(types.AccountOverdrawn, types.AccountOverdrawn, types.AccountOverdrawn, types.AccountOverdrawn)
+
+	acct, err := keeper.GetAccount(ctx, idle)
+	require.NoError(t, err)
+	require.Equal(t, types.AccountOpen, acct.State)
+}
+
+func Test_DepletionIndex(t *testing.T) {
+	ctx, ekeeper, _ := setupKeeper(t)
+	aid := genAccountID(t)
+	aowner := testutil.AccAddress(t)
+	powner := testutil.AccAddress(t)
+
+	depleting := func(height int64) []int64 {
+		t.Helper()
+		var heights []int64
+		ekeeper.WithDepletingAccounts(ctx, height, func(obj keeper.DepletingAccount) bool {
+			require.Equal(t, aid, obj.Account.ID)
+			heights = append(heights, obj.DepletionHeight)
+			return false
+		})
+		return heights
+	}
+
+	ctx = ctx.WithBlockHeight(10)
+
+	// no payments, not indexed
+	require.NoError(t, ekeeper.AccountCreate(ctx, aid, aowner, aowner, testutil.AkashCoin(t, 1000)))
+	require.Empty(t, depleting(math.MaxInt64))
+
+	require.NoError(t, ekeeper.PaymentCreate(ctx, aid, "p1", powner, sdk.NewDecCoinFromCoin(testutil.AkashCoin(t, 10))))
+	require.Equal(t, []int64{110}, depleting(math.MaxInt64))
+	require.Empty(t, depleting(109))
+	require.Equal(t, []int64{110}, depleting(110))
+
+	// deposit pushes depletion height
+	ctx = ctx.WithBlockHeight(20)
+	require.NoError(t, ekeeper.AccountDeposit(ctx, aid, aowner, testutil.AkashCoin(t, 500)))
+	require.Equal(t, []int64{160}, depleting(math.MaxInt64))
+
+	// another payment halves remaining blocks
+	ctx = ctx.WithBlockHeight(30)
+	require.NoError(t, ekeeper.PaymentCreate(ctx, aid, "p2", powner, sdk.NewDecCoinFromCoin(testutil.AkashCoin(t, 10))))
+	require.Equal(t, []int64{95}, depleting(math.MaxInt64))
+
+	// closing a payment restores it
+	ctx = ctx.WithBlockHeight(40)
+	require.NoError(t, ekeeper.PaymentClose(ctx, aid, "p2"))
+	require.Equal(t, []int64{150}, depleting(math.MaxInt64))
+
+	// rebuilding index yields the same result
+	ekeeper.ReindexAccounts(ctx)
+	require.Equal(t, []int64{150}, depleting(math.MaxInt64))
+
+	// overdrawn accounts are removed
+	ctx = ctx.WithBlockHeight(200)
+	require.Equal(t, 1, ekeeper.SweepAccounts(ctx, 10, 10))
+	require.Empty(t, depleting(math.MaxInt64))
+}
+
+func Test_MultiDenomAccount(t *testing.T) {
+	ctx, ekeeper, _ := setupKeeper(t)
+	aid := genAccountID(t)
+	aowner := testutil.AccAddress(t)
+	powner := testutil.AccAddress(t)
+
+	const stable = "ibc/stable"
+
+	ctx = ctx.WithBlockHeight(10)
+
+	require.NoError(t, ekeeper.AccountCreate(ctx, aid, aowner, aowner, testutil.AkashCoin(t, 1000)))
+
+	// payment must be priced in denomination account holds
+	require.ErrorIs(t, ekeeper.PaymentCreate(ctx, aid, "p2", powner, sdk.NewInt64DecCoin(stable, 5)), types.ErrInvalidDenomination)
+
+	require.NoError(t, ekeeper.AccountDeposit(ctx, aid, aowner, sdk.NewInt64Coin(stable, 100)))
+	require.NoError(t, ekeeper.PaymentCreate(ctx, aid, "p1", powner, sdk.NewDecCoinFromCoin(testutil.AkashCoin(t, 10))))
+	require.NoError(t, ekeeper.PaymentCreate(ctx, aid, "p2", powner, sdk.NewInt64DecCoin(stable, 5)))
+
+	denoms, err := ekeeper.AccountDenoms(ctx, aid)
+	require.NoError(t, err)
+	require.Equal(t, []string{testutil.CoinDenom, stable}, denoms)
+
+	// denomination running out first decides
+	var heights []int64
+	ekeeper.WithDepletingAccounts(ctx, math.MaxInt64, func(obj keeper.DepletingAccount) bool {
+		heights = append(heights, obj.DepletionHeight)
+		return false
+	})
+	require.Equal(t, []int64{30}, heights)
+
+	stableBalance := func() sdk.DecCoin {
+		t.Helper()
+		balances := ekeeper.GetAccountBalances(ctx, aid).Balances
+		require.Len(t, balances, 1)
+		return balances[0].Balance
+	}
+
+	// each denomination pays for payments priced in it
+	ctx = ctx.WithBlockHeight(20)
+	od, err := ekeeper.AccountSettle(ctx, aid)
+	require.NoError(t, err)
+	require.False(t, od)
+
+	acct, err := ekeeper.GetAccount(ctx, aid)
+	require.NoError(t, err)
+	require.Equal(t, testutil.AkashDecCoin(t, 900), acct.Balance)
+	require.Equal(t, sdk.NewInt64DecCoin(stable, 50), stableBalance())
+
+	payment, err := ekeeper.GetPayment(ctx, aid, "p2")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64DecCoin(stable, 50), payment.Balance)
+
+	// overdrawn in one denomination overdraws the account, the other one is returned to the owner
+	ctx = ctx.WithBlockHeight(40)
+	od, err = ekeeper.AccountSettle(ctx, aid)
+	require.NoError(t, err)
+	require.True(t, od)
+
+	acct, err = ekeeper.GetAccount(ctx, aid)
+	require.NoError(t, err)
+	require.Equal(t, types.AccountOverdrawn, acct.State)
+	require.True(t, acct.Balance.IsZero())
+	require.Equal(t, testutil.AkashDecCoin(t, 300), acct.Transferred)
+	require.True(t, stableBalance().IsZero())
+
+	payment, err = ekeeper.GetPayment(ctx, aid, "p2")
+	require.NoError(t, err)
+	require.Equal(t, types.PaymentOverdrawn, payment.State)
+	require.Equal(t, sdk.NewInt64Coin(stable, 100), payment.Withdrawn)
+}
+
+func Test_RefillAccounts(t *testing.T) {
+	ssuite := state.SetupTestSuite(t)
+	ctx := ssuite.Context()
+	ekeeper := ssuite.EscrowKeeper()
+	authzKeeper := ssuite.AuthzKeeper()
+
+	aid := genAccountID(t)
+	aowner := testutil.AccAddress(t)
+	funder := testutil.AccAddress(t)
+	powner := testutil.AccAddress(t)
+
+	msgType := sdk.MsgTypeURL(&dtypes.MsgDepositDeployment{})
+	grant := func(limit int64) *dtypes.DepositDeploymentAuthorization {
+		return &dtypes.DepositDeploymentAuthorization{SpendLimit: testutil.AkashCoin(t, limit)}
+	}
+
+	require.NoError(t, ekeeper.AccountCreate(ctx, aid, aowner, aowner, testutil.AkashCoin(t, 1000)))
+	require.NoError(t, ekeeper.PaymentCreate(ctx, aid, "p1", powner, sdk.NewDecCoinFromCoin(testutil.AkashCoin(t, 10))))
+
+	policy := ev1beta4.AutoRefill{
+		Threshold: testutil.AkashCoin(t, 200),
+		Amount:    testutil.AkashCoin(t, 300),
+		Funder:    funder.String(),
+	}
+
+	// funder must grant deposit authorization
+	authzKeeper.On("GetCleanAuthorization", mock.Anything, aowner, funder, msgType).Return(nil, time.Time{}).Once()
+	require.Error(t, ekeeper.SetAccountAutoRefill(ctx, aid, policy))
+
+	authzKeeper.On("GetCleanAuthorization", mock.Anything, aowner, funder, msgType).Return(grant(500), time.Time{}).Once()
+	require.NoError(t, ekeeper.SetAccountAutoRefill(ctx, aid, policy))
+
+	balance := func() sdk.Dec {
+		t.Helper()
+		acct, err := ekeeper.GetAccount(ctx, aid)
+		require.NoError(t, err)
+		return acct.TotalBalance().Amount
+	}
+
+	// projected balance above threshold
+	ctx = ctx.WithBlockHeight(50)
+	require.Equal(t, 0, ekeeper.RefillAccounts(ctx, 10))
+	require.Equal(t, sdk.NewDec(1000), balance())
+
+	// projected balance below threshold, refill in full
+	ctx = ctx.WithBlockHeight(85)
+	authzKeeper.On("GetCleanAuthorization", mock.Anything, aowner, funder, msgType).Return(grant(500), time.Time{}).Once()
+	authzKeeper.On("SaveGrant", mock.Anything, aowner, funder, grant(200), time.Time{}).Return(nil).Once()
+	require.Equal(t, 1, ekeeper.RefillAccounts(ctx, 10))
+	require.Equal(t, sdk.NewDec(1300), balance())
+
+	// spend limit left is lower than refill amount, use it up
+	ctx = ctx.WithBlockHeight(111)
+	authzKeeper.On("GetCleanAuthorization", mock.Anything, aowner, funder, msgType).Return(grant(100), time.Time{}).Once()
+	authzKeeper.On("DeleteGrant", mock.Anything, aowner, funder, msgType).Return(nil).Once()
+	require.Equal(t, 1, ekeeper.RefillAccounts(ctx, 10))
+	require.Equal(t, sdk.NewDec(1400), balance())
+
+	// grant is gone, policy is removed
+	ctx = ctx.WithBlockHeight(121)
+	authzKeeper.On("GetCleanAuthorization", mock.Anything, aowner, funder, msgType).Return(nil, time.Time{}).Once()
+	require.Equal(t, 0, ekeeper.RefillAccounts(ctx, 10))
+	require.Equal(t, sdk.NewDec(1400), balance())
+
+	_, err := ekeeper.GetAccountAutoRefill(ctx, aid)
+	require.ErrorIs(t, err, ev1beta4.ErrAutoRefillNotFound)
+
+	authzKeeper.AssertExpectations(t)
+}
+
+func Test_PaymentUpdateRate(t *testing.T) {
+	ctx, keeper, bkeeper := setupKeeper(t)
+	aid := genAccountID(t)
+	aowner := testutil.AccAddress(t)
+	amt := testutil.AkashCoin(t, 1000)
+	powner := testutil.AccAddress(t)
+	rate := testutil.AkashCoin(t, 10)
+
+	bkeeper.
+		On("SendCoinsFromAccountToModule", ctx, aowner, types.ModuleName, sdk.NewCoins(amt)).
+		Return(nil)
+	assert.NoError(t, keeper.AccountCreate(ctx, aid, aowner, aowner, amt))
+	assert.NoError(t, keeper.PaymentCreate(ctx, aid, "p1", powner, sdk.NewDecCoinFromCoin(rate)))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+
+	// rate must be positive and in account's denomination
+	require.ErrorIs(t, keeper.PaymentUpdateRate(ctx, aid, "p1", testutil.AkashDecCoin(t, 0)), types.ErrPaymentRateZero)
+	require.ErrorIs(t, keeper.PaymentUpdateRate(ctx, aid, "p1", sdk.NewDecCoin("ibc/stable", sdk.NewInt(1))), types.ErrInvalidDenomination)
+
+	require.NoError(t, keeper.PaymentUpdateRate(ctx, aid, "p1", testutil.AkashDecCoin(t, 20)))
+
+	// settled at previous rate
+	payment, err := keeper.GetPayment(ctx, aid, "p1")
+	require.NoError(t, err)
+	require.Equal(t, types.PaymentOpen, payment.State)
+	require.Equal(t, testutil.AkashDecCoin(t, 20), payment.Rate)
+	require.Equal(t, testutil.AkashDecCoin(t, 100), payment.Balance)
+
+	acct, err := keeper.GetAccount(ctx, aid)
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockHeight(), acct.SettledAt)
+	require.Equal(t, testutil.AkashDecCoin(t, 900), acct.Balance)
+
+	// new rate applies from now on
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+
+	preview, err := keeper.AccountSettlePreview(ctx, aid)
+	require.NoError(t, err)
+	require.Equal(t, testutil.AkashDecCoin(t, 700), preview.Account.Balance)
+
+	// closed payments keep their rate
+	require.NoError(t, keeper.PaymentClose(ctx, aid, "p1"))
+	require.ErrorIs(t, keeper.PaymentUpdateRate(ctx, aid, "p1", testutil.AkashDecCoin(t, 5)), types.ErrPaymentClosed)
+}
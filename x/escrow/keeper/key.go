@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"bytes"
+	"encoding/binary"
 
 	types "github.com/akash-network/akash-api/go/node/escrow/v1beta3"
 )
@@ -41,3 +42,81 @@ func paymentKey(id types.AccountID, pid string) []byte {
 	buf.WriteString(pid)
 	return buf.Bytes()
 }
+
+func accountIDSuffix(id types.AccountID) []byte {
+	buf := bytes.Buffer{}
+	buf.WriteRune('/')
+	buf.WriteString(id.Scope)
+	buf.WriteRune('/')
+	buf.WriteString(id.XID)
+	return buf.Bytes()
+}
+
+// accountIDFromKeySuffix parses AccountID from key suffix built by accountIDSuffix
+func accountIDFromKeySuffix(suffix []byte) types.AccountID {
+	// skip leading separator
+	parts := bytes.SplitN(suffix[1:], []byte{'/'}, 2)
+
+	return types.AccountID{
+		Scope: string(parts[0]),
+		XID:   string(parts[1]),
+	}
+}
+
+// depletionIndexPrefix is the prefix of open accounts index keyed by projected depletion height
+func depletionIndexPrefix() []byte {
+	return []byte{0x03}
+}
+
+// accountDepletionPrefix is the prefix of projected depletion height stored per account,
+// it is used to locate account entry in depletion index
+func accountDepletionPrefix() []byte {
+	return []byte{0x04}
+}
+
+func depletionIndexHeightKey(height int64) []byte {
+	buf := bytes.NewBuffer(depletionIndexPrefix())
+	if err := binary.Write(buf, binary.BigEndian, uint64(height)); err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
+func depletionIndexKey(height int64, id types.AccountID) []byte {
+	buf := bytes.NewBuffer(depletionIndexHeightKey(height))
+	buf.Write(accountIDSuffix(id))
+	return buf.Bytes()
+}
+
+func accountDepletionKey(id types.AccountID) []byte {
+	buf := bytes.NewBuffer(accountDepletionPrefix())
+	buf.Write(accountIDSuffix(id))
+	return buf.Bytes()
+}
+
+// autoRefillPrefix is the prefix of accounts auto refill policies
+func autoRefillPrefix() []byte {
+	return []byte{0x05}
+}
+
+// refillCursorKey stores the key of the last policy examined by RefillAccounts
+func refillCursorKey() []byte {
+	return []byte{0x06}
+}
+
+func autoRefillKey(id types.AccountID) []byte {
+	buf := bytes.NewBuffer(autoRefillPrefix())
+	buf.Write(accountIDSuffix(id))
+	return buf.Bytes()
+}
+
+// balancesPrefix is the prefix of account balances in denominations other than the one account was created with
+func balancesPrefix() []byte {
+	return []byte{0x07}
+}
+
+func balancesKey(id types.AccountID) []byte {
+	buf := bytes.NewBuffer(balancesPrefix())
+	buf.Write(accountIDSuffix(id))
+	return buf.Bytes()
+}
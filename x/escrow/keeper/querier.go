@@ -1,9 +1,5 @@
 package keeper
 
-import (
-	types "github.com/akash-network/akash-api/go/node/escrow/v1beta3"
-)
-
-func NewQuerier(k Keeper) types.QueryServer {
-	return nil
+func NewQuerier(k Keeper) Querier {
+	return Querier{Keeper: k}
 }
@@ -0,0 +1,260 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	dtypes "github.com/akash-network/akash-api/go/node/deployment/v1beta3"
+	types "github.com/akash-network/akash-api/go/node/escrow/v1beta3"
+
+	ev1beta4 "github.com/akash-network/node/x/escrow/types/v1beta4"
+)
+
+const (
+	// RefillMaxIterations is the maximum number of auto refill policies examined by the EndBlock pass
+	RefillMaxIterations = 200
+)
+
+// SetAccountAutoRefill registers auto refill policy for given account, replacing existing one
+func (k *keeper) SetAccountAutoRefill(ctx sdk.Context, id types.AccountID, policy ev1beta4.AutoRefill) error {
+	if err := policy.ValidateBasic(); err != nil {
+		return err
+	}
+
+	account, err := k.GetAccount(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if account.State != types.AccountOpen {
+		return types.ErrAccountClosed
+	}
+
+	if accountViewIndex(k.accountViews(ctx, account), policy.Amount.Denom) < 0 {
+		return types.ErrInvalidDenomination
+	}
+
+	if policy.Funder != account.Owner {
+		// account accepts funds from single depositor besides the owner
+		if account.HasDepositor() && account.Depositor != policy.Funder {
+			return types.ErrInvalidAccountDepositor
+		}
+
+		owner := sdk.MustAccAddressFromBech32(account.Owner)
+		funder := sdk.MustAccAddressFromBech32(policy.Funder)
+
+		msg := &dtypes.MsgDepositDeployment{Amount: policy.Amount}
+		if authorization, _ := k.authzKeeper.GetCleanAuthorization(ctx, owner, funder, sdk.MsgTypeURL(msg)); authorization == nil {
+			return sdkerrors.ErrUnauthorized.Wrap("authorization not found")
+		}
+	}
+
+	ctx.KVStore(k.skey).Set(autoRefillKey(id), k.cdc.MustMarshal(&policy))
+
+	return nil
+}
+
+// GetAccountAutoRefill returns auto refill policy of given account
+func (k *keeper) GetAccountAutoRefill(ctx sdk.Context, id types.AccountID) (ev1beta4.AutoRefill, error) {
+	buf := ctx.KVStore(k.skey).Get(autoRefillKey(id))
+	if buf == nil {
+		return ev1beta4.AutoRefill{}, ev1beta4.ErrAutoRefillNotFound
+	}
+
+	var policy ev1beta4.AutoRefill
+	k.cdc.MustUnmarshal(buf, &policy)
+
+	return policy, nil
+}
+
+// DeleteAccountAutoRefill removes auto refill policy of given account
+func (k *keeper) DeleteAccountAutoRefill(ctx sdk.Context, id types.AccountID) {
+	ctx.KVStore(k.skey).Delete(autoRefillKey(id))
+}
+
+// SaveAccountAutoRefill stores auto refill policy as is, without checking account state or funder authorization
+func (k *keeper) SaveAccountAutoRefill(ctx sdk.Context, obj ev1beta4.AccountAutoRefill) {
+	ctx.KVStore(k.skey).Set(autoRefillKey(obj.ID), k.cdc.MustMarshal(&obj.Policy))
+}
+
+// WithAutoRefills iterates all auto refill policies
+func (k *keeper) WithAutoRefills(ctx sdk.Context, fn func(ev1beta4.AccountAutoRefill) bool) {
+	store := ctx.KVStore(k.skey)
+	iter := sdk.KVStorePrefixIterator(store, autoRefillPrefix())
+
+	defer func() {
+		_ = iter.Close()
+	}()
+
+	for ; iter.Valid(); iter.Next() {
+		val := ev1beta4.AccountAutoRefill{
+			ID: accountIDFromKeySuffix(iter.Key()[len(autoRefillPrefix()):]),
+		}
+		k.cdc.MustUnmarshal(iter.Value(), &val.Policy)
+		if stop := fn(val); stop {
+			break
+		}
+	}
+}
+
+// RefillAccounts walks auto refill policies, resuming where previous call stopped, and tops up
+// accounts which projected balance dropped below policy threshold.
+// Policies of accounts which are no longer open or which funder's authorization is gone
+// or used up are removed. At most maxIterations policies are examined.
+// It returns number of refilled accounts.
+func (k *keeper) RefillAccounts(ctx sdk.Context, maxIterations int) int {
+	if maxIterations <= 0 {
+		return 0
+	}
+
+	type entry struct {
+		id     types.AccountID
+		policy ev1beta4.AutoRefill
+	}
+
+	store := ctx.KVStore(k.skey)
+
+	start := autoRefillPrefix()
+	if cursor := store.Get(refillCursorKey()); cursor != nil {
+		// resume right after the last examined key
+		start = append(append([]byte{}, cursor...), 0x00)
+	}
+
+	iter := store.Iterator(start, sdk.PrefixEndBytes(autoRefillPrefix()))
+
+	var entries []entry
+	var last []byte
+
+	for ; iter.Valid() && len(entries) < maxIterations; iter.Next() {
+		last = append([]byte{}, iter.Key()...)
+
+		var policy ev1beta4.AutoRefill
+		k.cdc.MustUnmarshal(iter.Value(), &policy)
+
+		entries = append(entries, entry{
+			id:     accountIDFromKeySuffix(iter.Key()[len(autoRefillPrefix()):]),
+			policy: policy,
+		})
+	}
+
+	if iter.Valid() {
+		store.Set(refillCursorKey(), last)
+	} else {
+		store.Delete(refillCursorKey())
+	}
+
+	_ = iter.Close()
+
+	refilled := 0
+
+	for _, e := range entries {
+		account, err := k.GetAccount(ctx, e.id)
+		if err != nil || account.State != types.AccountOpen {
+			k.DeleteAccountAutoRefill(ctx, e.id)
+			continue
+		}
+
+		balance, found := k.accountProjectedBalance(ctx, account, e.policy.Threshold.Denom)
+		if found && !balance.IsLT(e.policy.Threshold) {
+			continue
+		}
+
+		// isolate writes so that failed deposit does not leave grant or account half-updated
+		cctx, write := ctx.CacheContext()
+
+		if err := k.refillAccount(cctx, account, e.policy); err != nil {
+			ctx.Logger().Info("escrow refill: policy removed", "err", err, "id", e.id)
+			k.DeleteAccountAutoRefill(ctx, e.id)
+			continue
+		}
+
+		write()
+		ctx.EventManager().EmitEvents(cctx.EventManager().Events())
+
+		refilled++
+	}
+
+	return refilled
+}
+
+func (k *keeper) refillAccount(ctx sdk.Context, account types.Account, policy ev1beta4.AutoRefill) error {
+	owner := sdk.MustAccAddressFromBech32(account.Owner)
+	funder := sdk.MustAccAddressFromBech32(policy.Funder)
+
+	amount := policy.Amount
+
+	if !owner.Equals(funder) {
+		msg := &dtypes.MsgDepositDeployment{Amount: amount}
+		msgType := sdk.MsgTypeURL(msg)
+
+		authorization, expiration := k.authzKeeper.GetCleanAuthorization(ctx, owner, funder, msgType)
+		if authorization == nil {
+			return sdkerrors.ErrUnauthorized.Wrap("authorization not found")
+		}
+
+		if dauthz, valid := authorization.(*dtypes.DepositDeploymentAuthorization); valid {
+			if dauthz.SpendLimit.Denom != amount.Denom {
+				return sdkerrors.ErrUnauthorized.Wrapf("authorization spend limit is in %s", dauthz.SpendLimit.Denom)
+			}
+
+			// use whatever is left from the spend limit for the last refill
+			if dauthz.SpendLimit.IsLT(amount) {
+				amount = dauthz.SpendLimit
+				msg.Amount = amount
+			}
+		}
+
+		if !amount.IsPositive() {
+			return sdkerrors.ErrInsufficientFunds.Wrap("authorization spend limit used up")
+		}
+
+		resp, err := authorization.Accept(ctx, msg)
+		if err != nil {
+			return err
+		}
+
+		if !resp.Accept {
+			return sdkerrors.ErrUnauthorized
+		}
+
+		updated, valid := resp.Updated.(*dtypes.DepositDeploymentAuthorization)
+		if resp.Delete || (valid && updated.SpendLimit.IsZero()) {
+			err = k.authzKeeper.DeleteGrant(ctx, owner, funder, msgType)
+		} else if resp.Updated != nil {
+			err = k.authzKeeper.SaveGrant(ctx, owner, funder, resp.Updated, expiration)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return k.AccountDeposit(ctx, account.ID, funder, amount)
+}
+
+// accountProjectedBalance returns account balance in given denomination remaining after paying
+// open payments priced in it up to current height
+func (k *keeper) accountProjectedBalance(ctx sdk.Context, account types.Account, denom string) (sdk.Coin, bool) {
+	views := k.accountViews(ctx, account)
+
+	vidx := accountViewIndex(views, denom)
+	if vidx < 0 {
+		return sdk.Coin{}, false
+	}
+
+	total := views[vidx].TotalBalance()
+
+	heightDelta := ctx.BlockHeight() - account.SettledAt
+
+	for _, payment := range k.accountOpenPayments(ctx, account.ID) {
+		if payment.Rate.Denom != denom {
+			continue
+		}
+		total.Amount = total.Amount.Sub(payment.Rate.Amount.MulInt64(heightDelta))
+	}
+
+	if total.Amount.IsNegative() {
+		total.Amount = sdk.ZeroDec()
+	}
+
+	return sdk.NewCoin(total.Denom, total.Amount.TruncateInt()), true
+}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	types "github.com/akash-network/akash-api/go/node/escrow/v1beta3"
+)
+
+const (
+	// SweepMaxIterations is the maximum number of accounts examined by the EndBlock sweep
+	SweepMaxIterations = 500
+
+	// SweepMaxSettlements is the maximum number of depleted accounts settled by the EndBlock sweep
+	SweepMaxSettlements = 50
+)
+
+// SweepAccounts settles open accounts which ran out of funds at current height,
+// which moves them into overdrawn state and fires the account and payment closed hooks.
+// Accounts are picked from depletion index, lowest depletion height first.
+// At most maxIterations accounts are examined and maxSettlements accounts settled.
+// It returns number of settled accounts.
+func (k *keeper) SweepAccounts(ctx sdk.Context, maxIterations, maxSettlements int) int {
+	if maxIterations <= 0 || maxSettlements <= 0 {
+		return 0
+	}
+
+	var depleted []types.AccountID
+
+	iterations := 0
+
+	// account is overdrawn once settled past its depletion height
+	k.WithDepletingAccounts(ctx, ctx.BlockHeight()-1, func(obj DepletingAccount) bool {
+		iterations++
+
+		if obj.Account.State == types.AccountOpen {
+			depleted = append(depleted, obj.Account.ID)
+		}
+
+		return iterations >= maxIterations || len(depleted) >= maxSettlements
+	})
+
+	settled := 0
+
+	for _, id := range depleted {
+		// isolate writes so that failed settlement does not leave account half-updated
+		cctx, write := ctx.CacheContext()
+
+		if _, err := k.AccountSettle(cctx, id); err != nil {
+			ctx.Logger().Error("escrow sweep: account settle", "err", err, "id", id)
+			continue
+		}
+
+		write()
+		ctx.EventManager().EmitEvents(cctx.EventManager().Events())
+
+		settled++
+	}
+
+	return settled
+}
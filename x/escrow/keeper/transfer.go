@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	types "github.com/akash-network/akash-api/go/node/escrow/v1beta3"
+)
+
+// AccountTransfer moves account with given id and its payments under new id owned by given owner.
+// Balances in all denominations follow the account, funds deposited via authorization stay with their depositor.
+// Auto refill policy of the account is dropped as it was set up by the previous owner.
+func (k *keeper) AccountTransfer(ctx sdk.Context, id, to types.AccountID, owner sdk.AccAddress) error {
+	store := ctx.KVStore(k.skey)
+
+	account, err := k.GetAccount(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if store.Has(accountKey(to)) {
+		return types.ErrAccountExists
+	}
+
+	payments := k.accountPayments(ctx, id)
+	balances := k.GetAccountBalances(ctx, id)
+
+	k.removeDepletionIndex(ctx, id)
+	k.DeleteAccountAutoRefill(ctx, id)
+
+	store.Delete(accountKey(id))
+	store.Delete(balancesKey(id))
+	for _, payment := range payments {
+		store.Delete(paymentKey(id, payment.PaymentID))
+	}
+
+	if !account.HasDepositor() {
+		account.Depositor = owner.String()
+	}
+
+	account.ID = to
+	account.Owner = owner.String()
+	k.saveAccount(ctx, &account)
+
+	balances.ID = to
+	k.SaveAccountBalances(ctx, balances)
+
+	open := make([]types.FractionalPayment, 0, len(payments))
+
+	for idx := range payments {
+		payments[idx].AccountID = to
+		k.savePayment(ctx, &payments[idx])
+
+		if payments[idx].State == types.PaymentOpen {
+			open = append(open, payments[idx])
+		}
+	}
+
+	k.updateDepletionIndex(ctx, account, open)
+
+	return nil
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/akash-network/node/x/escrow/client/rest"
 	"github.com/akash-network/node/x/escrow/keeper"
 	"github.com/akash-network/node/x/escrow/query"
+	ev1beta4 "github.com/akash-network/node/x/escrow/types/v1beta4"
 )
 
 var (
@@ -71,7 +72,7 @@ func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingCo
 		return nil
 	}
 
-	var data types.GenesisState
+	var data ev1beta4.GenesisState
 
 	err := cdc.UnmarshalJSON(bz, &data)
 	if err != nil {
@@ -158,6 +159,7 @@ func (am AppModule) LegacyQuerierHandler(legacyQuerierCdc *codec.LegacyAmino) sd
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	querier := keeper.NewQuerier(am.keeper)
 	types.RegisterQueryServer(cfg.QueryServer(), querier)
+	ev1beta4.RegisterQueryServer(cfg.QueryServer(), querier)
 
 	utypes.ModuleMigrations(ModuleName, am.keeper, func(name string, forVersion uint64, handler module.MigrationHandler) {
 		if err := cfg.RegisterMigration(name, forVersion, handler); err != nil {
@@ -171,21 +173,24 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 func (am AppModule) RegisterQueryService(server grpc.Server) {
 	querier := keeper.NewQuerier(am.keeper)
 	types.RegisterQueryServer(server, querier)
+	ev1beta4.RegisterQueryServer(server, querier)
 }
 
 // BeginBlock performs no-op
 func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
-// EndBlock returns the end blocker for the audit module. It returns no auditor
-// updates.
+// EndBlock tops up escrow accounts with auto refill policies and settles accounts
+// which ran out of funds. It returns no validator updates.
 func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	am.keeper.RefillAccounts(ctx, keeper.RefillMaxIterations)
+	am.keeper.SweepAccounts(ctx, keeper.SweepMaxIterations, keeper.SweepMaxSettlements)
 	return []abci.ValidatorUpdate{}
 }
 
 // InitGenesis performs genesis initialization for the audit module. It returns
 // no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
-	var genesisState types.GenesisState
+	var genesisState ev1beta4.GenesisState
 	cdc.MustUnmarshalJSON(data, &genesisState)
 	return InitGenesis(ctx, am.keeper, &genesisState)
 }
@@ -0,0 +1,53 @@
+package query
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	types "github.com/akash-network/akash-api/go/node/escrow/v1beta3"
+)
+
+const (
+	settlePreviewPath = "settle-preview"
+	depletingPath     = "depleting"
+	autoRefillPath    = "auto-refill"
+)
+
+var (
+	ErrInvalidPath = errors.New("query: invalid path")
+)
+
+// SettlePreviewPath returns settle preview path of given account id for queries
+func SettlePreviewPath(id types.AccountID) string {
+	return fmt.Sprintf("%s/%s/%s", settlePreviewPath, id.Scope, id.XID)
+}
+
+// AutoRefillPath returns auto refill policy path of given account id for queries
+func AutoRefillPath(id types.AccountID) string {
+	return fmt.Sprintf("%s/%s/%s", autoRefillPath, id.Scope, id.XID)
+}
+
+// ParseAccountPath returns AccountID details from provided path parts.
+// XID may itself contain path separators, e.g. deployment ids are formatted as owner/dseq
+func ParseAccountPath(parts []string) (types.AccountID, error) {
+	if len(parts) < 2 {
+		return types.AccountID{}, ErrInvalidPath
+	}
+
+	id := types.AccountID{
+		Scope: parts[0],
+		XID:   strings.Join(parts[1:], "/"),
+	}
+
+	if err := id.ValidateBasic(); err != nil {
+		return types.AccountID{}, err
+	}
+
+	return id, nil
+}
+
+// DepletingPath returns path for querying accounts depleting within given number of blocks
+func DepletingPath(blocks int64) string {
+	return fmt.Sprintf("%s/%d", depletingPath, blocks)
+}
@@ -1,12 +1,93 @@
 package query
 
 import (
+	"math"
+	"strconv"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	types "github.com/akash-network/akash-api/go/node/escrow/v1beta3"
 
 	"github.com/akash-network/node/x/escrow/keeper"
 )
 
+// maxDepletingAccounts caps the number of accounts returned by depleting query
+const maxDepletingAccounts = 1000
+
 func NewQuerier(keeper keeper.Keeper, cdc *codec.LegacyAmino) sdk.Querier {
-	return nil
+	return func(ctx sdk.Context, path []string, _ abci.RequestQuery) ([]byte, error) {
+		if len(path) == 0 {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "empty %s query path", types.ModuleName)
+		}
+
+		switch path[0] {
+		case settlePreviewPath:
+			return querySettlePreview(ctx, path[1:], keeper, cdc)
+		case depletingPath:
+			return queryDepleting(ctx, path[1:], keeper, cdc)
+		case autoRefillPath:
+			return queryAutoRefill(ctx, path[1:], keeper, cdc)
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint: %s", types.ModuleName, path[0])
+		}
+	}
+}
+
+func querySettlePreview(ctx sdk.Context, path []string, keeper keeper.Keeper, cdc *codec.LegacyAmino) ([]byte, error) {
+	id, err := ParseAccountPath(path)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	preview, err := keeper.AccountSettlePreview(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return codec.MarshalJSONIndent(cdc, preview)
+}
+
+func queryAutoRefill(ctx sdk.Context, path []string, keeper keeper.Keeper, cdc *codec.LegacyAmino) ([]byte, error) {
+	id, err := ParseAccountPath(path)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	policy, err := keeper.GetAccountAutoRefill(ctx, id)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrNotFound, err.Error())
+	}
+
+	return codec.MarshalJSONIndent(cdc, policy)
+}
+
+func queryDepleting(ctx sdk.Context, path []string, k keeper.Keeper, cdc *codec.LegacyAmino) ([]byte, error) {
+	if len(path) != 1 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, ErrInvalidPath.Error())
+	}
+
+	blocks, err := strconv.ParseInt(path[0], 10, 64)
+	if err != nil || blocks < 0 {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid number of blocks: %s", path[0])
+	}
+
+	height := ctx.BlockHeight()
+	if blocks > math.MaxInt64-height {
+		height = math.MaxInt64
+	} else {
+		height += blocks
+	}
+
+	accounts := make([]keeper.DepletingAccount, 0)
+
+	k.WithDepletingAccounts(ctx, height, func(obj keeper.DepletingAccount) bool {
+		accounts = append(accounts, obj)
+		return len(accounts) >= maxDepletingAccounts
+	})
+
+	return codec.MarshalJSONIndent(cdc, accounts)
 }
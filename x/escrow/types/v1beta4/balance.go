@@ -0,0 +1,51 @@
+package v1beta4
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v1beta3 "github.com/akash-network/akash-api/go/node/escrow/v1beta3"
+)
+
+// DenomBalances is a list of escrow account balances, one per denomination
+type DenomBalances []DenomBalance
+
+// Denom returns denomination of the balance
+func (m DenomBalance) Denom() string {
+	return m.Balance.Denom
+}
+
+// ValidateBasic checks all coins are valid and share denomination
+func (m DenomBalance) ValidateBasic() error {
+	for _, coin := range []sdk.DecCoin{m.Balance, m.Transferred, m.Funds} {
+		if err := coin.Validate(); err != nil {
+			return err
+		}
+
+		if coin.Denom != m.Balance.Denom {
+			return fmt.Errorf("%w: mixed denominations %s and %s", v1beta3.ErrInvalidDenomination, m.Balance.Denom, coin.Denom)
+		}
+	}
+
+	return nil
+}
+
+// ValidateBasic checks balances are valid and do not repeat denomination
+func (m AccountBalances) ValidateBasic() error {
+	denoms := make(map[string]bool, len(m.Balances))
+
+	for _, balance := range m.Balances {
+		if err := balance.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if denoms[balance.Denom()] {
+			return fmt.Errorf("%w: duplicate denomination %s", v1beta3.ErrInvalidDenomination, balance.Denom())
+		}
+
+		denoms[balance.Denom()] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,683 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: akash/escrow/v1beta4/balance.proto
+
+package v1beta4
+
+import (
+	fmt "fmt"
+	v1beta3 "github.com/akash-network/akash-api/go/node/escrow/v1beta3"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DenomBalance stores escrow account state in a denomination other than
+// the one account was created with. Fields mirror the ones of akash.escrow.v1beta3.Account.
+type DenomBalance struct {
+	// unspent coins received from the owner's wallet
+	Balance types.DecCoin `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance" yaml:"balance"`
+	// total coins spent by this account
+	Transferred types.DecCoin `protobuf:"bytes,2,opt,name=transferred,proto3" json:"transferred" yaml:"transferred"`
+	// unspent coins received from the depositor
+	Funds types.DecCoin `protobuf:"bytes,3,opt,name=funds,proto3" json:"funds" yaml:"funds"`
+}
+
+func (m *DenomBalance) Reset()         { *m = DenomBalance{} }
+func (m *DenomBalance) String() string { return proto.CompactTextString(m) }
+func (*DenomBalance) ProtoMessage()    {}
+func (*DenomBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4b60ce94fb1a29e, []int{0}
+}
+func (m *DenomBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomBalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomBalance.Merge(m, src)
+}
+func (m *DenomBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomBalance proto.InternalMessageInfo
+
+func (m *DenomBalance) GetBalance() types.DecCoin {
+	if m != nil {
+		return m.Balance
+	}
+	return types.DecCoin{}
+}
+
+func (m *DenomBalance) GetTransferred() types.DecCoin {
+	if m != nil {
+		return m.Transferred
+	}
+	return types.DecCoin{}
+}
+
+func (m *DenomBalance) GetFunds() types.DecCoin {
+	if m != nil {
+		return m.Funds
+	}
+	return types.DecCoin{}
+}
+
+// AccountBalances lists balances of escrow account in additional denominations
+type AccountBalances struct {
+	ID       v1beta3.AccountID `protobuf:"bytes,1,opt,name=id,proto3" json:"id" yaml:"id"`
+	Balances DenomBalances     `protobuf:"bytes,2,rep,name=balances,proto3,castrepeated=DenomBalances" json:"balances" yaml:"balances"`
+}
+
+func (m *AccountBalances) Reset()         { *m = AccountBalances{} }
+func (m *AccountBalances) String() string { return proto.CompactTextString(m) }
+func (*AccountBalances) ProtoMessage()    {}
+func (*AccountBalances) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4b60ce94fb1a29e, []int{1}
+}
+func (m *AccountBalances) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountBalances) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountBalances.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountBalances) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountBalances.Merge(m, src)
+}
+func (m *AccountBalances) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountBalances) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountBalances.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountBalances proto.InternalMessageInfo
+
+func (m *AccountBalances) GetID() v1beta3.AccountID {
+	if m != nil {
+		return m.ID
+	}
+	return v1beta3.AccountID{}
+}
+
+func (m *AccountBalances) GetBalances() DenomBalances {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*DenomBalance)(nil), "akash.escrow.v1beta4.DenomBalance")
+	proto.RegisterType((*AccountBalances)(nil), "akash.escrow.v1beta4.AccountBalances")
+}
+
+func init() {
+	proto.RegisterFile("akash/escrow/v1beta4/balance.proto", fileDescriptor_c4b60ce94fb1a29e)
+}
+
+var fileDescriptor_c4b60ce94fb1a29e = []byte{
+	// 416 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x3f, 0x6f, 0xd4, 0x30,
+	0x14, 0xbf, 0xa4, 0xe2, 0x9f, 0xaf, 0x50, 0x29, 0xea, 0x10, 0x55, 0x10, 0x1f, 0x9e, 0xca, 0x80,
+	0xad, 0xeb, 0x65, 0x62, 0x23, 0x64, 0xe9, 0x52, 0x89, 0x6c, 0xb0, 0x39, 0x8e, 0x7b, 0xb5, 0xda,
+	0xd8, 0x55, 0xec, 0xa3, 0xf4, 0x5b, 0xf0, 0x2d, 0x90, 0xf8, 0x24, 0x1d, 0xbb, 0xc1, 0x64, 0x50,
+	0x6e, 0xeb, 0x98, 0x4f, 0x80, 0x2e, 0x76, 0xaa, 0x54, 0xba, 0xa1, 0x5b, 0xf2, 0xde, 0xef, 0x9f,
+	0xdf, 0x7b, 0x00, 0xd1, 0x73, 0xaa, 0xcf, 0x08, 0xd7, 0xac, 0x51, 0x57, 0xe4, 0xdb, 0xbc, 0xe4,
+	0x86, 0xa6, 0xa4, 0xa4, 0x17, 0x54, 0x32, 0x8e, 0x2f, 0x1b, 0x65, 0x54, 0xb4, 0xdf, 0x63, 0xb0,
+	0xc3, 0x60, 0x8f, 0x39, 0xd8, 0x5f, 0xaa, 0xa5, 0xea, 0x01, 0x64, 0xf3, 0xe5, 0xb0, 0x07, 0x09,
+	0x53, 0xba, 0x56, 0x9a, 0x94, 0x54, 0x73, 0x2f, 0x37, 0x27, 0x4c, 0x09, 0xe9, 0xfb, 0xb3, 0x2d,
+	0x7e, 0x0b, 0x62, 0xae, 0x2f, 0xb9, 0x76, 0x08, 0xf4, 0x33, 0x04, 0xbb, 0x39, 0x97, 0xaa, 0xce,
+	0x5c, 0x88, 0xe8, 0x0b, 0x78, 0xe6, 0xf3, 0xc4, 0xc1, 0x2c, 0x38, 0x9c, 0x1e, 0xbd, 0xc6, 0xce,
+	0x04, 0x6f, 0x4c, 0x7c, 0x9e, 0x39, 0xce, 0x39, 0xfb, 0xa4, 0x84, 0xcc, 0xde, 0xde, 0x58, 0x38,
+	0xb9, 0xb3, 0x70, 0x20, 0x75, 0x16, 0xbe, 0xba, 0xa6, 0xf5, 0xc5, 0x07, 0xe4, 0x0b, 0xa8, 0x18,
+	0x5a, 0x91, 0x00, 0x53, 0xd3, 0x50, 0xa9, 0x4f, 0x79, 0xd3, 0xf0, 0x2a, 0x0e, 0x1f, 0x21, 0xff,
+	0xce, 0xcb, 0x8f, 0x89, 0x9d, 0x85, 0x91, 0xb3, 0x18, 0x15, 0x51, 0x31, 0x86, 0x44, 0x9f, 0xc1,
+	0x93, 0xd3, 0x95, 0xac, 0x74, 0xbc, 0xf3, 0x08, 0x93, 0x37, 0xde, 0xc4, 0x51, 0x3a, 0x0b, 0x77,
+	0x9d, 0x7c, 0xff, 0x8b, 0x0a, 0x57, 0x46, 0xbf, 0x03, 0xb0, 0xf7, 0x91, 0x31, 0xb5, 0x92, 0xc6,
+	0xcf, 0x4a, 0x47, 0x27, 0x20, 0x14, 0x95, 0x9f, 0x13, 0xc4, 0x5b, 0x16, 0xb7, 0xc0, 0x9e, 0x72,
+	0x9c, 0x3b, 0x9b, 0xd6, 0xc2, 0xf0, 0x38, 0xbf, 0xb3, 0x30, 0x14, 0x9b, 0x87, 0xbc, 0x70, 0x4e,
+	0xa2, 0x42, 0x45, 0x28, 0xaa, 0xc8, 0x80, 0xe7, 0x7e, 0x58, 0x3a, 0x0e, 0x67, 0x3b, 0x87, 0xd3,
+	0x23, 0xb4, 0x4d, 0x35, 0xc5, 0xe3, 0x95, 0x65, 0xa9, 0xcf, 0x7f, 0xcf, 0xed, 0x2c, 0xdc, 0x7b,
+	0xb0, 0x04, 0x8d, 0x7e, 0xfd, 0x85, 0x2f, 0xc7, 0x24, 0x5d, 0xdc, 0xa3, 0xb3, 0x93, 0x9b, 0x36,
+	0x09, 0x6e, 0xdb, 0x24, 0xf8, 0xd7, 0x26, 0xc1, 0x8f, 0x75, 0x32, 0xb9, 0x5d, 0x27, 0x93, 0x3f,
+	0xeb, 0x64, 0xf2, 0x35, 0x5d, 0x0a, 0x73, 0xb6, 0x2a, 0x31, 0x53, 0x35, 0xe9, 0x73, 0xbc, 0x97,
+	0xdc, 0x5c, 0xa9, 0xe6, 0x9c, 0x48, 0x55, 0x71, 0xf2, 0x7d, 0xb8, 0xac, 0xfe, 0xa2, 0x86, 0x7b,
+	0x2e, 0x9f, 0xf6, 0xa7, 0xb5, 0xf8, 0x3f, 0x00, 0x7b, 0xae, 0xbc, 0x2a, 0xee, 0x02, 0x00, 0x00,
+}
+
+func (m *DenomBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Funds.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBalance(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Transferred.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBalance(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBalance(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountBalances) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountBalances) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountBalances) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBalance(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBalance(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBalance(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBalance(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DenomBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Balance.Size()
+	n += 1 + l + sovBalance(uint64(l))
+	l = m.Transferred.Size()
+	n += 1 + l + sovBalance(uint64(l))
+	l = m.Funds.Size()
+	n += 1 + l + sovBalance(uint64(l))
+	return n
+}
+
+func (m *AccountBalances) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovBalance(uint64(l))
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovBalance(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovBalance(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBalance(x uint64) (n int) {
+	return sovBalance(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DenomBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBalance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBalance
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBalance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transferred", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBalance
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBalance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Transferred.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBalance
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBalance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Funds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBalance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBalance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountBalances) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBalance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountBalances: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountBalances: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBalance
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBalance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBalance
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBalance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, DenomBalance{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBalance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBalance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBalance(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBalance
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBalance
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBalance
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBalance
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBalance
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBalance
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBalance        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBalance          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBalance = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,16 @@
+package v1beta4
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// error codes continue after range used by akash-api escrow types
+const (
+	errAutoRefillNotFound uint32 = iota + 100
+	errInvalidAutoRefill
+)
+
+var (
+	ErrAutoRefillNotFound = sdkerrors.Register(ModuleName, errAutoRefillNotFound, "auto refill policy not found")
+	ErrInvalidAutoRefill  = sdkerrors.Register(ModuleName, errInvalidAutoRefill, "invalid auto refill policy")
+)
@@ -0,0 +1,242 @@
+package v1beta4
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v1beta3 "github.com/akash-network/akash-api/go/node/escrow/v1beta3"
+	"github.com/akash-network/akash-api/go/sdkutil"
+)
+
+const (
+	EvActionAccountClosed    = "account-closed"
+	EvActionAccountOverdrawn = "account-overdrawn"
+	EvActionPaymentWithdrawn = "payment-withdrawn"
+	EvActionPaymentClosed    = "payment-closed"
+
+	EvScopeKey     = "scope"
+	EvXIDKey       = "xid"
+	EvOwnerKey     = "owner"
+	EvPaymentIDKey = "pid"
+	EvAmountKey    = "amount"
+	EvStateKey     = "state"
+)
+
+// EventAccountClosed struct
+type EventAccountClosed struct {
+	Context sdkutil.BaseModuleEvent `json:"context"`
+	ID      v1beta3.AccountID       `json:"id"`
+	Owner   string                  `json:"owner"`
+}
+
+func NewEventAccountClosed(id v1beta3.AccountID, owner string) EventAccountClosed {
+	return EventAccountClosed{
+		Context: sdkutil.BaseModuleEvent{
+			Module: ModuleName,
+			Action: EvActionAccountClosed,
+		},
+		ID:    id,
+		Owner: owner,
+	}
+}
+
+// ToSDKEvent method creates new sdk event for EventAccountClosed struct
+func (ev EventAccountClosed) ToSDKEvent() sdk.Event {
+	return sdk.NewEvent(sdkutil.EventTypeMessage,
+		append(append([]sdk.Attribute{
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, EvActionAccountClosed),
+		}, AccountEVAttributes(ev.ID)...),
+			sdk.NewAttribute(EvOwnerKey, ev.Owner),
+		)...,
+	)
+}
+
+// EventAccountOverdrawn struct
+type EventAccountOverdrawn struct {
+	Context sdkutil.BaseModuleEvent `json:"context"`
+	ID      v1beta3.AccountID       `json:"id"`
+	Owner   string                  `json:"owner"`
+}
+
+func NewEventAccountOverdrawn(id v1beta3.AccountID, owner string) EventAccountOverdrawn {
+	return EventAccountOverdrawn{
+		Context: sdkutil.BaseModuleEvent{
+			Module: ModuleName,
+			Action: EvActionAccountOverdrawn,
+		},
+		ID:    id,
+		Owner: owner,
+	}
+}
+
+// ToSDKEvent method creates new sdk event for EventAccountOverdrawn struct
+func (ev EventAccountOverdrawn) ToSDKEvent() sdk.Event {
+	return sdk.NewEvent(sdkutil.EventTypeMessage,
+		append(append([]sdk.Attribute{
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, EvActionAccountOverdrawn),
+		}, AccountEVAttributes(ev.ID)...),
+			sdk.NewAttribute(EvOwnerKey, ev.Owner),
+		)...,
+	)
+}
+
+// EventPaymentWithdrawn struct
+type EventPaymentWithdrawn struct {
+	Context   sdkutil.BaseModuleEvent `json:"context"`
+	AccountID v1beta3.AccountID       `json:"account_id"`
+	PaymentID string                  `json:"payment_id"`
+	Owner     string                  `json:"owner"`
+	Amount    sdk.Coin                `json:"amount"`
+}
+
+func NewEventPaymentWithdrawn(id v1beta3.AccountID, pid string, owner string, amount sdk.Coin) EventPaymentWithdrawn {
+	return EventPaymentWithdrawn{
+		Context: sdkutil.BaseModuleEvent{
+			Module: ModuleName,
+			Action: EvActionPaymentWithdrawn,
+		},
+		AccountID: id,
+		PaymentID: pid,
+		Owner:     owner,
+		Amount:    amount,
+	}
+}
+
+// ToSDKEvent method creates new sdk event for EventPaymentWithdrawn struct
+func (ev EventPaymentWithdrawn) ToSDKEvent() sdk.Event {
+	return sdk.NewEvent(sdkutil.EventTypeMessage,
+		append(append([]sdk.Attribute{
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, EvActionPaymentWithdrawn),
+		}, PaymentEVAttributes(ev.AccountID, ev.PaymentID)...),
+			sdk.NewAttribute(EvOwnerKey, ev.Owner),
+			sdk.NewAttribute(EvAmountKey, ev.Amount.String()),
+		)...,
+	)
+}
+
+// EventPaymentClosed struct
+type EventPaymentClosed struct {
+	Context   sdkutil.BaseModuleEvent         `json:"context"`
+	AccountID v1beta3.AccountID               `json:"account_id"`
+	PaymentID string                          `json:"payment_id"`
+	Owner     string                          `json:"owner"`
+	State     v1beta3.FractionalPayment_State `json:"state"`
+}
+
+func NewEventPaymentClosed(id v1beta3.AccountID, pid string, owner string, state v1beta3.FractionalPayment_State) EventPaymentClosed {
+	return EventPaymentClosed{
+		Context: sdkutil.BaseModuleEvent{
+			Module: ModuleName,
+			Action: EvActionPaymentClosed,
+		},
+		AccountID: id,
+		PaymentID: pid,
+		Owner:     owner,
+		State:     state,
+	}
+}
+
+// ToSDKEvent method creates new sdk event for EventPaymentClosed struct
+func (ev EventPaymentClosed) ToSDKEvent() sdk.Event {
+	return sdk.NewEvent(sdkutil.EventTypeMessage,
+		append(append([]sdk.Attribute{
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, EvActionPaymentClosed),
+		}, PaymentEVAttributes(ev.AccountID, ev.PaymentID)...),
+			sdk.NewAttribute(EvOwnerKey, ev.Owner),
+			sdk.NewAttribute(EvStateKey, ev.State.String()),
+		)...,
+	)
+}
+
+// AccountEVAttributes returns event attributes for given account id
+func AccountEVAttributes(id v1beta3.AccountID) []sdk.Attribute {
+	return []sdk.Attribute{
+		sdk.NewAttribute(EvScopeKey, id.Scope),
+		sdk.NewAttribute(EvXIDKey, id.XID),
+	}
+}
+
+// PaymentEVAttributes returns event attributes for given payment id
+func PaymentEVAttributes(id v1beta3.AccountID, pid string) []sdk.Attribute {
+	return append(AccountEVAttributes(id),
+		sdk.NewAttribute(EvPaymentIDKey, pid))
+}
+
+// ParseEVAccountID returns account id for given event attributes
+func ParseEVAccountID(attrs []sdk.Attribute) (v1beta3.AccountID, error) {
+	scope, err := sdkutil.GetString(attrs, EvScopeKey)
+	if err != nil {
+		return v1beta3.AccountID{}, err
+	}
+
+	xid, err := sdkutil.GetString(attrs, EvXIDKey)
+	if err != nil {
+		return v1beta3.AccountID{}, err
+	}
+
+	return v1beta3.AccountID{
+		Scope: scope,
+		XID:   xid,
+	}, nil
+}
+
+// ParseEvent parses event and returns details of event and error if occurred
+func ParseEvent(ev sdkutil.Event) (sdkutil.ModuleEvent, error) {
+	if ev.Type != sdkutil.EventTypeMessage {
+		return nil, sdkutil.ErrUnknownType
+	}
+	if ev.Module != ModuleName {
+		return nil, sdkutil.ErrUnknownModule
+	}
+
+	id, err := ParseEVAccountID(ev.Attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := sdkutil.GetString(ev.Attributes, EvOwnerKey)
+	if err != nil {
+		return nil, err
+	}
+
+	switch ev.Action {
+	case EvActionAccountClosed:
+		return NewEventAccountClosed(id, owner), nil
+	case EvActionAccountOverdrawn:
+		return NewEventAccountOverdrawn(id, owner), nil
+	case EvActionPaymentWithdrawn:
+		pid, err := sdkutil.GetString(ev.Attributes, EvPaymentIDKey)
+		if err != nil {
+			return nil, err
+		}
+
+		val, err := sdkutil.GetString(ev.Attributes, EvAmountKey)
+		if err != nil {
+			return nil, err
+		}
+
+		amount, err := sdk.ParseCoinNormalized(val)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewEventPaymentWithdrawn(id, pid, owner, amount), nil
+	case EvActionPaymentClosed:
+		pid, err := sdkutil.GetString(ev.Attributes, EvPaymentIDKey)
+		if err != nil {
+			return nil, err
+		}
+
+		state, err := sdkutil.GetString(ev.Attributes, EvStateKey)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewEventPaymentClosed(id, pid, owner, v1beta3.FractionalPayment_State(v1beta3.FractionalPayment_State_value[state])), nil
+	default:
+		return nil, sdkutil.ErrUnknownAction
+	}
+}
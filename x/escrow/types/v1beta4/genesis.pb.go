@@ -0,0 +1,531 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: akash/escrow/v1beta4/genesis.proto
+
+package v1beta4
+
+import (
+	fmt "fmt"
+	v1beta3 "github.com/akash-network/akash-api/go/node/escrow/v1beta3"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the basic genesis state used by escrow module.
+// It extends akash.escrow.v1beta3.GenesisState with state introduced by node.
+type GenesisState struct {
+	Accounts    []v1beta3.Account           `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts" yaml:"accounts"`
+	Payments    []v1beta3.FractionalPayment `protobuf:"bytes,2,rep,name=payments,proto3" json:"payments" yaml:"payments"`
+	AutoRefills []AccountAutoRefill         `protobuf:"bytes,3,rep,name=auto_refills,json=autoRefills,proto3" json:"auto_refills" yaml:"auto_refills"`
+	Balances    []AccountBalances           `protobuf:"bytes,4,rep,name=balances,proto3" json:"balances" yaml:"balances"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3bf4b4b5027758d0, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetAccounts() []v1beta3.Account {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPayments() []v1beta3.FractionalPayment {
+	if m != nil {
+		return m.Payments
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAutoRefills() []AccountAutoRefill {
+	if m != nil {
+		return m.AutoRefills
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBalances() []AccountBalances {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "akash.escrow.v1beta4.GenesisState")
+}
+
+func init() {
+	proto.RegisterFile("akash/escrow/v1beta4/genesis.proto", fileDescriptor_3bf4b4b5027758d0)
+}
+
+var fileDescriptor_3bf4b4b5027758d0 = []byte{
+	// 371 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcf, 0x4a, 0xf3, 0x40,
+	0x14, 0xc5, 0x93, 0xaf, 0x1f, 0x52, 0xd2, 0x82, 0x10, 0xbb, 0x28, 0x05, 0x93, 0x3a, 0x22, 0x0a,
+	0x62, 0x06, 0x6d, 0x57, 0xee, 0x9a, 0x85, 0xee, 0x44, 0xe2, 0xce, 0x85, 0x32, 0x89, 0xd3, 0x34,
+	0x34, 0xcd, 0x94, 0xcc, 0xc4, 0xda, 0xb7, 0xf0, 0x75, 0x7c, 0x83, 0x2e, 0xbb, 0x74, 0x15, 0xa4,
+	0xdd, 0xb9, 0xec, 0x13, 0x48, 0xe7, 0x4f, 0xa2, 0x10, 0xdd, 0x85, 0x7b, 0x7f, 0xf7, 0x9c, 0x39,
+	0xe4, 0x18, 0x00, 0x8d, 0x11, 0x1d, 0x41, 0x4c, 0x83, 0x94, 0xcc, 0xe0, 0xf3, 0xb9, 0x8f, 0x19,
+	0xea, 0xc3, 0x10, 0x27, 0x98, 0x46, 0xd4, 0x99, 0xa6, 0x84, 0x11, 0xb3, 0xc5, 0x19, 0x47, 0x30,
+	0x8e, 0x64, 0x3a, 0xad, 0x90, 0x84, 0x84, 0x03, 0x70, 0xfb, 0x25, 0xd8, 0x4e, 0xb7, 0x42, 0xaf,
+	0x07, 0xd9, 0x7c, 0x8a, 0xa5, 0x5a, 0xa7, 0xda, 0xd1, 0x47, 0x31, 0x4a, 0x02, 0x2c, 0x99, 0x83,
+	0x4a, 0x26, 0xc5, 0xc3, 0x28, 0x8e, 0x05, 0x02, 0xde, 0x6a, 0x46, 0xf3, 0x5a, 0x3c, 0xf3, 0x8e,
+	0x21, 0x86, 0xcd, 0x07, 0xa3, 0x8e, 0x82, 0x80, 0x64, 0x09, 0xa3, 0x6d, 0xbd, 0x5b, 0x3b, 0x69,
+	0x5c, 0xec, 0x3b, 0x15, 0x0f, 0xef, 0x39, 0x03, 0x41, 0xb9, 0x87, 0x8b, 0xdc, 0xd6, 0x3e, 0x73,
+	0xbb, 0x38, 0xdb, 0xe4, 0xf6, 0xee, 0x1c, 0x4d, 0xe2, 0x4b, 0xa0, 0x26, 0xc0, 0x2b, 0x96, 0xe6,
+	0xc8, 0xa8, 0x4f, 0xd1, 0x7c, 0x82, 0xb7, 0xfa, 0xff, 0xb8, 0xfe, 0x71, 0xb5, 0xfe, 0x55, 0x8a,
+	0x02, 0x16, 0x91, 0x04, 0xc5, 0xb7, 0x82, 0x2f, 0x9d, 0x94, 0x40, 0xe9, 0xa4, 0x26, 0xc0, 0x2b,
+	0x96, 0xe6, 0xcc, 0x68, 0xa2, 0x8c, 0x91, 0x47, 0x91, 0x97, 0xb6, 0x6b, 0xbf, 0xbb, 0xf5, 0x55,
+	0x9a, 0x41, 0xc6, 0x88, 0xc7, 0x79, 0xf7, 0x54, 0xba, 0xfd, 0x10, 0xd9, 0xe4, 0xf6, 0x9e, 0xcc,
+	0xf6, 0x6d, 0x0a, 0xbc, 0x06, 0x2a, 0x0e, 0xa9, 0x39, 0x34, 0xea, 0xf2, 0x3f, 0xd0, 0xf6, 0x7f,
+	0x6e, 0x7a, 0xf4, 0xa7, 0xa9, 0x2b, 0xe1, 0x32, 0xa0, 0x3a, 0x2f, 0x03, 0xaa, 0x09, 0xf0, 0x8a,
+	0xa5, 0x7b, 0xb3, 0x58, 0x59, 0xfa, 0x72, 0x65, 0xe9, 0x1f, 0x2b, 0x4b, 0x7f, 0x5d, 0x5b, 0xda,
+	0x72, 0x6d, 0x69, 0xef, 0x6b, 0x4b, 0xbb, 0xef, 0x87, 0x11, 0x1b, 0x65, 0xbe, 0x13, 0x90, 0x09,
+	0xe4, 0xce, 0x67, 0x09, 0x66, 0x33, 0x92, 0x8e, 0x61, 0x42, 0x9e, 0x30, 0x7c, 0x51, 0x95, 0xe0,
+	0x85, 0x52, 0xc5, 0xf0, 0x77, 0x78, 0x25, 0x7a, 0x5f, 0x03, 0x00, 0x9d, 0x72, 0xc1, 0xe7, 0xcd,
+	0x02, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AutoRefills) > 0 {
+		for iNdEx := len(m.AutoRefills) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoRefills[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Payments) > 0 {
+		for iNdEx := len(m.Payments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Payments) > 0 {
+		for _, e := range m.Payments {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AutoRefills) > 0 {
+		for _, e := range m.AutoRefills {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, v1beta3.Account{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payments = append(m.Payments, v1beta3.FractionalPayment{})
+			if err := m.Payments[len(m.Payments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRefills", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoRefills = append(m.AutoRefills, AccountAutoRefill{})
+			if err := m.AutoRefills[len(m.AutoRefills)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, AccountBalances{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
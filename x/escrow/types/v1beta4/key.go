@@ -0,0 +1,10 @@
+package v1beta4
+
+import (
+	v1beta3 "github.com/akash-network/akash-api/go/node/escrow/v1beta3"
+)
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = v1beta3.ModuleName
+)
@@ -0,0 +1,2205 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: akash/escrow/v1beta4/query.proto
+
+package v1beta4
+
+import (
+	context "context"
+	fmt "fmt"
+	v1beta3 "github.com/akash-network/akash-api/go/node/escrow/v1beta3"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QuerySettlePreviewRequest is request type for the Query/SettlePreview RPC method
+type QuerySettlePreviewRequest struct {
+	ID v1beta3.AccountID `protobuf:"bytes,1,opt,name=id,proto3" json:"id" yaml:"id"`
+}
+
+func (m *QuerySettlePreviewRequest) Reset()         { *m = QuerySettlePreviewRequest{} }
+func (m *QuerySettlePreviewRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySettlePreviewRequest) ProtoMessage()    {}
+func (*QuerySettlePreviewRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7746265312ec4674, []int{0}
+}
+func (m *QuerySettlePreviewRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySettlePreviewRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySettlePreviewRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySettlePreviewRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySettlePreviewRequest.Merge(m, src)
+}
+func (m *QuerySettlePreviewRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySettlePreviewRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySettlePreviewRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySettlePreviewRequest proto.InternalMessageInfo
+
+func (m *QuerySettlePreviewRequest) GetID() v1beta3.AccountID {
+	if m != nil {
+		return m.ID
+	}
+	return v1beta3.AccountID{}
+}
+
+// PaymentSettlePreview holds the payment state after settlement
+// and the amount it would receive from the account
+type PaymentSettlePreview struct {
+	Payment v1beta3.FractionalPayment `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment" yaml:"payment"`
+	Amount  types.DecCoin             `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount" yaml:"amount"`
+}
+
+func (m *PaymentSettlePreview) Reset()         { *m = PaymentSettlePreview{} }
+func (m *PaymentSettlePreview) String() string { return proto.CompactTextString(m) }
+func (*PaymentSettlePreview) ProtoMessage()    {}
+func (*PaymentSettlePreview) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7746265312ec4674, []int{1}
+}
+func (m *PaymentSettlePreview) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PaymentSettlePreview) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PaymentSettlePreview.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PaymentSettlePreview) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentSettlePreview.Merge(m, src)
+}
+func (m *PaymentSettlePreview) XXX_Size() int {
+	return m.Size()
+}
+func (m *PaymentSettlePreview) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaymentSettlePreview.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaymentSettlePreview proto.InternalMessageInfo
+
+func (m *PaymentSettlePreview) GetPayment() v1beta3.FractionalPayment {
+	if m != nil {
+		return m.Payment
+	}
+	return v1beta3.FractionalPayment{}
+}
+
+func (m *PaymentSettlePreview) GetAmount() types.DecCoin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.DecCoin{}
+}
+
+// QuerySettlePreviewResponse is response type for the Query/SettlePreview RPC method
+type QuerySettlePreviewResponse struct {
+	Height    int64                  `protobuf:"varint,1,opt,name=height,proto3" json:"height" yaml:"height"`
+	Account   v1beta3.Account        `protobuf:"bytes,2,opt,name=account,proto3" json:"account" yaml:"account"`
+	Balances  DenomBalances          `protobuf:"bytes,3,rep,name=balances,proto3,castrepeated=DenomBalances" json:"balances,omitempty" yaml:"balances"`
+	Payments  []PaymentSettlePreview `protobuf:"bytes,4,rep,name=payments,proto3" json:"payments" yaml:"payments"`
+	Overdrawn bool                   `protobuf:"varint,5,opt,name=overdrawn,proto3" json:"overdrawn" yaml:"overdrawn"`
+}
+
+func (m *QuerySettlePreviewResponse) Reset()         { *m = QuerySettlePreviewResponse{} }
+func (m *QuerySettlePreviewResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySettlePreviewResponse) ProtoMessage()    {}
+func (*QuerySettlePreviewResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7746265312ec4674, []int{2}
+}
+func (m *QuerySettlePreviewResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySettlePreviewResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySettlePreviewResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySettlePreviewResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySettlePreviewResponse.Merge(m, src)
+}
+func (m *QuerySettlePreviewResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySettlePreviewResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySettlePreviewResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySettlePreviewResponse proto.InternalMessageInfo
+
+func (m *QuerySettlePreviewResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QuerySettlePreviewResponse) GetAccount() v1beta3.Account {
+	if m != nil {
+		return m.Account
+	}
+	return v1beta3.Account{}
+}
+
+func (m *QuerySettlePreviewResponse) GetBalances() DenomBalances {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
+func (m *QuerySettlePreviewResponse) GetPayments() []PaymentSettlePreview {
+	if m != nil {
+		return m.Payments
+	}
+	return nil
+}
+
+func (m *QuerySettlePreviewResponse) GetOverdrawn() bool {
+	if m != nil {
+		return m.Overdrawn
+	}
+	return false
+}
+
+// QueryDepletingRequest is request type for the Query/Depleting RPC method
+type QueryDepletingRequest struct {
+	// number of blocks from current height
+	Blocks     int64              `protobuf:"varint,1,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDepletingRequest) Reset()         { *m = QueryDepletingRequest{} }
+func (m *QueryDepletingRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDepletingRequest) ProtoMessage()    {}
+func (*QueryDepletingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7746265312ec4674, []int{3}
+}
+func (m *QueryDepletingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDepletingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDepletingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDepletingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepletingRequest.Merge(m, src)
+}
+func (m *QueryDepletingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDepletingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepletingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDepletingRequest proto.InternalMessageInfo
+
+func (m *QueryDepletingRequest) GetBlocks() int64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+func (m *QueryDepletingRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// DepletingAccount is an open account along with the last height its balance
+// covers all open payments
+type DepletingAccount struct {
+	Account         v1beta3.Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account" yaml:"account"`
+	DepletionHeight int64           `protobuf:"varint,2,opt,name=depletion_height,json=depletionHeight,proto3" json:"depletion_height" yaml:"depletion_height"`
+}
+
+func (m *DepletingAccount) Reset()         { *m = DepletingAccount{} }
+func (m *DepletingAccount) String() string { return proto.CompactTextString(m) }
+func (*DepletingAccount) ProtoMessage()    {}
+func (*DepletingAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7746265312ec4674, []int{4}
+}
+func (m *DepletingAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepletingAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepletingAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DepletingAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepletingAccount.Merge(m, src)
+}
+func (m *DepletingAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepletingAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepletingAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepletingAccount proto.InternalMessageInfo
+
+func (m *DepletingAccount) GetAccount() v1beta3.Account {
+	if m != nil {
+		return m.Account
+	}
+	return v1beta3.Account{}
+}
+
+func (m *DepletingAccount) GetDepletionHeight() int64 {
+	if m != nil {
+		return m.DepletionHeight
+	}
+	return 0
+}
+
+// QueryDepletingResponse is response type for the Query/Depleting RPC method
+type QueryDepletingResponse struct {
+	Accounts   []DepletingAccount  `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts" yaml:"accounts"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDepletingResponse) Reset()         { *m = QueryDepletingResponse{} }
+func (m *QueryDepletingResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDepletingResponse) ProtoMessage()    {}
+func (*QueryDepletingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7746265312ec4674, []int{5}
+}
+func (m *QueryDepletingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDepletingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDepletingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDepletingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepletingResponse.Merge(m, src)
+}
+func (m *QueryDepletingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDepletingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepletingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDepletingResponse proto.InternalMessageInfo
+
+func (m *QueryDepletingResponse) GetAccounts() []DepletingAccount {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+func (m *QueryDepletingResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAccountBalancesRequest is request type for the Query/AccountBalances RPC method
+type QueryAccountBalancesRequest struct {
+	ID v1beta3.AccountID `protobuf:"bytes,1,opt,name=id,proto3" json:"id" yaml:"id"`
+}
+
+func (m *QueryAccountBalancesRequest) Reset()         { *m = QueryAccountBalancesRequest{} }
+func (m *QueryAccountBalancesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountBalancesRequest) ProtoMessage()    {}
+func (*QueryAccountBalancesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7746265312ec4674, []int{6}
+}
+func (m *QueryAccountBalancesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountBalancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountBalancesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountBalancesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountBalancesRequest.Merge(m, src)
+}
+func (m *QueryAccountBalancesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountBalancesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountBalancesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountBalancesRequest proto.InternalMessageInfo
+
+func (m *QueryAccountBalancesRequest) GetID() v1beta3.AccountID {
+	if m != nil {
+		return m.ID
+	}
+	return v1beta3.AccountID{}
+}
+
+// QueryAccountBalancesResponse is response type for the Query/AccountBalances RPC method
+type QueryAccountBalancesResponse struct {
+	Account  v1beta3.Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account" yaml:"account"`
+	Balances DenomBalances   `protobuf:"bytes,2,rep,name=balances,proto3,castrepeated=DenomBalances" json:"balances" yaml:"balances"`
+}
+
+func (m *QueryAccountBalancesResponse) Reset()         { *m = QueryAccountBalancesResponse{} }
+func (m *QueryAccountBalancesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountBalancesResponse) ProtoMessage()    {}
+func (*QueryAccountBalancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7746265312ec4674, []int{7}
+}
+func (m *QueryAccountBalancesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountBalancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountBalancesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountBalancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountBalancesResponse.Merge(m, src)
+}
+func (m *QueryAccountBalancesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountBalancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountBalancesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountBalancesResponse proto.InternalMessageInfo
+
+func (m *QueryAccountBalancesResponse) GetAccount() v1beta3.Account {
+	if m != nil {
+		return m.Account
+	}
+	return v1beta3.Account{}
+}
+
+func (m *QueryAccountBalancesResponse) GetBalances() DenomBalances {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QuerySettlePreviewRequest)(nil), "akash.escrow.v1beta4.QuerySettlePreviewRequest")
+	proto.RegisterType((*PaymentSettlePreview)(nil), "akash.escrow.v1beta4.PaymentSettlePreview")
+	proto.RegisterType((*QuerySettlePreviewResponse)(nil), "akash.escrow.v1beta4.QuerySettlePreviewResponse")
+	proto.RegisterType((*QueryDepletingRequest)(nil), "akash.escrow.v1beta4.QueryDepletingRequest")
+	proto.RegisterType((*DepletingAccount)(nil), "akash.escrow.v1beta4.DepletingAccount")
+	proto.RegisterType((*QueryDepletingResponse)(nil), "akash.escrow.v1beta4.QueryDepletingResponse")
+	proto.RegisterType((*QueryAccountBalancesRequest)(nil), "akash.escrow.v1beta4.QueryAccountBalancesRequest")
+	proto.RegisterType((*QueryAccountBalancesResponse)(nil), "akash.escrow.v1beta4.QueryAccountBalancesResponse")
+}
+
+func init() { proto.RegisterFile("akash/escrow/v1beta4/query.proto", fileDescriptor_7746265312ec4674) }
+
+var fileDescriptor_7746265312ec4674 = []byte{
+	// 826 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4d, 0x4f, 0xe3, 0x46,
+	0x18, 0x8e, 0x9d, 0x12, 0xc2, 0x20, 0x0a, 0xb2, 0x28, 0x4d, 0x03, 0xc4, 0x61, 0x2a, 0x15, 0x44,
+	0xa9, 0xdd, 0x7c, 0x9c, 0x7a, 0x68, 0x55, 0x37, 0xa2, 0xe5, 0x82, 0xa8, 0xab, 0x5e, 0xb8, 0x54,
+	0x13, 0x7b, 0xea, 0x58, 0x89, 0x3d, 0xc1, 0x76, 0x08, 0xb9, 0xf4, 0x37, 0xf4, 0x77, 0xec, 0x2f,
+	0x41, 0x9c, 0x90, 0xf6, 0xb2, 0xd2, 0x6a, 0xbd, 0xab, 0x70, 0xcb, 0x69, 0x95, 0x5f, 0xb0, 0xb2,
+	0x67, 0xc6, 0x4e, 0xb2, 0x86, 0x05, 0x69, 0xb9, 0xc5, 0xef, 0xd7, 0xf3, 0xce, 0xf3, 0x3e, 0xf3,
+	0x66, 0x40, 0x15, 0x75, 0x91, 0xdf, 0x51, 0xb1, 0x6f, 0x78, 0x64, 0xa8, 0x5e, 0xd6, 0xda, 0x38,
+	0x40, 0x4d, 0xf5, 0x62, 0x80, 0xbd, 0x91, 0xd2, 0xf7, 0x48, 0x40, 0xa4, 0xcd, 0x38, 0x42, 0xa1,
+	0x11, 0x0a, 0x8b, 0x28, 0x6f, 0x5a, 0xc4, 0x22, 0x71, 0x80, 0x1a, 0xfd, 0xa2, 0xb1, 0xe5, 0x8a,
+	0x41, 0x7c, 0x87, 0xf8, 0x6a, 0x1b, 0xf9, 0x98, 0x15, 0xab, 0xa9, 0x06, 0xb1, 0x5d, 0xe6, 0x3f,
+	0x9c, 0xf5, 0xc7, 0x20, 0x49, 0x54, 0x1f, 0x59, 0xb6, 0x8b, 0x02, 0x9b, 0xf0, 0xd8, 0xac, 0xce,
+	0x1a, 0x6a, 0x30, 0xea, 0x63, 0x9f, 0x45, 0xc0, 0xcc, 0xde, 0xdb, 0xa8, 0x87, 0x5c, 0x03, 0xd3,
+	0x18, 0xd8, 0x05, 0xdf, 0xfc, 0x19, 0xe1, 0xfc, 0x85, 0x83, 0xa0, 0x87, 0xcf, 0x3c, 0x7c, 0x69,
+	0xe3, 0xa1, 0x8e, 0x2f, 0x06, 0xd8, 0x0f, 0xa4, 0x53, 0x20, 0xda, 0x66, 0x49, 0xa8, 0x0a, 0x07,
+	0xab, 0x75, 0x59, 0xc9, 0x38, 0x67, 0x43, 0xf9, 0xd5, 0x30, 0xc8, 0xc0, 0x0d, 0x4e, 0x5a, 0xda,
+	0xee, 0x75, 0x28, 0xe7, 0xc6, 0xa1, 0x2c, 0x9e, 0xb4, 0x26, 0xa1, 0x2c, 0xda, 0xe6, 0x34, 0x94,
+	0x57, 0x46, 0xc8, 0xe9, 0xfd, 0x04, 0x6d, 0x13, 0xea, 0xa2, 0x6d, 0xc2, 0x97, 0x02, 0xd8, 0x3c,
+	0x43, 0x23, 0x07, 0xbb, 0xc1, 0x1c, 0x9e, 0x64, 0x82, 0xe5, 0x3e, 0xb5, 0x33, 0xb4, 0xfd, 0x6c,
+	0xb4, 0x63, 0x0f, 0x19, 0x11, 0x05, 0xa8, 0xc7, 0xca, 0x68, 0x7b, 0x11, 0xea, 0x24, 0x94, 0x79,
+	0xfe, 0x34, 0x94, 0xbf, 0xa4, 0xa0, 0xcc, 0x00, 0x75, 0xee, 0x92, 0xfe, 0x06, 0x05, 0xe4, 0x44,
+	0xdd, 0x96, 0xc4, 0x18, 0x64, 0x47, 0xa1, 0x74, 0x2b, 0x11, 0xdd, 0x0c, 0xa3, 0xa6, 0xb4, 0xb0,
+	0xf1, 0x1b, 0xb1, 0x5d, 0x4d, 0x66, 0x95, 0x59, 0xce, 0x34, 0x94, 0xd7, 0x68, 0x61, 0xfa, 0x0d,
+	0x75, 0xe6, 0x80, 0xaf, 0xf3, 0xa0, 0x9c, 0xc5, 0xa1, 0xdf, 0x27, 0xae, 0x8f, 0xa5, 0x06, 0x28,
+	0x74, 0xb0, 0x6d, 0x75, 0xe8, 0xd1, 0xf2, 0xda, 0x76, 0x54, 0x93, 0x5a, 0xd2, 0x9a, 0xf4, 0x1b,
+	0xea, 0xcc, 0x21, 0x9d, 0x83, 0x65, 0x64, 0x18, 0x33, 0xbd, 0xee, 0x3e, 0x48, 0x7f, 0x4a, 0x03,
+	0xcb, 0x4a, 0x69, 0x60, 0x06, 0xa8, 0x73, 0x97, 0xf4, 0x1f, 0x28, 0x32, 0x0d, 0xf8, 0xa5, 0x7c,
+	0x35, 0x7f, 0xb0, 0x5a, 0x87, 0x59, 0xc5, 0x9b, 0x4a, 0x0b, 0xbb, 0xc4, 0xd1, 0x68, 0xa8, 0xf6,
+	0x33, 0x43, 0x90, 0x78, 0xee, 0x11, 0x71, 0xec, 0x00, 0x3b, 0xfd, 0x60, 0x34, 0x0d, 0xe5, 0x75,
+	0x0a, 0xc6, 0x7d, 0xf0, 0xc5, 0x5b, 0x79, 0x6d, 0x36, 0xdd, 0xd7, 0x13, 0x4c, 0xa9, 0x0b, 0x8a,
+	0x6c, 0x22, 0x7e, 0xe9, 0x8b, 0x18, 0xff, 0x30, 0x1b, 0x3f, 0x4b, 0x2a, 0xda, 0xb7, 0xac, 0x8f,
+	0xa4, 0x46, 0x8a, 0xce, 0x2d, 0x50, 0x4f, 0x9c, 0xd2, 0x2f, 0x60, 0x85, 0x5c, 0x62, 0xcf, 0xf4,
+	0xd0, 0xd0, 0x2d, 0x2d, 0x55, 0x85, 0x83, 0xa2, 0xb6, 0x37, 0x09, 0xe5, 0xd4, 0x38, 0x0d, 0xe5,
+	0x0d, 0x9a, 0x9e, 0x98, 0xa0, 0x9e, 0xba, 0xe1, 0x10, 0x7c, 0x15, 0x0f, 0xb7, 0x85, 0xfb, 0x3d,
+	0x1c, 0xd8, 0xae, 0xc5, 0x2f, 0xc7, 0x16, 0x28, 0xb4, 0x7b, 0xc4, 0xe8, 0xfa, 0x74, 0xae, 0x3a,
+	0xfb, 0x92, 0x8e, 0x01, 0x48, 0xef, 0x2a, 0x9b, 0xde, 0x77, 0x73, 0x4a, 0xa3, 0xdb, 0x83, 0xeb,
+	0xed, 0x0c, 0x59, 0x98, 0xd5, 0xd4, 0x67, 0x32, 0xe1, 0x8d, 0x00, 0x36, 0x12, 0x50, 0x36, 0xe7,
+	0x59, 0x5d, 0x08, 0x9f, 0x5b, 0x17, 0xe7, 0x60, 0xc3, 0xa4, 0x78, 0xc4, 0xfd, 0x87, 0x49, 0x56,
+	0x8c, 0x25, 0xab, 0x4e, 0x42, 0xf9, 0x23, 0xdf, 0x34, 0x94, 0xbf, 0xa6, 0xa5, 0x16, 0x3d, 0x50,
+	0x5f, 0x4f, 0x4c, 0x7f, 0x50, 0xcb, 0x8d, 0x00, 0xb6, 0x16, 0x69, 0x64, 0xf7, 0xc3, 0x02, 0x45,
+	0xd6, 0x41, 0xc4, 0x64, 0x3e, 0x66, 0xeb, 0x1e, 0x39, 0xce, 0x93, 0x91, 0x4a, 0x81, 0xe7, 0xa7,
+	0x52, 0xe0, 0x16, 0xa8, 0x27, 0x4e, 0xe9, 0xf7, 0x8c, 0xc1, 0xec, 0x7f, 0x72, 0x30, 0xb4, 0xcb,
+	0xb9, 0xc9, 0x38, 0x60, 0x3b, 0x3e, 0x0b, 0xef, 0x83, 0x4b, 0xfc, 0x99, 0xb6, 0xe6, 0x7b, 0x01,
+	0xec, 0x64, 0xe3, 0x31, 0x06, 0x9f, 0x53, 0x14, 0xc1, 0xcc, 0xb2, 0x10, 0x1f, 0xbd, 0x2c, 0x9a,
+	0x7c, 0x32, 0x3c, 0xf7, 0x69, 0x2b, 0xa2, 0xfe, 0x46, 0x04, 0x4b, 0xf1, 0x91, 0x25, 0x0f, 0xac,
+	0xcd, 0xff, 0x55, 0xa8, 0xd9, 0xf0, 0xf7, 0xfe, 0x89, 0x95, 0x7f, 0x7c, 0x7c, 0x02, 0xe3, 0xf3,
+	0x5f, 0xb0, 0x92, 0x68, 0x4d, 0xfa, 0xfe, 0x81, 0xf4, 0xc5, 0x9d, 0x50, 0x3e, 0x7a, 0x5c, 0x30,
+	0xc3, 0xb9, 0x02, 0xeb, 0x0b, 0x23, 0x95, 0x6a, 0x0f, 0x14, 0xc8, 0x96, 0x5b, 0xb9, 0xfe, 0x94,
+	0x14, 0x8a, 0xac, 0x9d, 0x5e, 0x8f, 0x2b, 0xc2, 0xed, 0xb8, 0x22, 0xbc, 0x1b, 0x57, 0x84, 0xff,
+	0xef, 0x2a, 0xb9, 0xdb, 0xbb, 0x4a, 0xee, 0xd5, 0x5d, 0x25, 0x77, 0xde, 0xb4, 0xec, 0xa0, 0x33,
+	0x68, 0x2b, 0x06, 0x71, 0xd4, 0xb8, 0xee, 0x0f, 0x2e, 0x0e, 0x86, 0xc4, 0xeb, 0xaa, 0x2e, 0x31,
+	0xb1, 0x7a, 0xc5, 0x5f, 0x13, 0xf1, 0x3b, 0x83, 0xbf, 0x29, 0xda, 0x85, 0xf8, 0x31, 0xd1, 0xf8,
+	0x30, 0x00, 0xbc, 0x40, 0xc8, 0x33, 0x2e, 0x09, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// SettlePreview queries the outcome of settling escrow account at current height
+	SettlePreview(ctx context.Context, in *QuerySettlePreviewRequest, opts ...grpc.CallOption) (*QuerySettlePreviewResponse, error)
+	// Depleting queries open escrow accounts running out of funds within given number of blocks,
+	// ordered by depletion height
+	Depleting(ctx context.Context, in *QueryDepletingRequest, opts ...grpc.CallOption) (*QueryDepletingResponse, error)
+	// AccountBalances queries escrow account along with its balances in denominations
+	// other than the one account was created with
+	AccountBalances(ctx context.Context, in *QueryAccountBalancesRequest, opts ...grpc.CallOption) (*QueryAccountBalancesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) SettlePreview(ctx context.Context, in *QuerySettlePreviewRequest, opts ...grpc.CallOption) (*QuerySettlePreviewResponse, error) {
+	out := new(QuerySettlePreviewResponse)
+	err := c.cc.Invoke(ctx, "/akash.escrow.v1beta4.Query/SettlePreview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Depleting(ctx context.Context, in *QueryDepletingRequest, opts ...grpc.CallOption) (*QueryDepletingResponse, error) {
+	out := new(QueryDepletingResponse)
+	err := c.cc.Invoke(ctx, "/akash.escrow.v1beta4.Query/Depleting", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountBalances(ctx context.Context, in *QueryAccountBalancesRequest, opts ...grpc.CallOption) (*QueryAccountBalancesResponse, error) {
+	out := new(QueryAccountBalancesResponse)
+	err := c.cc.Invoke(ctx, "/akash.escrow.v1beta4.Query/AccountBalances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// SettlePreview queries the outcome of settling escrow account at current height
+	SettlePreview(context.Context, *QuerySettlePreviewRequest) (*QuerySettlePreviewResponse, error)
+	// Depleting queries open escrow accounts running out of funds within given number of blocks,
+	// ordered by depletion height
+	Depleting(context.Context, *QueryDepletingRequest) (*QueryDepletingResponse, error)
+	// AccountBalances queries escrow account along with its balances in denominations
+	// other than the one account was created with
+	AccountBalances(context.Context, *QueryAccountBalancesRequest) (*QueryAccountBalancesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) SettlePreview(ctx context.Context, req *QuerySettlePreviewRequest) (*QuerySettlePreviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettlePreview not implemented")
+}
+func (*UnimplementedQueryServer) Depleting(ctx context.Context, req *QueryDepletingRequest) (*QueryDepletingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Depleting not implemented")
+}
+func (*UnimplementedQueryServer) AccountBalances(ctx context.Context, req *QueryAccountBalancesRequest) (*QueryAccountBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountBalances not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_SettlePreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySettlePreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SettlePreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/akash.escrow.v1beta4.Query/SettlePreview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SettlePreview(ctx, req.(*QuerySettlePreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Depleting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepletingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Depleting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/akash.escrow.v1beta4.Query/Depleting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Depleting(ctx, req.(*QueryDepletingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/akash.escrow.v1beta4.Query/AccountBalances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountBalances(ctx, req.(*QueryAccountBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "akash.escrow.v1beta4.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SettlePreview",
+			Handler:    _Query_SettlePreview_Handler,
+		},
+		{
+			MethodName: "Depleting",
+			Handler:    _Query_Depleting_Handler,
+		},
+		{
+			MethodName: "AccountBalances",
+			Handler:    _Query_AccountBalances_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "akash/escrow/v1beta4/query.proto",
+}
+
+func (m *QuerySettlePreviewRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySettlePreviewRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySettlePreviewRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PaymentSettlePreview) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PaymentSettlePreview) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PaymentSettlePreview) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Payment.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySettlePreviewResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySettlePreviewResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySettlePreviewResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Overdrawn {
+		i--
+		if m.Overdrawn {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Payments) > 0 {
+		for iNdEx := len(m.Payments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepletingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepletingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepletingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Blocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepletingAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepletingAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepletingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DepletionHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DepletionHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepletingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepletingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepletingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountBalancesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountBalancesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountBalancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountBalancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountBalancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountBalancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QuerySettlePreviewRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *PaymentSettlePreview) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Payment.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySettlePreviewResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = m.Account.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Payments) > 0 {
+		for _, e := range m.Payments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Overdrawn {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryDepletingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		n += 1 + sovQuery(uint64(m.Blocks))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DepletingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Account.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.DepletionHeight != 0 {
+		n += 1 + sovQuery(uint64(m.DepletionHeight))
+	}
+	return n
+}
+
+func (m *QueryDepletingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountBalancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAccountBalancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Account.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QuerySettlePreviewRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySettlePreviewRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySettlePreviewRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PaymentSettlePreview) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PaymentSettlePreview: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PaymentSettlePreview: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Payment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySettlePreviewResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySettlePreviewResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySettlePreviewResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, DenomBalance{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payments = append(m.Payments, PaymentSettlePreview{})
+			if err := m.Payments[len(m.Payments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overdrawn", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Overdrawn = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepletingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepletingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepletingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepletingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepletingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepletingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepletionHeight", wireType)
+			}
+			m.DepletionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepletionHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepletingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepletingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepletingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, DepletingAccount{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountBalancesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountBalancesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountBalancesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountBalancesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, DenomBalance{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,28 @@
+package v1beta4
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateBasic checks policy coins are valid, share denomination and funder is valid address
+func (p AutoRefill) ValidateBasic() error {
+	if !p.Threshold.IsValid() {
+		return fmt.Errorf("%w: invalid threshold %s", ErrInvalidAutoRefill, p.Threshold)
+	}
+
+	if !p.Amount.IsValid() || !p.Amount.IsPositive() {
+		return fmt.Errorf("%w: invalid amount %s", ErrInvalidAutoRefill, p.Amount)
+	}
+
+	if p.Threshold.Denom != p.Amount.Denom {
+		return fmt.Errorf("%w: threshold and amount denominations do not match", ErrInvalidAutoRefill)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(p.Funder); err != nil {
+		return fmt.Errorf("%w: invalid funder address: %s", ErrInvalidAutoRefill, err)
+	}
+
+	return nil
+}
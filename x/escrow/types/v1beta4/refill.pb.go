@@ -0,0 +1,669 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: akash/escrow/v1beta4/refill.proto
+
+package v1beta4
+
+import (
+	fmt "fmt"
+	v1beta3 "github.com/akash-network/akash-api/go/node/escrow/v1beta3"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AutoRefill is a policy topping up an escrow account with amount taken from funder
+// once the projected balance of the account drops below threshold.
+// If funder is not the account owner, deposits are authorized by the DepositDeploymentAuthorization
+// granted by funder to the owner and the policy stops once the grant's spend limit is used up.
+type AutoRefill struct {
+	Threshold types.Coin `protobuf:"bytes,1,opt,name=threshold,proto3" json:"threshold" yaml:"threshold"`
+	Amount    types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount" yaml:"amount"`
+	// bech32 encoded address of the account refills are taken from
+	Funder string `protobuf:"bytes,3,opt,name=funder,proto3" json:"funder" yaml:"funder"`
+}
+
+func (m *AutoRefill) Reset()         { *m = AutoRefill{} }
+func (m *AutoRefill) String() string { return proto.CompactTextString(m) }
+func (*AutoRefill) ProtoMessage()    {}
+func (*AutoRefill) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66084a89dc22d63a, []int{0}
+}
+func (m *AutoRefill) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoRefill) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoRefill.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoRefill) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoRefill.Merge(m, src)
+}
+func (m *AutoRefill) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoRefill) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoRefill.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoRefill proto.InternalMessageInfo
+
+func (m *AutoRefill) GetThreshold() types.Coin {
+	if m != nil {
+		return m.Threshold
+	}
+	return types.Coin{}
+}
+
+func (m *AutoRefill) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *AutoRefill) GetFunder() string {
+	if m != nil {
+		return m.Funder
+	}
+	return ""
+}
+
+// AccountAutoRefill is an auto refill policy along with the account it tops up
+type AccountAutoRefill struct {
+	ID     v1beta3.AccountID `protobuf:"bytes,1,opt,name=id,proto3" json:"id" yaml:"id"`
+	Policy AutoRefill        `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy" yaml:"policy"`
+}
+
+func (m *AccountAutoRefill) Reset()         { *m = AccountAutoRefill{} }
+func (m *AccountAutoRefill) String() string { return proto.CompactTextString(m) }
+func (*AccountAutoRefill) ProtoMessage()    {}
+func (*AccountAutoRefill) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66084a89dc22d63a, []int{1}
+}
+func (m *AccountAutoRefill) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountAutoRefill) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountAutoRefill.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountAutoRefill) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountAutoRefill.Merge(m, src)
+}
+func (m *AccountAutoRefill) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountAutoRefill) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountAutoRefill.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountAutoRefill proto.InternalMessageInfo
+
+func (m *AccountAutoRefill) GetID() v1beta3.AccountID {
+	if m != nil {
+		return m.ID
+	}
+	return v1beta3.AccountID{}
+}
+
+func (m *AccountAutoRefill) GetPolicy() AutoRefill {
+	if m != nil {
+		return m.Policy
+	}
+	return AutoRefill{}
+}
+
+func init() {
+	proto.RegisterType((*AutoRefill)(nil), "akash.escrow.v1beta4.AutoRefill")
+	proto.RegisterType((*AccountAutoRefill)(nil), "akash.escrow.v1beta4.AccountAutoRefill")
+}
+
+func init() { proto.RegisterFile("akash/escrow/v1beta4/refill.proto", fileDescriptor_66084a89dc22d63a) }
+
+var fileDescriptor_66084a89dc22d63a = []byte{
+	// 395 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0x3f, 0x8b, 0xd4, 0x40,
+	0x14, 0xcf, 0x44, 0x08, 0xec, 0x88, 0xa0, 0xe1, 0x8a, 0xf5, 0xc4, 0x4c, 0x1c, 0x10, 0xae, 0x71,
+	0x86, 0x35, 0x5b, 0xd9, 0x5d, 0xbc, 0xe6, 0x9a, 0x2b, 0xd2, 0x69, 0x21, 0xe4, 0xcf, 0xdc, 0x66,
+	0xb8, 0x24, 0x6f, 0x49, 0x26, 0x9e, 0xfb, 0x2d, 0xfc, 0x44, 0xd6, 0x5b, 0x6e, 0x69, 0x15, 0x24,
+	0xdb, 0x6d, 0xb9, 0x60, 0x2f, 0xc9, 0xcc, 0x1a, 0x8b, 0x05, 0xbb, 0xbc, 0xf9, 0xfd, 0x7b, 0x3f,
+	0xf2, 0xf0, 0x9b, 0xf8, 0x21, 0x6e, 0x72, 0x2e, 0x9a, 0xb4, 0x86, 0x47, 0xfe, 0x75, 0x91, 0x08,
+	0x15, 0x2f, 0x79, 0x2d, 0xee, 0x65, 0x51, 0xb0, 0x75, 0x0d, 0x0a, 0xdc, 0x8b, 0x91, 0xc2, 0x34,
+	0x85, 0x19, 0xca, 0xe5, 0xc5, 0x0a, 0x56, 0x30, 0x12, 0xf8, 0xf0, 0xa5, 0xb9, 0x97, 0x5e, 0x0a,
+	0x4d, 0x09, 0x0d, 0x4f, 0xe2, 0x46, 0x18, 0xb7, 0x05, 0x4f, 0x41, 0x56, 0x06, 0xf7, 0xcf, 0xc4,
+	0x05, 0x5c, 0x6d, 0xd6, 0xa2, 0xd1, 0x0c, 0xfa, 0x1b, 0x61, 0x7c, 0xdd, 0x2a, 0x88, 0xc6, 0x15,
+	0xdc, 0x2f, 0x78, 0xa6, 0xf2, 0x5a, 0x34, 0x39, 0x14, 0xd9, 0x1c, 0xf9, 0xe8, 0xea, 0xe9, 0xfb,
+	0x97, 0x4c, 0x87, 0xb0, 0x21, 0xc4, 0xec, 0xb3, 0x60, 0x1f, 0x41, 0x56, 0xe1, 0xdb, 0x6d, 0x47,
+	0xac, 0x43, 0x47, 0x26, 0xcd, 0xb1, 0x23, 0xcf, 0x37, 0x71, 0x59, 0x7c, 0xa0, 0x7f, 0x9f, 0x68,
+	0x34, 0xc1, 0x6e, 0x84, 0x9d, 0xb8, 0x84, 0xb6, 0x52, 0x73, 0xfb, 0x7f, 0xe6, 0xc4, 0x98, 0x1b,
+	0xc1, 0xb1, 0x23, 0xcf, 0xb4, 0xb3, 0x9e, 0x69, 0x64, 0x00, 0x37, 0xc0, 0xce, 0x7d, 0x5b, 0x65,
+	0xa2, 0x9e, 0x3f, 0xf1, 0xd1, 0xd5, 0x2c, 0x7c, 0x35, 0x88, 0xf4, 0xcb, 0x24, 0xd2, 0x33, 0x8d,
+	0x0c, 0x40, 0x7f, 0x20, 0xfc, 0xe2, 0x3a, 0x4d, 0x07, 0x83, 0x7f, 0xea, 0xdf, 0x61, 0x5b, 0x9e,
+	0x7a, 0x13, 0x76, 0xe6, 0x47, 0x04, 0xcc, 0x88, 0x6e, 0x6f, 0xc2, 0xd7, 0xc3, 0x82, 0x7d, 0x47,
+	0xec, 0xdb, 0x9b, 0x43, 0x47, 0x6c, 0x39, 0x94, 0x9f, 0xe9, 0x34, 0x99, 0xd1, 0xc8, 0x96, 0x99,
+	0xfb, 0x09, 0x3b, 0x6b, 0x28, 0x64, 0xba, 0x31, 0x75, 0xfd, 0x73, 0x9e, 0x4b, 0x36, 0x6d, 0x30,
+	0xb5, 0xd6, 0xba, 0xa9, 0x80, 0x9e, 0x69, 0x64, 0x80, 0xf0, 0x6e, 0xdb, 0x7b, 0x68, 0xd7, 0x7b,
+	0xe8, 0x57, 0xef, 0xa1, 0xef, 0x7b, 0xcf, 0xda, 0xed, 0x3d, 0xeb, 0xe7, 0xde, 0xb3, 0x3e, 0x2f,
+	0x57, 0x52, 0xe5, 0x6d, 0xc2, 0x52, 0x28, 0xf9, 0x18, 0xf7, 0xae, 0x12, 0xea, 0x11, 0xea, 0x07,
+	0x5e, 0x41, 0x26, 0xf8, 0xb7, 0xd3, 0x39, 0x8c, 0x67, 0x70, 0xba, 0xc1, 0xc4, 0x19, 0xef, 0x21,
+	0xf8, 0x33, 0x00, 0xac, 0x81, 0x21, 0x5a, 0xa2, 0x02, 0x00, 0x00,
+}
+
+func (m *AutoRefill) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoRefill) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoRefill) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Funder) > 0 {
+		i -= len(m.Funder)
+		copy(dAtA[i:], m.Funder)
+		i = encodeVarintRefill(dAtA, i, uint64(len(m.Funder)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRefill(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Threshold.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRefill(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountAutoRefill) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountAutoRefill) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountAutoRefill) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRefill(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRefill(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRefill(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRefill(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AutoRefill) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Threshold.Size()
+	n += 1 + l + sovRefill(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovRefill(uint64(l))
+	l = len(m.Funder)
+	if l > 0 {
+		n += 1 + l + sovRefill(uint64(l))
+	}
+	return n
+}
+
+func (m *AccountAutoRefill) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovRefill(uint64(l))
+	l = m.Policy.Size()
+	n += 1 + l + sovRefill(uint64(l))
+	return n
+}
+
+func sovRefill(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRefill(x uint64) (n int) {
+	return sovRefill(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AutoRefill) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRefill
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoRefill: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoRefill: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRefill
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRefill
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRefill
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRefill
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRefill
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRefill
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRefill
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRefill
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRefill
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRefill(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRefill
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountAutoRefill) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRefill
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountAutoRefill: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountAutoRefill: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRefill
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRefill
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRefill
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRefill
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRefill
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRefill
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRefill(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRefill
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRefill(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRefill
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRefill
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRefill
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRefill
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRefill
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRefill
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRefill        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRefill          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRefill = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,94 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	sdkclient "github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	dtypes "github.com/akash-network/akash-api/go/node/deployment/v1beta3"
+
+	"github.com/akash-network/node/sdl"
+	dcli "github.com/akash-network/node/x/deployment/client/cli"
+	mv1beta5 "github.com/akash-network/node/x/market/types/v1beta5"
+)
+
+var (
+	errGroupNotFound  = errors.New("group not found in SDL")
+	errGroupAmbiguous = errors.New("SDL has more than one group, group name required")
+)
+
+func cmdProvidersFit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "providers-fit [sdl-file] [group-name]",
+		Short: "Query providers which last reported inventory could fit group of SDL",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sdlOpts, err := dcli.SDLReadOptionsFromFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			sdlManifest, err := sdl.ReadFile(args[0], sdlOpts...)
+			if err != nil {
+				return err
+			}
+
+			groups, err := sdlManifest.DeploymentGroups()
+			if err != nil {
+				return err
+			}
+
+			gspec, err := findGroupSpec(groups, args[1:])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := sdkclient.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := mv1beta5.NewQueryClient(cctx).ProvidersFit(cmd.Context(), &mv1beta5.QueryProvidersFitRequest{
+				GroupSpec:  *gspec,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return cctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "providers")
+	dcli.AddSDLVarFlags(cmd.Flags())
+
+	return cmd
+}
+
+func findGroupSpec(groups []*dtypes.GroupSpec, name []string) (*dtypes.GroupSpec, error) {
+	if len(name) == 0 {
+		if len(groups) != 1 {
+			return nil, errGroupAmbiguous
+		}
+
+		return groups[0], nil
+	}
+
+	for _, group := range groups {
+		if group.Name == name[0] {
+			return group, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", errGroupNotFound, name[0])
+}
@@ -21,6 +21,7 @@ func GetQueryCmd() *cobra.Command {
 		getOrderCmd(),
 		getBidCmd(),
 		getLeaseCmd(),
+		cmdProvidersFit(),
 	)
 
 	return cmd
@@ -14,6 +14,7 @@ import (
 	aclient "github.com/akash-network/node/client"
 	"github.com/akash-network/node/cmd/common"
 	dcli "github.com/akash-network/node/x/deployment/client/cli"
+	mv1beta5 "github.com/akash-network/node/x/market/types/v1beta5"
 )
 
 // GetTxCmd returns the transaction commands for market module
@@ -178,6 +179,8 @@ func cmdLease(key string) *cobra.Command {
 		cmdLeaseCreate(key),
 		cmdLeaseWithdraw(key),
 		cmdLeaseClose(key),
+		cmdLeaseProposePrice(key),
+		cmdLeaseAcceptPrice(key),
 	)
 	return cmd
 }
@@ -337,3 +340,114 @@ func cmdLeaseClose(key string) *cobra.Command {
 
 	return cmd
 }
+
+func cmdLeaseProposePrice(key string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-price <price>",
+		Short: fmt.Sprintf("Propose new price of an active %s lease to the other lease party", key),
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			cctx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			opts, err := cltypes.ClientOptionsFromFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			cl, err := aclient.DiscoverClient(ctx, cctx, opts...)
+			if err != nil {
+				return err
+			}
+
+			id, err := LeaseIDFromFlags(cmd.Flags(), dcli.WithOwner(cctx.FromAddress))
+			if err != nil {
+				return err
+			}
+
+			price, err := sdk.ParseDecCoin(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := mv1beta5.NewMsgProposeLeasePrice(id, cctx.FromAddress, price)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			resp, err := cl.Tx().Broadcast(ctx, []sdk.Msg{msg})
+			if err != nil {
+				return err
+			}
+
+			return cl.PrintMessage(resp)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	AddLeaseIDFlags(cmd.Flags())
+	MarkReqLeaseIDFlags(cmd, dcli.DeploymentIDOptionNoOwner(true))
+
+	return cmd
+}
+
+func cmdLeaseAcceptPrice(key string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-price <price>",
+		Short: fmt.Sprintf("Accept price of an active %s lease proposed by the other lease party", key),
+		Long:  "Price must match the pending proposal, otherwise the transaction fails.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			cctx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			opts, err := cltypes.ClientOptionsFromFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			cl, err := aclient.DiscoverClient(ctx, cctx, opts...)
+			if err != nil {
+				return err
+			}
+
+			id, err := LeaseIDFromFlags(cmd.Flags(), dcli.WithOwner(cctx.FromAddress))
+			if err != nil {
+				return err
+			}
+
+			price, err := sdk.ParseDecCoin(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := mv1beta5.NewMsgAcceptLeasePrice(id, cctx.FromAddress, price)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			resp, err := cl.Tx().Broadcast(ctx, []sdk.Msg{msg})
+			if err != nil {
+				return err
+			}
+
+			return cl.PrintMessage(resp)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	AddLeaseIDFlags(cmd.Flags())
+	MarkReqLeaseIDFlags(cmd, dcli.DeploymentIDOptionNoOwner(true))
+
+	return cmd
+}
@@ -2,6 +2,8 @@ package market
 
 import (
 	"encoding/json"
+	"fmt"
+	"math"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -10,33 +12,126 @@ import (
 	types "github.com/akash-network/akash-api/go/node/market/v1beta4"
 
 	"github.com/akash-network/node/x/market/keeper"
+	keys "github.com/akash-network/node/x/market/keeper/keys/v1beta4"
+	mv1beta5 "github.com/akash-network/node/x/market/types/v1beta5"
 )
 
 // ValidateGenesis does validation check of the Genesis
-func ValidateGenesis(data *types.GenesisState) error {
+func ValidateGenesis(data *mv1beta5.GenesisState) error {
 	if err := data.Params.Validate(); err != nil {
 		return err
 	}
 
+	if err := data.ExpiryParams.Validate(); err != nil {
+		return err
+	}
+
+	if err := data.ReputationParams.Validate(); err != nil {
+		return err
+	}
+
+	for idx, rep := range data.Reputations {
+		if _, err := sdk.AccAddressFromBech32(rep.Provider); err != nil {
+			return fmt.Errorf("%w: reputation of provider %q (idx %v)", err, rep.Provider, idx)
+		}
+	}
+
+	if err := data.DeregistrationParams.Validate(); err != nil {
+		return err
+	}
+
+	for idx, drain := range data.ProviderDrains {
+		if _, err := sdk.AccAddressFromBech32(drain.Provider); err != nil {
+			return fmt.Errorf("%w: deregistration of provider %q (idx %v)", err, drain.Provider, idx)
+		}
+	}
+
+	if err := data.InventoryParams.Validate(); err != nil {
+		return err
+	}
+
+	for idx, inv := range data.ProviderInventories {
+		if err := inv.ValidateBasic(); err != nil {
+			return fmt.Errorf("%w: inventory (idx %v)", err, idx)
+		}
+	}
+
+	for idx, auction := range data.GroupAuctions {
+		if auction.Window <= 0 {
+			return fmt.Errorf("%w: group auction %s (idx %v)", keeper.ErrInvalidAuctionWindow, auction.ID, idx)
+		}
+	}
+
 	return nil
 }
 
 // DefaultGenesisState returns default genesis state as raw bytes for the market
 // module.
-func DefaultGenesisState() *types.GenesisState {
-	return &types.GenesisState{
+func DefaultGenesisState() *mv1beta5.GenesisState {
+	return &mv1beta5.GenesisState{
 		Params: types.DefaultParams(),
+		ReputationParams: mv1beta5.ReputationParams{
+			EarlyCloseSlashRate: sdk.ZeroDec(),
+		},
+		DeregistrationParams: mv1beta5.DeregistrationParams{
+			ProviderDrainPeriod: keeper.DefaultProviderDrainPeriod,
+		},
+		InventoryParams: mv1beta5.InventoryParams{
+			InventoryUpdatePeriod: keeper.DefaultInventoryUpdatePeriod,
+		},
 	}
 }
 
 // InitGenesis initiate genesis state and return updated validator details
-func InitGenesis(ctx sdk.Context, keeper keeper.IKeeper, data *types.GenesisState) []abci.ValidatorUpdate {
+func InitGenesis(ctx sdk.Context, keeper keeper.IKeeper, data *mv1beta5.GenesisState) []abci.ValidatorUpdate {
 	keeper.SetParams(ctx, data.Params)
+	keeper.SetExpiryParams(ctx, data.ExpiryParams)
+	keeper.SetReputationParams(ctx, data.ReputationParams)
+	keeper.SetDeregistrationParams(ctx, data.DeregistrationParams)
+	keeper.SetInventoryParams(ctx, data.InventoryParams)
+
+	store := ctx.KVStore(keeper.StoreKey())
+	cdc := keeper.Codec()
+
+	for idx := range data.Orders {
+		store.Set(keys.OrderKey(data.Orders[idx].ID()), cdc.MustMarshal(&data.Orders[idx]))
+	}
+
+	for idx := range data.Bids {
+		store.Set(keys.BidKey(data.Bids[idx].ID()), cdc.MustMarshal(&data.Bids[idx]))
+	}
+
+	for idx := range data.Leases {
+		store.Set(keys.LeaseKey(data.Leases[idx].ID()), cdc.MustMarshal(&data.Leases[idx]))
+	}
+
+	keeper.ImportAuctions(ctx, data.OrderAuctions, data.GroupAuctions)
+
+	for _, proposal := range data.LeasePriceProposals {
+		keeper.SetLeasePriceProposal(ctx, proposal)
+	}
+
+	for _, rep := range data.Reputations {
+		keeper.SetProviderReputation(ctx, rep)
+	}
+
+	for _, drain := range data.ProviderDrains {
+		keeper.SetProviderDrain(ctx, drain)
+	}
+
+	for _, inv := range data.ProviderInventories {
+		keeper.SetProviderInventory(ctx, inv)
+	}
+
+	// expiry index is derived from open orders and bids, active lease index from leases
+	keeper.ReindexExpiry(ctx)
+	keeper.ReindexActiveLeases(ctx)
+
 	return []abci.ValidatorUpdate{}
 }
 
 // ExportGenesis returns genesis state as raw bytes for the market module
-func ExportGenesis(ctx sdk.Context, k keeper.IKeeper) *types.GenesisState {
+func ExportGenesis(ctx sdk.Context, k keeper.IKeeper) *mv1beta5.GenesisState {
 	params := k.GetParams(ctx)
 
 	var bids []types.Bid
@@ -58,18 +153,69 @@ func ExportGenesis(ctx sdk.Context, k keeper.IKeeper) *types.GenesisState {
 		return false
 	})
 
-	return &types.GenesisState{
-		Params: params,
-		Orders: orders,
-		Leases: leases,
-		Bids:   bids,
+	var orderAuctions []mv1beta5.OrderAuction
+	var groupAuctions []mv1beta5.GroupAuction
+
+	k.WithOrderAuctionsClosing(ctx, math.MaxInt64, func(auction mv1beta5.OrderAuction) bool {
+		orderAuctions = append(orderAuctions, auction)
+		return false
+	})
+
+	k.WithGroupAuctions(ctx, func(auction mv1beta5.GroupAuction) bool {
+		groupAuctions = append(groupAuctions, auction)
+		return false
+	})
+
+	var proposals []mv1beta5.LeasePriceProposal
+
+	k.WithLeasePriceProposals(ctx, func(proposal mv1beta5.LeasePriceProposal) bool {
+		proposals = append(proposals, proposal)
+		return false
+	})
+
+	var reputations []mv1beta5.ProviderReputation
+
+	k.WithProviderReputations(ctx, func(rep mv1beta5.ProviderReputation) bool {
+		reputations = append(reputations, rep)
+		return false
+	})
+
+	var drains []mv1beta5.ProviderDrain
+
+	k.WithProviderDrainsEnded(ctx, math.MaxInt64, func(drain mv1beta5.ProviderDrain) bool {
+		drains = append(drains, drain)
+		return false
+	})
+
+	var inventories []mv1beta5.ProviderInventory
+
+	k.WithProviderInventories(ctx, func(inv mv1beta5.ProviderInventory) bool {
+		inventories = append(inventories, inv)
+		return false
+	})
+
+	return &mv1beta5.GenesisState{
+		Params:               params,
+		Orders:               orders,
+		Leases:               leases,
+		Bids:                 bids,
+		ExpiryParams:         k.GetExpiryParams(ctx),
+		OrderAuctions:        orderAuctions,
+		GroupAuctions:        groupAuctions,
+		LeasePriceProposals:  proposals,
+		ReputationParams:     k.GetReputationParams(ctx),
+		Reputations:          reputations,
+		DeregistrationParams: k.GetDeregistrationParams(ctx),
+		ProviderDrains:       drains,
+		InventoryParams:      k.GetInventoryParams(ctx),
+		ProviderInventories:  inventories,
 	}
 }
 
 // GetGenesisStateFromAppState returns x/market GenesisState given raw application
 // genesis state.
-func GetGenesisStateFromAppState(cdc codec.JSONCodec, appState map[string]json.RawMessage) *types.GenesisState {
-	var genesisState types.GenesisState
+func GetGenesisStateFromAppState(cdc codec.JSONCodec, appState map[string]json.RawMessage) *mv1beta5.GenesisState {
+	var genesisState mv1beta5.GenesisState
 
 	if appState[ModuleName] != nil {
 		cdc.MustUnmarshalJSON(appState[ModuleName], &genesisState)
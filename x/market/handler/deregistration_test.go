@@ -0,0 +1,221 @@
+package handler_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	atypes "github.com/akash-network/akash-api/go/node/audit/v1beta3"
+	types "github.com/akash-network/akash-api/go/node/market/v1beta4"
+	akashtypes "github.com/akash-network/akash-api/go/node/types/v1beta3"
+	"github.com/akash-network/akash-api/go/sdkutil"
+
+	"github.com/akash-network/node/testutil"
+	"github.com/akash-network/node/x/market"
+	"github.com/akash-network/node/x/market/handler"
+	"github.com/akash-network/node/x/market/keeper"
+	keys "github.com/akash-network/node/x/market/keeper/keys/v1beta4"
+	mv1beta5 "github.com/akash-network/node/x/market/types/v1beta5"
+)
+
+func TestDeregisterProvider(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	suite.MarketKeeper().SetDeregistrationParams(suite.Context(), mv1beta5.DeregistrationParams{
+		ProviderDrainPeriod: 10,
+	})
+
+	leased, gspec := suite.createFundedOrder()
+	lid := types.LeaseID(suite.createProviderBid(leased, gspec.Requirements.Attributes, 10))
+	provider := sdk.MustAccAddressFromBech32(lid.Provider)
+
+	_, err := suite.handler(suite.Context(), &types.MsgCreateLease{BidID: lid.BidID()})
+	require.NoError(t, err)
+
+	open, ospec := suite.createFundedOrder()
+	bid, err := suite.MarketKeeper().CreateBid(suite.Context(), open.ID(), provider,
+		sdk.NewDecCoin(testutil.CoinDenom, sdk.NewInt(10)), types.ResourceOfferFromRU(ospec.Resources))
+	require.NoError(t, err)
+
+	err = suite.EscrowKeeper().AccountCreate(suite.Context(), types.EscrowAccountForBid(bid.ID()), provider, provider, types.DefaultBidMinDeposit)
+	require.NoError(t, err)
+
+	auditor := testutil.AccAddress(t)
+	err = suite.AuditKeeper().CreateOrUpdateProviderAttributes(suite.Context(),
+		atypes.ProviderID{Owner: provider, Auditor: auditor},
+		akashtypes.Attributes{{Key: "tier", Value: "community"}})
+	require.NoError(t, err)
+
+	drain, err := suite.MarketKeeper().OnProviderDraining(suite.Context(), provider)
+	require.NoError(t, err)
+	require.Equal(t, suite.Context().BlockHeight()+10, drain.EndsAt)
+
+	_, err = suite.MarketKeeper().OnProviderDraining(suite.Context(), provider)
+	require.ErrorIs(t, err, keeper.ErrProviderDraining)
+
+	// open bids are closed, new ones rejected
+	bid, _ = suite.MarketKeeper().GetBid(suite.Context(), bid.ID())
+	require.Equal(t, types.BidClosed, bid.State)
+
+	another, _ := suite.createFundedOrder()
+	_, err = suite.handler(suite.Context(), &types.MsgCreateBid{
+		Order:    another.ID(),
+		Provider: provider.String(),
+		Price:    sdk.NewDecCoin(testutil.CoinDenom, sdk.NewInt(10)),
+		Deposit:  types.DefaultBidMinDeposit,
+	})
+	require.ErrorIs(t, err, keeper.ErrProviderDraining)
+
+	keepers := suite.keepers()
+
+	// leases keep running during grace period
+	suite.SetBlockHeight(drain.EndsAt - 1)
+	require.Equal(t, 0, handler.DeregisterProviders(suite.Context(), keepers, handler.DeregistrationMaxProviders))
+
+	lease, _ := suite.MarketKeeper().GetLease(suite.Context(), lid)
+	require.Equal(t, types.LeaseActive, lease.State)
+
+	suite.SetBlockHeight(drain.EndsAt)
+	require.Equal(t, 1, handler.DeregisterProviders(suite.Context(), keepers, handler.DeregistrationMaxProviders))
+
+	lease, _ = suite.MarketKeeper().GetLease(suite.Context(), lid)
+	require.Equal(t, types.LeaseClosed, lease.State)
+	require.Equal(t, drain.EndsAt, lease.ClosedOn)
+
+	closed := false
+	for _, ev := range suite.Context().EventManager().ABCIEvents() {
+		sev, err := sdkutil.ParseEvent(sdk.StringifyEvent(ev))
+		if err != nil {
+			continue
+		}
+
+		if mev, err := mv1beta5.ParseEvent(sev); err == nil {
+			closed = closed || mev == mv1beta5.NewEventLeaseClosedProviderDeregistered(lid)
+		}
+	}
+	require.True(t, closed)
+
+	// group gets a new order to be bid on by other providers
+	reopened, found := suite.MarketKeeper().GetOrder(suite.Context(), types.MakeOrderID(leased.ID().GroupID(), 2))
+	require.True(t, found)
+	require.Equal(t, types.OrderOpen, reopened.State)
+
+	_, found = suite.ProviderKeeper().Get(suite.Context(), provider)
+	require.False(t, found)
+
+	_, found = suite.AuditKeeper().GetProviderAttributes(suite.Context(), provider)
+	require.False(t, found)
+
+	_, found = suite.MarketKeeper().GetProviderDrain(suite.Context(), provider)
+	require.False(t, found)
+
+	// provider is removed once
+	suite.SetBlockHeight(drain.EndsAt + 1)
+	require.Equal(t, 0, handler.DeregisterProviders(suite.Context(), keepers, handler.DeregistrationMaxProviders))
+}
+
+func TestDeregisterProviderDefaultDrainPeriod(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	require.Equal(t, keeper.DefaultProviderDrainPeriod, market.DefaultGenesisState().DeregistrationParams.ProviderDrainPeriod)
+
+	order, gspec := suite.createFundedOrder()
+	lid := types.LeaseID(suite.createProviderBid(order, gspec.Requirements.Attributes, 10))
+	provider := sdk.MustAccAddressFromBech32(lid.Provider)
+
+	_, err := suite.handler(suite.Context(), &types.MsgCreateLease{BidID: lid.BidID()})
+	require.NoError(t, err)
+
+	drain, err := suite.MarketKeeper().OnProviderDraining(suite.Context(), provider)
+	require.NoError(t, err)
+	require.Equal(t, suite.Context().BlockHeight()+keeper.DefaultProviderDrainPeriod, drain.EndsAt)
+
+	keepers := suite.keepers()
+
+	// leases are not closed in the block provider deregisters
+	require.Equal(t, 0, handler.DeregisterProviders(suite.Context(), keepers, handler.DeregistrationMaxProviders))
+
+	suite.SetBlockHeight(drain.EndsAt - 1)
+	require.Equal(t, 0, handler.DeregisterProviders(suite.Context(), keepers, handler.DeregistrationMaxProviders))
+
+	lease, _ := suite.MarketKeeper().GetLease(suite.Context(), lid)
+	require.Equal(t, types.LeaseActive, lease.State)
+
+	suite.SetBlockHeight(drain.EndsAt)
+	require.Equal(t, 1, handler.DeregisterProviders(suite.Context(), keepers, handler.DeregistrationMaxProviders))
+
+	lease, _ = suite.MarketKeeper().GetLease(suite.Context(), lid)
+	require.Equal(t, types.LeaseClosed, lease.State)
+}
+
+func TestDeregisterProviderKeptWhenLeaseCloseFails(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	order, gspec := suite.createFundedOrder()
+	lid := types.LeaseID(suite.createProviderBid(order, gspec.Requirements.Attributes, 10))
+	provider := sdk.MustAccAddressFromBech32(lid.Provider)
+
+	_, err := suite.handler(suite.Context(), &types.MsgCreateLease{BidID: lid.BidID()})
+	require.NoError(t, err)
+
+	drain, err := suite.MarketKeeper().OnProviderDraining(suite.Context(), provider)
+	require.NoError(t, err)
+
+	// lease can not be closed without its order
+	suite.Context().KVStore(suite.MarketKeeper().StoreKey()).Delete(keys.OrderKey(lid.OrderID()))
+
+	require.Equal(t, 0, handler.DeregisterProviders(suite.Context(), suite.keepers(), handler.DeregistrationMaxProviders))
+
+	lease, _ := suite.MarketKeeper().GetLease(suite.Context(), lid)
+	require.Equal(t, types.LeaseActive, lease.State)
+
+	_, found := suite.ProviderKeeper().Get(suite.Context(), provider)
+	require.True(t, found)
+
+	pending, found := suite.MarketKeeper().GetProviderDrain(suite.Context(), provider)
+	require.True(t, found)
+	require.Equal(t, drain, pending)
+}
+
+func TestDeregistrationGenesis(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	params := mv1beta5.DeregistrationParams{ProviderDrainPeriod: 10}
+	suite.MarketKeeper().SetDeregistrationParams(suite.Context(), params)
+
+	order, gspec := suite.createFundedOrder()
+	lid := types.LeaseID(suite.createProviderBid(order, gspec.Requirements.Attributes, 10))
+	provider := sdk.MustAccAddressFromBech32(lid.Provider)
+
+	_, err := suite.handler(suite.Context(), &types.MsgCreateLease{BidID: lid.BidID()})
+	require.NoError(t, err)
+
+	drain, err := suite.MarketKeeper().OnProviderDraining(suite.Context(), provider)
+	require.NoError(t, err)
+
+	gs := market.ExportGenesis(suite.Context(), suite.MarketKeeper())
+	require.NoError(t, market.ValidateGenesis(gs))
+	require.Equal(t, params, gs.DeregistrationParams)
+	require.Equal(t, []mv1beta5.ProviderDrain{drain}, gs.ProviderDrains)
+
+	imported := setupTestSuite(t)
+	market.InitGenesis(imported.Context(), imported.MarketKeeper(), gs)
+
+	require.Equal(t, params, imported.MarketKeeper().GetDeregistrationParams(imported.Context()))
+
+	var ended []mv1beta5.ProviderDrain
+	imported.MarketKeeper().WithProviderDrainsEnded(imported.Context(), drain.EndsAt, func(drain mv1beta5.ProviderDrain) bool {
+		ended = append(ended, drain)
+		return false
+	})
+	require.Equal(t, []mv1beta5.ProviderDrain{drain}, ended)
+
+	var leases []types.LeaseID
+	imported.MarketKeeper().WithActiveLeasesForProvider(imported.Context(), provider, func(lease types.Lease) bool {
+		leases = append(leases, lease.ID())
+		return false
+	})
+	require.Equal(t, []types.LeaseID{lid}, leases)
+}
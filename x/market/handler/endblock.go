@@ -0,0 +1,337 @@
+package handler
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	atypes "github.com/akash-network/akash-api/go/node/audit/v1beta3"
+	dtypes "github.com/akash-network/akash-api/go/node/deployment/v1beta3"
+	types "github.com/akash-network/akash-api/go/node/market/v1beta4"
+
+	mv1beta5 "github.com/akash-network/node/x/market/types/v1beta5"
+)
+
+const (
+	// AuctionMaxSettlements is the maximum number of order auctions settled by the EndBlock handler
+	AuctionMaxSettlements = 50
+
+	// ExpiryMaxClosings is the maximum number of stale orders, and separately bids, closed by the EndBlock handler
+	ExpiryMaxClosings = 100
+
+	// DeregistrationMaxProviders is the maximum number of providers removed by the EndBlock handler
+	DeregistrationMaxProviders = 10
+)
+
+// EndBlock settles reverse auctions which bidding window has closed,
+// closes orders and bids which outlived their time-to-live
+// and removes providers which deregistration grace period has ended
+func EndBlock(ctx sdk.Context, keepers Keepers) {
+	SettleAuctions(ctx, keepers, AuctionMaxSettlements)
+
+	params := keepers.Market.GetExpiryParams(ctx)
+	ExpireOrders(ctx, keepers, params.OrderTTL, ExpiryMaxClosings)
+	ExpireBids(ctx, keepers, params.BidTTL, ExpiryMaxClosings)
+
+	DeregisterProviders(ctx, keepers, DeregistrationMaxProviders)
+}
+
+// DeregisterProviders removes providers which deregistration grace period ended at or before current height.
+// Active leases of each provider are closed and their orders are recreated so tenants' groups get new bids,
+// then the provider record and attributes signed by auditors are deleted. Provider which leases could not all
+// be closed is kept along with its deregistration state and retried in following blocks.
+// It returns number of removed providers.
+func DeregisterProviders(ctx sdk.Context, keepers Keepers, maxProviders int) int {
+	if maxProviders <= 0 {
+		return 0
+	}
+
+	var drains []mv1beta5.ProviderDrain
+
+	keepers.Market.WithProviderDrainsEnded(ctx, ctx.BlockHeight(), func(drain mv1beta5.ProviderDrain) bool {
+		drains = append(drains, drain)
+		return len(drains) >= maxProviders
+	})
+
+	removed := 0
+
+	for _, drain := range drains {
+		provider, err := sdk.AccAddressFromBech32(drain.Provider)
+		if err != nil {
+			keepers.Market.DeleteProviderDrain(ctx, drain)
+			continue
+		}
+
+		var leases []types.Lease
+
+		keepers.Market.WithActiveLeasesForProvider(ctx, provider, func(lease types.Lease) bool {
+			leases = append(leases, lease)
+			return false
+		})
+
+		closed := true
+
+		for _, lease := range leases {
+			// isolate writes so that failed close does not leave payment or order half-updated
+			cctx, write := ctx.CacheContext()
+
+			if err := closeDeregisteredLease(cctx, keepers, lease); err != nil {
+				ctx.Logger().Error("market deregistration: close lease", "err", err, "lease", lease.ID())
+				closed = false
+				continue
+			}
+
+			write()
+			ctx.EventManager().EmitEvents(cctx.EventManager().Events())
+		}
+
+		if !closed {
+			continue
+		}
+
+		// expired attributes are removed as well
+		var audited []atypes.Provider
+
+		keepers.Audit.WithProvider(ctx, provider, func(attr atypes.Provider) bool {
+			audited = append(audited, attr)
+			return false
+		})
+
+		for _, attr := range audited {
+			auditor, err := sdk.AccAddressFromBech32(attr.Auditor)
+			if err != nil {
+				continue
+			}
+
+			if err := keepers.Audit.DeleteProviderAttributes(ctx, atypes.ProviderID{Owner: provider, Auditor: auditor}, nil); err != nil {
+				ctx.Logger().Error("market deregistration: delete audited attributes", "err", err, "provider", provider)
+			}
+		}
+
+		keepers.Market.DeleteProviderInventory(ctx, provider)
+		keepers.Provider.Delete(ctx, provider)
+		keepers.Market.DeleteProviderDrain(ctx, drain)
+
+		removed++
+	}
+
+	return removed
+}
+
+// closeDeregisteredLease closes lease of a deregistered provider along with its bid, order and payment,
+// and creates new order for the group
+func closeDeregisteredLease(ctx sdk.Context, keepers Keepers, lease types.Lease) error {
+	order, found := keepers.Market.GetOrder(ctx, lease.ID().OrderID())
+	if !found {
+		return types.ErrOrderNotFound
+	}
+
+	bid, found := keepers.Market.GetBid(ctx, lease.ID().BidID())
+	if !found {
+		return types.ErrBidNotFound
+	}
+
+	keepers.Market.OnLeaseClosedProviderDeregistered(ctx, lease)
+	keepers.Market.OnBidClosed(ctx, bid)
+	keepers.Market.OnOrderClosed(ctx, order)
+
+	if err := keepers.Escrow.PaymentClose(ctx,
+		dtypes.EscrowAccountForDeployment(lease.ID().DeploymentID()),
+		types.EscrowPaymentForLease(lease.ID()),
+	); err != nil {
+		return err
+	}
+
+	group, err := keepers.Deployment.OnLeaseClosed(ctx, lease.ID().GroupID())
+	if err != nil {
+		return err
+	}
+
+	if group.State != dtypes.GroupOpen {
+		return nil
+	}
+
+	_, err = keepers.Market.CreateOrder(ctx, group.ID(), group.GroupSpec)
+	return err
+}
+
+// ExpireOrders closes open orders created ttl or more blocks ago, along with their open bids,
+// and pauses the order's group so tenant may restart it. Escrow accounts of closed bids are closed,
+// returning deposits to providers. Zero ttl disables expiry.
+// It returns number of closed orders.
+func ExpireOrders(ctx sdk.Context, keepers Keepers, ttl int64, maxClosings int) int {
+	if ttl <= 0 || maxClosings <= 0 {
+		return 0
+	}
+
+	var orders []types.Order
+
+	keepers.Market.WithOrdersCreatedBefore(ctx, ctx.BlockHeight()-ttl, func(order types.Order) bool {
+		orders = append(orders, order)
+		return len(orders) >= maxClosings
+	})
+
+	for _, order := range orders {
+		var bids []types.Bid
+
+		keepers.Market.WithBidsForOrder(ctx, order.ID(), func(bid types.Bid) bool {
+			if bid.State == types.BidOpen {
+				bids = append(bids, bid)
+			}
+			return false
+		})
+
+		for _, bid := range bids {
+			keepers.Market.OnBidClosed(ctx, bid)
+		}
+
+		keepers.Market.OnOrderClosed(ctx, order)
+
+		if group, found := keepers.Deployment.GetGroup(ctx, order.ID().GroupID()); found && group.State == dtypes.GroupOpen {
+			if err := keepers.Deployment.OnBidClosed(ctx, group.ID()); err != nil {
+				ctx.Logger().Error("market expiry: pause group", "err", err, "group", group.ID())
+			}
+		}
+	}
+
+	return len(orders)
+}
+
+// ExpireBids closes open bids created ttl or more blocks ago and their escrow accounts,
+// returning deposits to providers. Zero ttl disables expiry.
+// It returns number of closed bids.
+func ExpireBids(ctx sdk.Context, keepers Keepers, ttl int64, maxClosings int) int {
+	if ttl <= 0 || maxClosings <= 0 {
+		return 0
+	}
+
+	var bids []types.Bid
+
+	keepers.Market.WithBidsCreatedBefore(ctx, ctx.BlockHeight()-ttl, func(bid types.Bid) bool {
+		bids = append(bids, bid)
+		return len(bids) >= maxClosings
+	})
+
+	for _, bid := range bids {
+		keepers.Market.OnBidClosed(ctx, bid)
+	}
+
+	return len(bids)
+}
+
+// SettleAuctions creates leases for orders which auction window closed at or before current height.
+// Winning bid is the lowest priced one, see auctionWinner for tie-breaking.
+// Auction of order with no open bids ends leaving the order open for tenant to match it manually.
+// At most maxSettlements auctions are processed, remaining ones are picked up by following blocks.
+// It returns number of leases created.
+func SettleAuctions(ctx sdk.Context, keepers Keepers, maxSettlements int) int {
+	if maxSettlements <= 0 {
+		return 0
+	}
+
+	var auctions []mv1beta5.OrderAuction
+
+	keepers.Market.WithOrderAuctionsClosing(ctx, ctx.BlockHeight(), func(auction mv1beta5.OrderAuction) bool {
+		auctions = append(auctions, auction)
+		return len(auctions) >= maxSettlements
+	})
+
+	created := 0
+
+	for _, auction := range auctions {
+		keepers.Market.DeleteOrderAuction(ctx, auction)
+
+		order, found := keepers.Market.GetOrder(ctx, auction.OrderID)
+		if !found || order.State != types.OrderOpen {
+			continue
+		}
+
+		bid, found := auctionWinner(ctx, keepers, order)
+		if !found {
+			ctx.Logger().Info("market auction: no bids", "order", order.ID())
+			continue
+		}
+
+		// isolate writes so that failed match does not leave payment or bids half-updated
+		cctx, write := ctx.CacheContext()
+
+		if err := createLease(cctx, keepers, bid.ID()); err != nil {
+			ctx.Logger().Error("market auction: create lease", "err", err, "bid", bid.ID())
+			continue
+		}
+
+		write()
+		ctx.EventManager().EmitEvents(cctx.EventManager().Events())
+
+		created++
+	}
+
+	return created
+}
+
+// auctionWinner picks the winning open bid of an order deterministically:
+// lowest price first, then the provider with more auditors signing its attributes,
+// then the provider with more attributes, then the earliest bid, then provider address.
+// Only bids priced in the order's denomination take part, prices in different denominations do not compare.
+func auctionWinner(ctx sdk.Context, keepers Keepers, order types.Order) (types.Bid, bool) {
+	type candidate struct {
+		bid        types.Bid
+		auditors   int
+		attributes int
+	}
+
+	var candidates []candidate
+
+	keepers.Market.WithBidsForOrder(ctx, order.ID(), func(bid types.Bid) bool {
+		if bid.State != types.BidOpen || bid.Price.Denom != order.Price().Denom {
+			return false
+		}
+
+		c := candidate{bid: bid}
+
+		provider, err := sdk.AccAddressFromBech32(bid.ID().Provider)
+		if err != nil {
+			return false
+		}
+
+		if prov, found := keepers.Provider.Get(ctx, provider); found {
+			c.attributes = len(prov.Attributes)
+		}
+
+		if audited, found := keepers.Audit.GetProviderAttributes(ctx, provider); found {
+			c.auditors = len(audited)
+		}
+
+		candidates = append(candidates, c)
+
+		return false
+	})
+
+	if len(candidates) == 0 {
+		return types.Bid{}, false
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+
+		if !a.bid.Price.Amount.Equal(b.bid.Price.Amount) {
+			return a.bid.Price.Amount.LT(b.bid.Price.Amount)
+		}
+
+		if a.auditors != b.auditors {
+			return a.auditors > b.auditors
+		}
+
+		if a.attributes != b.attributes {
+			return a.attributes > b.attributes
+		}
+
+		if a.bid.CreatedAt != b.bid.CreatedAt {
+			return a.bid.CreatedAt < b.bid.CreatedAt
+		}
+
+		return a.bid.ID().Provider < b.bid.ID().Provider
+	})
+
+	return candidates[0].bid, true
+}
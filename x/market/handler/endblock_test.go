@@ -0,0 +1,296 @@
+package handler_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	atypes "github.com/akash-network/akash-api/go/node/audit/v1beta3"
+	dtypes "github.com/akash-network/akash-api/go/node/deployment/v1beta3"
+	etypes "github.com/akash-network/akash-api/go/node/escrow/v1beta3"
+	types "github.com/akash-network/akash-api/go/node/market/v1beta4"
+	akashtypes "github.com/akash-network/akash-api/go/node/types/v1beta3"
+
+	"github.com/akash-network/node/testutil"
+	"github.com/akash-network/node/x/market"
+	"github.com/akash-network/node/x/market/handler"
+	"github.com/akash-network/node/x/market/keeper"
+	mv1beta5 "github.com/akash-network/node/x/market/types/v1beta5"
+)
+
+func TestSettleAuctions(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	order, gspec := suite.createAuctionOrder(10)
+
+	expensive := suite.createProviderBid(order, gspec.Requirements.Attributes, 3)
+	plain := suite.createProviderBid(order, gspec.Requirements.Attributes, 2)
+	audited := suite.createProviderBid(order, gspec.Requirements.Attributes, 2)
+
+	auditor := testutil.AccAddress(t)
+	owner := sdk.MustAccAddressFromBech32(audited.Provider)
+	err := suite.AuditKeeper().CreateOrUpdateProviderAttributes(suite.Context(),
+		atypes.ProviderID{Owner: owner, Auditor: auditor},
+		akashtypes.Attributes{{Key: "tier", Value: "community"}})
+	require.NoError(t, err)
+
+	keepers := suite.keepers()
+
+	// bidding window still open
+	suite.SetBlockHeight(order.CreatedAt + 9)
+	require.Equal(t, 0, handler.SettleAuctions(suite.Context(), keepers, handler.AuctionMaxSettlements))
+
+	suite.SetBlockHeight(order.CreatedAt + 10)
+	require.Equal(t, 1, handler.SettleAuctions(suite.Context(), keepers, handler.AuctionMaxSettlements))
+
+	lease, found := suite.MarketKeeper().GetLease(suite.Context(), types.LeaseID(audited))
+	require.True(t, found)
+	require.Equal(t, types.LeaseActive, lease.State)
+
+	matched, _ := suite.MarketKeeper().GetOrder(suite.Context(), order.ID())
+	require.Equal(t, types.OrderActive, matched.State)
+
+	for _, id := range []types.BidID{expensive, plain} {
+		bid, found := suite.MarketKeeper().GetBid(suite.Context(), id)
+		require.True(t, found)
+		require.Equal(t, types.BidLost, bid.State)
+
+		_, found = suite.MarketKeeper().GetLease(suite.Context(), types.LeaseID(id))
+		require.False(t, found)
+	}
+
+	// auction is settled once
+	suite.SetBlockHeight(order.CreatedAt + 11)
+	require.Equal(t, 0, handler.SettleAuctions(suite.Context(), keepers, handler.AuctionMaxSettlements))
+}
+
+func TestSettleAuctionsNoBids(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	order, _ := suite.createAuctionOrder(5)
+
+	suite.SetBlockHeight(order.CreatedAt + 5)
+	require.Equal(t, 0, handler.SettleAuctions(suite.Context(), suite.keepers(), handler.AuctionMaxSettlements))
+
+	open, _ := suite.MarketKeeper().GetOrder(suite.Context(), order.ID())
+	require.Equal(t, types.OrderOpen, open.State)
+}
+
+func TestSetOrderAuctionInvalid(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	order, _ := suite.createOrder(testutil.Resources(t))
+
+	err := suite.MarketKeeper().SetOrderAuction(suite.Context(), order.ID(), 0)
+	require.Error(t, err)
+
+	suite.MarketKeeper().OnOrderClosed(suite.Context(), order)
+
+	err = suite.MarketKeeper().SetOrderAuction(suite.Context(), order.ID(), 10)
+	require.ErrorIs(t, err, types.ErrOrderNotOpen)
+}
+
+func TestSettleGroupAuctions(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	order, gspec := suite.createFundedOrder()
+	gid := order.ID().GroupID()
+
+	err := suite.MarketKeeper().SetGroupAuction(suite.Context(), gid, 5)
+	require.NoError(t, err)
+
+	err = suite.MarketKeeper().SetGroupAuction(suite.Context(), gid, 10)
+	require.ErrorIs(t, err, keeper.ErrGroupAuctionExists)
+
+	// order open when auction was set is put into auction mode
+	var auctions []mv1beta5.OrderAuction
+	suite.MarketKeeper().WithOrderAuctionsClosing(suite.Context(), order.CreatedAt+5, func(auction mv1beta5.OrderAuction) bool {
+		auctions = append(auctions, auction)
+		return false
+	})
+	require.Len(t, auctions, 1)
+	require.Equal(t, order.ID(), auctions[0].OrderID)
+
+	// orders created for the group afterwards are auctioned too
+	suite.MarketKeeper().OnOrderClosed(suite.Context(), order)
+
+	next, err := suite.MarketKeeper().CreateOrder(suite.Context(), gid, gspec)
+	require.NoError(t, err)
+
+	bid := suite.createProviderBid(next, gspec.Requirements.Attributes, 2)
+
+	suite.SetBlockHeight(next.CreatedAt + 5)
+	require.Equal(t, 1, handler.SettleAuctions(suite.Context(), suite.keepers(), handler.AuctionMaxSettlements))
+
+	_, found := suite.MarketKeeper().GetLease(suite.Context(), types.LeaseID(bid))
+	require.True(t, found)
+
+	gs := market.ExportGenesis(suite.Context(), suite.MarketKeeper())
+	require.NoError(t, market.ValidateGenesis(gs))
+	require.Equal(t, []mv1beta5.GroupAuction{{ID: gid, Window: 5}}, gs.GroupAuctions)
+
+	imported := setupTestSuite(t)
+	market.InitGenesis(imported.Context(), imported.MarketKeeper(), gs)
+
+	window, found := imported.MarketKeeper().GetGroupAuction(imported.Context(), gid)
+	require.True(t, found)
+	require.Equal(t, int64(5), window)
+
+	suite.MarketKeeper().OnGroupClosed(suite.Context(), gid)
+
+	_, found = suite.MarketKeeper().GetGroupAuction(suite.Context(), gid)
+	require.True(t, found)
+
+	suite.MarketKeeper().DeleteGroupAuction(suite.Context(), gid)
+
+	_, found = suite.MarketKeeper().GetGroupAuction(suite.Context(), gid)
+	require.False(t, found)
+}
+
+func (st *testSuite) keepers() handler.Keepers {
+	return handler.Keepers{
+		Escrow:     st.EscrowKeeper(),
+		Audit:      st.AuditKeeper(),
+		Market:     st.MarketKeeper(),
+		Deployment: st.DeploymentKeeper(),
+		Provider:   st.ProviderKeeper(),
+	}
+}
+
+func (st *testSuite) createAuctionOrder(window int64) (types.Order, dtypes.GroupSpec) {
+	st.t.Helper()
+
+	order, gspec := st.createFundedOrder()
+
+	err := st.MarketKeeper().SetOrderAuction(st.Context(), order.ID(), window)
+	require.NoError(st.t, err)
+
+	return order, gspec
+}
+
+// createFundedOrder creates order which deployment has an escrow account
+func (st *testSuite) createFundedOrder() (types.Order, dtypes.GroupSpec) {
+	st.t.Helper()
+
+	order, gspec := st.createOrder(testutil.Resources(st.t))
+
+	owner := sdk.MustAccAddressFromBech32(order.ID().Owner)
+	err := st.EscrowKeeper().AccountCreate(st.Context(),
+		dtypes.EscrowAccountForDeployment(order.ID().GroupID().DeploymentID()),
+		owner,
+		owner,
+		sdk.NewInt64Coin(testutil.CoinDenom, 1000))
+	require.NoError(st.t, err)
+
+	return order, gspec
+}
+
+func (st *testSuite) createProviderBid(order types.Order, attr []akashtypes.Attribute, price int64) types.BidID {
+	st.t.Helper()
+
+	provider := st.createProvider(attr).Owner
+
+	msg := &types.MsgCreateBid{
+		Order:    order.ID(),
+		Provider: provider,
+		Price:    sdk.NewDecCoin(testutil.CoinDenom, sdk.NewInt(price)),
+		Deposit:  types.DefaultBidMinDeposit,
+	}
+
+	_, err := st.handler(st.Context(), msg)
+	require.NoError(st.t, err)
+
+	return types.MakeBidID(order.ID(), sdk.MustAccAddressFromBech32(provider))
+}
+
+func TestExpireOrders(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	order, gspec := suite.createFundedOrder()
+	bid := suite.createProviderBid(order, gspec.Requirements.Attributes, 1)
+
+	suite.MarketKeeper().SetExpiryParams(suite.Context(), mv1beta5.ExpiryParams{OrderTTL: 10})
+	params := suite.MarketKeeper().GetExpiryParams(suite.Context())
+	require.Equal(t, int64(10), params.OrderTTL)
+
+	suite.SetBlockHeight(order.CreatedAt + 9)
+	require.Equal(t, 0, handler.ExpireOrders(suite.Context(), suite.keepers(), params.OrderTTL, handler.ExpiryMaxClosings))
+
+	suite.SetBlockHeight(order.CreatedAt + 10)
+	require.Equal(t, 1, handler.ExpireOrders(suite.Context(), suite.keepers(), params.OrderTTL, handler.ExpiryMaxClosings))
+
+	closed, _ := suite.MarketKeeper().GetOrder(suite.Context(), order.ID())
+	require.Equal(t, types.OrderClosed, closed.State)
+
+	closedBid, _ := suite.MarketKeeper().GetBid(suite.Context(), bid)
+	require.Equal(t, types.BidClosed, closedBid.State)
+
+	account, err := suite.EscrowKeeper().GetAccount(suite.Context(), types.EscrowAccountForBid(bid))
+	require.NoError(t, err)
+	require.Equal(t, etypes.AccountClosed, account.State)
+
+	group, _ := suite.DeploymentKeeper().GetGroup(suite.Context(), order.ID().GroupID())
+	require.Equal(t, dtypes.GroupPaused, group.State)
+
+	// closed orders are dropped from the index
+	require.Equal(t, 0, handler.ExpireOrders(suite.Context(), suite.keepers(), params.OrderTTL, handler.ExpiryMaxClosings))
+}
+
+func TestExpiryIndexGenesis(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	order, gspec := suite.createFundedOrder()
+	bid := suite.createProviderBid(order, gspec.Requirements.Attributes, 1)
+
+	suite.MarketKeeper().SetExpiryParams(suite.Context(), mv1beta5.ExpiryParams{OrderTTL: 10, BidTTL: 5})
+
+	gs := market.ExportGenesis(suite.Context(), suite.MarketKeeper())
+	require.NoError(t, market.ValidateGenesis(gs))
+
+	imported := setupTestSuite(t)
+	market.InitGenesis(imported.Context(), imported.MarketKeeper(), gs)
+
+	require.Equal(t, gs.ExpiryParams, imported.MarketKeeper().GetExpiryParams(imported.Context()))
+
+	var orders []types.OrderID
+	imported.MarketKeeper().WithOrdersCreatedBefore(imported.Context(), order.CreatedAt+1, func(order types.Order) bool {
+		orders = append(orders, order.ID())
+		return false
+	})
+	require.Equal(t, []types.OrderID{order.ID()}, orders)
+
+	var bids []types.BidID
+	imported.MarketKeeper().WithBidsCreatedBefore(imported.Context(), order.CreatedAt+1, func(bid types.Bid) bool {
+		bids = append(bids, bid.ID())
+		return false
+	})
+	require.Equal(t, []types.BidID{bid}, bids)
+}
+
+func TestExpireBids(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	order, gspec := suite.createFundedOrder()
+	stale := suite.createProviderBid(order, gspec.Requirements.Attributes, 1)
+
+	suite.SetBlockHeight(order.CreatedAt + 5)
+	fresh := suite.createProviderBid(order, gspec.Requirements.Attributes, 1)
+
+	// zero ttl disables expiry
+	suite.SetBlockHeight(order.CreatedAt + 100)
+	require.Equal(t, 0, handler.ExpireBids(suite.Context(), suite.keepers(), 0, handler.ExpiryMaxClosings))
+
+	suite.SetBlockHeight(order.CreatedAt + 10)
+	require.Equal(t, 1, handler.ExpireBids(suite.Context(), suite.keepers(), 10, handler.ExpiryMaxClosings))
+
+	bid, _ := suite.MarketKeeper().GetBid(suite.Context(), stale)
+	require.Equal(t, types.BidClosed, bid.State)
+
+	bid, _ = suite.MarketKeeper().GetBid(suite.Context(), fresh)
+	require.Equal(t, types.BidOpen, bid.State)
+
+	open, _ := suite.MarketKeeper().GetOrder(suite.Context(), order.ID())
+	require.Equal(t, types.OrderOpen, open.State)
+}
@@ -5,11 +5,14 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	types "github.com/akash-network/akash-api/go/node/market/v1beta4"
+
+	mv1beta5 "github.com/akash-network/node/x/market/types/v1beta5"
 )
 
 // NewHandler returns a handler for "market" type messages
 func NewHandler(keepers Keepers) sdk.Handler {
 	ms := NewServer(keepers)
+	ns := NewNodeServer(keepers)
 
 	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
 		switch msg := msg.(type) {
@@ -33,6 +36,14 @@ func NewHandler(keepers Keepers) sdk.Handler {
 			res, err := ms.CloseLease(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *mv1beta5.MsgProposeLeasePrice:
+			res, err := ns.ProposeLeasePrice(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *mv1beta5.MsgAcceptLeasePrice:
+			res, err := ns.AcceptLeasePrice(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			return nil, sdkerrors.ErrUnknownRequest
 		}
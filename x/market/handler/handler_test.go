@@ -13,6 +13,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/tendermint/tendermint/libs/rand"
 
+	atypes "github.com/akash-network/akash-api/go/node/audit/v1beta3"
 	dtypes "github.com/akash-network/akash-api/go/node/deployment/v1beta3"
 	types "github.com/akash-network/akash-api/go/node/market/v1beta4"
 	ptypes "github.com/akash-network/akash-api/go/node/provider/v1beta3"
@@ -20,6 +21,7 @@ import (
 
 	"github.com/akash-network/node/testutil"
 	"github.com/akash-network/node/testutil/state"
+	av1beta4 "github.com/akash-network/node/x/audit/types/v1beta4"
 	"github.com/akash-network/node/x/market/handler"
 )
 
@@ -60,7 +62,7 @@ func TestProviderBadMessageType(t *testing.T) {
 func TestCreateBidValid(t *testing.T) {
 	suite := setupTestSuite(t)
 
-	order, gspec := suite.createOrder(testutil.Resources(t))
+	order, gspec := suite.createFundedOrder()
 
 	provider := suite.createProvider(gspec.Requirements.Attributes).Owner
 
@@ -117,6 +119,103 @@ func TestCreateBidInvalidPrice(t *testing.T) {
 	require.False(t, found)
 }
 
+func TestCreateBidSignedByAuditorDelegate(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	auditor := testutil.AccAddress(t)
+	delegate := testutil.AccAddress(t)
+
+	deployment := testutil.Deployment(t)
+	group := testutil.DeploymentGroup(t, deployment.ID(), 0)
+	group.GroupSpec.Resources = testutil.Resources(t)
+	group.GroupSpec.Requirements.SignedBy = akashtypes.SignedBy{AllOf: []string{auditor.String()}}
+
+	err := suite.DeploymentKeeper().Create(suite.Context(), deployment, []dtypes.Group{group})
+	require.NoError(t, err)
+
+	order, err := suite.MarketKeeper().CreateOrder(suite.Context(), group.ID(), group.GroupSpec)
+	require.NoError(t, err)
+
+	owner := sdk.MustAccAddressFromBech32(deployment.ID().Owner)
+	err = suite.EscrowKeeper().AccountCreate(suite.Context(), dtypes.EscrowAccountForDeployment(deployment.ID()),
+		owner, owner, sdk.NewInt64Coin(testutil.CoinDenom, 1000))
+	require.NoError(t, err)
+
+	provider := suite.createProvider(group.GroupSpec.Requirements.Attributes).Owner
+	providerAddr := sdk.MustAccAddressFromBech32(provider)
+
+	err = suite.AuditKeeper().CreateOrUpdateProviderAttributes(suite.Context(),
+		atypes.ProviderID{Owner: providerAddr, Auditor: delegate},
+		group.GroupSpec.Requirements.Attributes)
+	require.NoError(t, err)
+
+	msg := &types.MsgCreateBid{
+		Order:    order.ID(),
+		Provider: provider,
+		Price:    sdk.NewDecCoin(testutil.CoinDenom, sdk.NewInt(1)),
+		Deposit:  types.DefaultBidMinDeposit,
+	}
+
+	_, err = suite.handler(suite.Context(), msg)
+	require.ErrorIs(t, err, types.ErrAttributeMismatch)
+
+	err = suite.AuditKeeper().CreateAuditor(suite.Context(), av1beta4.Auditor{
+		Address: auditor.String(),
+		Name:    "auditor",
+		Deposit: sdk.NewInt64Coin(testutil.CoinDenom, 1000),
+	})
+	require.NoError(t, err)
+
+	err = suite.AuditKeeper().CreateAuditorDelegate(suite.Context(), auditor, delegate)
+	require.NoError(t, err)
+
+	res, err := suite.handler(suite.Context(), msg)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+}
+
+func TestCreateBidOtherDenom(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	order, gspec := suite.createFundedOrder()
+
+	provider := suite.createProvider(gspec.Requirements.Attributes).Owner
+
+	// order price does not cap bids in other denominations
+	msg := &types.MsgCreateBid{
+		Order:    order.ID(),
+		Provider: provider,
+		Price:    sdk.NewDecCoin("ibc/stable", order.Price().Amount.TruncateInt().AddRaw(1)),
+		Deposit:  types.DefaultBidMinDeposit,
+	}
+
+	// deployment is not funded in bid denomination
+	res, err := suite.handler(suite.Context(), msg)
+	require.Nil(t, res)
+	require.ErrorIs(t, err, types.ErrBidInvalidPrice)
+
+	providerAddr, err := sdk.AccAddressFromBech32(provider)
+	require.NoError(t, err)
+
+	_, found := suite.MarketKeeper().GetBid(suite.Context(), types.MakeBidID(order.ID(), providerAddr))
+	require.False(t, found)
+
+	did := order.ID().GroupID().DeploymentID()
+	owner, err := sdk.AccAddressFromBech32(did.Owner)
+	require.NoError(t, err)
+
+	err = suite.EscrowKeeper().AccountDeposit(suite.Context(), dtypes.EscrowAccountForDeployment(did), owner, sdk.NewInt64Coin("ibc/stable", 1000))
+	require.NoError(t, err)
+
+	res, err = suite.handler(suite.Context(), msg)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	bid, found := suite.MarketKeeper().GetBid(suite.Context(), types.MakeBidID(order.ID(), providerAddr))
+	require.True(t, found)
+	require.Equal(t, msg.Price, bid.Price)
+}
+
 func TestCreateBidNonExistingOrder(t *testing.T) {
 	suite := setupTestSuite(t)
 
@@ -210,7 +309,7 @@ func TestCreateBidInvalidAttributes(t *testing.T) {
 func TestCreateBidAlreadyExists(t *testing.T) {
 	suite := setupTestSuite(t)
 
-	order, gspec := suite.createOrder(testutil.Resources(t))
+	order, gspec := suite.createFundedOrder()
 
 	msg := &types.MsgCreateBid{
 		Order:    order.ID(),
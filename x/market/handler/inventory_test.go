@@ -0,0 +1,47 @@
+package handler_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/akash-network/node/testutil"
+	"github.com/akash-network/node/x/market"
+	mv1beta5 "github.com/akash-network/node/x/market/types/v1beta5"
+)
+
+func TestProviderInventoryGenesis(t *testing.T) {
+	suite := setupTestSuite(t)
+	ctx := suite.Context().WithBlockHeight(10)
+
+	params := mv1beta5.InventoryParams{InventoryUpdatePeriod: 5}
+	suite.MarketKeeper().SetInventoryParams(ctx, params)
+
+	provider := testutil.AccAddress(t)
+	inv := mv1beta5.ProviderInventory{
+		Provider: provider.String(),
+		CPU:      4000,
+		Memory:   8 << 30,
+		GPU:      []mv1beta5.InventoryGPU{{Vendor: "nvidia", Model: "a100", Count: 2}},
+		Storage:  []mv1beta5.InventoryStorage{{Class: mv1beta5.InventoryStorageEphemeral, Quantity: 10 << 30}},
+	}
+	require.NoError(t, suite.MarketKeeper().UpdateProviderInventory(ctx, inv))
+
+	gs := market.ExportGenesis(ctx, suite.MarketKeeper())
+	require.NoError(t, market.ValidateGenesis(gs))
+	require.Equal(t, params, gs.InventoryParams)
+	require.Len(t, gs.ProviderInventories, 1)
+	require.Equal(t, int64(10), gs.ProviderInventories[0].UpdatedAt)
+
+	imported := setupTestSuite(t)
+	market.InitGenesis(imported.Context(), imported.MarketKeeper(), gs)
+
+	require.Equal(t, params, imported.MarketKeeper().GetInventoryParams(imported.Context()))
+
+	stored, found := imported.MarketKeeper().GetProviderInventory(imported.Context(), provider)
+	require.True(t, found)
+	require.Equal(t, gs.ProviderInventories[0], stored)
+
+	gs.ProviderInventories[0].Provider = "invalid"
+	require.Error(t, market.ValidateGenesis(gs))
+}
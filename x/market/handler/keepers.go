@@ -16,19 +16,26 @@ type EscrowKeeper interface {
 	AccountCreate(ctx sdk.Context, id etypes.AccountID, owner, depositor sdk.AccAddress, deposit sdk.Coin) error
 	AccountDeposit(ctx sdk.Context, id etypes.AccountID, depositor sdk.AccAddress, amount sdk.Coin) error
 	AccountClose(ctx sdk.Context, id etypes.AccountID) error
+	AccountDenoms(ctx sdk.Context, id etypes.AccountID) ([]string, error)
+	AccountSlash(ctx sdk.Context, id etypes.AccountID, fraction sdk.Dec) (sdk.Coins, error)
 	PaymentCreate(ctx sdk.Context, id etypes.AccountID, pid string, owner sdk.AccAddress, rate sdk.DecCoin) error
 	PaymentWithdraw(ctx sdk.Context, id etypes.AccountID, pid string) error
 	PaymentClose(ctx sdk.Context, id etypes.AccountID, pid string) error
+	PaymentUpdateRate(ctx sdk.Context, id etypes.AccountID, pid string, rate sdk.DecCoin) error
 }
 
 // ProviderKeeper Interface includes provider methods
 type ProviderKeeper interface {
 	Get(ctx sdk.Context, id sdk.Address) (ptypes.Provider, bool)
 	WithProviders(ctx sdk.Context, fn func(ptypes.Provider) bool)
+	Delete(ctx sdk.Context, id sdk.Address)
 }
 
 type AuditKeeper interface {
 	GetProviderAttributes(ctx sdk.Context, id sdk.Address) (atypes.Providers, bool)
+	GetProviderAttributesDelegated(ctx sdk.Context, id sdk.Address) (atypes.Providers, bool)
+	DeleteProviderAttributes(ctx sdk.Context, id atypes.ProviderID, keys []string) error
+	WithProvider(ctx sdk.Context, id sdk.Address, fn func(atypes.Provider) bool)
 }
 
 // DeploymentKeeper Interface includes deployment methods
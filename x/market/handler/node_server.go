@@ -0,0 +1,20 @@
+package handler
+
+import (
+	mv1beta5 "github.com/akash-network/node/x/market/types/v1beta5"
+)
+
+var _ mv1beta5.MsgServer = nodeMsgServer{}
+
+// nodeMsgServer serves market messages defined by node on top of akash-api ones
+type nodeMsgServer struct {
+	msgServer
+}
+
+// NewNodeServer returns an implementation of the node market MsgServer interface
+// for the provided Keeper.
+func NewNodeServer(k Keepers) mv1beta5.MsgServer {
+	return &nodeMsgServer{
+		msgServer: msgServer{keepers: k},
+	}
+}
@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	dtypes "github.com/akash-network/akash-api/go/node/deployment/v1beta3"
+	types "github.com/akash-network/akash-api/go/node/market/v1beta4"
+
+	mv1beta5 "github.com/akash-network/node/x/market/types/v1beta5"
+)
+
+// ProposeLeasePrice records new price for an active lease proposed by its tenant or provider.
+// Pending proposal of the lease, if any, is replaced.
+func (ms nodeMsgServer) ProposeLeasePrice(goCtx context.Context, msg *mv1beta5.MsgProposeLeasePrice) (*mv1beta5.MsgProposeLeasePriceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	lease, err := activeLeaseParty(ctx, ms.keepers, msg.LeaseID, msg.Proposer)
+	if err != nil {
+		return nil, err
+	}
+
+	if !msg.Price.IsValid() || !msg.Price.IsPositive() {
+		return nil, types.ErrBidInvalidPrice
+	}
+
+	if msg.Price.Denom != lease.Price.Denom {
+		return nil, sdkerrors.Wrapf(types.ErrBidInvalidPrice, "price denomination must be %s", lease.Price.Denom)
+	}
+
+	ms.keepers.Market.SetLeasePriceProposal(ctx, mv1beta5.LeasePriceProposal{
+		LeaseID:    msg.LeaseID,
+		Proposer:   msg.Proposer,
+		Price:      msg.Price,
+		ProposedAt: ctx.BlockHeight(),
+	})
+
+	return &mv1beta5.MsgProposeLeasePriceResponse{}, nil
+}
+
+// AcceptLeasePrice applies pending price proposal of a lease once accepted by the other party.
+// Price of the message must match the proposal, so that a proposal replaced after the acceptor signed
+// is never applied. Lease payment is settled at the previous rate before the new one takes effect.
+func (ms nodeMsgServer) AcceptLeasePrice(goCtx context.Context, msg *mv1beta5.MsgAcceptLeasePrice) (*mv1beta5.MsgAcceptLeasePriceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	lease, err := activeLeaseParty(ctx, ms.keepers, msg.LeaseID, msg.Acceptor)
+	if err != nil {
+		return nil, err
+	}
+
+	proposal, found := ms.keepers.Market.GetLeasePriceProposal(ctx, msg.LeaseID)
+	if !found {
+		return nil, mv1beta5.ErrLeasePriceProposalNotFound
+	}
+
+	if proposal.Proposer == msg.Acceptor {
+		return nil, sdkerrors.ErrUnauthorized.Wrap("proposal must be accepted by the other party")
+	}
+
+	if !proposal.Price.IsEqual(msg.Price) {
+		return nil, sdkerrors.Wrapf(mv1beta5.ErrLeasePriceMismatch, "proposed %s, accepted %s", proposal.Price, msg.Price)
+	}
+
+	if err := ms.keepers.Escrow.PaymentUpdateRate(ctx,
+		dtypes.EscrowAccountForDeployment(msg.LeaseID.DeploymentID()),
+		types.EscrowPaymentForLease(msg.LeaseID),
+		proposal.Price); err != nil {
+		return nil, err
+	}
+
+	ms.keepers.Market.OnLeasePriceUpdated(ctx, lease, proposal.Price)
+
+	return &mv1beta5.MsgAcceptLeasePriceResponse{}, nil
+}
+
+// activeLeaseParty returns active lease with given id if addr is its tenant or provider
+func activeLeaseParty(ctx sdk.Context, keepers Keepers, id types.LeaseID, addr string) (types.Lease, error) {
+	lease, found := keepers.Market.GetLease(ctx, id)
+	if !found {
+		return types.Lease{}, types.ErrUnknownLease
+	}
+
+	if lease.State != types.LeaseActive {
+		return types.Lease{}, types.ErrLeaseNotActive
+	}
+
+	if addr != id.Owner && addr != id.Provider {
+		return types.Lease{}, sdkerrors.ErrUnauthorized.Wrap("not a lease party")
+	}
+
+	return lease, nil
+}
@@ -0,0 +1,91 @@
+package handler_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	dtypes "github.com/akash-network/akash-api/go/node/deployment/v1beta3"
+	types "github.com/akash-network/akash-api/go/node/market/v1beta4"
+	"github.com/akash-network/akash-api/go/sdkutil"
+
+	"github.com/akash-network/node/testutil"
+	"github.com/akash-network/node/x/market"
+	mv1beta5 "github.com/akash-network/node/x/market/types/v1beta5"
+)
+
+func TestLeasePriceRenegotiation(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	order, gspec := suite.createFundedOrder()
+	bid := suite.createProviderBid(order, gspec.Requirements.Attributes, 10)
+
+	_, err := suite.handler(suite.Context(), &types.MsgCreateLease{BidID: bid})
+	require.NoError(t, err)
+
+	lid := types.MakeLeaseID(bid)
+	tenant := sdk.MustAccAddressFromBech32(lid.Owner)
+	provider := sdk.MustAccAddressFromBech32(lid.Provider)
+	price := sdk.NewDecCoin(testutil.CoinDenom, sdk.NewInt(8))
+
+	// only lease parties may propose
+	_, err = suite.handler(suite.Context(), mv1beta5.NewMsgProposeLeasePrice(lid, testutil.AccAddress(t), price))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = suite.handler(suite.Context(), mv1beta5.NewMsgProposeLeasePrice(lid, provider, sdk.NewDecCoin("ibc/stable", sdk.NewInt(8))))
+	require.ErrorIs(t, err, types.ErrBidInvalidPrice)
+
+	_, err = suite.handler(suite.Context(), mv1beta5.NewMsgAcceptLeasePrice(lid, tenant, price))
+	require.ErrorIs(t, err, mv1beta5.ErrLeasePriceProposalNotFound)
+
+	_, err = suite.handler(suite.Context(), mv1beta5.NewMsgProposeLeasePrice(lid, provider, price))
+	require.NoError(t, err)
+
+	// proposer cannot accept own proposal
+	_, err = suite.handler(suite.Context(), mv1beta5.NewMsgAcceptLeasePrice(lid, provider, price))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// acceptor agrees to the proposal it has seen only
+	_, err = suite.handler(suite.Context(), mv1beta5.NewMsgAcceptLeasePrice(lid, tenant, sdk.NewDecCoin(testutil.CoinDenom, sdk.NewInt(9))))
+	require.ErrorIs(t, err, mv1beta5.ErrLeasePriceMismatch)
+
+	gs := market.ExportGenesis(suite.Context(), suite.MarketKeeper())
+	require.Len(t, gs.LeasePriceProposals, 1)
+	require.Equal(t, price, gs.LeasePriceProposals[0].Price)
+
+	suite.SetBlockHeight(suite.Context().BlockHeight() + 5)
+
+	res, err := suite.handler(suite.Context(), mv1beta5.NewMsgAcceptLeasePrice(lid, tenant, price))
+	require.NoError(t, err)
+
+	var updated []mv1beta5.EventLeasePriceUpdated
+	for _, ev := range res.Events {
+		sev, err := sdkutil.ParseEvent(sdk.StringifyEvent(ev))
+		if err != nil {
+			continue
+		}
+
+		if mev, err := mv1beta5.ParseEvent(sev); err == nil {
+			updated = append(updated, mev.(mv1beta5.EventLeasePriceUpdated))
+		}
+	}
+	require.Equal(t, []mv1beta5.EventLeasePriceUpdated{mv1beta5.NewEventLeasePriceUpdated(lid, price)}, updated)
+
+	lease, found := suite.MarketKeeper().GetLease(suite.Context(), lid)
+	require.True(t, found)
+	require.Equal(t, types.LeaseActive, lease.State)
+	require.Equal(t, price, lease.Price)
+
+	payment, err := suite.EscrowKeeper().GetPayment(suite.Context(),
+		dtypes.EscrowAccountForDeployment(lid.DeploymentID()),
+		types.EscrowPaymentForLease(lid))
+	require.NoError(t, err)
+	require.Equal(t, price, payment.Rate)
+	require.Equal(t, sdk.NewDecCoin(testutil.CoinDenom, sdk.NewInt(50)), payment.Balance)
+
+	_, found = suite.MarketKeeper().GetLeasePriceProposal(suite.Context(), lid)
+	require.False(t, found)
+}
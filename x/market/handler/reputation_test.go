@@ -0,0 +1,112 @@
+package handler_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	etypes "github.com/akash-network/akash-api/go/node/escrow/v1beta3"
+	types "github.com/akash-network/akash-api/go/node/market/v1beta4"
+
+	"github.com/akash-network/node/testutil"
+	"github.com/akash-network/node/x/market"
+	mv1beta5 "github.com/akash-network/node/x/market/types/v1beta5"
+)
+
+func TestProviderReputationBidWithdrawn(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	order, gspec := suite.createFundedOrder()
+	bid := suite.createProviderBid(order, gspec.Requirements.Attributes, 10)
+
+	_, err := suite.handler(suite.Context(), &types.MsgCloseBid{BidID: bid})
+	require.NoError(t, err)
+
+	rep := suite.MarketKeeper().GetProviderReputation(suite.Context(), sdk.MustAccAddressFromBech32(bid.Provider))
+	require.Equal(t, mv1beta5.ProviderReputation{Provider: bid.Provider, BidsWithdrawn: 1}, rep)
+}
+
+func TestProviderReputationEarlyClose(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	suite.MarketKeeper().SetReputationParams(suite.Context(), mv1beta5.ReputationParams{
+		MinLeaseDuration:    100,
+		EarlyCloseSlashRate: sdk.NewDecWithPrec(5, 1),
+	})
+
+	order, gspec := suite.createFundedOrder()
+	bid := suite.createProviderBid(order, gspec.Requirements.Attributes, 10)
+
+	_, err := suite.handler(suite.Context(), &types.MsgCreateLease{BidID: bid})
+	require.NoError(t, err)
+
+	provider := sdk.MustAccAddressFromBech32(bid.Provider)
+
+	rep := suite.MarketKeeper().GetProviderReputation(suite.Context(), provider)
+	require.Equal(t, mv1beta5.ProviderReputation{Provider: bid.Provider, LeasesWon: 1}, rep)
+
+	suite.SetBlockHeight(suite.Context().BlockHeight() + 10)
+
+	_, err = suite.handler(suite.Context(), &types.MsgCloseBid{BidID: bid})
+	require.NoError(t, err)
+
+	rep = suite.MarketKeeper().GetProviderReputation(suite.Context(), provider)
+	require.Equal(t, mv1beta5.ProviderReputation{Provider: bid.Provider, LeasesWon: 1, LeasesClosedEarly: 1}, rep)
+
+	slashed := sdk.NewCoins(sdk.NewInt64Coin(testutil.CoinDenom, types.DefaultBidMinDeposit.Amount.Int64()/2))
+	suite.BankKeeper().AssertCalled(t, "SendCoinsFromModuleToModule", suite.Context(), etypes.ModuleName, distrtypes.ModuleName, slashed)
+}
+
+func TestProviderReputationLateClose(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	suite.MarketKeeper().SetReputationParams(suite.Context(), mv1beta5.ReputationParams{
+		MinLeaseDuration:    5,
+		EarlyCloseSlashRate: sdk.NewDecWithPrec(5, 1),
+	})
+
+	order, gspec := suite.createFundedOrder()
+	bid := suite.createProviderBid(order, gspec.Requirements.Attributes, 10)
+
+	_, err := suite.handler(suite.Context(), &types.MsgCreateLease{BidID: bid})
+	require.NoError(t, err)
+
+	suite.SetBlockHeight(suite.Context().BlockHeight() + 5)
+
+	_, err = suite.handler(suite.Context(), &types.MsgCloseBid{BidID: bid})
+	require.NoError(t, err)
+
+	rep := suite.MarketKeeper().GetProviderReputation(suite.Context(), sdk.MustAccAddressFromBech32(bid.Provider))
+	require.Equal(t, mv1beta5.ProviderReputation{Provider: bid.Provider, LeasesWon: 1}, rep)
+}
+
+func TestProviderReputationGenesis(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	params := mv1beta5.ReputationParams{
+		MinLeaseDuration:    100,
+		EarlyCloseSlashRate: sdk.NewDecWithPrec(5, 1),
+	}
+	suite.MarketKeeper().SetReputationParams(suite.Context(), params)
+
+	order, gspec := suite.createFundedOrder()
+	bid := suite.createProviderBid(order, gspec.Requirements.Attributes, 10)
+
+	_, err := suite.handler(suite.Context(), &types.MsgCloseBid{BidID: bid})
+	require.NoError(t, err)
+
+	gs := market.ExportGenesis(suite.Context(), suite.MarketKeeper())
+	require.NoError(t, market.ValidateGenesis(gs))
+	require.Equal(t, params, gs.ReputationParams)
+
+	imported := setupTestSuite(t)
+	market.InitGenesis(imported.Context(), imported.MarketKeeper(), gs)
+
+	require.Equal(t, params, imported.MarketKeeper().GetReputationParams(imported.Context()))
+
+	rep := imported.MarketKeeper().GetProviderReputation(imported.Context(), sdk.MustAccAddressFromBech32(bid.Provider))
+	require.Equal(t, mv1beta5.ProviderReputation{Provider: bid.Provider, BidsWithdrawn: 1}, rep)
+}
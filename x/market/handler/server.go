@@ -3,6 +3,7 @@ package handler
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,6 +12,8 @@ import (
 	dtypes "github.com/akash-network/akash-api/go/node/deployment/v1beta3"
 	types "github.com/akash-network/akash-api/go/node/market/v1beta4"
 	ptypes "github.com/akash-network/akash-api/go/node/provider/v1beta3"
+
+	"github.com/akash-network/node/x/market/keeper"
 )
 
 type msgServer struct {
@@ -55,7 +58,18 @@ func (ms msgServer) CreateBid(goCtx context.Context, msg *types.MsgCreateBid) (*
 		return nil, types.ErrBidInvalidPrice
 	}
 
-	if order.Price().IsLT(msg.Price) {
+	// bid is paid from deployment escrow account, which must hold its denomination
+	denoms, err := ms.keepers.Escrow.AccountDenoms(ctx, dtypes.EscrowAccountForDeployment(msg.Order.GroupID().DeploymentID()))
+	if err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(denoms, msg.Price.Denom) {
+		return nil, fmt.Errorf("%w: deployment is not funded in %s", types.ErrBidInvalidPrice, msg.Price.Denom)
+	}
+
+	// order price caps bids in its own denomination only
+	if order.Price().Denom == msg.Price.Denom && order.Price().IsLT(msg.Price) {
 		return nil, types.ErrBidOverOrder
 	}
 
@@ -73,7 +87,12 @@ func (ms msgServer) CreateBid(goCtx context.Context, msg *types.MsgCreateBid) (*
 		return nil, types.ErrUnknownProvider
 	}
 
-	provAttr, _ := ms.keepers.Audit.GetProviderAttributes(ctx, provider)
+	if _, draining := ms.keepers.Market.GetProviderDrain(ctx, provider); draining {
+		return nil, keeper.ErrProviderDraining
+	}
+
+	// attributes signed by auditor delegates satisfy requirements signed by the auditor
+	provAttr, _ := ms.keepers.Audit.GetProviderAttributesDelegated(ctx, provider)
 
 	provAttr = append([]atypes.Provider{{
 		Owner:      msg.Provider,
@@ -120,6 +139,7 @@ func (ms msgServer) CloseBid(goCtx context.Context, msg *types.MsgCloseBid) (*ty
 	}
 
 	if bid.State == types.BidOpen {
+		ms.keepers.Market.OnBidWithdrawn(ctx, bid)
 		ms.keepers.Market.OnBidClosed(ctx, bid)
 		return &types.MsgCloseBidResponse{}, nil
 	}
@@ -141,6 +161,15 @@ func (ms msgServer) CloseBid(goCtx context.Context, msg *types.MsgCloseBid) (*ty
 		return nil, err
 	}
 
+	// part of the deposit is slashed before bid escrow account is closed
+	if early := ms.keepers.Market.OnLeaseClosedByProvider(ctx, lease); early {
+		if rate := ms.keepers.Market.GetReputationParams(ctx).EarlyCloseSlashRate; rate.IsPositive() {
+			if _, err := ms.keepers.Escrow.AccountSlash(ctx, types.EscrowAccountForBid(bid.ID()), rate); err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	ms.keepers.Market.OnLeaseClosed(ctx, lease, types.LeaseClosed)
 	ms.keepers.Market.OnBidClosed(ctx, bid)
 	ms.keepers.Market.OnOrderClosed(ctx, order)
@@ -175,54 +204,64 @@ func (ms msgServer) WithdrawLease(goCtx context.Context, msg *types.MsgWithdrawL
 func (ms msgServer) CreateLease(goCtx context.Context, msg *types.MsgCreateLease) (*types.MsgCreateLeaseResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	bid, found := ms.keepers.Market.GetBid(ctx, msg.BidID)
+	if err := createLease(ctx, ms.keepers, msg.BidID); err != nil {
+		return &types.MsgCreateLeaseResponse{}, err
+	}
+
+	return &types.MsgCreateLeaseResponse{}, nil
+}
+
+// createLease matches order with the bid of given id: creates lease and its payment
+// and closes losing bids of the order
+func createLease(ctx sdk.Context, keepers Keepers, id types.BidID) error {
+	bid, found := keepers.Market.GetBid(ctx, id)
 	if !found {
-		return &types.MsgCreateLeaseResponse{}, types.ErrBidNotFound
+		return types.ErrBidNotFound
 	}
 
 	if bid.State != types.BidOpen {
-		return &types.MsgCreateLeaseResponse{}, types.ErrBidNotOpen
+		return types.ErrBidNotOpen
 	}
 
-	order, found := ms.keepers.Market.GetOrder(ctx, msg.BidID.OrderID())
+	order, found := keepers.Market.GetOrder(ctx, id.OrderID())
 	if !found {
-		return &types.MsgCreateLeaseResponse{}, types.ErrOrderNotFound
+		return types.ErrOrderNotFound
 	}
 
 	if order.State != types.OrderOpen {
-		return &types.MsgCreateLeaseResponse{}, types.ErrOrderNotOpen
+		return types.ErrOrderNotOpen
 	}
 
-	group, found := ms.keepers.Deployment.GetGroup(ctx, order.ID().GroupID())
+	group, found := keepers.Deployment.GetGroup(ctx, order.ID().GroupID())
 	if !found {
-		return &types.MsgCreateLeaseResponse{}, types.ErrGroupNotFound
+		return types.ErrGroupNotFound
 	}
 
 	if group.State != dtypes.GroupOpen {
-		return &types.MsgCreateLeaseResponse{}, types.ErrGroupNotOpen
+		return types.ErrGroupNotOpen
 	}
 
-	owner, err := sdk.AccAddressFromBech32(msg.BidID.Provider)
+	owner, err := sdk.AccAddressFromBech32(id.Provider)
 	if err != nil {
-		return &types.MsgCreateLeaseResponse{}, err
+		return err
 	}
 
-	if err := ms.keepers.Escrow.PaymentCreate(ctx,
-		dtypes.EscrowAccountForDeployment(msg.BidID.DeploymentID()),
-		types.EscrowPaymentForLease(msg.BidID.LeaseID()),
+	if err := keepers.Escrow.PaymentCreate(ctx,
+		dtypes.EscrowAccountForDeployment(id.DeploymentID()),
+		types.EscrowPaymentForLease(id.LeaseID()),
 		owner,
 		bid.Price); err != nil {
-		return &types.MsgCreateLeaseResponse{}, err
+		return err
 	}
 
-	ms.keepers.Market.CreateLease(ctx, bid)
-	ms.keepers.Market.OnOrderMatched(ctx, order)
-	ms.keepers.Market.OnBidMatched(ctx, bid)
+	keepers.Market.CreateLease(ctx, bid)
+	keepers.Market.OnOrderMatched(ctx, order)
+	keepers.Market.OnBidMatched(ctx, bid)
 
 	// close losing bids
 	var lostbids []types.Bid
-	ms.keepers.Market.WithBidsForOrder(ctx, msg.BidID.OrderID(), func(bid types.Bid) bool {
-		if bid.ID().Equals(msg.BidID) {
+	keepers.Market.WithBidsForOrder(ctx, id.OrderID(), func(bid types.Bid) bool {
+		if bid.ID().Equals(id) {
 			return false
 		}
 		if bid.State != types.BidOpen {
@@ -234,14 +273,14 @@ func (ms msgServer) CreateLease(goCtx context.Context, msg *types.MsgCreateLease
 	})
 
 	for _, bid := range lostbids {
-		ms.keepers.Market.OnBidLost(ctx, bid)
-		if err := ms.keepers.Escrow.AccountClose(ctx,
+		keepers.Market.OnBidLost(ctx, bid)
+		if err := keepers.Escrow.AccountClose(ctx,
 			types.EscrowAccountForBid(bid.ID())); err != nil {
-			return &types.MsgCreateLeaseResponse{}, err
+			return err
 		}
 	}
 
-	return &types.MsgCreateLeaseResponse{}, nil
+	return nil
 }
 
 func (ms msgServer) CloseLease(goCtx context.Context, msg *types.MsgCloseLease) (*types.MsgCloseLeaseResponse, error) {
@@ -19,6 +19,7 @@ type MarketKeeper interface {
 	GetBid(ctx sdk.Context, id mtypes.BidID) (mtypes.Bid, bool)
 	GetLease(ctx sdk.Context, id mtypes.LeaseID) (mtypes.Lease, bool)
 	OnGroupClosed(ctx sdk.Context, id dtypes.GroupID)
+	DeleteGroupAuction(ctx sdk.Context, id dtypes.GroupID)
 	OnOrderClosed(ctx sdk.Context, order mtypes.Order)
 	OnBidClosed(ctx sdk.Context, bid mtypes.Bid)
 	OnLeaseClosed(ctx sdk.Context, lease mtypes.Lease, state mtypes.Lease_State)
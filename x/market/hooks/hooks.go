@@ -51,6 +51,7 @@ func (h *hooks) OnEscrowAccountClosed(ctx sdk.Context, obj etypes.Account) {
 		if group.ValidateClosable() == nil {
 			_ = h.dkeeper.OnCloseGroup(ctx, group, gstate)
 			h.mkeeper.OnGroupClosed(ctx, group.ID())
+			h.mkeeper.DeleteGroupAuction(ctx, group.ID())
 		}
 	}
 }
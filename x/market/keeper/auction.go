@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dtypes "github.com/akash-network/akash-api/go/node/deployment/v1beta3"
+	types "github.com/akash-network/akash-api/go/node/market/v1beta4"
+
+	keys "github.com/akash-network/node/x/market/keeper/keys/v1beta4"
+	mv1beta5 "github.com/akash-network/node/x/market/types/v1beta5"
+)
+
+var (
+	ErrInvalidAuctionWindow = errors.New("invalid auction window")
+	ErrGroupAuctionExists   = errors.New("group auction already set")
+)
+
+// SetOrderAuction puts open order with given id into reverse auction mode.
+// Bidding window closes window blocks after the order was created.
+func (k Keeper) SetOrderAuction(ctx sdk.Context, id types.OrderID, window int64) error {
+	if window <= 0 {
+		return fmt.Errorf("%w: window must be positive", ErrInvalidAuctionWindow)
+	}
+
+	order, found := k.GetOrder(ctx, id)
+	if !found {
+		return types.ErrOrderNotFound
+	}
+
+	if order.State != types.OrderOpen {
+		return types.ErrOrderNotOpen
+	}
+
+	closesAt := order.CreatedAt + window
+	if closesAt < ctx.BlockHeight() {
+		closesAt = ctx.BlockHeight()
+	}
+
+	k.saveOrderAuction(ctx, mv1beta5.OrderAuction{OrderID: id, ClosesAt: closesAt})
+
+	return nil
+}
+
+// SetGroupAuction puts orders of group with given id into reverse auction mode,
+// starting with the order currently open for bids.
+func (k Keeper) SetGroupAuction(ctx sdk.Context, id dtypes.GroupID, window int64) error {
+	if window <= 0 {
+		return fmt.Errorf("%w: window must be positive", ErrInvalidAuctionWindow)
+	}
+
+	if _, found := k.GetGroupAuction(ctx, id); found {
+		return ErrGroupAuctionExists
+	}
+
+	k.saveGroupAuction(ctx, mv1beta5.GroupAuction{ID: id, Window: window})
+
+	var err error
+	k.WithOrdersForGroup(ctx, id, func(order types.Order) bool {
+		if order.State != types.OrderOpen {
+			return false
+		}
+
+		err = k.SetOrderAuction(ctx, order.ID(), window)
+		return true
+	})
+
+	return err
+}
+
+// GetGroupAuction returns auction window of orders of group with given id
+func (k Keeper) GetGroupAuction(ctx sdk.Context, id dtypes.GroupID) (int64, bool) {
+	buf := ctx.KVStore(k.skey).Get(keys.GroupAuctionKey(id))
+	if buf == nil {
+		return 0, false
+	}
+
+	var auction mv1beta5.GroupAuction
+	k.cdc.MustUnmarshal(buf, &auction)
+
+	return auction.Window, true
+}
+
+// DeleteGroupAuction takes orders of group with given id out of reverse auction mode.
+// Auctions of orders already created are left to run.
+func (k Keeper) DeleteGroupAuction(ctx sdk.Context, id dtypes.GroupID) {
+	ctx.KVStore(k.skey).Delete(keys.GroupAuctionKey(id))
+}
+
+// WithGroupAuctions iterates groups which orders run in reverse auction mode
+func (k Keeper) WithGroupAuctions(ctx sdk.Context, fn func(mv1beta5.GroupAuction) bool) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.skey), keys.GroupAuctionPrefix())
+	defer func() {
+		_ = iter.Close()
+	}()
+
+	for ; iter.Valid(); iter.Next() {
+		var auction mv1beta5.GroupAuction
+		k.cdc.MustUnmarshal(iter.Value(), &auction)
+
+		if stop := fn(auction); stop {
+			break
+		}
+	}
+}
+
+// ImportAuctions stores order and group auctions as they are, without checking state of orders and groups.
+// Should only be called by InitGenesis.
+func (k Keeper) ImportAuctions(ctx sdk.Context, orders []mv1beta5.OrderAuction, groups []mv1beta5.GroupAuction) {
+	for _, auction := range orders {
+		k.saveOrderAuction(ctx, auction)
+	}
+
+	for _, auction := range groups {
+		k.saveGroupAuction(ctx, auction)
+	}
+}
+
+func (k Keeper) saveOrderAuction(ctx sdk.Context, auction mv1beta5.OrderAuction) {
+	ctx.KVStore(k.skey).Set(keys.OrderAuctionKey(auction.ClosesAt, auction.OrderID), k.cdc.MustMarshal(&auction.OrderID))
+}
+
+func (k Keeper) saveGroupAuction(ctx sdk.Context, auction mv1beta5.GroupAuction) {
+	ctx.KVStore(k.skey).Set(keys.GroupAuctionKey(auction.ID), k.cdc.MustMarshal(&auction))
+}
+
+// DeleteOrderAuction removes auction entry of given order
+func (k Keeper) DeleteOrderAuction(ctx sdk.Context, auction mv1beta5.OrderAuction) {
+	ctx.KVStore(k.skey).Delete(keys.OrderAuctionKey(auction.ClosesAt, auction.OrderID))
+}
+
+// WithOrderAuctionsClosing iterates auctions which bidding window closes at or before given height,
+// earliest first
+func (k Keeper) WithOrderAuctionsClosing(ctx sdk.Context, height int64, fn func(mv1beta5.OrderAuction) bool) {
+	if height < 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.skey)
+	start := keys.OrderAuctionPrefix()
+
+	iter := store.Iterator(start, keys.HeightIndexEnd(start, height))
+	defer func() {
+		_ = iter.Close()
+	}()
+
+	for ; iter.Valid(); iter.Next() {
+		var auction mv1beta5.OrderAuction
+
+		k.cdc.MustUnmarshal(iter.Value(), &auction.OrderID)
+		auction.ClosesAt = int64(sdk.BigEndianToUint64(iter.Key()[len(start) : len(start)+8]))
+
+		if stop := fn(auction); stop {
+			break
+		}
+	}
+}
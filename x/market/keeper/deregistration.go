@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	types "github.com/akash-network/akash-api/go/node/market/v1beta4"
+
+	keys "github.com/akash-network/node/x/market/keeper/keys/v1beta4"
+	mv1beta5 "github.com/akash-network/node/x/market/types/v1beta5"
+)
+
+const (
+	// DefaultProviderDrainPeriod is number of blocks, about a day, active leases of a deregistering
+	// provider keep running when DeregistrationParams were never set
+	DefaultProviderDrainPeriod int64 = 14400
+)
+
+var (
+	ErrProviderDraining = errors.New("provider is being deregistered")
+)
+
+// GetDeregistrationParams returns provider deregistration params
+func (k Keeper) GetDeregistrationParams(ctx sdk.Context) mv1beta5.DeregistrationParams {
+	params := mv1beta5.DeregistrationParams{
+		ProviderDrainPeriod: DefaultProviderDrainPeriod,
+	}
+
+	for _, pair := range params.ParamSetPairs() {
+		k.pspace.GetIfExists(ctx, pair.Key, pair.Value)
+	}
+
+	return params
+}
+
+// SetDeregistrationParams sets provider deregistration params
+func (k Keeper) SetDeregistrationParams(ctx sdk.Context, params mv1beta5.DeregistrationParams) {
+	k.pspace.SetParamSet(ctx, &params)
+}
+
+// GetProviderDrain returns deregistration state of given provider
+func (k Keeper) GetProviderDrain(ctx sdk.Context, provider sdk.AccAddress) (mv1beta5.ProviderDrain, bool) {
+	buf := ctx.KVStore(k.skey).Get(keys.ProviderDrainKey(provider))
+	if buf == nil {
+		return mv1beta5.ProviderDrain{}, false
+	}
+
+	var drain mv1beta5.ProviderDrain
+	k.cdc.MustUnmarshal(buf, &drain)
+
+	return drain, true
+}
+
+// OnProviderDraining starts deregistration of given provider: its open bids are closed,
+// returning deposits, and its active leases are closed once DeregistrationParams.ProviderDrainPeriod passes.
+func (k Keeper) OnProviderDraining(ctx sdk.Context, provider sdk.AccAddress) (mv1beta5.ProviderDrain, error) {
+	if _, found := k.GetProviderDrain(ctx, provider); found {
+		return mv1beta5.ProviderDrain{}, ErrProviderDraining
+	}
+
+	drain := mv1beta5.ProviderDrain{
+		Provider:  provider.String(),
+		StartedAt: ctx.BlockHeight(),
+		EndsAt:    ctx.BlockHeight() + k.GetDeregistrationParams(ctx).ProviderDrainPeriod,
+	}
+
+	k.SetProviderDrain(ctx, drain)
+
+	var bids []types.Bid
+
+	k.WithBids(ctx, func(bid types.Bid) bool {
+		if bid.State == types.BidOpen && bid.ID().Provider == drain.Provider {
+			bids = append(bids, bid)
+		}
+		return false
+	})
+
+	for _, bid := range bids {
+		k.OnBidClosed(ctx, bid)
+	}
+
+	ctx.EventManager().EmitEvent(
+		mv1beta5.NewEventProviderDraining(provider, drain.EndsAt).
+			ToSDKEvent(),
+	)
+
+	return drain, nil
+}
+
+// SetProviderDrain stores deregistration state of a provider and indexes it by the end of its grace period
+func (k Keeper) SetProviderDrain(ctx sdk.Context, drain mv1beta5.ProviderDrain) {
+	provider, err := sdk.AccAddressFromBech32(drain.Provider)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.skey)
+	store.Set(keys.ProviderDrainKey(provider), k.cdc.MustMarshal(&drain))
+	store.Set(keys.ProviderDrainEndKey(drain.EndsAt, provider), provider)
+}
+
+// WithProviderDrainsEnded iterates providers which grace period ends at or before given height, earliest first
+func (k Keeper) WithProviderDrainsEnded(ctx sdk.Context, height int64, fn func(mv1beta5.ProviderDrain) bool) {
+	k.withHeightIndex(ctx, keys.ProviderDrainEndPrefix(), height, func(value []byte) bool {
+		drain, found := k.GetProviderDrain(ctx, sdk.AccAddress(value))
+		if !found {
+			return false
+		}
+
+		return fn(drain)
+	})
+}
+
+// DeleteProviderDrain removes deregistration state of a provider
+func (k Keeper) DeleteProviderDrain(ctx sdk.Context, drain mv1beta5.ProviderDrain) {
+	provider, err := sdk.AccAddressFromBech32(drain.Provider)
+	if err != nil {
+		return
+	}
+
+	store := ctx.KVStore(k.skey)
+	store.Delete(keys.ProviderDrainKey(provider))
+	store.Delete(keys.ProviderDrainEndKey(drain.EndsAt, provider))
+}
+
+// OnLeaseClosedProviderDeregistered closes active lease of a provider being deregistered.
+// Lease ends in state closed, which is told apart from other closes by the emitted event.
+// Escrow payment of the lease must be closed by the caller.
+func (k Keeper) OnLeaseClosedProviderDeregistered(ctx sdk.Context, lease types.Lease) {
+	if lease.State != types.LeaseActive {
+		return
+	}
+
+	k.OnLeaseClosed(ctx, lease, types.LeaseClosed)
+
+	ctx.EventManager().EmitEvent(
+		mv1beta5.NewEventLeaseClosedProviderDeregistered(lease.ID()).
+			ToSDKEvent(),
+	)
+}
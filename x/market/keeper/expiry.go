@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	types "github.com/akash-network/akash-api/go/node/market/v1beta4"
+
+	keys "github.com/akash-network/node/x/market/keeper/keys/v1beta4"
+	mv1beta5 "github.com/akash-network/node/x/market/types/v1beta5"
+)
+
+// ParamKeyTable returns key table of market params including expiry, reputation, deregistration and inventory params
+func ParamKeyTable() paramtypes.KeyTable {
+	return types.ParamKeyTable().
+		RegisterParamSet(&mv1beta5.ExpiryParams{}).
+		RegisterParamSet(&mv1beta5.ReputationParams{}).
+		RegisterParamSet(&mv1beta5.DeregistrationParams{}).
+		RegisterParamSet(&mv1beta5.InventoryParams{})
+}
+
+// GetExpiryParams returns order and bid expiry params. Params never set default to zero.
+func (k Keeper) GetExpiryParams(ctx sdk.Context) mv1beta5.ExpiryParams {
+	var params mv1beta5.ExpiryParams
+
+	for _, pair := range params.ParamSetPairs() {
+		k.pspace.GetIfExists(ctx, pair.Key, pair.Value)
+	}
+
+	return params
+}
+
+// SetExpiryParams sets order and bid expiry params
+func (k Keeper) SetExpiryParams(ctx sdk.Context, params mv1beta5.ExpiryParams) {
+	k.pspace.SetParamSet(ctx, &params)
+}
+
+// WithOrdersCreatedBefore iterates open orders created at or before given height, oldest first
+func (k Keeper) WithOrdersCreatedBefore(ctx sdk.Context, height int64, fn func(types.Order) bool) {
+	k.withHeightIndex(ctx, keys.OrderExpiryPrefix(), height, func(value []byte) bool {
+		var id types.OrderID
+		k.cdc.MustUnmarshal(value, &id)
+
+		order, found := k.GetOrder(ctx, id)
+		if !found {
+			return false
+		}
+
+		return fn(order)
+	})
+}
+
+// WithBidsCreatedBefore iterates open bids created at or before given height, oldest first
+func (k Keeper) WithBidsCreatedBefore(ctx sdk.Context, height int64, fn func(types.Bid) bool) {
+	k.withHeightIndex(ctx, keys.BidExpiryPrefix(), height, func(value []byte) bool {
+		var id types.BidID
+		k.cdc.MustUnmarshal(value, &id)
+
+		bid, found := k.GetBid(ctx, id)
+		if !found {
+			return false
+		}
+
+		return fn(bid)
+	})
+}
+
+// ReindexExpiry rebuilds indexes of open orders and bids by creation height
+func (k Keeper) ReindexExpiry(ctx sdk.Context) {
+	store := ctx.KVStore(k.skey)
+
+	for _, prefix := range [][]byte{keys.OrderExpiryPrefix(), keys.BidExpiryPrefix()} {
+		iter := sdk.KVStorePrefixIterator(store, prefix)
+
+		var stale [][]byte
+		for ; iter.Valid(); iter.Next() {
+			stale = append(stale, iter.Key())
+		}
+		_ = iter.Close()
+
+		for _, key := range stale {
+			store.Delete(key)
+		}
+	}
+
+	k.WithOrders(ctx, func(order types.Order) bool {
+		k.updateOrderExpiryIndex(ctx, order)
+		return false
+	})
+
+	k.WithBids(ctx, func(bid types.Bid) bool {
+		k.updateBidExpiryIndex(ctx, bid)
+		return false
+	})
+}
+
+func (k Keeper) withHeightIndex(ctx sdk.Context, prefix []byte, height int64, fn func([]byte) bool) {
+	if height < 0 {
+		return
+	}
+
+	iter := ctx.KVStore(k.skey).Iterator(prefix, keys.HeightIndexEnd(prefix, height))
+	defer func() {
+		_ = iter.Close()
+	}()
+
+	for ; iter.Valid(); iter.Next() {
+		if stop := fn(iter.Value()); stop {
+			break
+		}
+	}
+}
+
+func (k Keeper) updateOrderExpiryIndex(ctx sdk.Context, order types.Order) {
+	store := ctx.KVStore(k.skey)
+	key := keys.OrderExpiryKey(order.CreatedAt, order.ID())
+
+	if order.State != types.OrderOpen {
+		store.Delete(key)
+		return
+	}
+
+	id := order.ID()
+	store.Set(key, k.cdc.MustMarshal(&id))
+}
+
+func (k Keeper) updateBidExpiryIndex(ctx sdk.Context, bid types.Bid) {
+	store := ctx.KVStore(k.skey)
+	key := keys.BidExpiryKey(bid.CreatedAt, bid.ID())
+
+	if bid.State != types.BidOpen {
+		store.Delete(key)
+		return
+	}
+
+	id := bid.ID()
+	store.Set(key, k.cdc.MustMarshal(&id))
+}
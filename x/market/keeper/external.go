@@ -10,5 +10,6 @@ type EscrowKeeper interface {
 	GetAccount(ctx sdk.Context, id etypes.AccountID) (etypes.Account, error)
 	GetPayment(ctx sdk.Context, id etypes.AccountID, pid string) (etypes.FractionalPayment, error)
 	AccountClose(ctx sdk.Context, id etypes.AccountID) error
+	AccountTransfer(ctx sdk.Context, id, to etypes.AccountID, owner sdk.AccAddress) error
 	PaymentClose(ctx sdk.Context, id etypes.AccountID, pid string) error
 }
@@ -14,6 +14,7 @@ import (
 	types "github.com/akash-network/akash-api/go/node/market/v1beta4"
 
 	keys "github.com/akash-network/node/x/market/keeper/keys/v1beta4"
+	mv1beta5 "github.com/akash-network/node/x/market/types/v1beta5"
 )
 
 // Querier is used as Keeper will have duplicate methods if used directly, and gRPC names take precedence over keeper
@@ -21,7 +22,10 @@ type Querier struct {
 	Keeper
 }
 
-var _ types.QueryServer = Querier{}
+var (
+	_ types.QueryServer    = Querier{}
+	_ mv1beta5.QueryServer = Querier{}
+)
 
 // Orders returns orders based on filters
 func (k Querier) Orders(c context.Context, req *types.QueryOrdersRequest) (*types.QueryOrdersResponse, error) {
@@ -287,3 +291,83 @@ func (k Querier) Lease(c context.Context, req *types.QueryLeaseRequest) (*types.
 		EscrowPayment: payment,
 	}, nil
 }
+
+// ProviderReputation returns reputation counters of provider
+func (k Querier) ProviderReputation(c context.Context, req *mv1beta5.QueryProviderReputationRequest) (*mv1beta5.QueryProviderReputationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	provider, err := sdk.AccAddressFromBech32(req.Provider)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid provider address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &mv1beta5.QueryProviderReputationResponse{
+		Reputation: k.GetProviderReputation(ctx, provider),
+	}, nil
+}
+
+// ProviderInventory returns free capacity last reported by provider
+func (k Querier) ProviderInventory(c context.Context, req *mv1beta5.QueryProviderInventoryRequest) (*mv1beta5.QueryProviderInventoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	provider, err := sdk.AccAddressFromBech32(req.Provider)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid provider address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	inv, found := k.GetProviderInventory(ctx, provider)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "provider %s has no inventory", provider)
+	}
+
+	return &mv1beta5.QueryProviderInventoryResponse{
+		Inventory: inv,
+	}, nil
+}
+
+// ProvidersFit returns inventories of providers which last reported free capacity could run given group
+func (k Querier) ProvidersFit(c context.Context, req *mv1beta5.QueryProvidersFitRequest) (*mv1beta5.QueryProvidersFitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var inventories []mv1beta5.ProviderInventory
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(k.skey), keys.ProviderInventoryPrefix())
+
+	pageRes, err := sdkquery.FilteredPaginate(store, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var inv mv1beta5.ProviderInventory
+
+		err := k.cdc.Unmarshal(value, &inv)
+		if err != nil {
+			return false, err
+		}
+
+		if !k.providerFits(ctx, inv, req.GroupSpec) {
+			return false, nil
+		}
+
+		if accumulate {
+			inventories = append(inventories, inv)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &mv1beta5.QueryProvidersFitResponse{
+		Inventories: inventories,
+		Pagination:  pageRes,
+	}, nil
+}
@@ -12,11 +12,14 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
 
+	dtypes "github.com/akash-network/akash-api/go/node/deployment/v1beta3"
 	types "github.com/akash-network/akash-api/go/node/market/v1beta4"
+	akashtypes "github.com/akash-network/akash-api/go/node/types/v1beta3"
 
 	"github.com/akash-network/node/testutil"
 	"github.com/akash-network/node/testutil/state"
 	"github.com/akash-network/node/x/market/keeper"
+	mv1beta5 "github.com/akash-network/node/x/market/types/v1beta5"
 )
 
 type grpcTestSuite struct {
@@ -25,7 +28,8 @@ type grpcTestSuite struct {
 	ctx    sdk.Context
 	keeper keeper.IKeeper
 
-	queryClient types.QueryClient
+	queryClient     types.QueryClient
+	nodeQueryClient mv1beta5.QueryClient
 }
 
 func setupTest(t *testing.T) *grpcTestSuite {
@@ -42,7 +46,9 @@ func setupTest(t *testing.T) *grpcTestSuite {
 
 	queryHelper := baseapp.NewQueryServerTestHelper(suite.ctx, suite.App().InterfaceRegistry())
 	types.RegisterQueryServer(queryHelper, querier)
+	mv1beta5.RegisterQueryServer(queryHelper, querier)
 	suite.queryClient = types.NewQueryClient(queryHelper)
+	suite.nodeQueryClient = mv1beta5.NewQueryClient(queryHelper)
 
 	return suite
 }
@@ -1144,3 +1150,128 @@ func TestGRPCQueryLeases(t *testing.T) {
 		})
 	}
 }
+
+func TestGRPCQueryProviderReputation(t *testing.T) {
+	suite := setupTest(t)
+
+	provider := testutil.AccAddress(t)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	_, err := suite.nodeQueryClient.ProviderReputation(ctx, &mv1beta5.QueryProviderReputationRequest{Provider: "invalid"})
+	require.Error(t, err)
+
+	// provider without counters
+	res, err := suite.nodeQueryClient.ProviderReputation(ctx, &mv1beta5.QueryProviderReputationRequest{Provider: provider.String()})
+	require.NoError(t, err)
+	require.Equal(t, mv1beta5.ProviderReputation{Provider: provider.String()}, res.Reputation)
+
+	rep := mv1beta5.ProviderReputation{
+		Provider:          provider.String(),
+		LeasesWon:         3,
+		LeasesClosedEarly: 1,
+		BidsWithdrawn:     2,
+	}
+	suite.keeper.SetProviderReputation(suite.ctx, rep)
+
+	res, err = suite.nodeQueryClient.ProviderReputation(ctx, &mv1beta5.QueryProviderReputationRequest{Provider: provider.String()})
+	require.NoError(t, err)
+	require.Equal(t, rep, res.Reputation)
+}
+
+func TestGRPCQueryProvidersFit(t *testing.T) {
+	suite := setupTest(t)
+
+	gspec := dtypes.GroupSpec{
+		Name: "web",
+		Resources: dtypes.ResourceUnits{{
+			Resources: akashtypes.Resources{
+				ID:     1,
+				CPU:    &akashtypes.CPU{Units: akashtypes.NewResourceValue(1000)},
+				Memory: &akashtypes.Memory{Quantity: akashtypes.NewResourceValue(1 << 30)},
+				Storage: akashtypes.Volumes{
+					{Name: "default", Quantity: akashtypes.NewResourceValue(1 << 30)},
+				},
+			},
+			Count: 1,
+		}},
+	}
+
+	inventory := func(cpu uint64) mv1beta5.ProviderInventory {
+		return mv1beta5.ProviderInventory{
+			Provider: testutil.AccAddress(t).String(),
+			CPU:      cpu,
+			Memory:   2 << 30,
+			Storage:  []mv1beta5.InventoryStorage{{Class: mv1beta5.InventoryStorageEphemeral, Quantity: 2 << 30}},
+		}
+	}
+
+	fitting := map[string]bool{}
+	for i := 0; i < 3; i++ {
+		inv := inventory(2000)
+		require.NoError(t, suite.keeper.UpdateProviderInventory(suite.ctx, inv))
+		fitting[inv.Provider] = true
+	}
+
+	require.NoError(t, suite.keeper.UpdateProviderInventory(suite.ctx, inventory(500)))
+
+	draining := inventory(2000)
+	require.NoError(t, suite.keeper.UpdateProviderInventory(suite.ctx, draining))
+	_, err := suite.keeper.OnProviderDraining(suite.ctx, sdk.MustAccAddressFromBech32(draining.Provider))
+	require.NoError(t, err)
+
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	res, err := suite.nodeQueryClient.ProvidersFit(ctx, &mv1beta5.QueryProvidersFitRequest{GroupSpec: gspec})
+	require.NoError(t, err)
+	require.Len(t, res.Inventories, 3)
+	for _, inv := range res.Inventories {
+		require.True(t, fitting[inv.Provider])
+	}
+
+	res, err = suite.nodeQueryClient.ProvidersFit(ctx, &mv1beta5.QueryProvidersFitRequest{
+		GroupSpec:  gspec,
+		Pagination: &sdkquery.PageRequest{Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Inventories, 2)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	seen := map[string]bool{}
+	for _, inv := range res.Inventories {
+		seen[inv.Provider] = true
+	}
+
+	res, err = suite.nodeQueryClient.ProvidersFit(ctx, &mv1beta5.QueryProvidersFitRequest{
+		GroupSpec:  gspec,
+		Pagination: &sdkquery.PageRequest{Key: res.Pagination.NextKey, Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Inventories, 1)
+	require.True(t, fitting[res.Inventories[0].Provider])
+	require.False(t, seen[res.Inventories[0].Provider])
+}
+
+func TestGRPCQueryProviderInventory(t *testing.T) {
+	suite := setupTest(t)
+
+	provider := testutil.AccAddress(t)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	_, err := suite.nodeQueryClient.ProviderInventory(ctx, &mv1beta5.QueryProviderInventoryRequest{Provider: "invalid"})
+	require.Error(t, err)
+
+	_, err = suite.nodeQueryClient.ProviderInventory(ctx, &mv1beta5.QueryProviderInventoryRequest{Provider: provider.String()})
+	require.Error(t, err)
+
+	inv := mv1beta5.ProviderInventory{
+		Provider: provider.String(),
+		CPU:      2000,
+		Memory:   2 << 30,
+	}
+	require.NoError(t, suite.keeper.UpdateProviderInventory(suite.ctx, inv))
+
+	res, err := suite.nodeQueryClient.ProviderInventory(ctx, &mv1beta5.QueryProviderInventoryRequest{Provider: provider.String()})
+	require.NoError(t, err)
+	require.Equal(t, provider.String(), res.Inventory.Provider)
+	require.Equal(t, inv.CPU, res.Inventory.CPU)
+}
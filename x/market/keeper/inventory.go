@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dtypes "github.com/akash-network/akash-api/go/node/deployment/v1beta3"
+
+	keys "github.com/akash-network/node/x/market/keeper/keys/v1beta4"
+	mv1beta5 "github.com/akash-network/node/x/market/types/v1beta5"
+)
+
+const (
+	// DefaultInventoryUpdatePeriod is number of blocks provider waits between inventory updates
+	// when InventoryParams were never set
+	DefaultInventoryUpdatePeriod int64 = 100
+)
+
+var (
+	ErrInventoryRateLimited = errors.New("provider inventory updated too recently")
+)
+
+// GetInventoryParams returns provider inventory params
+func (k Keeper) GetInventoryParams(ctx sdk.Context) mv1beta5.InventoryParams {
+	params := mv1beta5.InventoryParams{
+		InventoryUpdatePeriod: DefaultInventoryUpdatePeriod,
+	}
+
+	for _, pair := range params.ParamSetPairs() {
+		k.pspace.GetIfExists(ctx, pair.Key, pair.Value)
+	}
+
+	return params
+}
+
+// SetInventoryParams sets provider inventory params
+func (k Keeper) SetInventoryParams(ctx sdk.Context, params mv1beta5.InventoryParams) {
+	k.pspace.SetParamSet(ctx, &params)
+}
+
+// GetProviderInventory returns inventory last reported by given provider
+func (k Keeper) GetProviderInventory(ctx sdk.Context, provider sdk.AccAddress) (mv1beta5.ProviderInventory, bool) {
+	buf := ctx.KVStore(k.skey).Get(keys.ProviderInventoryKey(provider))
+	if buf == nil {
+		return mv1beta5.ProviderInventory{}, false
+	}
+
+	var inv mv1beta5.ProviderInventory
+	k.cdc.MustUnmarshal(buf, &inv)
+
+	return inv, true
+}
+
+// UpdateProviderInventory replaces inventory of provider. Provider may update its inventory
+// once every InventoryParams.InventoryUpdatePeriod blocks.
+func (k Keeper) UpdateProviderInventory(ctx sdk.Context, inv mv1beta5.ProviderInventory) error {
+	if err := inv.ValidateBasic(); err != nil {
+		return err
+	}
+
+	provider, _ := sdk.AccAddressFromBech32(inv.Provider)
+
+	if current, found := k.GetProviderInventory(ctx, provider); found {
+		next := current.UpdatedAt + k.GetInventoryParams(ctx).InventoryUpdatePeriod
+		if ctx.BlockHeight() < next {
+			return fmt.Errorf("%w: next update allowed at height %d", ErrInventoryRateLimited, next)
+		}
+	}
+
+	inv.UpdatedAt = ctx.BlockHeight()
+
+	k.SetProviderInventory(ctx, inv)
+
+	ctx.EventManager().EmitEvent(
+		mv1beta5.NewEventProviderInventoryUpdated(provider).
+			ToSDKEvent(),
+	)
+
+	return nil
+}
+
+// SetProviderInventory stores inventory of provider as is
+func (k Keeper) SetProviderInventory(ctx sdk.Context, inv mv1beta5.ProviderInventory) {
+	provider, err := sdk.AccAddressFromBech32(inv.Provider)
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.KVStore(k.skey).Set(keys.ProviderInventoryKey(provider), k.cdc.MustMarshal(&inv))
+}
+
+// DeleteProviderInventory removes inventory of provider
+func (k Keeper) DeleteProviderInventory(ctx sdk.Context, provider sdk.AccAddress) {
+	ctx.KVStore(k.skey).Delete(keys.ProviderInventoryKey(provider))
+}
+
+// WithProviderInventories iterates inventories of all providers
+func (k Keeper) WithProviderInventories(ctx sdk.Context, fn func(mv1beta5.ProviderInventory) bool) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.skey), keys.ProviderInventoryPrefix())
+	defer func() {
+		_ = iter.Close()
+	}()
+
+	for ; iter.Valid(); iter.Next() {
+		var inv mv1beta5.ProviderInventory
+		k.cdc.MustUnmarshal(iter.Value(), &inv)
+
+		if stop := fn(inv); stop {
+			break
+		}
+	}
+}
+
+// ProvidersFittingGroupSpec returns inventories of providers which last reported free capacity
+// could run given group. Providers being deregistered are skipped.
+func (k Keeper) ProvidersFittingGroupSpec(ctx sdk.Context, gspec dtypes.GroupSpec) []mv1beta5.ProviderInventory {
+	res := make([]mv1beta5.ProviderInventory, 0)
+
+	k.WithProviderInventories(ctx, func(inv mv1beta5.ProviderInventory) bool {
+		if k.providerFits(ctx, inv, gspec) {
+			res = append(res, inv)
+		}
+
+		return false
+	})
+
+	return res
+}
+
+// providerFits returns true if provider is not being deregistered and its inventory fits given group
+func (k Keeper) providerFits(ctx sdk.Context, inv mv1beta5.ProviderInventory, gspec dtypes.GroupSpec) bool {
+	provider, err := sdk.AccAddressFromBech32(inv.Provider)
+	if err != nil {
+		return false
+	}
+
+	if _, draining := k.GetProviderDrain(ctx, provider); draining {
+		return false
+	}
+
+	return inv.FitsGroupSpec(gspec)
+}
@@ -9,8 +9,10 @@ import (
 
 	dtypes "github.com/akash-network/akash-api/go/node/deployment/v1beta3"
 	types "github.com/akash-network/akash-api/go/node/market/v1beta4"
+	akashtypes "github.com/akash-network/akash-api/go/node/types/v1beta3"
 
 	keys "github.com/akash-network/node/x/market/keeper/keys/v1beta4"
+	mv1beta5 "github.com/akash-network/node/x/market/types/v1beta5"
 )
 
 // TODO: use interface for all keepers, queriers
@@ -35,11 +37,57 @@ type IKeeper interface {
 	WithOrders(ctx sdk.Context, fn func(types.Order) bool)
 	WithBids(ctx sdk.Context, fn func(types.Bid) bool)
 	WithLeases(ctx sdk.Context, fn func(types.Lease) bool)
+	WithActiveLeasesForProvider(ctx sdk.Context, provider sdk.AccAddress, fn func(types.Lease) bool)
+	ReindexActiveLeases(ctx sdk.Context)
 	WithOrdersForGroup(ctx sdk.Context, id dtypes.GroupID, fn func(types.Order) bool)
 	WithBidsForOrder(ctx sdk.Context, id types.OrderID, fn func(types.Bid) bool)
 	BidCountForOrder(ctx sdk.Context, id types.OrderID) uint32
 	GetParams(ctx sdk.Context) (params types.Params)
 	SetParams(ctx sdk.Context, params types.Params)
+	SetOrderAuction(ctx sdk.Context, id types.OrderID, window int64) error
+	DeleteOrderAuction(ctx sdk.Context, auction mv1beta5.OrderAuction)
+	WithOrderAuctionsClosing(ctx sdk.Context, height int64, fn func(mv1beta5.OrderAuction) bool)
+	SetGroupAuction(ctx sdk.Context, id dtypes.GroupID, window int64) error
+	GetGroupAuction(ctx sdk.Context, id dtypes.GroupID) (int64, bool)
+	DeleteGroupAuction(ctx sdk.Context, id dtypes.GroupID)
+	WithGroupAuctions(ctx sdk.Context, fn func(mv1beta5.GroupAuction) bool)
+	ImportAuctions(ctx sdk.Context, orders []mv1beta5.OrderAuction, groups []mv1beta5.GroupAuction)
+	GetExpiryParams(ctx sdk.Context) mv1beta5.ExpiryParams
+	SetExpiryParams(ctx sdk.Context, params mv1beta5.ExpiryParams)
+	WithOrdersCreatedBefore(ctx sdk.Context, height int64, fn func(types.Order) bool)
+	WithBidsCreatedBefore(ctx sdk.Context, height int64, fn func(types.Bid) bool)
+	ReindexExpiry(ctx sdk.Context)
+	SetLeasePriceProposal(ctx sdk.Context, proposal mv1beta5.LeasePriceProposal)
+	GetLeasePriceProposal(ctx sdk.Context, id types.LeaseID) (mv1beta5.LeasePriceProposal, bool)
+	DeleteLeasePriceProposal(ctx sdk.Context, id types.LeaseID)
+	WithLeasePriceProposals(ctx sdk.Context, fn func(mv1beta5.LeasePriceProposal) bool)
+	OnLeasePriceUpdated(ctx sdk.Context, lease types.Lease, price sdk.DecCoin)
+	GetReputationParams(ctx sdk.Context) mv1beta5.ReputationParams
+	SetReputationParams(ctx sdk.Context, params mv1beta5.ReputationParams)
+	GetProviderReputation(ctx sdk.Context, provider sdk.AccAddress) mv1beta5.ProviderReputation
+	SetProviderReputation(ctx sdk.Context, rep mv1beta5.ProviderReputation)
+	WithProviderReputations(ctx sdk.Context, fn func(mv1beta5.ProviderReputation) bool)
+	OnBidWithdrawn(ctx sdk.Context, bid types.Bid)
+	OnLeaseClosedByProvider(ctx sdk.Context, lease types.Lease) bool
+	OnDeploymentTransferred(ctx sdk.Context, id dtypes.DeploymentID, owner sdk.AccAddress) error
+	GetDeregistrationParams(ctx sdk.Context) mv1beta5.DeregistrationParams
+	SetDeregistrationParams(ctx sdk.Context, params mv1beta5.DeregistrationParams)
+	GetProviderDrain(ctx sdk.Context, provider sdk.AccAddress) (mv1beta5.ProviderDrain, bool)
+	OnProviderDraining(ctx sdk.Context, provider sdk.AccAddress) (mv1beta5.ProviderDrain, error)
+	SetProviderDrain(ctx sdk.Context, drain mv1beta5.ProviderDrain)
+	WithProviderDrainsEnded(ctx sdk.Context, height int64, fn func(mv1beta5.ProviderDrain) bool)
+	DeleteProviderDrain(ctx sdk.Context, drain mv1beta5.ProviderDrain)
+	OnLeaseClosedProviderDeregistered(ctx sdk.Context, lease types.Lease)
+	LeasesUnmatchedByAttributes(ctx sdk.Context, provider sdk.AccAddress, from, to akashtypes.Attributes) []types.Lease
+	OnLeaseClosedAttributesChanged(ctx sdk.Context, lease types.Lease)
+	GetInventoryParams(ctx sdk.Context) mv1beta5.InventoryParams
+	SetInventoryParams(ctx sdk.Context, params mv1beta5.InventoryParams)
+	GetProviderInventory(ctx sdk.Context, provider sdk.AccAddress) (mv1beta5.ProviderInventory, bool)
+	UpdateProviderInventory(ctx sdk.Context, inv mv1beta5.ProviderInventory) error
+	SetProviderInventory(ctx sdk.Context, inv mv1beta5.ProviderInventory)
+	DeleteProviderInventory(ctx sdk.Context, provider sdk.AccAddress)
+	WithProviderInventories(ctx sdk.Context, fn func(mv1beta5.ProviderInventory) bool)
+	ProvidersFittingGroupSpec(ctx sdk.Context, gspec dtypes.GroupSpec) []mv1beta5.ProviderInventory
 }
 
 // Keeper of the market store
@@ -53,7 +101,7 @@ type Keeper struct {
 // NewKeeper creates and returns an instance for Market keeper
 func NewKeeper(cdc codec.BinaryCodec, skey sdk.StoreKey, pspace paramtypes.Subspace, ekeeper EscrowKeeper) IKeeper {
 	if !pspace.HasKeyTable() {
-		pspace = pspace.WithKeyTable(types.ParamKeyTable())
+		pspace = pspace.WithKeyTable(ParamKeyTable())
 	}
 
 	return Keeper{
@@ -111,6 +159,13 @@ func (k Keeper) CreateOrder(ctx sdk.Context, gid dtypes.GroupID, spec dtypes.Gro
 	}
 
 	store.Set(key, k.cdc.MustMarshal(&order))
+	k.updateOrderExpiryIndex(ctx, order)
+
+	if window, found := k.GetGroupAuction(ctx, gid); found {
+		if err := k.SetOrderAuction(ctx, order.ID(), window); err != nil {
+			return types.Order{}, err
+		}
+	}
 
 	ctx.Logger().Info("created order", "order", order.ID())
 	ctx.EventManager().EmitEvent(
@@ -139,6 +194,7 @@ func (k Keeper) CreateBid(ctx sdk.Context, oid types.OrderID, provider sdk.AccAd
 	}
 
 	store.Set(key, k.cdc.MustMarshal(&bid))
+	k.updateBidExpiryIndex(ctx, bid)
 
 	ctx.EventManager().EmitEvent(
 		types.NewEventBidCreated(bid.ID(), price).
@@ -163,6 +219,7 @@ func (k Keeper) CreateLease(ctx sdk.Context, bid types.Bid) {
 	// create (active) lease in store
 	key := keys.LeaseKey(lease.ID())
 	store.Set(key, k.cdc.MustMarshal(&lease))
+	k.updateActiveLeaseIndex(ctx, lease)
 
 	ctx.Logger().Info("created lease", "lease", lease.ID())
 	ctx.EventManager().EmitEvent(
@@ -181,6 +238,7 @@ func (k Keeper) OnOrderMatched(ctx sdk.Context, order types.Order) {
 func (k Keeper) OnBidMatched(ctx sdk.Context, bid types.Bid) {
 	bid.State = types.BidActive
 	k.updateBid(ctx, bid)
+	k.onLeaseWon(ctx, bid)
 }
 
 // OnBidLost updates bid state to bid lost
@@ -229,6 +287,7 @@ func (k Keeper) OnLeaseClosed(ctx sdk.Context, lease types.Lease, state types.Le
 	lease.State = state
 	lease.ClosedOn = ctx.BlockHeight()
 	k.updateLease(ctx, lease)
+	k.DeleteLeasePriceProposal(ctx, lease.ID())
 
 	ctx.EventManager().EmitEvent(
 		types.NewEventLeaseClosed(lease.ID(), lease.Price).
@@ -236,7 +295,8 @@ func (k Keeper) OnLeaseClosed(ctx sdk.Context, lease types.Lease, state types.Le
 	)
 }
 
-// OnGroupClosed updates state of all orders, bids and leases in group to closed
+// OnGroupClosed updates state of all orders, bids and leases in group to closed.
+// Group auction is kept, callers closing the group for good must delete it.
 func (k Keeper) OnGroupClosed(ctx sdk.Context, id dtypes.GroupID) {
 	k.WithOrdersForGroup(ctx, id, func(order types.Order) bool {
 		k.OnOrderClosed(ctx, order)
@@ -366,6 +426,47 @@ func (k Keeper) WithLeases(ctx sdk.Context, fn func(types.Lease) bool) {
 	}
 }
 
+// WithActiveLeasesForProvider iterates active leases of given provider
+func (k Keeper) WithActiveLeasesForProvider(ctx sdk.Context, provider sdk.AccAddress, fn func(types.Lease) bool) {
+	store := ctx.KVStore(k.skey)
+	iter := sdk.KVStorePrefixIterator(store, keys.ActiveLeasesForProviderPrefix(provider))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var id types.LeaseID
+		k.cdc.MustUnmarshal(iter.Value(), &id)
+
+		lease, found := k.GetLease(ctx, id)
+		if !found {
+			continue
+		}
+
+		if stop := fn(lease); stop {
+			break
+		}
+	}
+}
+
+// ReindexActiveLeases rebuilds index of active leases by provider
+func (k Keeper) ReindexActiveLeases(ctx sdk.Context) {
+	store := ctx.KVStore(k.skey)
+	iter := sdk.KVStorePrefixIterator(store, keys.ActiveLeasePrefix())
+
+	var stale [][]byte
+	for ; iter.Valid(); iter.Next() {
+		stale = append(stale, iter.Key())
+	}
+	_ = iter.Close()
+
+	for _, key := range stale {
+		store.Delete(key)
+	}
+
+	k.WithLeases(ctx, func(lease types.Lease) bool {
+		k.updateActiveLeaseIndex(ctx, lease)
+		return false
+	})
+}
+
 // WithOrdersForGroup iterates all orders of a group in market with given GroupID
 func (k Keeper) WithOrdersForGroup(ctx sdk.Context, id dtypes.GroupID, fn func(types.Order) bool) {
 	store := ctx.KVStore(k.skey)
@@ -421,16 +522,32 @@ func (k Keeper) updateOrder(ctx sdk.Context, order types.Order) {
 	store := ctx.KVStore(k.skey)
 	key := keys.OrderKey(order.ID())
 	store.Set(key, k.cdc.MustMarshal(&order))
+	k.updateOrderExpiryIndex(ctx, order)
 }
 
 func (k Keeper) updateBid(ctx sdk.Context, bid types.Bid) {
 	store := ctx.KVStore(k.skey)
 	key := keys.BidKey(bid.ID())
 	store.Set(key, k.cdc.MustMarshal(&bid))
+	k.updateBidExpiryIndex(ctx, bid)
 }
 
 func (k Keeper) updateLease(ctx sdk.Context, lease types.Lease) {
 	store := ctx.KVStore(k.skey)
 	key := keys.LeaseKey(lease.ID())
 	store.Set(key, k.cdc.MustMarshal(&lease))
+	k.updateActiveLeaseIndex(ctx, lease)
+}
+
+func (k Keeper) updateActiveLeaseIndex(ctx sdk.Context, lease types.Lease) {
+	store := ctx.KVStore(k.skey)
+	key := keys.ActiveLeaseKey(lease.ID())
+
+	if lease.State != types.LeaseActive {
+		store.Delete(key)
+		return
+	}
+
+	id := lease.ID()
+	store.Set(key, k.cdc.MustMarshal(&id))
 }
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -10,10 +11,12 @@ import (
 
 	dtypes "github.com/akash-network/akash-api/go/node/deployment/v1beta3"
 	types "github.com/akash-network/akash-api/go/node/market/v1beta4"
+	akashtypes "github.com/akash-network/akash-api/go/node/types/v1beta3"
 
 	"github.com/akash-network/node/testutil"
 	"github.com/akash-network/node/testutil/state"
 	"github.com/akash-network/node/x/market/keeper"
+	mv1beta5 "github.com/akash-network/node/x/market/types/v1beta5"
 )
 
 func Test_CreateOrder(t *testing.T) {
@@ -257,6 +260,111 @@ func Test_OnGroupClosed(t *testing.T) {
 	assert.Equal(t, types.OrderClosed, order.State)
 }
 
+func Test_ProviderInventory(t *testing.T) {
+	ctx, mk, _ := setupKeeper(t)
+	ctx = ctx.WithBlockHeight(10)
+
+	provider := testutil.AccAddress(t)
+
+	inv := mv1beta5.ProviderInventory{
+		Provider: provider.String(),
+		CPU:      4000,
+		Memory:   8 << 30,
+	}
+
+	for i := 0; i <= 32; i++ {
+		inv.GPU = append(inv.GPU, mv1beta5.InventoryGPU{Vendor: "nvidia", Model: fmt.Sprintf("m%d", i), Count: 1})
+	}
+
+	err := mk.UpdateProviderInventory(ctx, inv)
+	require.ErrorIs(t, err, mv1beta5.ErrInventoryInvalid)
+
+	inv.GPU = []mv1beta5.InventoryGPU{{Vendor: "nvidia", Model: "a100", Count: 2}}
+	err = mk.UpdateProviderInventory(ctx, inv)
+	require.NoError(t, err)
+
+	err = mk.UpdateProviderInventory(ctx.WithBlockHeight(10+keeper.DefaultInventoryUpdatePeriod-1), inv)
+	require.ErrorIs(t, err, keeper.ErrInventoryRateLimited)
+
+	mk.SetInventoryParams(ctx, mv1beta5.InventoryParams{InventoryUpdatePeriod: 5})
+	err = mk.UpdateProviderInventory(ctx.WithBlockHeight(15), inv)
+	require.NoError(t, err)
+
+	found, ok := mk.GetProviderInventory(ctx, provider)
+	require.True(t, ok)
+	require.Equal(t, int64(15), found.UpdatedAt)
+
+	mk.DeleteProviderInventory(ctx, provider)
+	_, ok = mk.GetProviderInventory(ctx, provider)
+	require.False(t, ok)
+}
+
+func Test_ProvidersFittingGroupSpec(t *testing.T) {
+	ctx, mk, _ := setupKeeper(t)
+
+	gspec := dtypes.GroupSpec{
+		Name: "gpu",
+		Resources: dtypes.ResourceUnits{{
+			Resources: akashtypes.Resources{
+				ID:     1,
+				CPU:    &akashtypes.CPU{Units: akashtypes.NewResourceValue(1000)},
+				Memory: &akashtypes.Memory{Quantity: akashtypes.NewResourceValue(1 << 30)},
+				GPU: &akashtypes.GPU{
+					Units:      akashtypes.NewResourceValue(1),
+					Attributes: akashtypes.Attributes{{Key: "vendor/nvidia/model/a100", Value: "true"}},
+				},
+				Storage: akashtypes.Volumes{
+					{Name: "default", Quantity: akashtypes.NewResourceValue(1 << 30)},
+					{
+						Name:       "data",
+						Quantity:   akashtypes.NewResourceValue(10 << 30),
+						Attributes: akashtypes.Attributes{{Key: "persistent", Value: "true"}, {Key: "class", Value: "beta2"}},
+					},
+				},
+			},
+			Count: 2,
+		}},
+	}
+
+	fits := mv1beta5.ProviderInventory{
+		Provider: testutil.AccAddress(t).String(),
+		CPU:      2000,
+		Memory:   2 << 30,
+		GPU:      []mv1beta5.InventoryGPU{{Vendor: "nvidia", Model: "t4", Count: 8}, {Vendor: "nvidia", Model: "a100", Count: 2}},
+		Storage:  []mv1beta5.InventoryStorage{{Class: mv1beta5.InventoryStorageEphemeral, Quantity: 2 << 30}, {Class: "beta2", Quantity: 20 << 30}},
+	}
+	require.True(t, fits.FitsGroupSpec(gspec))
+
+	noGPU := fits
+	noGPU.Provider = testutil.AccAddress(t).String()
+	noGPU.GPU = []mv1beta5.InventoryGPU{{Vendor: "nvidia", Model: "t4", Count: 8}}
+	require.False(t, noGPU.FitsGroupSpec(gspec))
+
+	noCPU := fits
+	noCPU.Provider = testutil.AccAddress(t).String()
+	noCPU.CPU = 1999
+	require.False(t, noCPU.FitsGroupSpec(gspec))
+
+	noStorage := fits
+	noStorage.Provider = testutil.AccAddress(t).String()
+	noStorage.Storage = []mv1beta5.InventoryStorage{{Class: mv1beta5.InventoryStorageEphemeral, Quantity: 2 << 30}, {Class: "beta3", Quantity: 20 << 30}}
+	require.False(t, noStorage.FitsGroupSpec(gspec))
+
+	draining := fits
+	draining.Provider = testutil.AccAddress(t).String()
+
+	for _, inv := range []mv1beta5.ProviderInventory{fits, noGPU, noCPU, noStorage, draining} {
+		require.NoError(t, mk.UpdateProviderInventory(ctx, inv))
+	}
+
+	_, err := mk.OnProviderDraining(ctx, sdk.MustAccAddressFromBech32(draining.Provider))
+	require.NoError(t, err)
+
+	res := mk.ProvidersFittingGroupSpec(ctx, gspec)
+	require.Len(t, res, 1)
+	require.Equal(t, fits.Provider, res[0].Provider)
+}
+
 func createLease(t testing.TB, suite *state.TestSuite) types.LeaseID {
 	t.Helper()
 	ctx := suite.Context()
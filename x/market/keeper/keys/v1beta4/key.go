@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/binary"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 
 	dtypes "github.com/akash-network/akash-api/go/node/deployment/v1beta3"
@@ -162,3 +163,130 @@ func BidsForOrderPrefix(id types.OrderID) []byte {
 	}
 	return buf.Bytes()
 }
+
+// OrderAuctionPrefix indexes orders running in reverse auction mode by the height their bidding window closes at.
+// It is local to this module and not part of the akash-api store layout.
+func OrderAuctionPrefix() []byte {
+	return []byte{0x04, 0x00}
+}
+
+func OrderAuctionKey(closesAt int64, id types.OrderID) []byte {
+	return heightIndexKey(OrderAuctionPrefix(), closesAt, OrderKey(id)[len(types.OrderPrefix()):])
+}
+
+// OrderExpiryPrefix indexes open orders by their creation height
+func OrderExpiryPrefix() []byte {
+	return []byte{0x04, 0x01}
+}
+
+// BidExpiryPrefix indexes open bids by their creation height
+func BidExpiryPrefix() []byte {
+	return []byte{0x04, 0x02}
+}
+
+func OrderExpiryKey(createdAt int64, id types.OrderID) []byte {
+	return heightIndexKey(OrderExpiryPrefix(), createdAt, OrderKey(id)[len(types.OrderPrefix()):])
+}
+
+func BidExpiryKey(createdAt int64, id types.BidID) []byte {
+	return heightIndexKey(BidExpiryPrefix(), createdAt, BidKey(id)[len(types.BidPrefix()):])
+}
+
+// HeightIndexEnd returns the end key, exclusive, of height index entries at or before given height
+func HeightIndexEnd(prefix []byte, height int64) []byte {
+	buf := bytes.NewBuffer(append([]byte{}, prefix...))
+	if err := binary.Write(buf, binary.BigEndian, uint64(height)+1); err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
+func heightIndexKey(prefix []byte, height int64, suffix []byte) []byte {
+	buf := bytes.NewBuffer(append([]byte{}, prefix...))
+	if err := binary.Write(buf, binary.BigEndian, uint64(height)); err != nil {
+		panic(err)
+	}
+	buf.Write(suffix)
+	return buf.Bytes()
+}
+
+// LeasePriceProposalPrefix holds pending lease price proposals
+func LeasePriceProposalPrefix() []byte {
+	return []byte{0x04, 0x03}
+}
+
+func LeasePriceProposalKey(id types.LeaseID) []byte {
+	buf := bytes.NewBuffer(LeasePriceProposalPrefix())
+	buf.Write(LeaseKey(id)[len(types.LeasePrefix()):])
+	return buf.Bytes()
+}
+
+// ProviderReputationPrefix holds reputation counters of providers
+func ProviderReputationPrefix() []byte {
+	return []byte{0x04, 0x04}
+}
+
+func ProviderReputationKey(provider sdk.AccAddress) []byte {
+	buf := bytes.NewBuffer(ProviderReputationPrefix())
+	buf.Write(address.MustLengthPrefix(provider))
+	return buf.Bytes()
+}
+
+// ProviderDrainPrefix holds providers being deregistered
+func ProviderDrainPrefix() []byte {
+	return []byte{0x04, 0x05}
+}
+
+// ProviderDrainEndPrefix indexes providers being deregistered by the height their grace period ends at
+func ProviderDrainEndPrefix() []byte {
+	return []byte{0x04, 0x06}
+}
+
+func ProviderDrainKey(provider sdk.AccAddress) []byte {
+	buf := bytes.NewBuffer(ProviderDrainPrefix())
+	buf.Write(address.MustLengthPrefix(provider))
+	return buf.Bytes()
+}
+
+func ProviderDrainEndKey(endsAt int64, provider sdk.AccAddress) []byte {
+	return heightIndexKey(ProviderDrainEndPrefix(), endsAt, address.MustLengthPrefix(provider))
+}
+
+// ProviderInventoryPrefix holds free capacity last reported by providers
+func ProviderInventoryPrefix() []byte {
+	return []byte{0x04, 0x07}
+}
+
+func ProviderInventoryKey(provider sdk.AccAddress) []byte {
+	buf := bytes.NewBuffer(ProviderInventoryPrefix())
+	buf.Write(address.MustLengthPrefix(provider))
+	return buf.Bytes()
+}
+
+// GroupAuctionPrefix holds auction window of groups which orders run in reverse auction mode
+func GroupAuctionPrefix() []byte {
+	return []byte{0x04, 0x08}
+}
+
+func GroupAuctionKey(id dtypes.GroupID) []byte {
+	buf := bytes.NewBuffer(GroupAuctionPrefix())
+	buf.Write(OrdersForGroupPrefix(id)[len(types.OrderPrefix()):])
+	return buf.Bytes()
+}
+
+// ActiveLeasePrefix indexes active leases by provider
+func ActiveLeasePrefix() []byte {
+	return []byte{0x04, 0x09}
+}
+
+func ActiveLeasesForProviderPrefix(provider sdk.AccAddress) []byte {
+	buf := bytes.NewBuffer(ActiveLeasePrefix())
+	buf.Write(address.MustLengthPrefix(provider))
+	return buf.Bytes()
+}
+
+func ActiveLeaseKey(id types.LeaseID) []byte {
+	buf := bytes.NewBuffer(ActiveLeasesForProviderPrefix(sdkutil.MustAccAddressFromBech32(id.Provider)))
+	buf.Write(LeaseKey(id)[len(types.LeasePrefix()):])
+	return buf.Bytes()
+}
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	types "github.com/akash-network/akash-api/go/node/market/v1beta4"
+
+	keys "github.com/akash-network/node/x/market/keeper/keys/v1beta4"
+	mv1beta5 "github.com/akash-network/node/x/market/types/v1beta5"
+)
+
+// SetLeasePriceProposal stores price proposal for a lease, replacing existing one
+func (k Keeper) SetLeasePriceProposal(ctx sdk.Context, proposal mv1beta5.LeasePriceProposal) {
+	ctx.KVStore(k.skey).Set(keys.LeasePriceProposalKey(proposal.LeaseID), k.cdc.MustMarshal(&proposal))
+}
+
+// GetLeasePriceProposal returns pending price proposal of lease with given id
+func (k Keeper) GetLeasePriceProposal(ctx sdk.Context, id types.LeaseID) (mv1beta5.LeasePriceProposal, bool) {
+	buf := ctx.KVStore(k.skey).Get(keys.LeasePriceProposalKey(id))
+	if buf == nil {
+		return mv1beta5.LeasePriceProposal{}, false
+	}
+
+	var proposal mv1beta5.LeasePriceProposal
+	k.cdc.MustUnmarshal(buf, &proposal)
+
+	return proposal, true
+}
+
+// DeleteLeasePriceProposal removes pending price proposal of lease with given id
+func (k Keeper) DeleteLeasePriceProposal(ctx sdk.Context, id types.LeaseID) {
+	ctx.KVStore(k.skey).Delete(keys.LeasePriceProposalKey(id))
+}
+
+// WithLeasePriceProposals iterates all pending lease price proposals
+func (k Keeper) WithLeasePriceProposals(ctx sdk.Context, fn func(mv1beta5.LeasePriceProposal) bool) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.skey), keys.LeasePriceProposalPrefix())
+	defer func() {
+		_ = iter.Close()
+	}()
+
+	for ; iter.Valid(); iter.Next() {
+		var proposal mv1beta5.LeasePriceProposal
+		k.cdc.MustUnmarshal(iter.Value(), &proposal)
+
+		if stop := fn(proposal); stop {
+			break
+		}
+	}
+}
+
+// OnLeasePriceUpdated updates price of an active lease and drops its pending price proposal.
+// Escrow payment rate of the lease must be updated by the caller.
+func (k Keeper) OnLeasePriceUpdated(ctx sdk.Context, lease types.Lease, price sdk.DecCoin) {
+	lease.Price = price
+	k.updateLease(ctx, lease)
+	k.DeleteLeasePriceProposal(ctx, lease.ID())
+
+	ctx.Logger().Info("updated lease price", "lease", lease.ID(), "price", price)
+	ctx.EventManager().EmitEvent(
+		mv1beta5.NewEventLeasePriceUpdated(lease.ID(), price).
+			ToSDKEvent(),
+	)
+}
@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	types "github.com/akash-network/akash-api/go/node/market/v1beta4"
+
+	keys "github.com/akash-network/node/x/market/keeper/keys/v1beta4"
+	mv1beta5 "github.com/akash-network/node/x/market/types/v1beta5"
+)
+
+// GetReputationParams returns provider reputation params. Params never set default to zero.
+func (k Keeper) GetReputationParams(ctx sdk.Context) mv1beta5.ReputationParams {
+	params := mv1beta5.ReputationParams{
+		EarlyCloseSlashRate: sdk.ZeroDec(),
+	}
+
+	for _, pair := range params.ParamSetPairs() {
+		k.pspace.GetIfExists(ctx, pair.Key, pair.Value)
+	}
+
+	return params
+}
+
+// SetReputationParams sets provider reputation params
+func (k Keeper) SetReputationParams(ctx sdk.Context, params mv1beta5.ReputationParams) {
+	k.pspace.SetParamSet(ctx, &params)
+}
+
+// GetProviderReputation returns reputation counters of given provider
+func (k Keeper) GetProviderReputation(ctx sdk.Context, provider sdk.AccAddress) mv1beta5.ProviderReputation {
+	rep := mv1beta5.ProviderReputation{
+		Provider: provider.String(),
+	}
+
+	buf := ctx.KVStore(k.skey).Get(keys.ProviderReputationKey(provider))
+	if buf == nil {
+		return rep
+	}
+
+	k.cdc.MustUnmarshal(buf, &rep)
+
+	return rep
+}
+
+// OnBidWithdrawn counts open bid closed by its provider
+func (k Keeper) OnBidWithdrawn(ctx sdk.Context, bid types.Bid) {
+	k.updateProviderReputation(ctx, bid.ID().Provider, func(rep *mv1beta5.ProviderReputation) {
+		rep.BidsWithdrawn++
+	})
+}
+
+// OnLeaseClosedByProvider counts lease closed by its provider and returns true
+// if lease was closed before reaching MinLeaseDuration
+func (k Keeper) OnLeaseClosedByProvider(ctx sdk.Context, lease types.Lease) bool {
+	params := k.GetReputationParams(ctx)
+
+	early := params.MinLeaseDuration > 0 && ctx.BlockHeight()-lease.CreatedAt < params.MinLeaseDuration
+	if early {
+		k.updateProviderReputation(ctx, lease.ID().Provider, func(rep *mv1beta5.ProviderReputation) {
+			rep.LeasesClosedEarly++
+		})
+	}
+
+	return early
+}
+
+func (k Keeper) onLeaseWon(ctx sdk.Context, bid types.Bid) {
+	k.updateProviderReputation(ctx, bid.ID().Provider, func(rep *mv1beta5.ProviderReputation) {
+		rep.LeasesWon++
+	})
+}
+
+func (k Keeper) updateProviderReputation(ctx sdk.Context, provider string, fn func(*mv1beta5.ProviderReputation)) {
+	addr, err := sdk.AccAddressFromBech32(provider)
+	if err != nil {
+		return
+	}
+
+	rep := k.GetProviderReputation(ctx, addr)
+	fn(&rep)
+
+	k.SetProviderReputation(ctx, rep)
+}
+
+// SetProviderReputation stores reputation counters of provider
+func (k Keeper) SetProviderReputation(ctx sdk.Context, rep mv1beta5.ProviderReputation) {
+	addr, err := sdk.AccAddressFromBech32(rep.Provider)
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.KVStore(k.skey).Set(keys.ProviderReputationKey(addr), k.cdc.MustMarshal(&rep))
+}
+
+// WithProviderReputations iterates all stored provider reputations
+func (k Keeper) WithProviderReputations(ctx sdk.Context, fn func(mv1beta5.ProviderReputation) bool) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.skey), keys.ProviderReputationPrefix())
+	defer func() {
+		_ = iter.Close()
+	}()
+
+	for ; iter.Valid(); iter.Next() {
+		var rep mv1beta5.ProviderReputation
+		k.cdc.MustUnmarshal(iter.Value(), &rep)
+
+		if stop := fn(rep); stop {
+			break
+		}
+	}
+}
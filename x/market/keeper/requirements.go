@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	atypes "github.com/akash-network/akash-api/go/node/audit/v1beta3"
+	types "github.com/akash-network/akash-api/go/node/market/v1beta4"
+	akashtypes "github.com/akash-network/akash-api/go/node/types/v1beta3"
+
+	mv1beta5 "github.com/akash-network/node/x/market/types/v1beta5"
+)
+
+// LeasesUnmatchedByAttributes returns active leases of given provider which orders are matched by
+// provider attributes from but not by attributes to. Leases which orders did not match from either,
+// e.g. matched before requirements checks changed, are not returned.
+func (k Keeper) LeasesUnmatchedByAttributes(ctx sdk.Context, provider sdk.AccAddress, from, to akashtypes.Attributes) []types.Lease {
+	var leases []types.Lease
+
+	k.WithActiveLeasesForProvider(ctx, provider, func(lease types.Lease) bool {
+		order, found := k.GetOrder(ctx, lease.ID().OrderID())
+		if !found {
+			return false
+		}
+
+		if matchProviderAttributes(order, provider, from) && !matchProviderAttributes(order, provider, to) {
+			leases = append(leases, lease)
+		}
+
+		return false
+	})
+
+	return leases
+}
+
+// OnLeaseClosedAttributesChanged closes active lease which order provider no longer matches after
+// updating its attributes. Lease ends in state closed, which is told apart from other closes by the emitted event.
+// Escrow payment of the lease must be closed by the caller.
+func (k Keeper) OnLeaseClosedAttributesChanged(ctx sdk.Context, lease types.Lease) {
+	if lease.State != types.LeaseActive {
+		return
+	}
+
+	k.OnLeaseClosed(ctx, lease, types.LeaseClosed)
+
+	ctx.EventManager().EmitEvent(
+		mv1beta5.NewEventLeaseClosedAttributesChanged(lease.ID()).
+			ToSDKEvent(),
+	)
+}
+
+// matchProviderAttributes checks order requirements which depend on attributes of the provider record.
+// Requirements signed by auditors are matched against audited attributes only and are not checked.
+func matchProviderAttributes(order types.Order, provider sdk.AccAddress, attr akashtypes.Attributes) bool {
+	if !order.MatchResourcesRequirements(attr) {
+		return false
+	}
+
+	signedBy := order.Spec.Requirements.SignedBy
+	if len(signedBy.AllOf) != 0 || len(signedBy.AnyOf) != 0 {
+		return true
+	}
+
+	return order.MatchRequirements([]atypes.Provider{{
+		Owner:      provider.String(),
+		Attributes: attr,
+	}})
+}
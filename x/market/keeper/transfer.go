@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dtypes "github.com/akash-network/akash-api/go/node/deployment/v1beta3"
+	types "github.com/akash-network/akash-api/go/node/market/v1beta4"
+
+	keys "github.com/akash-network/node/x/market/keeper/keys/v1beta4"
+	mv1beta5 "github.com/akash-network/node/x/market/types/v1beta5"
+)
+
+// OnDeploymentTransferred moves orders, bids and leases of given deployment, along with their indexes,
+// pending price proposals and bid escrow accounts, under the new owner of the deployment.
+func (k Keeper) OnDeploymentTransferred(ctx sdk.Context, id dtypes.DeploymentID, owner sdk.AccAddress) error {
+	store := ctx.KVStore(k.skey)
+
+	var auctions []mv1beta5.OrderAuction
+	k.WithOrderAuctionsClosing(ctx, math.MaxInt64, func(auction mv1beta5.OrderAuction) bool {
+		if auction.OrderID.GroupID().DeploymentID().Equals(id) {
+			auctions = append(auctions, auction)
+		}
+		return false
+	})
+
+	for _, auction := range auctions {
+		k.DeleteOrderAuction(ctx, auction)
+
+		auction.OrderID.Owner = owner.String()
+		k.saveOrderAuction(ctx, auction)
+	}
+
+	for _, order := range k.deploymentOrders(ctx, id) {
+		gid := order.ID().GroupID()
+		if window, found := k.GetGroupAuction(ctx, gid); found {
+			k.DeleteGroupAuction(ctx, gid)
+
+			gid.Owner = owner.String()
+			k.saveGroupAuction(ctx, mv1beta5.GroupAuction{ID: gid, Window: window})
+		}
+
+		store.Delete(keys.OrderKey(order.ID()))
+		store.Delete(keys.OrderExpiryKey(order.CreatedAt, order.ID()))
+
+		order.OrderID.Owner = owner.String()
+		k.updateOrder(ctx, order)
+	}
+
+	for _, bid := range k.deploymentBids(ctx, id) {
+		store.Delete(keys.BidKey(bid.ID()))
+		store.Delete(keys.BidExpiryKey(bid.CreatedAt, bid.ID()))
+
+		from := types.EscrowAccountForBid(bid.ID())
+
+		bid.BidID.Owner = owner.String()
+		k.updateBid(ctx, bid)
+
+		// bids placed before deposits were escrowed have no account
+		if _, err := k.ekeeper.GetAccount(ctx, from); err != nil {
+			continue
+		}
+
+		provider, err := sdk.AccAddressFromBech32(bid.ID().Provider)
+		if err != nil {
+			return err
+		}
+
+		if err := k.ekeeper.AccountTransfer(ctx, from, types.EscrowAccountForBid(bid.ID()), provider); err != nil {
+			return err
+		}
+	}
+
+	for _, lease := range k.deploymentLeases(ctx, id) {
+		store.Delete(keys.LeaseKey(lease.ID()))
+		store.Delete(keys.ActiveLeaseKey(lease.ID()))
+
+		proposal, hasProposal := k.GetLeasePriceProposal(ctx, lease.ID())
+		k.DeleteLeasePriceProposal(ctx, lease.ID())
+
+		secondary := keys.SecondaryKeysForLease(lease.ID())
+
+		lease.LeaseID.Owner = owner.String()
+		k.updateLease(ctx, lease)
+
+		for idx, key := range keys.SecondaryKeysForLease(lease.ID()) {
+			if val := store.Get(secondary[idx]); val != nil {
+				store.Delete(secondary[idx])
+				store.Set(key, val)
+			}
+		}
+
+		if hasProposal {
+			if proposal.Proposer == id.Owner {
+				proposal.Proposer = owner.String()
+			}
+
+			proposal.LeaseID = lease.ID()
+			k.SetLeasePriceProposal(ctx, proposal)
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) deploymentOrders(ctx sdk.Context, id dtypes.DeploymentID) []types.Order {
+	prefix, err := keys.OrderPrefixFromFilter(types.OrderFilters{Owner: id.Owner, DSeq: id.DSeq})
+	if err != nil {
+		panic(err)
+	}
+
+	var orders []types.Order
+	k.withPrefix(ctx, prefix, func(buf []byte) {
+		var order types.Order
+		k.cdc.MustUnmarshal(buf, &order)
+		orders = append(orders, order)
+	})
+
+	return orders
+}
+
+func (k Keeper) deploymentBids(ctx sdk.Context, id dtypes.DeploymentID) []types.Bid {
+	prefix, err := keys.BidPrefixFromFilter(types.BidFilters{Owner: id.Owner, DSeq: id.DSeq})
+	if err != nil {
+		panic(err)
+	}
+
+	var bids []types.Bid
+	k.withPrefix(ctx, prefix, func(buf []byte) {
+		var bid types.Bid
+		k.cdc.MustUnmarshal(buf, &bid)
+		bids = append(bids, bid)
+	})
+
+	return bids
+}
+
+func (k Keeper) deploymentLeases(ctx sdk.Context, id dtypes.DeploymentID) []types.Lease {
+	prefix, _, err := keys.LeasePrefixFromFilter(types.LeaseFilters{Owner: id.Owner, DSeq: id.DSeq})
+	if err != nil {
+		panic(err)
+	}
+
+	var leases []types.Lease
+	k.withPrefix(ctx, prefix, func(buf []byte) {
+		var lease types.Lease
+		k.cdc.MustUnmarshal(buf, &lease)
+		leases = append(leases, lease)
+	})
+
+	return leases
+}
+
+func (k Keeper) withPrefix(ctx sdk.Context, prefix []byte, fn func([]byte)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.skey), prefix)
+	defer func() {
+		_ = iter.Close()
+	}()
+
+	for ; iter.Valid(); iter.Next() {
+		fn(iter.Value())
+	}
+}
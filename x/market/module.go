@@ -33,7 +33,9 @@ import (
 	"github.com/akash-network/node/x/market/client/rest"
 	"github.com/akash-network/node/x/market/handler"
 	"github.com/akash-network/node/x/market/keeper"
+	"github.com/akash-network/node/x/market/query"
 	"github.com/akash-network/node/x/market/simulation"
+	mv1beta5 "github.com/akash-network/node/x/market/types/v1beta5"
 )
 
 var (
@@ -55,11 +57,13 @@ func (AppModuleBasic) Name() string {
 // RegisterLegacyAminoCodec registers the market module's types for the given codec.
 func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	types.RegisterLegacyAminoCodec(cdc)
+	mv1beta5.RegisterLegacyAminoCodec(cdc)
 }
 
 // RegisterInterfaces registers the module's interface types
 func (b AppModuleBasic) RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	types.RegisterInterfaces(registry)
+	mv1beta5.RegisterInterfaces(registry)
 	v1beta3types.RegisterInterfaces(registry)
 	v1beta2types.RegisterInterfaces(registry)
 	v1beta1types.RegisterInterfaces(registry)
@@ -73,7 +77,7 @@ func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
 
 // ValidateGenesis validation check of the Genesis
 func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
-	var data types.GenesisState
+	var data mv1beta5.GenesisState
 	err := cdc.UnmarshalJSON(bz, &data)
 	if err != nil {
 		return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
@@ -153,19 +157,21 @@ func (am AppModule) Route() sdk.Route {
 
 // QuerierRoute returns the market module's querier route name.
 func (am AppModule) QuerierRoute() string {
-	return ""
+	return types.ModuleName
 }
 
 // LegacyQuerierHandler returns the sdk.Querier for market module
-func (am AppModule) LegacyQuerierHandler(_ *codec.LegacyAmino) sdk.Querier {
-	return nil
+func (am AppModule) LegacyQuerierHandler(legacyQuerierCdc *codec.LegacyAmino) sdk.Querier {
+	return query.NewQuerier(am.keepers.Market, legacyQuerierCdc)
 }
 
 // RegisterServices registers the module's services
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), handler.NewServer(am.keepers))
+	mv1beta5.RegisterMsgServer(cfg.MsgServer(), handler.NewNodeServer(am.keepers))
 	querier := am.keepers.Market.NewQuerier()
 	types.RegisterQueryServer(cfg.QueryServer(), querier)
+	mv1beta5.RegisterQueryServer(cfg.QueryServer(), querier)
 
 	utypes.ModuleMigrations(ModuleName, am.keepers.Market, func(name string, forVersion uint64, handler module.MigrationHandler) {
 		if err := cfg.RegisterMigration(name, forVersion, handler); err != nil {
@@ -179,14 +185,15 @@ func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
 // EndBlock returns the end blocker for the market module. It returns no validator
 // updates.
-func (am AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	handler.EndBlock(ctx, am.keepers)
 	return []abci.ValidatorUpdate{}
 }
 
 // InitGenesis performs genesis initialization for the market module. It returns
 // no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
-	var genesisState types.GenesisState
+	var genesisState mv1beta5.GenesisState
 	cdc.MustUnmarshalJSON(data, &genesisState)
 	return InitGenesis(ctx, am.keepers.Market, &genesisState)
 }
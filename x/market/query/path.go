@@ -19,6 +19,11 @@ const (
 	bidPath    = "bid"
 	leasesPath = "leases"
 	leasePath  = "lease"
+
+	reputationPath     = "reputation"
+	deregistrationPath = "deregistration"
+	inventoryPath      = "inventory"
+	inventoryFitPath   = "inventory-fit"
 )
 
 var (
@@ -57,6 +62,26 @@ func LeasePath(id types.LeaseID) string {
 	return fmt.Sprintf("%s/%s/%s", leasePath, orderParts(id.OrderID()), id.Provider)
 }
 
+// ReputationPath returns reputation path of given provider for queries
+func ReputationPath(provider sdk.AccAddress) string {
+	return fmt.Sprintf("%s/%s", reputationPath, provider)
+}
+
+// DeregistrationPath returns deregistration path of given provider for queries
+func DeregistrationPath(provider sdk.AccAddress) string {
+	return fmt.Sprintf("%s/%s", deregistrationPath, provider)
+}
+
+// InventoryPath returns inventory path of given provider for queries
+func InventoryPath(provider sdk.AccAddress) string {
+	return fmt.Sprintf("%s/%s", inventoryPath, provider)
+}
+
+// InventoryFitPath returns path of providers which inventory fits group spec sent as query data
+func InventoryFitPath() string {
+	return inventoryFitPath
+}
+
 func orderParts(id types.OrderID) string {
 	return fmt.Sprintf("%s/%v/%v/%v", id.Owner, id.DSeq, id.GSeq, id.OSeq)
 }
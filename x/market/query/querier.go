@@ -0,0 +1,93 @@
+package query
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	dtypes "github.com/akash-network/akash-api/go/node/deployment/v1beta3"
+	types "github.com/akash-network/akash-api/go/node/market/v1beta4"
+
+	"github.com/akash-network/node/x/market/keeper"
+)
+
+func NewQuerier(keeper keeper.IKeeper, cdc *codec.LegacyAmino) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		if len(path) == 0 {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "empty %s query path", types.ModuleName)
+		}
+
+		switch path[0] {
+		case reputationPath:
+			return queryReputation(ctx, path[1:], keeper, cdc)
+		case deregistrationPath:
+			return queryDeregistration(ctx, path[1:], keeper, cdc)
+		case inventoryPath:
+			return queryInventory(ctx, path[1:], keeper, cdc)
+		case inventoryFitPath:
+			return queryInventoryFit(ctx, req.Data, keeper, cdc)
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint: %s", types.ModuleName, path[0])
+		}
+	}
+}
+
+func queryReputation(ctx sdk.Context, path []string, keeper keeper.IKeeper, cdc *codec.LegacyAmino) ([]byte, error) {
+	if len(path) != 1 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, ErrInvalidPath.Error())
+	}
+
+	provider, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	return codec.MarshalJSONIndent(cdc, keeper.GetProviderReputation(ctx, provider))
+}
+
+func queryDeregistration(ctx sdk.Context, path []string, keeper keeper.IKeeper, cdc *codec.LegacyAmino) ([]byte, error) {
+	if len(path) != 1 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, ErrInvalidPath.Error())
+	}
+
+	provider, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	drain, found := keeper.GetProviderDrain(ctx, provider)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "provider %s is not being deregistered", provider)
+	}
+
+	return codec.MarshalJSONIndent(cdc, drain)
+}
+
+func queryInventory(ctx sdk.Context, path []string, keeper keeper.IKeeper, cdc *codec.LegacyAmino) ([]byte, error) {
+	if len(path) != 1 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, ErrInvalidPath.Error())
+	}
+
+	provider, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	inv, found := keeper.GetProviderInventory(ctx, provider)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "provider %s has no inventory", provider)
+	}
+
+	return codec.MarshalJSONIndent(cdc, inv)
+}
+
+func queryInventoryFit(ctx sdk.Context, data []byte, keeper keeper.IKeeper, cdc *codec.LegacyAmino) ([]byte, error) {
+	var gspec dtypes.GroupSpec
+	if err := cdc.UnmarshalJSON(data, &gspec); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	return codec.MarshalJSONIndent(cdc, keeper.ProvidersFittingGroupSpec(ctx, gspec))
+}
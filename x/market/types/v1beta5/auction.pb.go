@@ -0,0 +1,581 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: akash/market/v1beta5/auction.proto
+
+package v1beta5
+
+import (
+	fmt "fmt"
+	v1beta3 "github.com/akash-network/akash-api/go/node/deployment/v1beta3"
+	v1beta4 "github.com/akash-network/akash-api/go/node/market/v1beta4"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// OrderAuction is an order running in reverse auction mode.
+// Once its bidding window closes, lease is created for the lowest priced bid.
+type OrderAuction struct {
+	OrderID  v1beta4.OrderID `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id" yaml:"order_id"`
+	ClosesAt int64           `protobuf:"varint,2,opt,name=closes_at,json=closesAt,proto3" json:"closes_at" yaml:"closes_at"`
+}
+
+func (m *OrderAuction) Reset()         { *m = OrderAuction{} }
+func (m *OrderAuction) String() string { return proto.CompactTextString(m) }
+func (*OrderAuction) ProtoMessage()    {}
+func (*OrderAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2acab3f16b6424b3, []int{0}
+}
+func (m *OrderAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderAuction.Merge(m, src)
+}
+func (m *OrderAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderAuction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderAuction proto.InternalMessageInfo
+
+func (m *OrderAuction) GetOrderID() v1beta4.OrderID {
+	if m != nil {
+		return m.OrderID
+	}
+	return v1beta4.OrderID{}
+}
+
+func (m *OrderAuction) GetClosesAt() int64 {
+	if m != nil {
+		return m.ClosesAt
+	}
+	return 0
+}
+
+// GroupAuction puts orders of deployment group into reverse auction mode
+type GroupAuction struct {
+	ID v1beta3.GroupID `protobuf:"bytes,1,opt,name=id,proto3" json:"id" yaml:"id"`
+	// Window is number of blocks order stays open for bids
+	Window int64 `protobuf:"varint,2,opt,name=window,proto3" json:"window" yaml:"window"`
+}
+
+func (m *GroupAuction) Reset()         { *m = GroupAuction{} }
+func (m *GroupAuction) String() string { return proto.CompactTextString(m) }
+func (*GroupAuction) ProtoMessage()    {}
+func (*GroupAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2acab3f16b6424b3, []int{1}
+}
+func (m *GroupAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GroupAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GroupAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GroupAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupAuction.Merge(m, src)
+}
+func (m *GroupAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *GroupAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupAuction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GroupAuction proto.InternalMessageInfo
+
+func (m *GroupAuction) GetID() v1beta3.GroupID {
+	if m != nil {
+		return m.ID
+	}
+	return v1beta3.GroupID{}
+}
+
+func (m *GroupAuction) GetWindow() int64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*OrderAuction)(nil), "akash.market.v1beta5.OrderAuction")
+	proto.RegisterType((*GroupAuction)(nil), "akash.market.v1beta5.GroupAuction")
+}
+
+func init() {
+	proto.RegisterFile("akash/market/v1beta5/auction.proto", fileDescriptor_2acab3f16b6424b3)
+}
+
+var fileDescriptor_2acab3f16b6424b3 = []byte{
+	// 379 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xbf, 0xae, 0xda, 0x30,
+	0x14, 0x87, 0xe3, 0x54, 0xe2, 0x4f, 0x4a, 0xd5, 0x2a, 0x62, 0x40, 0x54, 0xc4, 0x90, 0xa1, 0x62,
+	0xa9, 0xad, 0x36, 0x74, 0xe9, 0x50, 0x89, 0x08, 0xa9, 0x62, 0x69, 0x2b, 0xc6, 0x2e, 0x28, 0x60,
+	0x2b, 0x44, 0x90, 0x38, 0x4a, 0x4c, 0x29, 0x6f, 0x71, 0x97, 0xfb, 0x2e, 0xf7, 0x11, 0x18, 0x19,
+	0xef, 0x64, 0x5d, 0x85, 0x8d, 0x91, 0x27, 0xb8, 0x8a, 0xed, 0xc0, 0xc2, 0x76, 0xfc, 0xf3, 0x77,
+	0x8e, 0xbf, 0xc4, 0xb6, 0xdc, 0x60, 0x1d, 0xe4, 0x2b, 0x1c, 0x07, 0xd9, 0x9a, 0x72, 0xfc, 0xef,
+	0xcb, 0x82, 0xf2, 0xe0, 0x1b, 0x0e, 0xb6, 0x4b, 0x1e, 0xb1, 0x04, 0xa5, 0x19, 0xe3, 0xcc, 0x6e,
+	0x4b, 0x06, 0x29, 0x06, 0x69, 0xa6, 0xdb, 0x0e, 0x59, 0xc8, 0x24, 0x80, 0xcb, 0x4a, 0xb1, 0xdd,
+	0x4f, 0x6a, 0x1e, 0xa1, 0xe9, 0x86, 0xed, 0x63, 0x9a, 0x54, 0x33, 0x3d, 0x1c, 0x66, 0x6c, 0x9b,
+	0x46, 0x44, 0x73, 0xfd, 0x3b, 0xe7, 0x8e, 0x30, 0xcb, 0x08, 0xcd, 0x14, 0xe1, 0x3e, 0x01, 0xab,
+	0xf5, 0xbb, 0x5c, 0x8f, 0x95, 0x8c, 0xbd, 0xb2, 0x1a, 0x72, 0x7f, 0x1e, 0x91, 0x0e, 0xe8, 0x83,
+	0xe1, 0xdb, 0xaf, 0x3d, 0x74, 0xc7, 0x6c, 0x84, 0x64, 0xd7, 0x74, 0xe2, 0xa3, 0x83, 0x80, 0x46,
+	0x21, 0x60, 0x5d, 0x07, 0x67, 0x01, 0xaf, 0x13, 0x2e, 0x02, 0xbe, 0xdf, 0x07, 0xf1, 0xe6, 0xbb,
+	0x5b, 0x25, 0xee, 0xac, 0x2e, 0xcb, 0x29, 0xb1, 0x7f, 0x58, 0xcd, 0xe5, 0x86, 0xe5, 0x34, 0x9f,
+	0x07, 0xbc, 0x63, 0xf6, 0xc1, 0xf0, 0x8d, 0x3f, 0x38, 0x0b, 0x78, 0x0b, 0x2f, 0x02, 0x7e, 0x50,
+	0xdd, 0xd7, 0xc8, 0x9d, 0x35, 0x54, 0x3d, 0xe6, 0xee, 0x23, 0xb0, 0x5a, 0x3f, 0xcb, 0xcf, 0xad,
+	0xd4, 0xff, 0x58, 0xe6, 0x55, 0x7a, 0xa0, 0xa5, 0x6f, 0xbf, 0x48, 0x8b, 0x7b, 0x48, 0xf6, 0x4c,
+	0x27, 0x7e, 0x4f, 0x8b, 0x9b, 0xd2, 0xd9, 0x94, 0xb6, 0x4d, 0x75, 0x5e, 0xe9, 0x69, 0x46, 0xc4,
+	0xf6, 0xac, 0xda, 0x2e, 0x4a, 0x08, 0xdb, 0x69, 0xbf, 0x8f, 0x67, 0x01, 0x75, 0x72, 0x11, 0xf0,
+	0x9d, 0x82, 0xd5, 0xda, 0x9d, 0xe9, 0x0d, 0xff, 0xd7, 0xa1, 0x70, 0xc0, 0xb1, 0x70, 0xc0, 0x4b,
+	0xe1, 0x80, 0x87, 0x93, 0x63, 0x1c, 0x4f, 0x8e, 0xf1, 0x7c, 0x72, 0x8c, 0xbf, 0xa3, 0x30, 0xe2,
+	0xab, 0xed, 0x02, 0x2d, 0x59, 0x8c, 0xa5, 0xde, 0xe7, 0x84, 0xf2, 0x1d, 0xcb, 0xd6, 0x38, 0x61,
+	0x84, 0xe2, 0xff, 0xd5, 0x45, 0xf1, 0x7d, 0x4a, 0xf3, 0xea, 0x99, 0x2c, 0x6a, 0xf2, 0xa6, 0xbc,
+	0xd7, 0x01, 0x00, 0x6a, 0x9b, 0x26, 0xa4, 0x45, 0x02, 0x00, 0x00,
+}
+
+func (m *OrderAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClosesAt != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.ClosesAt))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.OrderID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GroupAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Window != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuction(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuction(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *OrderAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OrderID.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	if m.ClosesAt != 0 {
+		n += 1 + sovAuction(uint64(m.ClosesAt))
+	}
+	return n
+}
+
+func (m *GroupAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	if m.Window != 0 {
+		n += 1 + sovAuction(uint64(m.Window))
+	}
+	return n
+}
+
+func sovAuction(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuction(x uint64) (n int) {
+	return sovAuction(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *OrderAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OrderID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosesAt", wireType)
+			}
+			m.ClosesAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClosesAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GroupAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuction(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuction
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuction
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuction
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuction        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuction          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuction = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,556 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: akash/market/v1beta5/deregistration.proto
+
+package v1beta5
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DeregistrationParams holds number of blocks active leases of a deregistering provider keep running,
+// giving tenants time to move their workloads. Zero closes leases in the block provider deregisters.
+type DeregistrationParams struct {
+	ProviderDrainPeriod int64 `protobuf:"varint,1,opt,name=provider_drain_period,json=providerDrainPeriod,proto3" json:"provider_drain_period" yaml:"provider_drain_period"`
+}
+
+func (m *DeregistrationParams) Reset()         { *m = DeregistrationParams{} }
+func (m *DeregistrationParams) String() string { return proto.CompactTextString(m) }
+func (*DeregistrationParams) ProtoMessage()    {}
+func (*DeregistrationParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64b17051146719cf, []int{0}
+}
+func (m *DeregistrationParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeregistrationParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeregistrationParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeregistrationParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeregistrationParams.Merge(m, src)
+}
+func (m *DeregistrationParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeregistrationParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeregistrationParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeregistrationParams proto.InternalMessageInfo
+
+func (m *DeregistrationParams) GetProviderDrainPeriod() int64 {
+	if m != nil {
+		return m.ProviderDrainPeriod
+	}
+	return 0
+}
+
+// ProviderDrain is a provider being deregistered. Provider can not bid while draining,
+// its active leases are closed and the provider is removed once the grace period ends.
+type ProviderDrain struct {
+	Provider  string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider" yaml:"provider"`
+	StartedAt int64  `protobuf:"varint,2,opt,name=started_at,json=startedAt,proto3" json:"started_at" yaml:"started_at"`
+	EndsAt    int64  `protobuf:"varint,3,opt,name=ends_at,json=endsAt,proto3" json:"ends_at" yaml:"ends_at"`
+}
+
+func (m *ProviderDrain) Reset()         { *m = ProviderDrain{} }
+func (m *ProviderDrain) String() string { return proto.CompactTextString(m) }
+func (*ProviderDrain) ProtoMessage()    {}
+func (*ProviderDrain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64b17051146719cf, []int{1}
+}
+func (m *ProviderDrain) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderDrain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderDrain.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProviderDrain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderDrain.Merge(m, src)
+}
+func (m *ProviderDrain) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderDrain) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderDrain.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderDrain proto.InternalMessageInfo
+
+func (m *ProviderDrain) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *ProviderDrain) GetStartedAt() int64 {
+	if m != nil {
+		return m.StartedAt
+	}
+	return 0
+}
+
+func (m *ProviderDrain) GetEndsAt() int64 {
+	if m != nil {
+		return m.EndsAt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*DeregistrationParams)(nil), "akash.market.v1beta5.DeregistrationParams")
+	proto.RegisterType((*ProviderDrain)(nil), "akash.market.v1beta5.ProviderDrain")
+}
+
+func init() {
+	proto.RegisterFile("akash/market/v1beta5/deregistration.proto", fileDescriptor_64b17051146719cf)
+}
+
+var fileDescriptor_64b17051146719cf = []byte{
+	// 336 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xcf, 0x4a, 0xeb, 0x40,
+	0x14, 0xc6, 0x3b, 0xb7, 0xd0, 0x7b, 0x3b, 0x70, 0x15, 0x63, 0x85, 0x22, 0x9a, 0x91, 0xb8, 0xd1,
+	0x85, 0x19, 0xc4, 0x3f, 0xa0, 0xae, 0x1a, 0xba, 0x96, 0x92, 0xa5, 0x9b, 0x32, 0x75, 0x86, 0x34,
+	0xd4, 0x64, 0xc2, 0xe4, 0x58, 0xed, 0x03, 0xb8, 0xf7, 0xb1, 0xba, 0xec, 0xd2, 0xd5, 0x20, 0xed,
+	0x2e, 0xcb, 0x3c, 0x81, 0x64, 0xd2, 0xd4, 0x16, 0xba, 0x3b, 0xf9, 0x9d, 0x5f, 0xbe, 0x33, 0xf0,
+	0xe1, 0x73, 0x36, 0x62, 0xe9, 0x90, 0x46, 0x4c, 0x8d, 0x04, 0xd0, 0xf1, 0xe5, 0x40, 0x00, 0xbb,
+	0xa1, 0x5c, 0x28, 0x11, 0x84, 0x29, 0x28, 0x06, 0xa1, 0x8c, 0xdd, 0x44, 0x49, 0x90, 0x56, 0xcb,
+	0xa8, 0x6e, 0xa9, 0xba, 0x4b, 0xf5, 0xb0, 0x15, 0xc8, 0x40, 0x1a, 0x81, 0x16, 0x53, 0xe9, 0x3a,
+	0x1f, 0x08, 0xb7, 0xba, 0x1b, 0x21, 0x3d, 0xa6, 0x58, 0x94, 0x5a, 0x11, 0x3e, 0x48, 0x94, 0x1c,
+	0x87, 0x5c, 0xa8, 0x3e, 0x57, 0x2c, 0x8c, 0xfb, 0x89, 0x50, 0xa1, 0xe4, 0x6d, 0x74, 0x82, 0xce,
+	0xea, 0xde, 0x5d, 0xa6, 0xc9, 0x76, 0x21, 0xd7, 0xe4, 0x68, 0xc2, 0xa2, 0x97, 0x7b, 0x67, 0xeb,
+	0xda, 0xf1, 0xf7, 0x2b, 0xde, 0x2d, 0x70, 0xaf, 0xa4, 0x53, 0x84, 0xff, 0xf7, 0xd6, 0xb9, 0xf5,
+	0x80, 0xff, 0x55, 0xa2, 0xb9, 0xd9, 0xf4, 0x48, 0xa6, 0xc9, 0x8a, 0xe5, 0x9a, 0xec, 0x6e, 0x9e,
+	0x71, 0xfc, 0xd5, 0xd2, 0xf2, 0x30, 0x4e, 0x81, 0x29, 0x10, 0xbc, 0xcf, 0xa0, 0xfd, 0xc7, 0x3c,
+	0xf9, 0x34, 0xd3, 0x64, 0x8d, 0xe6, 0x9a, 0xec, 0x95, 0x01, 0xbf, 0xcc, 0xf1, 0x9b, 0xcb, 0x8f,
+	0x0e, 0x58, 0xb7, 0xf8, 0xaf, 0x88, 0x79, 0x5a, 0x04, 0xd4, 0x4d, 0xc0, 0x71, 0xa6, 0x49, 0x85,
+	0x72, 0x4d, 0x76, 0xca, 0xbf, 0x97, 0xc0, 0xf1, 0x1b, 0xc5, 0xd4, 0x01, 0xef, 0x71, 0x3a, 0xb7,
+	0xd1, 0x6c, 0x6e, 0xa3, 0xef, 0xb9, 0x8d, 0x3e, 0x17, 0x76, 0x6d, 0xb6, 0xb0, 0x6b, 0x5f, 0x0b,
+	0xbb, 0xf6, 0x74, 0x1d, 0x84, 0x30, 0x7c, 0x1d, 0xb8, 0xcf, 0x32, 0xa2, 0xa6, 0xa3, 0x8b, 0x58,
+	0xc0, 0x9b, 0x54, 0x23, 0x1a, 0x4b, 0x2e, 0xe8, 0x7b, 0xd5, 0x2e, 0x4c, 0x12, 0x91, 0x56, 0x1d,
+	0x0f, 0x1a, 0xa6, 0xa9, 0xab, 0x9f, 0x01, 0x00, 0xfc, 0x5b, 0x46, 0x48, 0x02, 0x02, 0x00, 0x00,
+}
+
+func (m *DeregistrationParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeregistrationParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeregistrationParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProviderDrainPeriod != 0 {
+		i = encodeVarintDeregistration(dAtA, i, uint64(m.ProviderDrainPeriod))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderDrain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderDrain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderDrain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndsAt != 0 {
+		i = encodeVarintDeregistration(dAtA, i, uint64(m.EndsAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartedAt != 0 {
+		i = encodeVarintDeregistration(dAtA, i, uint64(m.StartedAt))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintDeregistration(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDeregistration(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDeregistration(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DeregistrationParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProviderDrainPeriod != 0 {
+		n += 1 + sovDeregistration(uint64(m.ProviderDrainPeriod))
+	}
+	return n
+}
+
+func (m *ProviderDrain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovDeregistration(uint64(l))
+	}
+	if m.StartedAt != 0 {
+		n += 1 + sovDeregistration(uint64(m.StartedAt))
+	}
+	if m.EndsAt != 0 {
+		n += 1 + sovDeregistration(uint64(m.EndsAt))
+	}
+	return n
+}
+
+func sovDeregistration(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDeregistration(x uint64) (n int) {
+	return sovDeregistration(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DeregistrationParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDeregistration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeregistrationParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeregistrationParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderDrainPeriod", wireType)
+			}
+			m.ProviderDrainPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeregistration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProviderDrainPeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDeregistration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDeregistration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProviderDrain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDeregistration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderDrain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderDrain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeregistration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDeregistration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDeregistration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			m.StartedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeregistration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndsAt", wireType)
+			}
+			m.EndsAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeregistration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndsAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDeregistration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDeregistration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDeregistration(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDeregistration
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDeregistration
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDeregistration
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDeregistration
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDeregistration
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDeregistration
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDeregistration        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDeregistration          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDeregistration = fmt.Errorf("proto: unexpected end of group")
+)
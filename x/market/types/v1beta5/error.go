@@ -0,0 +1,18 @@
+package v1beta5
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// error codes continue after range used by akash-api market types
+const (
+	errLeasePriceProposalNotFound uint32 = iota + 100
+	errLeasePriceMismatch
+	errInventoryInvalid
+)
+
+var (
+	ErrLeasePriceProposalNotFound = sdkerrors.Register(ModuleName, errLeasePriceProposalNotFound, "lease price proposal not found")
+	ErrLeasePriceMismatch         = sdkerrors.Register(ModuleName, errLeasePriceMismatch, "price does not match lease price proposal")
+	ErrInventoryInvalid           = sdkerrors.Register(ModuleName, errInventoryInvalid, "invalid provider inventory")
+)
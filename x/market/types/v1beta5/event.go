@@ -0,0 +1,277 @@
+package v1beta5
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dtypes "github.com/akash-network/akash-api/go/node/deployment/v1beta3"
+	v1beta4 "github.com/akash-network/akash-api/go/node/market/v1beta4"
+	"github.com/akash-network/akash-api/go/sdkutil"
+)
+
+const (
+	EvActionProviderDraining                = "provider-draining"
+	EvActionLeaseClosedProviderDeregistered = "lease-closed-provider-deregistered"
+	EvActionLeaseClosedAttributesChanged    = "lease-closed-attributes-changed"
+	EvActionProviderInventoryUpdated        = "provider-inventory-updated"
+	EvActionLeasePriceUpdated               = "lease-price-updated"
+
+	EvOSeqKey     = "oseq"
+	EvProviderKey = "provider"
+	EvEndsAtKey   = "ends-at"
+
+	EvPriceDenomKey  = "price-denom"
+	EvPriceAmountKey = "price-amount"
+)
+
+// EventProviderDraining struct
+type EventProviderDraining struct {
+	Context  sdkutil.BaseModuleEvent `json:"context"`
+	Provider sdk.AccAddress          `json:"provider"`
+	EndsAt   int64                   `json:"ends_at"`
+}
+
+func NewEventProviderDraining(provider sdk.AccAddress, endsAt int64) EventProviderDraining {
+	return EventProviderDraining{
+		Context: sdkutil.BaseModuleEvent{
+			Module: ModuleName,
+			Action: EvActionProviderDraining,
+		},
+		Provider: provider,
+		EndsAt:   endsAt,
+	}
+}
+
+// ToSDKEvent method creates new sdk event for EventProviderDraining struct
+func (ev EventProviderDraining) ToSDKEvent() sdk.Event {
+	return sdk.NewEvent(sdkutil.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeyAction, EvActionProviderDraining),
+		sdk.NewAttribute(EvProviderKey, ev.Provider.String()),
+		sdk.NewAttribute(EvEndsAtKey, strconv.FormatInt(ev.EndsAt, 10)),
+	)
+}
+
+// EventLeaseClosedProviderDeregistered struct
+type EventLeaseClosedProviderDeregistered struct {
+	Context sdkutil.BaseModuleEvent `json:"context"`
+	ID      v1beta4.LeaseID         `json:"id"`
+}
+
+func NewEventLeaseClosedProviderDeregistered(id v1beta4.LeaseID) EventLeaseClosedProviderDeregistered {
+	return EventLeaseClosedProviderDeregistered{
+		Context: sdkutil.BaseModuleEvent{
+			Module: ModuleName,
+			Action: EvActionLeaseClosedProviderDeregistered,
+		},
+		ID: id,
+	}
+}
+
+// ToSDKEvent method creates new sdk event for EventLeaseClosedProviderDeregistered struct
+func (ev EventLeaseClosedProviderDeregistered) ToSDKEvent() sdk.Event {
+	return sdk.NewEvent(sdkutil.EventTypeMessage,
+		append([]sdk.Attribute{
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, EvActionLeaseClosedProviderDeregistered),
+		}, LeaseIDEVAttributes(ev.ID)...)...,
+	)
+}
+
+// EventLeaseClosedAttributesChanged struct
+type EventLeaseClosedAttributesChanged struct {
+	Context sdkutil.BaseModuleEvent `json:"context"`
+	ID      v1beta4.LeaseID         `json:"id"`
+}
+
+func NewEventLeaseClosedAttributesChanged(id v1beta4.LeaseID) EventLeaseClosedAttributesChanged {
+	return EventLeaseClosedAttributesChanged{
+		Context: sdkutil.BaseModuleEvent{
+			Module: ModuleName,
+			Action: EvActionLeaseClosedAttributesChanged,
+		},
+		ID: id,
+	}
+}
+
+// ToSDKEvent method creates new sdk event for EventLeaseClosedAttributesChanged struct
+func (ev EventLeaseClosedAttributesChanged) ToSDKEvent() sdk.Event {
+	return sdk.NewEvent(sdkutil.EventTypeMessage,
+		append([]sdk.Attribute{
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, EvActionLeaseClosedAttributesChanged),
+		}, LeaseIDEVAttributes(ev.ID)...)...,
+	)
+}
+
+// EventProviderInventoryUpdated struct
+type EventProviderInventoryUpdated struct {
+	Context  sdkutil.BaseModuleEvent `json:"context"`
+	Provider sdk.AccAddress          `json:"provider"`
+}
+
+func NewEventProviderInventoryUpdated(provider sdk.AccAddress) EventProviderInventoryUpdated {
+	return EventProviderInventoryUpdated{
+		Context: sdkutil.BaseModuleEvent{
+			Module: ModuleName,
+			Action: EvActionProviderInventoryUpdated,
+		},
+		Provider: provider,
+	}
+}
+
+// ToSDKEvent method creates new sdk event for EventProviderInventoryUpdated struct
+func (ev EventProviderInventoryUpdated) ToSDKEvent() sdk.Event {
+	return sdk.NewEvent(sdkutil.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeyAction, EvActionProviderInventoryUpdated),
+		sdk.NewAttribute(EvProviderKey, ev.Provider.String()),
+	)
+}
+
+// EventLeasePriceUpdated struct
+type EventLeasePriceUpdated struct {
+	Context sdkutil.BaseModuleEvent `json:"context"`
+	ID      v1beta4.LeaseID         `json:"id"`
+	Price   sdk.DecCoin             `json:"price"`
+}
+
+func NewEventLeasePriceUpdated(id v1beta4.LeaseID, price sdk.DecCoin) EventLeasePriceUpdated {
+	return EventLeasePriceUpdated{
+		Context: sdkutil.BaseModuleEvent{
+			Module: ModuleName,
+			Action: EvActionLeasePriceUpdated,
+		},
+		ID:    id,
+		Price: price,
+	}
+}
+
+// ToSDKEvent method creates new sdk event for EventLeasePriceUpdated struct
+func (ev EventLeasePriceUpdated) ToSDKEvent() sdk.Event {
+	return sdk.NewEvent(sdkutil.EventTypeMessage,
+		append(
+			append([]sdk.Attribute{
+				sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+				sdk.NewAttribute(sdk.AttributeKeyAction, EvActionLeasePriceUpdated),
+			}, LeaseIDEVAttributes(ev.ID)...),
+			PriceEVAttributes(ev.Price)...)...,
+	)
+}
+
+// PriceEVAttributes returns event attributes for given price
+func PriceEVAttributes(price sdk.DecCoin) []sdk.Attribute {
+	return []sdk.Attribute{
+		sdk.NewAttribute(EvPriceDenomKey, price.Denom),
+		sdk.NewAttribute(EvPriceAmountKey, price.Amount.String()),
+	}
+}
+
+// ParseEVPrice returns price for given event attributes
+func ParseEVPrice(attrs []sdk.Attribute) (sdk.DecCoin, error) {
+	denom, err := sdkutil.GetString(attrs, EvPriceDenomKey)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	amount, err := sdkutil.GetString(attrs, EvPriceAmountKey)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	dec, err := sdk.NewDecFromStr(amount)
+	if err != nil {
+		return sdk.DecCoin{}, v1beta4.ErrParsingPrice
+	}
+
+	return sdk.NewDecCoinFromDec(denom, dec), nil
+}
+
+// LeaseIDEVAttributes returns event attributes for given lease id
+func LeaseIDEVAttributes(id v1beta4.LeaseID) []sdk.Attribute {
+	return append(dtypes.GroupIDEVAttributes(id.GroupID()),
+		sdk.NewAttribute(EvOSeqKey, strconv.FormatUint(uint64(id.OSeq), 10)),
+		sdk.NewAttribute(EvProviderKey, id.Provider),
+	)
+}
+
+// ParseEVLeaseID returns lease id for given event attributes
+func ParseEVLeaseID(attrs []sdk.Attribute) (v1beta4.LeaseID, error) {
+	gid, err := dtypes.ParseEVGroupID(attrs)
+	if err != nil {
+		return v1beta4.LeaseID{}, err
+	}
+
+	oseq, err := sdkutil.GetUint64(attrs, EvOSeqKey)
+	if err != nil {
+		return v1beta4.LeaseID{}, err
+	}
+
+	provider, err := sdkutil.GetAccAddress(attrs, EvProviderKey)
+	if err != nil {
+		return v1beta4.LeaseID{}, err
+	}
+
+	return v1beta4.MakeLeaseID(v1beta4.MakeBidID(v1beta4.MakeOrderID(gid, uint32(oseq)), provider)), nil
+}
+
+// ParseEvent parses event and returns details of event and error if occurred
+func ParseEvent(ev sdkutil.Event) (sdkutil.ModuleEvent, error) {
+	if ev.Type != sdkutil.EventTypeMessage {
+		return nil, sdkutil.ErrUnknownType
+	}
+	if ev.Module != ModuleName {
+		return nil, sdkutil.ErrUnknownModule
+	}
+
+	switch ev.Action {
+	case EvActionProviderDraining:
+		provider, err := sdkutil.GetAccAddress(ev.Attributes, EvProviderKey)
+		if err != nil {
+			return nil, err
+		}
+
+		endsAt, err := sdkutil.GetUint64(ev.Attributes, EvEndsAtKey)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewEventProviderDraining(provider, int64(endsAt)), nil
+	case EvActionLeaseClosedProviderDeregistered:
+		id, err := ParseEVLeaseID(ev.Attributes)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewEventLeaseClosedProviderDeregistered(id), nil
+	case EvActionLeaseClosedAttributesChanged:
+		id, err := ParseEVLeaseID(ev.Attributes)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewEventLeaseClosedAttributesChanged(id), nil
+	case EvActionProviderInventoryUpdated:
+		provider, err := sdkutil.GetAccAddress(ev.Attributes, EvProviderKey)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewEventProviderInventoryUpdated(provider), nil
+	case EvActionLeasePriceUpdated:
+		id, err := ParseEVLeaseID(ev.Attributes)
+		if err != nil {
+			return nil, err
+		}
+
+		price, err := ParseEVPrice(ev.Attributes)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewEventLeasePriceUpdated(id, price), nil
+	default:
+		return nil, sdkutil.ErrUnknownAction
+	}
+}
@@ -0,0 +1,1132 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: akash/market/v1beta5/genesis.proto
+
+package v1beta5
+
+import (
+	fmt "fmt"
+	v1beta4 "github.com/akash-network/akash-api/go/node/market/v1beta4"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the basic genesis state used by market module.
+// It extends akash.market.v1beta4.GenesisState with state introduced by node.
+type GenesisState struct {
+	Params               v1beta4.Params       `protobuf:"bytes,1,opt,name=params,proto3" json:"params" yaml:"params"`
+	Orders               []v1beta4.Order      `protobuf:"bytes,2,rep,name=orders,proto3" json:"orders" yaml:"orders"`
+	Leases               []v1beta4.Lease      `protobuf:"bytes,3,rep,name=leases,proto3" json:"leases" yaml:"leases"`
+	Bids                 []v1beta4.Bid        `protobuf:"bytes,4,rep,name=bids,proto3" json:"bids" yaml:"bids"`
+	ExpiryParams         ExpiryParams         `protobuf:"bytes,5,opt,name=expiry_params,json=expiryParams,proto3" json:"expiry_params" yaml:"expiry_params"`
+	OrderAuctions        []OrderAuction       `protobuf:"bytes,6,rep,name=order_auctions,json=orderAuctions,proto3" json:"order_auctions" yaml:"order_auctions"`
+	GroupAuctions        []GroupAuction       `protobuf:"bytes,7,rep,name=group_auctions,json=groupAuctions,proto3" json:"group_auctions" yaml:"group_auctions"`
+	LeasePriceProposals  []LeasePriceProposal `protobuf:"bytes,8,rep,name=lease_price_proposals,json=leasePriceProposals,proto3" json:"lease_price_proposals" yaml:"lease_price_proposals"`
+	ReputationParams     ReputationParams     `protobuf:"bytes,9,opt,name=reputation_params,json=reputationParams,proto3" json:"reputation_params" yaml:"reputation_params"`
+	Reputations          []ProviderReputation `protobuf:"bytes,10,rep,name=reputations,proto3" json:"reputations" yaml:"reputations"`
+	DeregistrationParams DeregistrationParams `protobuf:"bytes,11,opt,name=deregistration_params,json=deregistrationParams,proto3" json:"deregistration_params" yaml:"deregistration_params"`
+	ProviderDrains       []ProviderDrain      `protobuf:"bytes,12,rep,name=provider_drains,json=providerDrains,proto3" json:"provider_drains" yaml:"provider_drains"`
+	InventoryParams      InventoryParams      `protobuf:"bytes,13,opt,name=inventory_params,json=inventoryParams,proto3" json:"inventory_params" yaml:"inventory_params"`
+	ProviderInventories  []ProviderInventory  `protobuf:"bytes,14,rep,name=provider_inventories,json=providerInventories,proto3" json:"provider_inventories" yaml:"provider_inventories"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73efc258394be6e9, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() v1beta4.Params {
+	if m != nil {
+		return m.Params
+	}
+	return v1beta4.Params{}
+}
+
+func (m *GenesisState) GetOrders() []v1beta4.Order {
+	if m != nil {
+		return m.Orders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLeases() []v1beta4.Lease {
+	if m != nil {
+		return m.Leases
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBids() []v1beta4.Bid {
+	if m != nil {
+		return m.Bids
+	}
+	return nil
+}
+
+func (m *GenesisState) GetExpiryParams() ExpiryParams {
+	if m != nil {
+		return m.ExpiryParams
+	}
+	return ExpiryParams{}
+}
+
+func (m *GenesisState) GetOrderAuctions() []OrderAuction {
+	if m != nil {
+		return m.OrderAuctions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGroupAuctions() []GroupAuction {
+	if m != nil {
+		return m.GroupAuctions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLeasePriceProposals() []LeasePriceProposal {
+	if m != nil {
+		return m.LeasePriceProposals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReputationParams() ReputationParams {
+	if m != nil {
+		return m.ReputationParams
+	}
+	return ReputationParams{}
+}
+
+func (m *GenesisState) GetReputations() []ProviderReputation {
+	if m != nil {
+		return m.Reputations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDeregistrationParams() DeregistrationParams {
+	if m != nil {
+		return m.DeregistrationParams
+	}
+	return DeregistrationParams{}
+}
+
+func (m *GenesisState) GetProviderDrains() []ProviderDrain {
+	if m != nil {
+		return m.ProviderDrains
+	}
+	return nil
+}
+
+func (m *GenesisState) GetInventoryParams() InventoryParams {
+	if m != nil {
+		return m.InventoryParams
+	}
+	return InventoryParams{}
+}
+
+func (m *GenesisState) GetProviderInventories() []ProviderInventory {
+	if m != nil {
+		return m.ProviderInventories
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "akash.market.v1beta5.GenesisState")
+}
+
+func init() {
+	proto.RegisterFile("akash/market/v1beta5/genesis.proto", fileDescriptor_73efc258394be6e9)
+}
+
+var fileDescriptor_73efc258394be6e9 = []byte{
+	// 799 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x95, 0x4f, 0x6f, 0xd3, 0x48,
+	0x18, 0xc6, 0xe3, 0x6d, 0x37, 0xbb, 0xeb, 0x34, 0x6d, 0xd7, 0x4d, 0x77, 0xbd, 0x6d, 0x15, 0x67,
+	0x07, 0x0a, 0x29, 0x52, 0x63, 0xf5, 0x4f, 0x2e, 0x20, 0x0e, 0x58, 0x45, 0x15, 0x08, 0x41, 0x34,
+	0xc0, 0x85, 0x4b, 0xe4, 0xd4, 0x23, 0x77, 0xd4, 0xc4, 0x63, 0xcd, 0x38, 0xa1, 0xe1, 0xc2, 0x89,
+	0x0b, 0x27, 0x6e, 0x1c, 0x90, 0xf8, 0x12, 0x7c, 0x89, 0x1e, 0x7b, 0xe4, 0x64, 0xa1, 0xf6, 0xc6,
+	0xd1, 0x9f, 0x00, 0x79, 0x3c, 0x89, 0xff, 0x64, 0x9a, 0x4b, 0x55, 0xcf, 0xfb, 0xbc, 0xbf, 0xf7,
+	0xc9, 0xf3, 0x4e, 0x62, 0x15, 0xd8, 0x67, 0x36, 0x3b, 0x35, 0x07, 0x36, 0x3d, 0x43, 0x81, 0x39,
+	0xda, 0xeb, 0xa1, 0xc0, 0x6e, 0x9b, 0x2e, 0xf2, 0x10, 0xc3, 0xac, 0xe5, 0x53, 0x12, 0x10, 0xad,
+	0xc6, 0x35, 0xad, 0x44, 0xd3, 0x12, 0x9a, 0x8d, 0x9a, 0x4b, 0x5c, 0xc2, 0x05, 0x66, 0xfc, 0x5f,
+	0xa2, 0xdd, 0x68, 0x48, 0x78, 0x87, 0x26, 0xa1, 0x0e, 0xa2, 0x73, 0x15, 0x7d, 0x64, 0x33, 0x24,
+	0x14, 0x75, 0xa9, 0xa2, 0x87, 0x1d, 0x51, 0xff, 0x5f, 0x5a, 0xf7, 0x6d, 0x6a, 0x0f, 0x84, 0xe5,
+	0x0d, 0xf9, 0xc7, 0xb2, 0x87, 0x27, 0x01, 0x26, 0x9e, 0xd0, 0xec, 0x48, 0x35, 0x0e, 0xa2, 0xc8,
+	0xc5, 0x2c, 0xa0, 0x76, 0x46, 0x7a, 0x5b, 0x2a, 0xc5, 0xde, 0x08, 0x79, 0x01, 0xa1, 0xe3, 0x39,
+	0xbe, 0xda, 0x79, 0x5f, 0x4d, 0xa9, 0x84, 0x22, 0x0f, 0xb9, 0x24, 0xc0, 0xd9, 0x91, 0xdb, 0x37,
+	0x28, 0xfd, 0x61, 0x90, 0x91, 0x81, 0x6f, 0x55, 0x75, 0xe9, 0x38, 0xd9, 0xd6, 0xcb, 0xc0, 0x0e,
+	0x90, 0xf6, 0x5a, 0x2d, 0x27, 0x13, 0x75, 0xa5, 0xa1, 0x34, 0x2b, 0xfb, 0x5b, 0x2d, 0xc9, 0xf6,
+	0x0e, 0x5b, 0x1d, 0xae, 0xb1, 0x8c, 0x8b, 0xd0, 0x28, 0xfd, 0x0c, 0x0d, 0xd1, 0x13, 0x85, 0x46,
+	0x75, 0x6c, 0x0f, 0xfa, 0xf7, 0x41, 0xf2, 0x0c, 0xa0, 0x28, 0x68, 0xaf, 0xd4, 0x32, 0x5f, 0x22,
+	0xd3, 0x7f, 0x6b, 0x2c, 0x34, 0x2b, 0xfb, 0x9b, 0x72, 0xec, 0x8b, 0x58, 0x93, 0x52, 0x93, 0x96,
+	0x94, 0x9a, 0x3c, 0x03, 0x28, 0x0a, 0x31, 0x95, 0x2f, 0x9e, 0xe9, 0x0b, 0xf3, 0xa8, 0xcf, 0x62,
+	0x4d, 0x4a, 0x4d, 0x5a, 0x52, 0x6a, 0xf2, 0x0c, 0xa0, 0x28, 0x68, 0x4f, 0xd5, 0xc5, 0x1e, 0x76,
+	0x98, 0xbe, 0xc8, 0x99, 0xff, 0xc9, 0x99, 0x16, 0x76, 0xac, 0x4d, 0x41, 0xe4, 0xf2, 0x28, 0x34,
+	0x2a, 0x09, 0x2f, 0x7e, 0x02, 0x90, 0x1f, 0x6a, 0x23, 0xb5, 0x8a, 0xce, 0x7d, 0x4c, 0xc7, 0x5d,
+	0x91, 0xea, 0xef, 0x3c, 0x55, 0x20, 0x83, 0xb6, 0x5b, 0x8f, 0xb9, 0x54, 0x64, 0xbb, 0x2b, 0xe8,
+	0x79, 0x40, 0x14, 0x1a, 0xb5, 0x64, 0x4c, 0xee, 0x18, 0xc0, 0x25, 0x94, 0x69, 0xd6, 0xde, 0xa9,
+	0xcb, 0x3c, 0xa3, 0xae, 0xb8, 0xb3, 0x4c, 0x2f, 0x37, 0x16, 0x6e, 0x1e, 0xcc, 0x73, 0x7f, 0x94,
+	0x48, 0x2d, 0x53, 0x0c, 0x2e, 0x10, 0xa2, 0xd0, 0x58, 0xcf, 0xac, 0x61, 0x7a, 0x0e, 0x60, 0x95,
+	0x64, 0xda, 0xf9, 0x6c, 0x97, 0x92, 0xa1, 0x9f, 0xce, 0xfe, 0x63, 0xde, 0xec, 0xe3, 0x58, 0x3b,
+	0x33, 0x3b, 0x4f, 0x48, 0x67, 0xe7, 0xcf, 0x01, 0xac, 0xba, 0x99, 0x76, 0xa6, 0x7d, 0x51, 0xd4,
+	0x75, 0xbe, 0xc6, 0xae, 0x4f, 0xf1, 0x49, 0xfc, 0x97, 0xf8, 0x84, 0xd9, 0x7d, 0xa6, 0xff, 0xc9,
+	0x3d, 0x34, 0xe5, 0x1e, 0xf8, 0x0d, 0xe9, 0xc4, 0x1d, 0x1d, 0xd1, 0x60, 0x3d, 0x14, 0x4e, 0xe4,
+	0xb8, 0x28, 0x34, 0xb6, 0x32, 0xb7, 0xa7, 0x58, 0x06, 0x70, 0xad, 0x3f, 0x83, 0x64, 0xda, 0x47,
+	0x45, 0xfd, 0x3b, 0xfd, 0x0a, 0x4e, 0xae, 0xc4, 0x5f, 0xfc, 0x4a, 0xdc, 0x91, 0x3b, 0x83, 0x53,
+	0xb9, 0xb8, 0x16, 0x6d, 0xe1, 0x6b, 0x16, 0x14, 0x85, 0x86, 0x9e, 0x78, 0x9a, 0x29, 0x01, 0xb8,
+	0x4a, 0x0b, 0x20, 0x6d, 0xa8, 0x56, 0xd2, 0x33, 0xa6, 0xab, 0xf3, 0xf2, 0xe9, 0x50, 0x32, 0xc2,
+	0x0e, 0xa2, 0xa9, 0x1b, 0x6b, 0x47, 0xf8, 0xc8, 0x42, 0xa2, 0xd0, 0xd0, 0x8a, 0x0e, 0x18, 0x80,
+	0x59, 0x89, 0xf6, 0x55, 0x51, 0xd7, 0xf3, 0x3f, 0x92, 0x93, 0x1c, 0x2a, 0x3c, 0x87, 0x7b, 0x72,
+	0x07, 0x47, 0xb9, 0x16, 0x91, 0xc5, 0x74, 0x47, 0x52, 0x60, 0xba, 0x23, 0x69, 0x19, 0xc0, 0x9a,
+	0x23, 0x81, 0x6a, 0xef, 0xd5, 0x15, 0x5f, 0x7c, 0xdc, 0xae, 0x43, 0x6d, 0xec, 0x31, 0x7d, 0x89,
+	0x67, 0x73, 0x6b, 0x7e, 0x36, 0x47, 0xb1, 0xd6, 0xda, 0x13, 0x96, 0x8a, 0x8c, 0x28, 0x34, 0xfe,
+	0x11, 0x3f, 0x8d, 0xf9, 0x02, 0x80, 0xcb, 0x7e, 0x96, 0xc0, 0xb4, 0x0f, 0x8a, 0xba, 0x3a, 0x7d,
+	0x37, 0x4c, 0xc2, 0xa9, 0xf2, 0x70, 0xb6, 0xe5, 0x16, 0x9e, 0x4c, 0xd4, 0x22, 0x97, 0x03, 0x61,
+	0x62, 0x06, 0x13, 0x85, 0xc6, 0xbf, 0x89, 0x8b, 0x62, 0x05, 0xc0, 0x15, 0x9c, 0xa7, 0x68, 0x9f,
+	0x15, 0xb5, 0x36, 0x35, 0x3b, 0x29, 0x62, 0xc4, 0xf4, 0x65, 0x1e, 0xc7, 0xdd, 0xf9, 0x71, 0x4c,
+	0x3d, 0x59, 0x0f, 0x84, 0x1b, 0x29, 0x2c, 0x0a, 0x8d, 0xcd, 0x42, 0x2e, 0x99, 0x2a, 0x80, 0x6b,
+	0x7e, 0x81, 0x87, 0x11, 0xb3, 0x9e, 0x5f, 0x5c, 0xd5, 0x95, 0xcb, 0xab, 0xba, 0xf2, 0xe3, 0xaa,
+	0xae, 0x7c, 0xba, 0xae, 0x97, 0x2e, 0xaf, 0xeb, 0xa5, 0xef, 0xd7, 0xf5, 0xd2, 0x9b, 0x43, 0x17,
+	0x07, 0xa7, 0xc3, 0x5e, 0xeb, 0x84, 0x0c, 0x4c, 0x6e, 0x6f, 0xd7, 0x43, 0xc1, 0x5b, 0x42, 0xcf,
+	0x4c, 0x8f, 0x38, 0xc8, 0x3c, 0x9f, 0xbc, 0x10, 0x83, 0xb1, 0x8f, 0xd8, 0xe4, 0xb5, 0xd8, 0x2b,
+	0xf3, 0x97, 0xe1, 0xc1, 0xaf, 0x01, 0x00, 0x16, 0x1c, 0x56, 0x36, 0xce, 0x08, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProviderInventories) > 0 {
+		for iNdEx := len(m.ProviderInventories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProviderInventories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	{
+		size, err := m.InventoryParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if len(m.ProviderDrains) > 0 {
+		for iNdEx := len(m.ProviderDrains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProviderDrains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	{
+		size, err := m.DeregistrationParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.Reputations) > 0 {
+		for iNdEx := len(m.Reputations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reputations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	{
+		size, err := m.ReputationParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.LeasePriceProposals) > 0 {
+		for iNdEx := len(m.LeasePriceProposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LeasePriceProposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.GroupAuctions) > 0 {
+		for iNdEx := len(m.GroupAuctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GroupAuctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.OrderAuctions) > 0 {
+		for iNdEx := len(m.OrderAuctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OrderAuctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.ExpiryParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Bids) > 0 {
+		for iNdEx := len(m.Bids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Leases) > 0 {
+		for iNdEx := len(m.Leases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Leases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Leases) > 0 {
+		for _, e := range m.Leases {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Bids) > 0 {
+		for _, e := range m.Bids {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.ExpiryParams.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.OrderAuctions) > 0 {
+		for _, e := range m.OrderAuctions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GroupAuctions) > 0 {
+		for _, e := range m.GroupAuctions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LeasePriceProposals) > 0 {
+		for _, e := range m.LeasePriceProposals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.ReputationParams.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Reputations) > 0 {
+		for _, e := range m.Reputations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.DeregistrationParams.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ProviderDrains) > 0 {
+		for _, e := range m.ProviderDrains {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.InventoryParams.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ProviderInventories) > 0 {
+		for _, e := range m.ProviderInventories {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orders = append(m.Orders, v1beta4.Order{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Leases = append(m.Leases, v1beta4.Lease{})
+			if err := m.Leases[len(m.Leases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bids = append(m.Bids, v1beta4.Bid{})
+			if err := m.Bids[len(m.Bids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExpiryParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderAuctions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderAuctions = append(m.OrderAuctions, OrderAuction{})
+			if err := m.OrderAuctions[len(m.OrderAuctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupAuctions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupAuctions = append(m.GroupAuctions, GroupAuction{})
+			if err := m.GroupAuctions[len(m.GroupAuctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeasePriceProposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeasePriceProposals = append(m.LeasePriceProposals, LeasePriceProposal{})
+			if err := m.LeasePriceProposals[len(m.LeasePriceProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputationParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReputationParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reputations = append(m.Reputations, ProviderReputation{})
+			if err := m.Reputations[len(m.Reputations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeregistrationParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DeregistrationParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderDrains", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderDrains = append(m.ProviderDrains, ProviderDrain{})
+			if err := m.ProviderDrains[len(m.ProviderDrains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InventoryParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InventoryParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderInventories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderInventories = append(m.ProviderInventories, ProviderInventory{})
+			if err := m.ProviderInventories[len(m.ProviderInventories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
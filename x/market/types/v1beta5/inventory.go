@@ -0,0 +1,206 @@
+package v1beta5
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dtypes "github.com/akash-network/akash-api/go/node/deployment/v1beta3"
+	mtypes "github.com/akash-network/akash-api/go/node/market/v1beta4"
+	akashtypes "github.com/akash-network/akash-api/go/node/types/v1beta3"
+)
+
+const (
+	// InventoryStorageEphemeral is class of storage volumes requested without class attribute
+	InventoryStorageEphemeral = "ephemeral"
+
+	maxInventoryGPUModels      = 32
+	maxInventoryStorageClasses = 16
+	maxInventoryNameLength     = 64
+
+	storageAttributeClass = "class"
+)
+
+// ValidateBasic checks inventory is well-formed and within size bounds
+func (inv ProviderInventory) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(inv.Provider); err != nil {
+		return fmt.Errorf("%w: provider: %s", ErrInventoryInvalid, err)
+	}
+
+	if len(inv.GPU) > maxInventoryGPUModels {
+		return fmt.Errorf("%w: at most %d gpu models allowed", ErrInventoryInvalid, maxInventoryGPUModels)
+	}
+
+	if len(inv.Storage) > maxInventoryStorageClasses {
+		return fmt.Errorf("%w: at most %d storage classes allowed", ErrInventoryInvalid, maxInventoryStorageClasses)
+	}
+
+	gpus := make(map[string]bool, len(inv.GPU))
+	for _, gpu := range inv.GPU {
+		if err := validateInventoryName(gpu.Vendor); err != nil {
+			return fmt.Errorf("%w: gpu vendor: %s", ErrInventoryInvalid, err)
+		}
+
+		if err := validateInventoryName(gpu.Model); err != nil || gpu.Model == "*" {
+			return fmt.Errorf("%w: gpu model %q", ErrInventoryInvalid, gpu.Model)
+		}
+
+		id := gpu.Vendor + "/" + gpu.Model
+		if gpus[id] {
+			return fmt.Errorf("%w: duplicate gpu model %s", ErrInventoryInvalid, id)
+		}
+		gpus[id] = true
+	}
+
+	classes := make(map[string]bool, len(inv.Storage))
+	for _, storage := range inv.Storage {
+		if err := validateInventoryName(storage.Class); err != nil {
+			return fmt.Errorf("%w: storage class: %s", ErrInventoryInvalid, err)
+		}
+
+		if classes[storage.Class] {
+			return fmt.Errorf("%w: duplicate storage class %s", ErrInventoryInvalid, storage.Class)
+		}
+		classes[storage.Class] = true
+	}
+
+	return nil
+}
+
+func validateInventoryName(name string) error {
+	if name == "" || len(name) > maxInventoryNameLength {
+		return fmt.Errorf("name must be 1 to %d characters", maxInventoryNameLength)
+	}
+
+	if strings.ContainsAny(name, "/ ") {
+		return fmt.Errorf("name %q must not contain slashes or spaces", name)
+	}
+
+	return nil
+}
+
+// FitsGroupSpec returns true if free capacity of inventory could run all replicas of given group.
+// Each resource unit is allocated from the remaining capacity and units which fit are offered;
+// the group fits when the offer matches the group spec.
+func (inv ProviderInventory) FitsGroupSpec(gspec dtypes.GroupSpec) bool {
+	free := inv.dup()
+
+	offer := make(mtypes.ResourcesOffer, 0, len(gspec.Resources))
+
+	for _, ru := range gspec.Resources {
+		if free.allocate(ru.Resources, uint64(ru.Count)) {
+			offer = append(offer, mtypes.ResourceOffer{
+				Resources: ru.Resources,
+				Count:     ru.Count,
+			})
+		}
+	}
+
+	if len(offer) != len(gspec.Resources) {
+		return false
+	}
+
+	return offer.MatchGSpec(gspec)
+}
+
+func (inv ProviderInventory) dup() ProviderInventory {
+	res := inv
+	res.GPU = append([]InventoryGPU(nil), inv.GPU...)
+	res.Storage = append([]InventoryStorage(nil), inv.Storage...)
+
+	return res
+}
+
+// allocate subtracts count replicas of resources from the inventory.
+// Inventory is left unchanged if they do not fit.
+func (inv *ProviderInventory) allocate(res akashtypes.Resources, count uint64) bool {
+	next := inv.dup()
+
+	if res.CPU != nil && !subtract(&next.CPU, res.CPU.Units.Value(), count) {
+		return false
+	}
+
+	if res.Memory != nil && !subtract(&next.Memory, res.Memory.Quantity.Value(), count) {
+		return false
+	}
+
+	if res.GPU != nil && res.GPU.Units.Value() > 0 {
+		idx := next.findGPU(res.GPU.Attributes, res.GPU.Units.Value(), count)
+		if idx < 0 {
+			return false
+		}
+
+		next.GPU[idx].Count -= res.GPU.Units.Value() * count
+	}
+
+	for _, volume := range res.Storage {
+		class := InventoryStorageEphemeral
+		for _, attr := range volume.Attributes {
+			if attr.Key == storageAttributeClass {
+				class = attr.Value
+			}
+		}
+
+		fits := false
+		for idx := range next.Storage {
+			if next.Storage[idx].Class == class {
+				fits = subtract(&next.Storage[idx].Quantity, volume.Quantity.Value(), count)
+				break
+			}
+		}
+
+		if !fits {
+			return false
+		}
+	}
+
+	*inv = next
+
+	return true
+}
+
+// findGPU returns index of a GPU model with enough free units for count replicas, -1 if none.
+// Attributes keys are "vendor/<vendor>/model/<model>", model "*" allows any model of the vendor.
+// GPUs requested without attributes may be of any vendor and model.
+func (inv ProviderInventory) findGPU(attrs akashtypes.Attributes, units, count uint64) int {
+	for idx, gpu := range inv.GPU {
+		free := gpu.Count
+		if !subtract(&free, units, count) {
+			continue
+		}
+
+		if len(attrs) == 0 {
+			return idx
+		}
+
+		for _, attr := range attrs {
+			parts := strings.Split(attr.Key, "/")
+			if len(parts) < 4 || parts[0] != "vendor" || parts[2] != "model" {
+				continue
+			}
+
+			if parts[1] == gpu.Vendor && (parts[3] == "*" || parts[3] == gpu.Model) {
+				return idx
+			}
+		}
+	}
+
+	return -1
+}
+
+// subtract subtracts count times val from free, returns false on underflow or overflow
+func subtract(free *uint64, val, count uint64) bool {
+	if count != 0 && val > math.MaxUint64/count {
+		return false
+	}
+
+	if val*count > *free {
+		return false
+	}
+
+	*free -= val * count
+
+	return true
+}
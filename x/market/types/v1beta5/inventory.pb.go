@@ -0,0 +1,1194 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: akash/market/v1beta5/inventory.proto
+
+package v1beta5
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// InventoryParams holds minimum number of blocks between inventory updates of a provider.
+// Zero disables the limit.
+type InventoryParams struct {
+	InventoryUpdatePeriod int64 `protobuf:"varint,1,opt,name=inventory_update_period,json=inventoryUpdatePeriod,proto3" json:"inventory_update_period" yaml:"inventory_update_period"`
+}
+
+func (m *InventoryParams) Reset()         { *m = InventoryParams{} }
+func (m *InventoryParams) String() string { return proto.CompactTextString(m) }
+func (*InventoryParams) ProtoMessage()    {}
+func (*InventoryParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e44b2e4913a99c, []int{0}
+}
+func (m *InventoryParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InventoryParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InventoryParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InventoryParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InventoryParams.Merge(m, src)
+}
+func (m *InventoryParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *InventoryParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_InventoryParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InventoryParams proto.InternalMessageInfo
+
+func (m *InventoryParams) GetInventoryUpdatePeriod() int64 {
+	if m != nil {
+		return m.InventoryUpdatePeriod
+	}
+	return 0
+}
+
+// InventoryGPU is number of free GPUs of a model
+type InventoryGPU struct {
+	Vendor string `protobuf:"bytes,1,opt,name=vendor,proto3" json:"vendor" yaml:"vendor"`
+	Model  string `protobuf:"bytes,2,opt,name=model,proto3" json:"model" yaml:"model"`
+	Count  uint64 `protobuf:"varint,3,opt,name=count,proto3" json:"count" yaml:"count"`
+}
+
+func (m *InventoryGPU) Reset()         { *m = InventoryGPU{} }
+func (m *InventoryGPU) String() string { return proto.CompactTextString(m) }
+func (*InventoryGPU) ProtoMessage()    {}
+func (*InventoryGPU) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e44b2e4913a99c, []int{1}
+}
+func (m *InventoryGPU) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InventoryGPU) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InventoryGPU.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InventoryGPU) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InventoryGPU.Merge(m, src)
+}
+func (m *InventoryGPU) XXX_Size() int {
+	return m.Size()
+}
+func (m *InventoryGPU) XXX_DiscardUnknown() {
+	xxx_messageInfo_InventoryGPU.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InventoryGPU proto.InternalMessageInfo
+
+func (m *InventoryGPU) GetVendor() string {
+	if m != nil {
+		return m.Vendor
+	}
+	return ""
+}
+
+func (m *InventoryGPU) GetModel() string {
+	if m != nil {
+		return m.Model
+	}
+	return ""
+}
+
+func (m *InventoryGPU) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// InventoryStorage is free storage of a class, in bytes
+type InventoryStorage struct {
+	Class    string `protobuf:"bytes,1,opt,name=class,proto3" json:"class" yaml:"class"`
+	Quantity uint64 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity" yaml:"quantity"`
+}
+
+func (m *InventoryStorage) Reset()         { *m = InventoryStorage{} }
+func (m *InventoryStorage) String() string { return proto.CompactTextString(m) }
+func (*InventoryStorage) ProtoMessage()    {}
+func (*InventoryStorage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e44b2e4913a99c, []int{2}
+}
+func (m *InventoryStorage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InventoryStorage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InventoryStorage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InventoryStorage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InventoryStorage.Merge(m, src)
+}
+func (m *InventoryStorage) XXX_Size() int {
+	return m.Size()
+}
+func (m *InventoryStorage) XXX_DiscardUnknown() {
+	xxx_messageInfo_InventoryStorage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InventoryStorage proto.InternalMessageInfo
+
+func (m *InventoryStorage) GetClass() string {
+	if m != nil {
+		return m.Class
+	}
+	return ""
+}
+
+func (m *InventoryStorage) GetQuantity() uint64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+// ProviderInventory is summary of free capacity reported by provider. CPU is in millicores,
+// memory in bytes. It is summed over the whole cluster, so a fit is possible but not guaranteed
+// when capacity is spread across nodes.
+type ProviderInventory struct {
+	Provider  string             `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider" yaml:"provider"`
+	CPU       uint64             `protobuf:"varint,2,opt,name=cpu,proto3" json:"cpu" yaml:"cpu"`
+	Memory    uint64             `protobuf:"varint,3,opt,name=memory,proto3" json:"memory" yaml:"memory"`
+	GPU       []InventoryGPU     `protobuf:"bytes,4,rep,name=gpu,proto3" json:"gpu,omitempty" yaml:"gpu,omitempty"`
+	Storage   []InventoryStorage `protobuf:"bytes,5,rep,name=storage,proto3" json:"storage,omitempty" yaml:"storage,omitempty"`
+	UpdatedAt int64              `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at" yaml:"updated_at"`
+}
+
+func (m *ProviderInventory) Reset()         { *m = ProviderInventory{} }
+func (m *ProviderInventory) String() string { return proto.CompactTextString(m) }
+func (*ProviderInventory) ProtoMessage()    {}
+func (*ProviderInventory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72e44b2e4913a99c, []int{3}
+}
+func (m *ProviderInventory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderInventory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderInventory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProviderInventory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderInventory.Merge(m, src)
+}
+func (m *ProviderInventory) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderInventory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderInventory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderInventory proto.InternalMessageInfo
+
+func (m *ProviderInventory) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *ProviderInventory) GetCPU() uint64 {
+	if m != nil {
+		return m.CPU
+	}
+	return 0
+}
+
+func (m *ProviderInventory) GetMemory() uint64 {
+	if m != nil {
+		return m.Memory
+	}
+	return 0
+}
+
+func (m *ProviderInventory) GetGPU() []InventoryGPU {
+	if m != nil {
+		return m.GPU
+	}
+	return nil
+}
+
+func (m *ProviderInventory) GetStorage() []InventoryStorage {
+	if m != nil {
+		return m.Storage
+	}
+	return nil
+}
+
+func (m *ProviderInventory) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*InventoryParams)(nil), "akash.market.v1beta5.InventoryParams")
+	proto.RegisterType((*InventoryGPU)(nil), "akash.market.v1beta5.InventoryGPU")
+	proto.RegisterType((*InventoryStorage)(nil), "akash.market.v1beta5.InventoryStorage")
+	proto.RegisterType((*ProviderInventory)(nil), "akash.market.v1beta5.ProviderInventory")
+}
+
+func init() {
+	proto.RegisterFile("akash/market/v1beta5/inventory.proto", fileDescriptor_72e44b2e4913a99c)
+}
+
+var fileDescriptor_72e44b2e4913a99c = []byte{
+	// 572 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0x3d, 0x6f, 0xd3, 0x40,
+	0x18, 0xc7, 0x63, 0xdc, 0x16, 0x7a, 0xb4, 0x2a, 0xb5, 0x82, 0x30, 0x2f, 0xf2, 0x45, 0x07, 0x42,
+	0x19, 0xc0, 0x16, 0xb4, 0x5d, 0x40, 0x0c, 0x98, 0xa1, 0x62, 0x41, 0x91, 0x51, 0x16, 0x96, 0xe8,
+	0x12, 0x9f, 0x5c, 0x93, 0xd8, 0x67, 0xec, 0x73, 0x4a, 0x36, 0x46, 0x46, 0xbe, 0x04, 0xdf, 0xa5,
+	0x63, 0x47, 0xa6, 0x13, 0x4a, 0x36, 0x8f, 0xfe, 0x04, 0xe8, 0x5e, 0xec, 0xa4, 0x82, 0x8a, 0x29,
+	0x79, 0x7e, 0xcf, 0xcb, 0xff, 0x6f, 0x3f, 0xe7, 0x03, 0x4f, 0xf0, 0x14, 0x17, 0x67, 0x5e, 0x82,
+	0xf3, 0x29, 0x61, 0xde, 0xfc, 0xc5, 0x98, 0x30, 0x7c, 0xe2, 0xc5, 0xe9, 0x9c, 0xa4, 0x8c, 0xe6,
+	0x0b, 0x37, 0xcb, 0x29, 0xa3, 0x56, 0x57, 0x56, 0xb9, 0xaa, 0xca, 0xd5, 0x55, 0x0f, 0xba, 0x11,
+	0x8d, 0xa8, 0x2c, 0xf0, 0xc4, 0x3f, 0x55, 0x8b, 0xbe, 0x1b, 0xe0, 0xe0, 0x7d, 0xd3, 0x3f, 0xc0,
+	0x39, 0x4e, 0x0a, 0xab, 0x04, 0xf7, 0xda, 0x91, 0xa3, 0x32, 0x0b, 0x31, 0x23, 0xa3, 0x8c, 0xe4,
+	0x31, 0x0d, 0x6d, 0xa3, 0x67, 0xf4, 0x4d, 0xff, 0x4d, 0xc5, 0xe1, 0x75, 0x25, 0x35, 0x87, 0xce,
+	0x02, 0x27, 0xb3, 0x57, 0xe8, 0x9a, 0x02, 0x14, 0xdc, 0x6d, 0x33, 0x43, 0x99, 0x18, 0x28, 0xfe,
+	0xd3, 0x00, 0x7b, 0xad, 0x95, 0xd3, 0xc1, 0xd0, 0x3a, 0x02, 0x3b, 0x73, 0x92, 0x86, 0x34, 0x97,
+	0xb2, 0xbb, 0xfe, 0xc3, 0x8a, 0x43, 0x4d, 0x6a, 0x0e, 0xf7, 0x95, 0x8a, 0x8a, 0x51, 0xa0, 0x13,
+	0x96, 0x07, 0xb6, 0x13, 0x1a, 0x92, 0x99, 0x7d, 0x43, 0xf6, 0xdc, 0xaf, 0x38, 0x54, 0xa0, 0xe6,
+	0x70, 0x4f, 0xb5, 0xc8, 0x10, 0x05, 0x0a, 0x8b, 0x86, 0x09, 0x2d, 0x53, 0x66, 0x9b, 0x3d, 0xa3,
+	0xbf, 0xa5, 0x1a, 0x24, 0x58, 0x37, 0xc8, 0x10, 0x05, 0x0a, 0xa3, 0x6f, 0x06, 0xb8, 0xd3, 0xfa,
+	0xfc, 0xc8, 0x68, 0x8e, 0x23, 0x22, 0xa7, 0xcc, 0x70, 0x51, 0x68, 0xab, 0x6a, 0x8a, 0x00, 0x1b,
+	0x53, 0x44, 0x28, 0xa6, 0x88, 0x5f, 0xeb, 0x35, 0xb8, 0xf5, 0xa5, 0xc4, 0x29, 0x8b, 0xd9, 0x42,
+	0x5a, 0xdd, 0xf2, 0x61, 0xc5, 0x61, 0xcb, 0x6a, 0x0e, 0x0f, 0x54, 0x5b, 0x43, 0x50, 0xd0, 0x26,
+	0x11, 0x37, 0xc1, 0xe1, 0x20, 0xa7, 0xf3, 0x38, 0x24, 0x79, 0x6b, 0x45, 0x8c, 0xcc, 0x34, 0xd4,
+	0x36, 0xe4, 0xc8, 0x86, 0xad, 0x47, 0x36, 0x04, 0x05, 0x6d, 0xd2, 0x72, 0x81, 0x39, 0xc9, 0x4a,
+	0x6d, 0xe5, 0xd1, 0x92, 0x43, 0xf3, 0xdd, 0x60, 0x58, 0x71, 0x28, 0x68, 0xcd, 0x21, 0xd0, 0xcf,
+	0x90, 0x95, 0x28, 0x10, 0x48, 0x2c, 0x27, 0x21, 0x09, 0xcd, 0x17, 0xfa, 0xbd, 0xc9, 0xe5, 0x28,
+	0xb2, 0x5e, 0x8e, 0x8a, 0x51, 0xa0, 0x13, 0xd6, 0x67, 0x60, 0x46, 0x59, 0x69, 0x6f, 0xf5, 0xcc,
+	0xfe, 0xed, 0x97, 0xc8, 0xfd, 0xd7, 0x39, 0x75, 0x37, 0x8f, 0x80, 0x7f, 0x7c, 0xc1, 0x61, 0x47,
+	0x98, 0x39, 0x95, 0x66, 0xf6, 0xa3, 0xac, 0x7c, 0x46, 0x93, 0x98, 0x91, 0x24, 0x93, 0xef, 0xa8,
+	0xab, 0x74, 0xae, 0x60, 0x14, 0x08, 0x11, 0xeb, 0x1c, 0xdc, 0x2c, 0xd4, 0x72, 0xec, 0x6d, 0xa9,
+	0xf7, 0xf4, 0x3f, 0x7a, 0x7a, 0x95, 0xfe, 0x89, 0xd0, 0xac, 0x38, 0x3c, 0xd4, 0xed, 0x57, 0x04,
+	0x6d, 0x25, 0xf8, 0x57, 0x0a, 0x05, 0x8d, 0x9a, 0xe5, 0x03, 0xa0, 0x0e, 0x7c, 0x38, 0xc2, 0xcc,
+	0xde, 0x91, 0x5f, 0xcc, 0xe3, 0x8a, 0xc3, 0x0d, 0x5a, 0x73, 0x78, 0xa8, 0x06, 0xad, 0x19, 0x0a,
+	0x76, 0x75, 0xf0, 0x96, 0xf9, 0x1f, 0x2e, 0x96, 0x8e, 0x71, 0xb9, 0x74, 0x8c, 0xdf, 0x4b, 0xc7,
+	0xf8, 0xb1, 0x72, 0x3a, 0x97, 0x2b, 0xa7, 0xf3, 0x6b, 0xe5, 0x74, 0x3e, 0x1d, 0x47, 0x31, 0x3b,
+	0x2b, 0xc7, 0xee, 0x84, 0x26, 0x9e, 0x7c, 0x9e, 0xe7, 0x29, 0x61, 0xe7, 0x34, 0x9f, 0x7a, 0x29,
+	0x0d, 0x89, 0xf7, 0xb5, 0xb9, 0x1c, 0xd8, 0x22, 0x23, 0x45, 0x73, 0x45, 0x8c, 0x77, 0xe4, 0xd7,
+	0x7e, 0xf4, 0x67, 0x00, 0xb1, 0x22, 0xba, 0x1d, 0x41, 0x04, 0x00, 0x00,
+}
+
+func (m *InventoryParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InventoryParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InventoryParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InventoryUpdatePeriod != 0 {
+		i = encodeVarintInventory(dAtA, i, uint64(m.InventoryUpdatePeriod))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InventoryGPU) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InventoryGPU) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InventoryGPU) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintInventory(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Model) > 0 {
+		i -= len(m.Model)
+		copy(dAtA[i:], m.Model)
+		i = encodeVarintInventory(dAtA, i, uint64(len(m.Model)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Vendor) > 0 {
+		i -= len(m.Vendor)
+		copy(dAtA[i:], m.Vendor)
+		i = encodeVarintInventory(dAtA, i, uint64(len(m.Vendor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InventoryStorage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InventoryStorage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InventoryStorage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Quantity != 0 {
+		i = encodeVarintInventory(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Class) > 0 {
+		i -= len(m.Class)
+		copy(dAtA[i:], m.Class)
+		i = encodeVarintInventory(dAtA, i, uint64(len(m.Class)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderInventory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderInventory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderInventory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpdatedAt != 0 {
+		i = encodeVarintInventory(dAtA, i, uint64(m.UpdatedAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Storage) > 0 {
+		for iNdEx := len(m.Storage) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Storage[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInventory(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.GPU) > 0 {
+		for iNdEx := len(m.GPU) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GPU[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInventory(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Memory != 0 {
+		i = encodeVarintInventory(dAtA, i, uint64(m.Memory))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CPU != 0 {
+		i = encodeVarintInventory(dAtA, i, uint64(m.CPU))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintInventory(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintInventory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovInventory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InventoryParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InventoryUpdatePeriod != 0 {
+		n += 1 + sovInventory(uint64(m.InventoryUpdatePeriod))
+	}
+	return n
+}
+
+func (m *InventoryGPU) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Vendor)
+	if l > 0 {
+		n += 1 + l + sovInventory(uint64(l))
+	}
+	l = len(m.Model)
+	if l > 0 {
+		n += 1 + l + sovInventory(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovInventory(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *InventoryStorage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Class)
+	if l > 0 {
+		n += 1 + l + sovInventory(uint64(l))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovInventory(uint64(m.Quantity))
+	}
+	return n
+}
+
+func (m *ProviderInventory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovInventory(uint64(l))
+	}
+	if m.CPU != 0 {
+		n += 1 + sovInventory(uint64(m.CPU))
+	}
+	if m.Memory != 0 {
+		n += 1 + sovInventory(uint64(m.Memory))
+	}
+	if len(m.GPU) > 0 {
+		for _, e := range m.GPU {
+			l = e.Size()
+			n += 1 + l + sovInventory(uint64(l))
+		}
+	}
+	if len(m.Storage) > 0 {
+		for _, e := range m.Storage {
+			l = e.Size()
+			n += 1 + l + sovInventory(uint64(l))
+		}
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovInventory(uint64(m.UpdatedAt))
+	}
+	return n
+}
+
+func sovInventory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozInventory(x uint64) (n int) {
+	return sovInventory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InventoryParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInventory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InventoryParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InventoryParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InventoryUpdatePeriod", wireType)
+			}
+			m.InventoryUpdatePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInventory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InventoryUpdatePeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInventory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInventory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InventoryGPU) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInventory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InventoryGPU: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InventoryGPU: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vendor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInventory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInventory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInventory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vendor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Model", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInventory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInventory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInventory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Model = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInventory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInventory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInventory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InventoryStorage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInventory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InventoryStorage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InventoryStorage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Class", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInventory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInventory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInventory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Class = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInventory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInventory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInventory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProviderInventory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInventory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderInventory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderInventory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInventory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInventory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInventory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CPU", wireType)
+			}
+			m.CPU = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInventory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CPU |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
+			}
+			m.Memory = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInventory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Memory |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GPU", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInventory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInventory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInventory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GPU = append(m.GPU, InventoryGPU{})
+			if err := m.GPU[len(m.GPU)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInventory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInventory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInventory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Storage = append(m.Storage, InventoryStorage{})
+			if err := m.Storage[len(m.Storage)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInventory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInventory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInventory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipInventory(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowInventory
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInventory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInventory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthInventory
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupInventory
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthInventory
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthInventory        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowInventory          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupInventory = fmt.Errorf("proto: unexpected end of group")
+)
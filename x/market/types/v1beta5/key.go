@@ -0,0 +1,13 @@
+package v1beta5
+
+import (
+	v1beta4 "github.com/akash-network/akash-api/go/node/market/v1beta4"
+)
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = v1beta4.ModuleName
+
+	// RouterKey is the message route for market
+	RouterKey = v1beta4.RouterKey
+)
@@ -0,0 +1,963 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: akash/market/v1beta5/leasemsg.proto
+
+package v1beta5
+
+import (
+	fmt "fmt"
+	v1beta4 "github.com/akash-network/akash-api/go/node/market/v1beta4"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgProposeLeasePrice proposes new price of an active lease, replacing pending proposal if any.
+// It is sent by either the tenant or the provider of the lease.
+type MsgProposeLeasePrice struct {
+	LeaseID  v1beta4.LeaseID `protobuf:"bytes,1,opt,name=lease_id,json=leaseId,proto3" json:"lease_id" yaml:"lease_id"`
+	Proposer string          `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer" yaml:"proposer"`
+	Price    types.DecCoin   `protobuf:"bytes,3,opt,name=price,proto3" json:"price" yaml:"price"`
+}
+
+func (m *MsgProposeLeasePrice) Reset()         { *m = MsgProposeLeasePrice{} }
+func (m *MsgProposeLeasePrice) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeLeasePrice) ProtoMessage()    {}
+func (*MsgProposeLeasePrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_394bd78777079a40, []int{0}
+}
+func (m *MsgProposeLeasePrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposeLeasePrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeLeasePrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgProposeLeasePrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeLeasePrice.Merge(m, src)
+}
+func (m *MsgProposeLeasePrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeLeasePrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeLeasePrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProposeLeasePrice proto.InternalMessageInfo
+
+func (m *MsgProposeLeasePrice) GetLeaseID() v1beta4.LeaseID {
+	if m != nil {
+		return m.LeaseID
+	}
+	return v1beta4.LeaseID{}
+}
+
+func (m *MsgProposeLeasePrice) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *MsgProposeLeasePrice) GetPrice() types.DecCoin {
+	if m != nil {
+		return m.Price
+	}
+	return types.DecCoin{}
+}
+
+// MsgProposeLeasePriceResponse defines the Msg/ProposeLeasePrice response type.
+type MsgProposeLeasePriceResponse struct {
+}
+
+func (m *MsgProposeLeasePriceResponse) Reset()         { *m = MsgProposeLeasePriceResponse{} }
+func (m *MsgProposeLeasePriceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeLeasePriceResponse) ProtoMessage()    {}
+func (*MsgProposeLeasePriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_394bd78777079a40, []int{1}
+}
+func (m *MsgProposeLeasePriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposeLeasePriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeLeasePriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgProposeLeasePriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeLeasePriceResponse.Merge(m, src)
+}
+func (m *MsgProposeLeasePriceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeLeasePriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeLeasePriceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProposeLeasePriceResponse proto.InternalMessageInfo
+
+// MsgAcceptLeasePrice accepts pending price proposal of an active lease.
+// It is sent by the lease party which did not propose the price.
+type MsgAcceptLeasePrice struct {
+	LeaseID  v1beta4.LeaseID `protobuf:"bytes,1,opt,name=lease_id,json=leaseId,proto3" json:"lease_id" yaml:"lease_id"`
+	Acceptor string          `protobuf:"bytes,2,opt,name=acceptor,proto3" json:"acceptor" yaml:"acceptor"`
+	// Price must match price of pending proposal so that acceptor never agrees
+	// to a proposal replaced after the message was signed
+	Price types.DecCoin `protobuf:"bytes,3,opt,name=price,proto3" json:"price" yaml:"price"`
+}
+
+func (m *MsgAcceptLeasePrice) Reset()         { *m = MsgAcceptLeasePrice{} }
+func (m *MsgAcceptLeasePrice) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptLeasePrice) ProtoMessage()    {}
+func (*MsgAcceptLeasePrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_394bd78777079a40, []int{2}
+}
+func (m *MsgAcceptLeasePrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptLeasePrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptLeasePrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptLeasePrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptLeasePrice.Merge(m, src)
+}
+func (m *MsgAcceptLeasePrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptLeasePrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptLeasePrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptLeasePrice proto.InternalMessageInfo
+
+func (m *MsgAcceptLeasePrice) GetLeaseID() v1beta4.LeaseID {
+	if m != nil {
+		return m.LeaseID
+	}
+	return v1beta4.LeaseID{}
+}
+
+func (m *MsgAcceptLeasePrice) GetAcceptor() string {
+	if m != nil {
+		return m.Acceptor
+	}
+	return ""
+}
+
+func (m *MsgAcceptLeasePrice) GetPrice() types.DecCoin {
+	if m != nil {
+		return m.Price
+	}
+	return types.DecCoin{}
+}
+
+// MsgAcceptLeasePriceResponse defines the Msg/AcceptLeasePrice response type.
+type MsgAcceptLeasePriceResponse struct {
+}
+
+func (m *MsgAcceptLeasePriceResponse) Reset()         { *m = MsgAcceptLeasePriceResponse{} }
+func (m *MsgAcceptLeasePriceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptLeasePriceResponse) ProtoMessage()    {}
+func (*MsgAcceptLeasePriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_394bd78777079a40, []int{3}
+}
+func (m *MsgAcceptLeasePriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptLeasePriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptLeasePriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptLeasePriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptLeasePriceResponse.Merge(m, src)
+}
+func (m *MsgAcceptLeasePriceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptLeasePriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptLeasePriceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptLeasePriceResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgProposeLeasePrice)(nil), "akash.market.v1beta5.MsgProposeLeasePrice")
+	proto.RegisterType((*MsgProposeLeasePriceResponse)(nil), "akash.market.v1beta5.MsgProposeLeasePriceResponse")
+	proto.RegisterType((*MsgAcceptLeasePrice)(nil), "akash.market.v1beta5.MsgAcceptLeasePrice")
+	proto.RegisterType((*MsgAcceptLeasePriceResponse)(nil), "akash.market.v1beta5.MsgAcceptLeasePriceResponse")
+}
+
+func init() {
+	proto.RegisterFile("akash/market/v1beta5/leasemsg.proto", fileDescriptor_394bd78777079a40)
+}
+
+var fileDescriptor_394bd78777079a40 = []byte{
+	// 408 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x93, 0xbf, 0xae, 0xd3, 0x30,
+	0x18, 0xc5, 0x93, 0xf2, 0xe7, 0x5e, 0x0c, 0x12, 0x52, 0xe8, 0x50, 0x5d, 0x6e, 0xed, 0xca, 0x2c,
+	0x77, 0xc1, 0x56, 0xa1, 0x2c, 0x65, 0x22, 0x74, 0xa9, 0x44, 0x51, 0xc9, 0xc8, 0x82, 0x1c, 0xd7,
+	0x4a, 0xa3, 0x36, 0x71, 0x14, 0x87, 0x3f, 0x7d, 0x0b, 0x16, 0x98, 0x79, 0x9c, 0x8e, 0x1d, 0x99,
+	0x22, 0x94, 0x2e, 0xa8, 0x63, 0x9f, 0x00, 0xc5, 0x8e, 0x5b, 0x21, 0x65, 0x45, 0x6c, 0xd1, 0xf9,
+	0xce, 0xf9, 0xbe, 0xa3, 0x9f, 0x1c, 0xf0, 0x84, 0xad, 0x98, 0x5a, 0xd2, 0x84, 0xe5, 0x2b, 0x51,
+	0xd0, 0x4f, 0xc3, 0x50, 0x14, 0xec, 0x05, 0x5d, 0x0b, 0xa6, 0x44, 0xa2, 0x22, 0x92, 0xe5, 0xb2,
+	0x90, 0x5e, 0x57, 0x9b, 0x88, 0x31, 0x91, 0xc6, 0x74, 0xd5, 0x8d, 0x64, 0x24, 0xb5, 0x81, 0xd6,
+	0x5f, 0xc6, 0x7b, 0x05, 0xb9, 0x54, 0x89, 0x54, 0x34, 0x64, 0x4a, 0x34, 0xfb, 0x86, 0x94, 0xcb,
+	0x38, 0x6d, 0xe6, 0x83, 0x96, 0x83, 0x23, 0x73, 0xd0, 0x38, 0xf0, 0xf7, 0x0e, 0xe8, 0xce, 0x54,
+	0x34, 0xcf, 0x65, 0x26, 0x95, 0x78, 0x53, 0x4f, 0xe6, 0x79, 0xcc, 0x85, 0xb7, 0x04, 0x97, 0xda,
+	0xf7, 0x21, 0x5e, 0xf4, 0xdc, 0x81, 0x7b, 0x73, 0xff, 0x59, 0x9f, 0xb4, 0x34, 0x1b, 0x11, 0x9d,
+	0x99, 0x4e, 0x7c, 0xb2, 0x2d, 0x91, 0x53, 0x95, 0xe8, 0xa2, 0x11, 0x0e, 0x25, 0x3a, 0x6d, 0x38,
+	0x96, 0xe8, 0xe1, 0x86, 0x25, 0xeb, 0x31, 0xb6, 0x0a, 0x0e, 0x2e, 0xf4, 0xe7, 0x74, 0xe1, 0xbd,
+	0x04, 0x97, 0x99, 0x39, 0x9f, 0xf7, 0x3a, 0x03, 0xf7, 0xe6, 0x9e, 0x8f, 0xea, 0xac, 0xd5, 0xce,
+	0x59, 0xab, 0xe0, 0xe0, 0x34, 0xf4, 0xde, 0x81, 0x3b, 0x59, 0xdd, 0xb7, 0x77, 0x4b, 0x77, 0xbc,
+	0x26, 0x86, 0x08, 0xa9, 0x89, 0x34, 0x15, 0x87, 0x64, 0x22, 0xf8, 0x6b, 0x19, 0xa7, 0x7e, 0xbf,
+	0xae, 0x78, 0x28, 0x91, 0x89, 0x1c, 0x4b, 0xf4, 0xc0, 0x2e, 0x8e, 0xb9, 0xc0, 0x81, 0x91, 0xc7,
+	0xb7, 0x7f, 0xff, 0x40, 0x0e, 0x86, 0xe0, 0xba, 0x8d, 0x4b, 0x20, 0x54, 0x26, 0x53, 0x25, 0xf0,
+	0xb7, 0x0e, 0x78, 0x34, 0x53, 0xd1, 0x2b, 0xce, 0x45, 0x56, 0xfc, 0x2f, 0x6e, 0x4c, 0x5f, 0x97,
+	0x7f, 0x71, 0xb3, 0xda, 0x39, 0x6b, 0x15, 0x1c, 0x9c, 0x86, 0xff, 0x8e, 0x5b, 0x1f, 0x3c, 0x6e,
+	0xc1, 0x62, 0xb1, 0xf9, 0x6f, 0xb7, 0x15, 0x74, 0x77, 0x15, 0x74, 0x7f, 0x55, 0xd0, 0xfd, 0xba,
+	0x87, 0xce, 0x6e, 0x0f, 0x9d, 0x9f, 0x7b, 0xe8, 0xbc, 0x1f, 0x45, 0x71, 0xb1, 0xfc, 0x18, 0x12,
+	0x2e, 0x13, 0xaa, 0x81, 0x3d, 0x4d, 0x45, 0xf1, 0x59, 0xe6, 0x2b, 0x9a, 0xca, 0x85, 0xa0, 0x5f,
+	0xec, 0x2b, 0x2e, 0x36, 0x99, 0x50, 0xf6, 0xe7, 0x09, 0xef, 0xea, 0x67, 0xfc, 0xfc, 0xcf, 0x00,
+	0x6b, 0xd2, 0x04, 0xfc, 0x5b, 0x03, 0x00, 0x00,
+}
+
+func (m *MsgProposeLeasePrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeLeasePrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeLeasePrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLeasemsg(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintLeasemsg(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.LeaseID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLeasemsg(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposeLeasePriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeLeasePriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeLeasePriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptLeasePrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptLeasePrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptLeasePrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLeasemsg(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Acceptor) > 0 {
+		i -= len(m.Acceptor)
+		copy(dAtA[i:], m.Acceptor)
+		i = encodeVarintLeasemsg(dAtA, i, uint64(len(m.Acceptor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.LeaseID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLeasemsg(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptLeasePriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptLeasePriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptLeasePriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLeasemsg(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLeasemsg(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgProposeLeasePrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LeaseID.Size()
+	n += 1 + l + sovLeasemsg(uint64(l))
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovLeasemsg(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovLeasemsg(uint64(l))
+	return n
+}
+
+func (m *MsgProposeLeasePriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAcceptLeasePrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LeaseID.Size()
+	n += 1 + l + sovLeasemsg(uint64(l))
+	l = len(m.Acceptor)
+	if l > 0 {
+		n += 1 + l + sovLeasemsg(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovLeasemsg(uint64(l))
+	return n
+}
+
+func (m *MsgAcceptLeasePriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovLeasemsg(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLeasemsg(x uint64) (n int) {
+	return sovLeasemsg(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgProposeLeasePrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLeasemsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposeLeasePrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposeLeasePrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLeasemsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLeasemsg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLeasemsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LeaseID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLeasemsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLeasemsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLeasemsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLeasemsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLeasemsg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLeasemsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLeasemsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLeasemsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposeLeasePriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLeasemsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposeLeasePriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposeLeasePriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLeasemsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLeasemsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptLeasePrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLeasemsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptLeasePrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptLeasePrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLeasemsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLeasemsg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLeasemsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LeaseID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acceptor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLeasemsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLeasemsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLeasemsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Acceptor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLeasemsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLeasemsg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLeasemsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLeasemsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLeasemsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptLeasePriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLeasemsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptLeasePriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptLeasePriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLeasemsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLeasemsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipLeasemsg(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowLeasemsg
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLeasemsg
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLeasemsg
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthLeasemsg
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupLeasemsg
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthLeasemsg
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthLeasemsg        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowLeasemsg          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupLeasemsg = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,115 @@
+package v1beta5
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	v1beta4 "github.com/akash-network/akash-api/go/node/market/v1beta4"
+)
+
+const (
+	MsgTypeProposeLeasePrice = "propose-lease-price"
+	MsgTypeAcceptLeasePrice  = "accept-lease-price"
+)
+
+var (
+	_, _ sdk.Msg = &MsgProposeLeasePrice{}, &MsgAcceptLeasePrice{}
+)
+
+// NewMsgProposeLeasePrice creates a new MsgProposeLeasePrice instance
+func NewMsgProposeLeasePrice(id v1beta4.LeaseID, proposer sdk.AccAddress, price sdk.DecCoin) *MsgProposeLeasePrice {
+	return &MsgProposeLeasePrice{
+		LeaseID:  id,
+		Proposer: proposer.String(),
+		Price:    price,
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgProposeLeasePrice) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface
+func (msg MsgProposeLeasePrice) Type() string { return MsgTypeProposeLeasePrice }
+
+// GetSignBytes encodes the message for signing
+func (msg MsgProposeLeasePrice) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgProposeLeasePrice) GetSigners() []sdk.AccAddress {
+	proposer, err := sdk.AccAddressFromBech32(msg.Proposer)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{proposer}
+}
+
+// ValidateBasic does basic validation of lease id, proposer and price
+func (msg MsgProposeLeasePrice) ValidateBasic() error {
+	if err := validateLeaseParty(msg.LeaseID, msg.Proposer); err != nil {
+		return err
+	}
+
+	if !msg.Price.IsValid() || !msg.Price.IsPositive() {
+		return v1beta4.ErrBidInvalidPrice
+	}
+
+	return nil
+}
+
+// NewMsgAcceptLeasePrice creates a new MsgAcceptLeasePrice instance
+func NewMsgAcceptLeasePrice(id v1beta4.LeaseID, acceptor sdk.AccAddress, price sdk.DecCoin) *MsgAcceptLeasePrice {
+	return &MsgAcceptLeasePrice{
+		LeaseID:  id,
+		Acceptor: acceptor.String(),
+		Price:    price,
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgAcceptLeasePrice) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface
+func (msg MsgAcceptLeasePrice) Type() string { return MsgTypeAcceptLeasePrice }
+
+// GetSignBytes encodes the message for signing
+func (msg MsgAcceptLeasePrice) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgAcceptLeasePrice) GetSigners() []sdk.AccAddress {
+	acceptor, err := sdk.AccAddressFromBech32(msg.Acceptor)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{acceptor}
+}
+
+// ValidateBasic does basic validation of lease id, acceptor and expected price
+func (msg MsgAcceptLeasePrice) ValidateBasic() error {
+	if err := validateLeaseParty(msg.LeaseID, msg.Acceptor); err != nil {
+		return err
+	}
+
+	if !msg.Price.IsValid() || !msg.Price.IsPositive() {
+		return v1beta4.ErrBidInvalidPrice
+	}
+
+	return nil
+}
+
+func validateLeaseParty(id v1beta4.LeaseID, party string) error {
+	if err := id.Validate(); err != nil {
+		return err
+	}
+
+	if party != id.Owner && party != id.Provider {
+		return sdkerrors.ErrUnauthorized.Wrap("not a lease party")
+	}
+
+	return nil
+}
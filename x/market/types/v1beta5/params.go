@@ -0,0 +1,115 @@
+package v1beta5
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+const (
+	keyOrderTTL = "OrderTTL"
+	keyBidTTL   = "BidTTL"
+)
+
+var _ paramtypes.ParamSet = (*ExpiryParams)(nil)
+
+// ParamSetPairs implements paramtypes.ParamSet. Expiry params are kept in the market params
+// subspace next to v1beta4.Params and can be changed by parameter change proposals.
+func (p *ExpiryParams) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair([]byte(keyOrderTTL), &p.OrderTTL, validateBlocks),
+		paramtypes.NewParamSetPair([]byte(keyBidTTL), &p.BidTTL, validateBlocks),
+	}
+}
+
+func (p ExpiryParams) Validate() error {
+	if err := validateBlocks(p.OrderTTL); err != nil {
+		return err
+	}
+
+	return validateBlocks(p.BidTTL)
+}
+
+func validateBlocks(i interface{}) error {
+	val, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if val < 0 {
+		return fmt.Errorf("number of blocks must not be negative: %d", val)
+	}
+
+	return nil
+}
+
+const (
+	keyMinLeaseDuration    = "MinLeaseDuration"
+	keyEarlyCloseSlashRate = "EarlyCloseSlashRate"
+)
+
+var _ paramtypes.ParamSet = (*ReputationParams)(nil)
+
+// ParamSetPairs implements paramtypes.ParamSet
+func (p *ReputationParams) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair([]byte(keyMinLeaseDuration), &p.MinLeaseDuration, validateBlocks),
+		paramtypes.NewParamSetPair([]byte(keyEarlyCloseSlashRate), &p.EarlyCloseSlashRate, validateSlashRate),
+	}
+}
+
+func (p ReputationParams) Validate() error {
+	if err := validateBlocks(p.MinLeaseDuration); err != nil {
+		return err
+	}
+
+	return validateSlashRate(p.EarlyCloseSlashRate)
+}
+
+func validateSlashRate(i interface{}) error {
+	val, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if val.IsNil() || val.IsNegative() || val.GT(sdk.OneDec()) {
+		return fmt.Errorf("slash rate must be within [0, 1]: %s", val)
+	}
+
+	return nil
+}
+
+const (
+	keyProviderDrainPeriod = "ProviderDrainPeriod"
+)
+
+var _ paramtypes.ParamSet = (*DeregistrationParams)(nil)
+
+// ParamSetPairs implements paramtypes.ParamSet
+func (p *DeregistrationParams) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair([]byte(keyProviderDrainPeriod), &p.ProviderDrainPeriod, validateBlocks),
+	}
+}
+
+func (p DeregistrationParams) Validate() error {
+	return validateBlocks(p.ProviderDrainPeriod)
+}
+
+const (
+	keyInventoryUpdatePeriod = "InventoryUpdatePeriod"
+)
+
+var _ paramtypes.ParamSet = (*InventoryParams)(nil)
+
+// ParamSetPairs implements paramtypes.ParamSet
+func (p *InventoryParams) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair([]byte(keyInventoryUpdatePeriod), &p.InventoryUpdatePeriod, validateBlocks),
+	}
+}
+
+func (p InventoryParams) Validate() error {
+	return validateBlocks(p.InventoryUpdatePeriod)
+}
@@ -0,0 +1,343 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: akash/market/v1beta5/params.proto
+
+package v1beta5
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ExpiryParams holds number of blocks orders and bids may stay open.
+// Zero disables expiry.
+type ExpiryParams struct {
+	OrderTTL int64 `protobuf:"varint,1,opt,name=order_ttl,json=orderTtl,proto3" json:"order_ttl" yaml:"order_ttl"`
+	BidTTL   int64 `protobuf:"varint,2,opt,name=bid_ttl,json=bidTtl,proto3" json:"bid_ttl" yaml:"bid_ttl"`
+}
+
+func (m *ExpiryParams) Reset()         { *m = ExpiryParams{} }
+func (m *ExpiryParams) String() string { return proto.CompactTextString(m) }
+func (*ExpiryParams) ProtoMessage()    {}
+func (*ExpiryParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5db3b08f7b20cd98, []int{0}
+}
+func (m *ExpiryParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExpiryParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExpiryParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExpiryParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExpiryParams.Merge(m, src)
+}
+func (m *ExpiryParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExpiryParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExpiryParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExpiryParams proto.InternalMessageInfo
+
+func (m *ExpiryParams) GetOrderTTL() int64 {
+	if m != nil {
+		return m.OrderTTL
+	}
+	return 0
+}
+
+func (m *ExpiryParams) GetBidTTL() int64 {
+	if m != nil {
+		return m.BidTTL
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ExpiryParams)(nil), "akash.market.v1beta5.ExpiryParams")
+}
+
+func init() { proto.RegisterFile("akash/market/v1beta5/params.proto", fileDescriptor_5db3b08f7b20cd98) }
+
+var fileDescriptor_5db3b08f7b20cd98 = []byte{
+	// 264 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0xcc, 0x4e, 0x2c,
+	0xce, 0xd0, 0xcf, 0x4d, 0x2c, 0xca, 0x4e, 0x2d, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34,
+	0xd5, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x01,
+	0x2b, 0xd1, 0x83, 0x28, 0xd1, 0x83, 0x2a, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b, 0xd0,
+	0x07, 0xb1, 0x20, 0x6a, 0x95, 0xe6, 0x30, 0x72, 0xf1, 0xb8, 0x56, 0x14, 0x64, 0x16, 0x55, 0x06,
+	0x80, 0x8d, 0x10, 0xf2, 0xe2, 0xe2, 0xcc, 0x2f, 0x4a, 0x49, 0x2d, 0x8a, 0x2f, 0x29, 0xc9, 0x91,
+	0x60, 0x54, 0x60, 0xd4, 0x60, 0x76, 0xd2, 0x7d, 0x74, 0x4f, 0x9e, 0xc3, 0x1f, 0x24, 0x18, 0x12,
+	0xe2, 0xf3, 0xea, 0x9e, 0x3c, 0x42, 0xc1, 0xa7, 0x7b, 0xf2, 0x02, 0x95, 0x89, 0xb9, 0x39, 0x56,
+	0x4a, 0x70, 0x21, 0xa5, 0x20, 0x0e, 0x30, 0x3b, 0xa4, 0x24, 0x47, 0xc8, 0x81, 0x8b, 0x3d, 0x29,
+	0x33, 0x05, 0x6c, 0x12, 0x13, 0xd8, 0x24, 0xf5, 0x47, 0xf7, 0xe4, 0xd9, 0x9c, 0x32, 0x53, 0x20,
+	0xe6, 0xc0, 0x24, 0x3f, 0xdd, 0x93, 0xe7, 0x83, 0x98, 0x02, 0x15, 0x50, 0x0a, 0x62, 0x4b, 0xca,
+	0x4c, 0x09, 0x29, 0xc9, 0x71, 0xf2, 0x3b, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07,
+	0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86,
+	0x28, 0x93, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0xb0, 0x7f, 0x75,
+	0xf3, 0x52, 0x4b, 0xca, 0xf3, 0x8b, 0xb2, 0xf5, 0xf3, 0xf2, 0x53, 0x52, 0xf5, 0x2b, 0x60, 0x21,
+	0x54, 0x52, 0x59, 0x90, 0x5a, 0x0c, 0x0b, 0xa7, 0x24, 0x36, 0xb0, 0xaf, 0x8d, 0x01, 0x03, 0x00,
+	0x81, 0xe1, 0x91, 0xad, 0x46, 0x01, 0x00, 0x00,
+}
+
+func (m *ExpiryParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExpiryParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExpiryParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BidTTL != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BidTTL))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OrderTTL != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.OrderTTL))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ExpiryParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderTTL != 0 {
+		n += 1 + sovParams(uint64(m.OrderTTL))
+	}
+	if m.BidTTL != 0 {
+		n += 1 + sovParams(uint64(m.BidTTL))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ExpiryParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExpiryParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExpiryParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderTTL", wireType)
+			}
+			m.OrderTTL = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderTTL |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidTTL", wireType)
+			}
+			m.BidTTL = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BidTTL |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,1501 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: akash/market/v1beta5/query.proto
+
+package v1beta5
+
+import (
+	context "context"
+	fmt "fmt"
+	v1beta3 "github.com/akash-network/akash-api/go/node/deployment/v1beta3"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryProviderReputationRequest is request type for the Query/ProviderReputation RPC method
+type QueryProviderReputationRequest struct {
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+}
+
+func (m *QueryProviderReputationRequest) Reset()         { *m = QueryProviderReputationRequest{} }
+func (m *QueryProviderReputationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderReputationRequest) ProtoMessage()    {}
+func (*QueryProviderReputationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4fc8c96bdc37dc38, []int{0}
+}
+func (m *QueryProviderReputationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderReputationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderReputationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProviderReputationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderReputationRequest.Merge(m, src)
+}
+func (m *QueryProviderReputationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderReputationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderReputationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProviderReputationRequest proto.InternalMessageInfo
+
+func (m *QueryProviderReputationRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+// QueryProviderReputationResponse is response type for the Query/ProviderReputation RPC method
+type QueryProviderReputationResponse struct {
+	Reputation ProviderReputation `protobuf:"bytes,1,opt,name=reputation,proto3" json:"reputation" yaml:"reputation"`
+}
+
+func (m *QueryProviderReputationResponse) Reset()         { *m = QueryProviderReputationResponse{} }
+func (m *QueryProviderReputationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderReputationResponse) ProtoMessage()    {}
+func (*QueryProviderReputationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4fc8c96bdc37dc38, []int{1}
+}
+func (m *QueryProviderReputationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderReputationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderReputationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProviderReputationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderReputationResponse.Merge(m, src)
+}
+func (m *QueryProviderReputationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderReputationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderReputationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProviderReputationResponse proto.InternalMessageInfo
+
+func (m *QueryProviderReputationResponse) GetReputation() ProviderReputation {
+	if m != nil {
+		return m.Reputation
+	}
+	return ProviderReputation{}
+}
+
+// QueryProviderInventoryRequest is request type for the Query/ProviderInventory RPC method
+type QueryProviderInventoryRequest struct {
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+}
+
+func (m *QueryProviderInventoryRequest) Reset()         { *m = QueryProviderInventoryRequest{} }
+func (m *QueryProviderInventoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderInventoryRequest) ProtoMessage()    {}
+func (*QueryProviderInventoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4fc8c96bdc37dc38, []int{2}
+}
+func (m *QueryProviderInventoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderInventoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderInventoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProviderInventoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderInventoryRequest.Merge(m, src)
+}
+func (m *QueryProviderInventoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderInventoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderInventoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProviderInventoryRequest proto.InternalMessageInfo
+
+func (m *QueryProviderInventoryRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+// QueryProviderInventoryResponse is response type for the Query/ProviderInventory RPC method
+type QueryProviderInventoryResponse struct {
+	Inventory ProviderInventory `protobuf:"bytes,1,opt,name=inventory,proto3" json:"inventory" yaml:"inventory"`
+}
+
+func (m *QueryProviderInventoryResponse) Reset()         { *m = QueryProviderInventoryResponse{} }
+func (m *QueryProviderInventoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderInventoryResponse) ProtoMessage()    {}
+func (*QueryProviderInventoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4fc8c96bdc37dc38, []int{3}
+}
+func (m *QueryProviderInventoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderInventoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderInventoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProviderInventoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderInventoryResponse.Merge(m, src)
+}
+func (m *QueryProviderInventoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderInventoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderInventoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProviderInventoryResponse proto.InternalMessageInfo
+
+func (m *QueryProviderInventoryResponse) GetInventory() ProviderInventory {
+	if m != nil {
+		return m.Inventory
+	}
+	return ProviderInventory{}
+}
+
+// QueryProvidersFitRequest is request type for the Query/ProvidersFit RPC method
+type QueryProvidersFitRequest struct {
+	GroupSpec  v1beta3.GroupSpec  `protobuf:"bytes,1,opt,name=group_spec,json=groupSpec,proto3" json:"group_spec" yaml:"group_spec"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryProvidersFitRequest) Reset()         { *m = QueryProvidersFitRequest{} }
+func (m *QueryProvidersFitRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProvidersFitRequest) ProtoMessage()    {}
+func (*QueryProvidersFitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4fc8c96bdc37dc38, []int{4}
+}
+func (m *QueryProvidersFitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProvidersFitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProvidersFitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProvidersFitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProvidersFitRequest.Merge(m, src)
+}
+func (m *QueryProvidersFitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProvidersFitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProvidersFitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProvidersFitRequest proto.InternalMessageInfo
+
+func (m *QueryProvidersFitRequest) GetGroupSpec() v1beta3.GroupSpec {
+	if m != nil {
+		return m.GroupSpec
+	}
+	return v1beta3.GroupSpec{}
+}
+
+func (m *QueryProvidersFitRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryProvidersFitResponse is response type for the Query/ProvidersFit RPC method
+type QueryProvidersFitResponse struct {
+	Inventories []ProviderInventory `protobuf:"bytes,1,rep,name=inventories,proto3" json:"inventories" yaml:"inventories"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryProvidersFitResponse) Reset()         { *m = QueryProvidersFitResponse{} }
+func (m *QueryProvidersFitResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProvidersFitResponse) ProtoMessage()    {}
+func (*QueryProvidersFitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4fc8c96bdc37dc38, []int{5}
+}
+func (m *QueryProvidersFitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProvidersFitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProvidersFitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProvidersFitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProvidersFitResponse.Merge(m, src)
+}
+func (m *QueryProvidersFitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProvidersFitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProvidersFitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProvidersFitResponse proto.InternalMessageInfo
+
+func (m *QueryProvidersFitResponse) GetInventories() []ProviderInventory {
+	if m != nil {
+		return m.Inventories
+	}
+	return nil
+}
+
+func (m *QueryProvidersFitResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryProviderReputationRequest)(nil), "akash.market.v1beta5.QueryProviderReputationRequest")
+	proto.RegisterType((*QueryProviderReputationResponse)(nil), "akash.market.v1beta5.QueryProviderReputationResponse")
+	proto.RegisterType((*QueryProviderInventoryRequest)(nil), "akash.market.v1beta5.QueryProviderInventoryRequest")
+	proto.RegisterType((*QueryProviderInventoryResponse)(nil), "akash.market.v1beta5.QueryProviderInventoryResponse")
+	proto.RegisterType((*QueryProvidersFitRequest)(nil), "akash.market.v1beta5.QueryProvidersFitRequest")
+	proto.RegisterType((*QueryProvidersFitResponse)(nil), "akash.market.v1beta5.QueryProvidersFitResponse")
+}
+
+func init() { proto.RegisterFile("akash/market/v1beta5/query.proto", fileDescriptor_4fc8c96bdc37dc38) }
+
+var fileDescriptor_4fc8c96bdc37dc38 = []byte{
+	// 572 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x31, 0x6f, 0xd3, 0x40,
+	0x18, 0xcd, 0x15, 0x81, 0xc8, 0x95, 0x81, 0x9e, 0x3a, 0x04, 0x4b, 0xd8, 0x91, 0xa1, 0x24, 0x20,
+	0x71, 0xa7, 0x36, 0xe9, 0x02, 0x4c, 0x19, 0x5a, 0xb1, 0xa0, 0x62, 0x36, 0x16, 0xe4, 0x24, 0x9f,
+	0x5c, 0x2b, 0x89, 0xcf, 0xf1, 0x5d, 0x02, 0x59, 0x90, 0xf8, 0x03, 0x88, 0x81, 0x1f, 0xd5, 0x8d,
+	0x8e, 0x88, 0x21, 0x42, 0xc9, 0xc6, 0x98, 0x99, 0x01, 0xc5, 0x3e, 0xfb, 0x1c, 0xe2, 0xb6, 0xc9,
+	0xd6, 0x9e, 0xdf, 0xf7, 0xbe, 0xf7, 0x5e, 0xde, 0x1d, 0xae, 0xba, 0x3d, 0x57, 0x9c, 0xb3, 0x81,
+	0x1b, 0xf5, 0x40, 0xb2, 0xf1, 0x61, 0x1b, 0xa4, 0x7b, 0xcc, 0x86, 0x23, 0x88, 0x26, 0x34, 0x8c,
+	0xb8, 0xe4, 0x64, 0x3f, 0x46, 0xd0, 0x04, 0x41, 0x15, 0xc2, 0xd8, 0xf7, 0xb8, 0xc7, 0x63, 0x00,
+	0x5b, 0xfe, 0x95, 0x60, 0x8d, 0x67, 0x1d, 0x2e, 0x06, 0x5c, 0xb0, 0xb6, 0x2b, 0x20, 0x21, 0x51,
+	0x94, 0x87, 0x2c, 0x74, 0x3d, 0x3f, 0x70, 0xa5, 0xcf, 0x03, 0x85, 0xad, 0x27, 0x9b, 0xbb, 0x10,
+	0xf6, 0xf9, 0x64, 0x00, 0x41, 0xba, 0xbd, 0xc1, 0xbc, 0x88, 0x8f, 0x42, 0x11, 0x42, 0x47, 0x21,
+	0x1f, 0x17, 0x6a, 0xf4, 0x83, 0x31, 0x04, 0x92, 0xa7, 0x3a, 0x8d, 0x83, 0x42, 0x54, 0x04, 0xe1,
+	0x48, 0xe6, 0xd6, 0xda, 0xaf, 0xb0, 0xf9, 0x76, 0x29, 0xec, 0x2c, 0xe2, 0x63, 0xbf, 0x0b, 0x91,
+	0x93, 0x01, 0x1c, 0x18, 0x8e, 0x40, 0x48, 0x62, 0xe0, 0xbb, 0xa1, 0xfa, 0x58, 0x41, 0x55, 0x54,
+	0x2f, 0x3b, 0xd9, 0xff, 0xf6, 0x77, 0x84, 0xad, 0x2b, 0xc7, 0x45, 0xc8, 0x03, 0x01, 0x64, 0x88,
+	0xb1, 0xde, 0x1a, 0x33, 0xec, 0x1e, 0xd5, 0x69, 0x51, 0x8a, 0x74, 0x9d, 0xa5, 0x55, 0xbb, 0x98,
+	0x5a, 0xa5, 0x3f, 0x53, 0x2b, 0xc7, 0xb1, 0x98, 0x5a, 0x7b, 0x13, 0x77, 0xd0, 0x7f, 0x61, 0xeb,
+	0x33, 0xdb, 0xc9, 0x01, 0xec, 0x97, 0xf8, 0xe1, 0x8a, 0xaa, 0xd7, 0x69, 0x36, 0x9b, 0x78, 0xfa,
+	0x8a, 0xb0, 0x79, 0xd5, 0xb4, 0xb2, 0xd4, 0xc7, 0xe5, 0x2c, 0x6e, 0xe5, 0xa8, 0x76, 0xbd, 0xa3,
+	0x8c, 0xa3, 0x75, 0xa0, 0x0c, 0x69, 0x86, 0xc5, 0xd4, 0xba, 0x9f, 0xf8, 0xc9, 0x8e, 0x6c, 0x47,
+	0x7f, 0xb6, 0x7f, 0x20, 0x5c, 0x59, 0x11, 0x24, 0x4e, 0x7c, 0x99, 0x3a, 0xe9, 0x61, 0x1c, 0xf7,
+	0xe3, 0xc3, 0xb2, 0x20, 0x4a, 0xcb, 0x23, 0xa5, 0x45, 0x77, 0x49, 0xe9, 0x69, 0xd0, 0xd3, 0x25,
+	0xf6, 0x5d, 0x08, 0x1d, 0x1d, 0xac, 0x1e, 0xd7, 0xc1, 0xea, 0x33, 0xdb, 0x29, 0x7b, 0xe9, 0x0c,
+	0x39, 0xc1, 0x58, 0xf7, 0xb6, 0xb2, 0x13, 0x2f, 0x7b, 0x42, 0x93, 0x92, 0xd3, 0x65, 0xc9, 0x69,
+	0x72, 0x53, 0x54, 0xc9, 0xe9, 0x99, 0xeb, 0x81, 0x12, 0xea, 0xe4, 0x26, 0xed, 0x5f, 0x08, 0x3f,
+	0x28, 0x70, 0xa4, 0xd2, 0x95, 0x78, 0x37, 0x35, 0xef, 0x83, 0xa8, 0xa0, 0xea, 0xad, 0x6d, 0xf2,
+	0x7d, 0xaa, 0x7c, 0xe5, 0x39, 0x16, 0x53, 0x8b, 0xac, 0x26, 0xec, 0x83, 0xb0, 0x9d, 0x3c, 0x84,
+	0x9c, 0x16, 0x78, 0xab, 0xdd, 0xe8, 0x2d, 0x91, 0x9c, 0x37, 0x77, 0xf4, 0x77, 0x07, 0xdf, 0x8e,
+	0xcd, 0x91, 0x2f, 0x08, 0x93, 0xf5, 0x4a, 0x93, 0x66, 0xb1, 0x95, 0xeb, 0xaf, 0xa1, 0x71, 0xbc,
+	0xe5, 0x94, 0x0a, 0xf3, 0x33, 0xde, 0x5b, 0xcb, 0x88, 0x34, 0x36, 0xe0, 0xfa, 0xff, 0xce, 0x18,
+	0xcd, 0xed, 0x86, 0xd4, 0x7e, 0x8e, 0xef, 0xe5, 0x7f, 0x64, 0x42, 0x37, 0x60, 0xc9, 0xf5, 0xdb,
+	0x60, 0x1b, 0xe3, 0x93, 0x85, 0xad, 0x37, 0x17, 0x33, 0x13, 0x5d, 0xce, 0x4c, 0xf4, 0x7b, 0x66,
+	0xa2, 0x6f, 0x73, 0xb3, 0x74, 0x39, 0x37, 0x4b, 0x3f, 0xe7, 0x66, 0xe9, 0x7d, 0xd3, 0xf3, 0xe5,
+	0xf9, 0xa8, 0x4d, 0x3b, 0x7c, 0xc0, 0x62, 0xd2, 0xe7, 0x01, 0xc8, 0x8f, 0x3c, 0xea, 0xb1, 0x80,
+	0x77, 0x81, 0x7d, 0x4a, 0xdf, 0x4a, 0x39, 0x09, 0x41, 0xa4, 0x2f, 0x66, 0xfb, 0x4e, 0xfc, 0x4e,
+	0x36, 0xfe, 0x0d, 0x00, 0xf8, 0x2f, 0xbc, 0x2a, 0x1a, 0x06, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// ProviderReputation queries reputation counters of provider
+	ProviderReputation(ctx context.Context, in *QueryProviderReputationRequest, opts ...grpc.CallOption) (*QueryProviderReputationResponse, error)
+	// ProviderInventory queries free capacity last reported by provider
+	ProviderInventory(ctx context.Context, in *QueryProviderInventoryRequest, opts ...grpc.CallOption) (*QueryProviderInventoryResponse, error)
+	// ProvidersFit queries inventories of providers which last reported free capacity could run given group.
+	// Providers being deregistered are skipped.
+	ProvidersFit(ctx context.Context, in *QueryProvidersFitRequest, opts ...grpc.CallOption) (*QueryProvidersFitResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) ProviderReputation(ctx context.Context, in *QueryProviderReputationRequest, opts ...grpc.CallOption) (*QueryProviderReputationResponse, error) {
+	out := new(QueryProviderReputationResponse)
+	err := c.cc.Invoke(ctx, "/akash.market.v1beta5.Query/ProviderReputation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProviderInventory(ctx context.Context, in *QueryProviderInventoryRequest, opts ...grpc.CallOption) (*QueryProviderInventoryResponse, error) {
+	out := new(QueryProviderInventoryResponse)
+	err := c.cc.Invoke(ctx, "/akash.market.v1beta5.Query/ProviderInventory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProvidersFit(ctx context.Context, in *QueryProvidersFitRequest, opts ...grpc.CallOption) (*QueryProvidersFitResponse, error) {
+	out := new(QueryProvidersFitResponse)
+	err := c.cc.Invoke(ctx, "/akash.market.v1beta5.Query/ProvidersFit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// ProviderReputation queries reputation counters of provider
+	ProviderReputation(context.Context, *QueryProviderReputationRequest) (*QueryProviderReputationResponse, error)
+	// ProviderInventory queries free capacity last reported by provider
+	ProviderInventory(context.Context, *QueryProviderInventoryRequest) (*QueryProviderInventoryResponse, error)
+	// ProvidersFit queries inventories of providers which last reported free capacity could run given group.
+	// Providers being deregistered are skipped.
+	ProvidersFit(context.Context, *QueryProvidersFitRequest) (*QueryProvidersFitResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) ProviderReputation(ctx context.Context, req *QueryProviderReputationRequest) (*QueryProviderReputationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderReputation not implemented")
+}
+func (*UnimplementedQueryServer) ProviderInventory(ctx context.Context, req *QueryProviderInventoryRequest) (*QueryProviderInventoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderInventory not implemented")
+}
+func (*UnimplementedQueryServer) ProvidersFit(ctx context.Context, req *QueryProvidersFitRequest) (*QueryProvidersFitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProvidersFit not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_ProviderReputation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderReputationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProviderReputation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/akash.market.v1beta5.Query/ProviderReputation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProviderReputation(ctx, req.(*QueryProviderReputationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProviderInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderInventoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProviderInventory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/akash.market.v1beta5.Query/ProviderInventory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProviderInventory(ctx, req.(*QueryProviderInventoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProvidersFit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProvidersFitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProvidersFit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/akash.market.v1beta5.Query/ProvidersFit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProvidersFit(ctx, req.(*QueryProvidersFitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "akash.market.v1beta5.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ProviderReputation",
+			Handler:    _Query_ProviderReputation_Handler,
+		},
+		{
+			MethodName: "ProviderInventory",
+			Handler:    _Query_ProviderInventory_Handler,
+		},
+		{
+			MethodName: "ProvidersFit",
+			Handler:    _Query_ProvidersFit_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "akash/market/v1beta5/query.proto",
+}
+
+func (m *QueryProviderReputationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderReputationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderReputationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderReputationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderReputationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderReputationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Reputation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderInventoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderInventoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderInventoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderInventoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderInventoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderInventoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Inventory.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProvidersFitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProvidersFitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProvidersFitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.GroupSpec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProvidersFitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProvidersFitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProvidersFitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Inventories) > 0 {
+		for iNdEx := len(m.Inventories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Inventories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryProviderReputationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProviderReputationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Reputation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProviderInventoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProviderInventoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Inventory.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProvidersFitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GroupSpec.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProvidersFitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Inventories) > 0 {
+		for _, e := range m.Inventories {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryProviderReputationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProviderReputationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProviderReputationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProviderReputationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProviderReputationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProviderReputationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reputation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProviderInventoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProviderInventoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProviderInventoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProviderInventoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProviderInventoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProviderInventoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inventory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inventory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProvidersFitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProvidersFitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProvidersFitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupSpec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GroupSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProvidersFitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProvidersFitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProvidersFitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inventories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Inventories = append(m.Inventories, ProviderInventory{})
+			if err := m.Inventories[len(m.Inventories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,477 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: akash/market/v1beta5/renegotiation.proto
+
+package v1beta5
+
+import (
+	fmt "fmt"
+	v1beta4 "github.com/akash-network/akash-api/go/node/market/v1beta4"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// LeasePriceProposal is a new price for an active lease proposed by either the tenant or the provider.
+// Lease price changes once the other party accepts it.
+type LeasePriceProposal struct {
+	LeaseID    v1beta4.LeaseID `protobuf:"bytes,1,opt,name=lease_id,json=leaseId,proto3" json:"lease_id" yaml:"lease_id"`
+	Proposer   string          `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer" yaml:"proposer"`
+	Price      types.DecCoin   `protobuf:"bytes,3,opt,name=price,proto3" json:"price" yaml:"price"`
+	ProposedAt int64           `protobuf:"varint,4,opt,name=proposed_at,json=proposedAt,proto3" json:"proposed_at" yaml:"proposed_at"`
+}
+
+func (m *LeasePriceProposal) Reset()         { *m = LeasePriceProposal{} }
+func (m *LeasePriceProposal) String() string { return proto.CompactTextString(m) }
+func (*LeasePriceProposal) ProtoMessage()    {}
+func (*LeasePriceProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b145b32ef596bca0, []int{0}
+}
+func (m *LeasePriceProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LeasePriceProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LeasePriceProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LeasePriceProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeasePriceProposal.Merge(m, src)
+}
+func (m *LeasePriceProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *LeasePriceProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeasePriceProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LeasePriceProposal proto.InternalMessageInfo
+
+func (m *LeasePriceProposal) GetLeaseID() v1beta4.LeaseID {
+	if m != nil {
+		return m.LeaseID
+	}
+	return v1beta4.LeaseID{}
+}
+
+func (m *LeasePriceProposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *LeasePriceProposal) GetPrice() types.DecCoin {
+	if m != nil {
+		return m.Price
+	}
+	return types.DecCoin{}
+}
+
+func (m *LeasePriceProposal) GetProposedAt() int64 {
+	if m != nil {
+		return m.ProposedAt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*LeasePriceProposal)(nil), "akash.market.v1beta5.LeasePriceProposal")
+}
+
+func init() {
+	proto.RegisterFile("akash/market/v1beta5/renegotiation.proto", fileDescriptor_b145b32ef596bca0)
+}
+
+var fileDescriptor_b145b32ef596bca0 = []byte{
+	// 395 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0x41, 0x8b, 0xda, 0x40,
+	0x14, 0x4e, 0xd4, 0x56, 0x1b, 0x0b, 0x85, 0xe0, 0x21, 0x48, 0xcd, 0x84, 0x40, 0x21, 0x97, 0xce,
+	0x60, 0x6b, 0x2f, 0xf6, 0xd4, 0x54, 0x0a, 0x42, 0x29, 0x36, 0xc7, 0x5e, 0x64, 0x92, 0x0c, 0x71,
+	0xd0, 0x64, 0x42, 0x32, 0x6d, 0xd7, 0x7f, 0xb1, 0x3f, 0x61, 0x7f, 0x8e, 0x47, 0x8f, 0x7b, 0x58,
+	0x86, 0x25, 0x5e, 0x16, 0x8f, 0xfe, 0x82, 0x25, 0x93, 0xc4, 0xdd, 0x05, 0x6f, 0xef, 0x7d, 0xf3,
+	0x7d, 0xdf, 0xfb, 0x78, 0x6f, 0x34, 0x07, 0xaf, 0x71, 0xbe, 0x42, 0x31, 0xce, 0xd6, 0x84, 0xa3,
+	0x7f, 0x63, 0x9f, 0x70, 0xfc, 0x05, 0x65, 0x24, 0x21, 0x11, 0xe3, 0x14, 0x73, 0xca, 0x12, 0x98,
+	0x66, 0x8c, 0x33, 0x7d, 0x20, 0x99, 0xb0, 0x62, 0xc2, 0x9a, 0x39, 0x1c, 0x44, 0x2c, 0x62, 0x92,
+	0x80, 0xca, 0xaa, 0xe2, 0x0e, 0xcd, 0x80, 0xe5, 0x31, 0xcb, 0x91, 0x8f, 0x73, 0x52, 0x9b, 0x8e,
+	0x51, 0xc0, 0x68, 0xed, 0x35, 0xb4, 0x2e, 0x4c, 0x9d, 0xa0, 0x0d, 0xc1, 0x39, 0xa9, 0x18, 0xf6,
+	0x5d, 0x4b, 0xd3, 0x7f, 0x96, 0xfd, 0x22, 0xa3, 0x01, 0x59, 0x64, 0x2c, 0x65, 0x39, 0xde, 0xe8,
+	0x2b, 0xad, 0x27, 0x59, 0x4b, 0x1a, 0x1a, 0xaa, 0xa5, 0x3a, 0xfd, 0x4f, 0x23, 0x78, 0x21, 0xd7,
+	0x04, 0x4a, 0xed, 0x7c, 0xe6, 0xc2, 0x9d, 0x00, 0x4a, 0x21, 0x40, 0xb7, 0x06, 0x8e, 0x02, 0x9c,
+	0x1d, 0x4e, 0x02, 0xbc, 0xdb, 0xe2, 0x78, 0x33, 0xb5, 0x1b, 0xc4, 0xf6, 0xba, 0xb2, 0x9c, 0x87,
+	0xfa, 0x57, 0xad, 0x97, 0xca, 0xa9, 0x24, 0x33, 0x5a, 0x96, 0xea, 0xbc, 0x71, 0x41, 0xa9, 0x6d,
+	0xb0, 0x27, 0x6d, 0x83, 0xd8, 0xde, 0xf9, 0x51, 0xff, 0xad, 0xbd, 0x4a, 0xcb, 0xdc, 0x46, 0x5b,
+	0x66, 0x7c, 0x0f, 0xab, 0x7d, 0xc0, 0x72, 0x1f, 0x75, 0xc4, 0x31, 0x9c, 0x91, 0xe0, 0x3b, 0xa3,
+	0x89, 0x3b, 0x2a, 0x23, 0x1e, 0x05, 0xa8, 0x24, 0x27, 0x01, 0xde, 0x36, 0xc6, 0x34, 0x20, 0xb6,
+	0x57, 0xc1, 0xfa, 0x0f, 0xad, 0x5f, 0xdb, 0x87, 0x4b, 0xcc, 0x8d, 0x8e, 0xa5, 0x3a, 0x6d, 0xf7,
+	0xc3, 0x51, 0x80, 0xe7, 0xf0, 0x49, 0x00, 0xfd, 0x45, 0xaa, 0x12, 0xb4, 0x3d, 0xad, 0xe9, 0xbe,
+	0xf1, 0x69, 0xe7, 0xe1, 0x06, 0x28, 0xee, 0xaf, 0x5d, 0x61, 0xaa, 0xfb, 0xc2, 0x54, 0xef, 0x0b,
+	0x53, 0xbd, 0x3e, 0x98, 0xca, 0xfe, 0x60, 0x2a, 0xb7, 0x07, 0x53, 0xf9, 0x33, 0x89, 0x28, 0x5f,
+	0xfd, 0xf5, 0x61, 0xc0, 0x62, 0x24, 0x37, 0xfb, 0x31, 0x21, 0xfc, 0x3f, 0xcb, 0xd6, 0x28, 0x61,
+	0x21, 0x41, 0x57, 0xcd, 0xd1, 0xf8, 0x36, 0x25, 0x79, 0xf3, 0x61, 0xfc, 0xd7, 0xf2, 0x6a, 0x9f,
+	0x1f, 0x07, 0x00, 0x6d, 0xfe, 0x0a, 0x40, 0x4f, 0x02, 0x00, 0x00,
+}
+
+func (m *LeasePriceProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LeasePriceProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LeasePriceProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposedAt != 0 {
+		i = encodeVarintRenegotiation(dAtA, i, uint64(m.ProposedAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRenegotiation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintRenegotiation(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.LeaseID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRenegotiation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRenegotiation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRenegotiation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LeasePriceProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LeaseID.Size()
+	n += 1 + l + sovRenegotiation(uint64(l))
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovRenegotiation(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovRenegotiation(uint64(l))
+	if m.ProposedAt != 0 {
+		n += 1 + sovRenegotiation(uint64(m.ProposedAt))
+	}
+	return n
+}
+
+func sovRenegotiation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRenegotiation(x uint64) (n int) {
+	return sovRenegotiation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LeasePriceProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRenegotiation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LeasePriceProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LeasePriceProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRenegotiation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRenegotiation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRenegotiation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LeaseID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRenegotiation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRenegotiation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRenegotiation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRenegotiation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRenegotiation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRenegotiation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedAt", wireType)
+			}
+			m.ProposedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRenegotiation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRenegotiation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRenegotiation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRenegotiation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRenegotiation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRenegotiation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRenegotiation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRenegotiation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRenegotiation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRenegotiation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRenegotiation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRenegotiation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRenegotiation = fmt.Errorf("proto: unexpected end of group")
+)
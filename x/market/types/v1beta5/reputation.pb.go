@@ -0,0 +1,648 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: akash/market/v1beta5/reputation.proto
+
+package v1beta5
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ReputationParams defines when provider closing a lease counts as an early close, and its cost.
+// Leases closed by provider within min_lease_duration blocks of creation count as early closes,
+// and early_close_slash_rate fraction of provider's bid deposit is moved to the community pool.
+// Zero values disable tracking of early closes and slashing respectively.
+type ReputationParams struct {
+	MinLeaseDuration    int64                                  `protobuf:"varint,1,opt,name=min_lease_duration,json=minLeaseDuration,proto3" json:"min_lease_duration" yaml:"min_lease_duration"`
+	EarlyCloseSlashRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=early_close_slash_rate,json=earlyCloseSlashRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"early_close_slash_rate" yaml:"early_close_slash_rate"`
+}
+
+func (m *ReputationParams) Reset()         { *m = ReputationParams{} }
+func (m *ReputationParams) String() string { return proto.CompactTextString(m) }
+func (*ReputationParams) ProtoMessage()    {}
+func (*ReputationParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_817f11c4b3f05a14, []int{0}
+}
+func (m *ReputationParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReputationParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReputationParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReputationParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReputationParams.Merge(m, src)
+}
+func (m *ReputationParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReputationParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReputationParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReputationParams proto.InternalMessageInfo
+
+func (m *ReputationParams) GetMinLeaseDuration() int64 {
+	if m != nil {
+		return m.MinLeaseDuration
+	}
+	return 0
+}
+
+// ProviderReputation holds counters of provider's market activity
+type ProviderReputation struct {
+	Provider          string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider" yaml:"provider"`
+	LeasesWon         uint64 `protobuf:"varint,2,opt,name=leases_won,json=leasesWon,proto3" json:"leases_won" yaml:"leases_won"`
+	LeasesClosedEarly uint64 `protobuf:"varint,3,opt,name=leases_closed_early,json=leasesClosedEarly,proto3" json:"leases_closed_early" yaml:"leases_closed_early"`
+	BidsWithdrawn     uint64 `protobuf:"varint,4,opt,name=bids_withdrawn,json=bidsWithdrawn,proto3" json:"bids_withdrawn" yaml:"bids_withdrawn"`
+}
+
+func (m *ProviderReputation) Reset()         { *m = ProviderReputation{} }
+func (m *ProviderReputation) String() string { return proto.CompactTextString(m) }
+func (*ProviderReputation) ProtoMessage()    {}
+func (*ProviderReputation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_817f11c4b3f05a14, []int{1}
+}
+func (m *ProviderReputation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderReputation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderReputation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProviderReputation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderReputation.Merge(m, src)
+}
+func (m *ProviderReputation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderReputation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderReputation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderReputation proto.InternalMessageInfo
+
+func (m *ProviderReputation) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *ProviderReputation) GetLeasesWon() uint64 {
+	if m != nil {
+		return m.LeasesWon
+	}
+	return 0
+}
+
+func (m *ProviderReputation) GetLeasesClosedEarly() uint64 {
+	if m != nil {
+		return m.LeasesClosedEarly
+	}
+	return 0
+}
+
+func (m *ProviderReputation) GetBidsWithdrawn() uint64 {
+	if m != nil {
+		return m.BidsWithdrawn
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ReputationParams)(nil), "akash.market.v1beta5.ReputationParams")
+	proto.RegisterType((*ProviderReputation)(nil), "akash.market.v1beta5.ProviderReputation")
+}
+
+func init() {
+	proto.RegisterFile("akash/market/v1beta5/reputation.proto", fileDescriptor_817f11c4b3f05a14)
+}
+
+var fileDescriptor_817f11c4b3f05a14 = []byte{
+	// 462 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x9b, 0x6e, 0x42, 0xd4, 0x12, 0xb0, 0x79, 0x03, 0x95, 0x49, 0xc4, 0x53, 0x10, 0x68,
+	0x12, 0x5a, 0x22, 0x34, 0x76, 0x81, 0x5b, 0x18, 0x37, 0x84, 0x26, 0x73, 0x98, 0xc4, 0x25, 0x72,
+	0x6b, 0xab, 0xb5, 0x9a, 0xd8, 0x95, 0xed, 0xae, 0xf4, 0x2d, 0x38, 0x71, 0xe4, 0x79, 0x76, 0xec,
+	0x11, 0x71, 0xb0, 0x50, 0x7b, 0xcb, 0x31, 0x4f, 0x80, 0x62, 0xa7, 0x5d, 0x07, 0xe5, 0x94, 0xf8,
+	0xf7, 0xfb, 0xf4, 0xb7, 0xfd, 0xf9, 0x03, 0x2f, 0xc8, 0x88, 0xe8, 0x61, 0x52, 0x10, 0x35, 0x62,
+	0x26, 0xb9, 0x7e, 0xdd, 0x63, 0x86, 0x9c, 0x27, 0x8a, 0x8d, 0x27, 0x86, 0x18, 0x2e, 0x45, 0x3c,
+	0x56, 0xd2, 0x48, 0x78, 0xe8, 0xca, 0x62, 0x5f, 0x16, 0x37, 0x65, 0x47, 0x87, 0x03, 0x39, 0x90,
+	0xae, 0x20, 0xa9, 0xff, 0x7c, 0x6d, 0xf4, 0xbd, 0x0d, 0xf6, 0xf0, 0x3a, 0xe0, 0x92, 0x28, 0x52,
+	0x68, 0x48, 0x00, 0x2c, 0xb8, 0xc8, 0x72, 0x46, 0x34, 0xcb, 0xe8, 0x44, 0x39, 0xd7, 0x0d, 0x8e,
+	0x83, 0x93, 0x9d, 0xf4, 0xac, 0xb4, 0x68, 0x8b, 0xad, 0x2c, 0x7a, 0x3a, 0x23, 0x45, 0xfe, 0x36,
+	0xfa, 0xd7, 0x45, 0x78, 0xaf, 0xe0, 0xe2, 0x63, 0xcd, 0x2e, 0x1a, 0x04, 0x7f, 0x04, 0xe0, 0x09,
+	0x23, 0x2a, 0x9f, 0x65, 0xfd, 0x5c, 0x6a, 0x96, 0xe9, 0x9c, 0xe8, 0x61, 0xa6, 0x88, 0x61, 0xdd,
+	0xf6, 0x71, 0x70, 0xd2, 0x49, 0xf9, 0x8d, 0x45, 0xad, 0x5f, 0x16, 0xbd, 0x1c, 0x70, 0x33, 0x9c,
+	0xf4, 0xe2, 0xbe, 0x2c, 0x92, 0xbe, 0xd4, 0x85, 0xd4, 0xcd, 0xe7, 0x54, 0xd3, 0x51, 0x62, 0x66,
+	0x63, 0xa6, 0xe3, 0x0b, 0xd6, 0x2f, 0x2d, 0xfa, 0x4f, 0x5e, 0x65, 0xd1, 0x33, 0x7f, 0xb2, 0xed,
+	0x3e, 0xc2, 0x07, 0x4e, 0xbc, 0xaf, 0xf9, 0xe7, 0x1a, 0xe3, 0x9a, 0xce, 0xdb, 0x00, 0x5e, 0x2a,
+	0x79, 0xcd, 0x29, 0x53, 0xb7, 0x0d, 0x82, 0xef, 0xc0, 0xfd, 0x71, 0x43, 0x5d, 0x43, 0x3a, 0x29,
+	0x2a, 0x2d, 0x5a, 0xb3, 0xca, 0xa2, 0x47, 0x7e, 0xb3, 0x15, 0x89, 0xf0, 0x5a, 0xc2, 0x14, 0x00,
+	0xd7, 0x19, 0x9d, 0x4d, 0xa5, 0x70, 0xf7, 0xdc, 0x4d, 0x9f, 0x97, 0x16, 0x6d, 0xd0, 0xca, 0xa2,
+	0x7d, 0x1f, 0x70, 0xcb, 0x22, 0xdc, 0xf1, 0x8b, 0x2b, 0x29, 0x20, 0x03, 0x07, 0x8d, 0x71, 0x17,
+	0xa1, 0x99, 0x3b, 0x7c, 0x77, 0xc7, 0x85, 0x9d, 0x97, 0x16, 0x6d, 0xd3, 0x95, 0x45, 0x47, 0x77,
+	0x52, 0x37, 0x65, 0x84, 0xf7, 0x3d, 0x75, 0x1d, 0xa0, 0x1f, 0x6a, 0x06, 0x31, 0x78, 0xd8, 0xe3,
+	0x54, 0x67, 0x53, 0x6e, 0x86, 0x54, 0x91, 0xa9, 0xe8, 0xee, 0xba, 0x1d, 0x5e, 0x95, 0x16, 0xfd,
+	0x65, 0x2a, 0x8b, 0x1e, 0xfb, 0xf0, 0xbb, 0x3c, 0xc2, 0x0f, 0x6a, 0x70, 0xb5, 0x5a, 0xa7, 0x9f,
+	0x6e, 0x16, 0x61, 0x30, 0x5f, 0x84, 0xc1, 0xef, 0x45, 0x18, 0x7c, 0x5b, 0x86, 0xad, 0xf9, 0x32,
+	0x6c, 0xfd, 0x5c, 0x86, 0xad, 0x2f, 0x6f, 0x36, 0x1e, 0xd9, 0x0d, 0xef, 0xa9, 0x60, 0x66, 0x2a,
+	0xd5, 0x28, 0x11, 0x92, 0xb2, 0xe4, 0xeb, 0x6a, 0xe4, 0xdd, 0x73, 0xaf, 0x06, 0xbf, 0x77, 0xcf,
+	0x8d, 0xf0, 0xd9, 0x9f, 0x01, 0x00, 0x52, 0x2f, 0xfe, 0xae, 0x17, 0x03, 0x00, 0x00,
+}
+
+func (m *ReputationParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReputationParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReputationParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.EarlyCloseSlashRate.Size()
+		i -= size
+		if _, err := m.EarlyCloseSlashRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintReputation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.MinLeaseDuration != 0 {
+		i = encodeVarintReputation(dAtA, i, uint64(m.MinLeaseDuration))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderReputation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderReputation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderReputation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BidsWithdrawn != 0 {
+		i = encodeVarintReputation(dAtA, i, uint64(m.BidsWithdrawn))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LeasesClosedEarly != 0 {
+		i = encodeVarintReputation(dAtA, i, uint64(m.LeasesClosedEarly))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LeasesWon != 0 {
+		i = encodeVarintReputation(dAtA, i, uint64(m.LeasesWon))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintReputation(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintReputation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovReputation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ReputationParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinLeaseDuration != 0 {
+		n += 1 + sovReputation(uint64(m.MinLeaseDuration))
+	}
+	l = m.EarlyCloseSlashRate.Size()
+	n += 1 + l + sovReputation(uint64(l))
+	return n
+}
+
+func (m *ProviderReputation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovReputation(uint64(l))
+	}
+	if m.LeasesWon != 0 {
+		n += 1 + sovReputation(uint64(m.LeasesWon))
+	}
+	if m.LeasesClosedEarly != 0 {
+		n += 1 + sovReputation(uint64(m.LeasesClosedEarly))
+	}
+	if m.BidsWithdrawn != 0 {
+		n += 1 + sovReputation(uint64(m.BidsWithdrawn))
+	}
+	return n
+}
+
+func sovReputation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozReputation(x uint64) (n int) {
+	return sovReputation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ReputationParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReputation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReputationParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReputationParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinLeaseDuration", wireType)
+			}
+			m.MinLeaseDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinLeaseDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarlyCloseSlashRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReputation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReputation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EarlyCloseSlashRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReputation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReputation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProviderReputation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReputation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderReputation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderReputation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReputation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReputation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeasesWon", wireType)
+			}
+			m.LeasesWon = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeasesWon |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeasesClosedEarly", wireType)
+			}
+			m.LeasesClosedEarly = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeasesClosedEarly |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidsWithdrawn", wireType)
+			}
+			m.BidsWithdrawn = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BidsWithdrawn |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReputation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReputation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipReputation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowReputation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReputation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReputation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthReputation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupReputation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthReputation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthReputation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowReputation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupReputation = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,169 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: akash/market/v1beta5/service.proto
+
+package v1beta5
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+func init() {
+	proto.RegisterFile("akash/market/v1beta5/service.proto", fileDescriptor_f1203af46a0757a8)
+}
+
+var fileDescriptor_f1203af46a0757a8 = []byte{
+	// 229 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4a, 0xcc, 0x4e, 0x2c,
+	0xce, 0xd0, 0xcf, 0x4d, 0x2c, 0xca, 0x4e, 0x2d, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34,
+	0xd5, 0x2f, 0x4e, 0x2d, 0x2a, 0xcb, 0x4c, 0x4e, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
+	0x01, 0xab, 0xd1, 0x83, 0xa8, 0xd1, 0x83, 0xaa, 0x91, 0x52, 0xc6, 0xaa, 0x33, 0x27, 0x35, 0xb1,
+	0x38, 0x35, 0xb7, 0x38, 0x1d, 0xa2, 0xd5, 0xe8, 0x0d, 0x23, 0x17, 0xb3, 0x6f, 0x71, 0xba, 0x50,
+	0x31, 0x97, 0x60, 0x40, 0x51, 0x7e, 0x41, 0x7e, 0x71, 0xaa, 0x0f, 0x48, 0x41, 0x40, 0x51, 0x66,
+	0x72, 0xaa, 0x90, 0x96, 0x1e, 0x36, 0x83, 0xf5, 0x7c, 0x8b, 0xd3, 0x31, 0xd4, 0x4a, 0x19, 0x11,
+	0xaf, 0x36, 0x28, 0xb5, 0xb8, 0x20, 0x3f, 0xaf, 0x38, 0x55, 0xa8, 0x80, 0x4b, 0xc0, 0x31, 0x39,
+	0x39, 0xb5, 0xa0, 0x04, 0xc9, 0x4e, 0x4d, 0x9c, 0xe6, 0xa0, 0x2b, 0x95, 0x32, 0x24, 0x5a, 0x29,
+	0xcc, 0x46, 0x27, 0xbf, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e,
+	0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x49,
+	0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x07, 0x1b, 0xab, 0x9b, 0x97, 0x5a,
+	0x52, 0x9e, 0x5f, 0x94, 0xad, 0x9f, 0x97, 0x9f, 0x92, 0xaa, 0x5f, 0x01, 0x0b, 0xc7, 0x92, 0xca,
+	0x82, 0xd4, 0x62, 0x58, 0x68, 0x26, 0xb1, 0x81, 0x43, 0xd1, 0x18, 0x30, 0x00, 0x00, 0xf3, 0x06,
+	0xe1, 0xa6, 0x01, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// ProposeLeasePrice proposes new price of an active lease.
+	ProposeLeasePrice(ctx context.Context, in *MsgProposeLeasePrice, opts ...grpc.CallOption) (*MsgProposeLeasePriceResponse, error)
+	// AcceptLeasePrice accepts pending price proposal of an active lease.
+	AcceptLeasePrice(ctx context.Context, in *MsgAcceptLeasePrice, opts ...grpc.CallOption) (*MsgAcceptLeasePriceResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) ProposeLeasePrice(ctx context.Context, in *MsgProposeLeasePrice, opts ...grpc.CallOption) (*MsgProposeLeasePriceResponse, error) {
+	out := new(MsgProposeLeasePriceResponse)
+	err := c.cc.Invoke(ctx, "/akash.market.v1beta5.Msg/ProposeLeasePrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptLeasePrice(ctx context.Context, in *MsgAcceptLeasePrice, opts ...grpc.CallOption) (*MsgAcceptLeasePriceResponse, error) {
+	out := new(MsgAcceptLeasePriceResponse)
+	err := c.cc.Invoke(ctx, "/akash.market.v1beta5.Msg/AcceptLeasePrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// ProposeLeasePrice proposes new price of an active lease.
+	ProposeLeasePrice(context.Context, *MsgProposeLeasePrice) (*MsgProposeLeasePriceResponse, error)
+	// AcceptLeasePrice accepts pending price proposal of an active lease.
+	AcceptLeasePrice(context.Context, *MsgAcceptLeasePrice) (*MsgAcceptLeasePriceResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) ProposeLeasePrice(ctx context.Context, req *MsgProposeLeasePrice) (*MsgProposeLeasePriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeLeasePrice not implemented")
+}
+func (*UnimplementedMsgServer) AcceptLeasePrice(ctx context.Context, req *MsgAcceptLeasePrice) (*MsgAcceptLeasePriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptLeasePrice not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_ProposeLeasePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposeLeasePrice)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProposeLeasePrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/akash.market.v1beta5.Msg/ProposeLeasePrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposeLeasePrice(ctx, req.(*MsgProposeLeasePrice))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptLeasePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptLeasePrice)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptLeasePrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/akash.market.v1beta5.Msg/AcceptLeasePrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptLeasePrice(ctx, req.(*MsgAcceptLeasePrice))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "akash.market.v1beta5.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ProposeLeasePrice",
+			Handler:    _Msg_ProposeLeasePrice_Handler,
+		},
+		{
+			MethodName: "AcceptLeasePrice",
+			Handler:    _Msg_AcceptLeasePrice_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "akash/market/v1beta5/service.proto",
+}
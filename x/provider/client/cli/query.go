@@ -1,14 +1,19 @@
 package cli
 
 import (
+	"fmt"
+
 	sdkclient "github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
 
+	mtypes "github.com/akash-network/akash-api/go/node/market/v1beta4"
 	types "github.com/akash-network/akash-api/go/node/provider/v1beta3"
 
 	aclient "github.com/akash-network/node/client"
+	mquery "github.com/akash-network/node/x/market/query"
+	mv1beta5 "github.com/akash-network/node/x/market/types/v1beta5"
 )
 
 // GetQueryCmd returns the transaction commands for the provider module
@@ -23,6 +28,9 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		cmdGetProviders(),
 		cmdGetProvider(),
+		cmdGetReputation(),
+		cmdGetDeregistration(),
+		cmdGetInventory(),
 	)
 
 	return cmd
@@ -105,3 +113,97 @@ func cmdGetProvider() *cobra.Command {
 
 	return cmd
 }
+
+func cmdGetReputation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reputation [address]",
+		Short: "Query provider reputation counters",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := mv1beta5.NewQueryClient(cctx).ProviderReputation(cmd.Context(), &mv1beta5.QueryProviderReputationRequest{
+				Provider: owner.String(),
+			})
+			if err != nil {
+				return err
+			}
+
+			return cctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func cmdGetDeregistration() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deregistration [address]",
+		Short: "Query deregistration grace period of provider",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cctx.QueryWithData(fmt.Sprintf("custom/%s/%s", mtypes.StoreKey, mquery.DeregistrationPath(owner)), nil)
+			if err != nil {
+				return err
+			}
+
+			return cctx.PrintBytes(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func cmdGetInventory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inventory [address]",
+		Short: "Query free capacity last reported by provider",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := mv1beta5.NewQueryClient(cctx).ProviderInventory(cmd.Context(), &mv1beta5.QueryProviderInventoryRequest{
+				Provider: owner.String(),
+			})
+			if err != nil {
+				return err
+			}
+
+			return cctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -13,6 +13,11 @@ import (
 
 	aclient "github.com/akash-network/node/client"
 	"github.com/akash-network/node/x/provider/config"
+	pv1beta4 "github.com/akash-network/node/x/provider/types/v1beta4"
+)
+
+const (
+	FlagCloseLeases = "close-leases"
 )
 
 // GetTxCmd returns the transaction commands for provider module
@@ -26,6 +31,8 @@ func GetTxCmd(key string) *cobra.Command {
 	cmd.AddCommand(
 		cmdCreate(key),
 		cmdUpdate(key),
+		cmdUpdateInventory(key),
+		cmdDelete(key),
 	)
 	return cmd
 }
@@ -113,13 +120,119 @@ func cmdUpdate(key string) *cobra.Command {
 				return err
 			}
 
-			msg := &types.MsgUpdateProvider{
+			closeLeases, err := cmd.Flags().GetBool(FlagCloseLeases)
+			if err != nil {
+				return err
+			}
+
+			var msg sdk.Msg = &types.MsgUpdateProvider{
 				Owner:      cctx.GetFromAddress().String(),
 				HostURI:    cfg.Host,
 				Info:       cfg.Info,
 				Attributes: cfg.GetAttributes(),
 			}
 
+			// akash-api message has no close leases flag
+			if closeLeases {
+				msg = pv1beta4.NewMsgUpdateProvider(cctx.GetFromAddress(), cfg.Host, cfg.GetAttributes(), cfg.Info, true)
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			resp, err := cl.Tx().Broadcast(ctx, []sdk.Msg{msg})
+			if err != nil {
+				return err
+			}
+
+			return cl.PrintMessage(resp)
+		},
+	}
+
+	cmd.Flags().Bool(FlagCloseLeases, false, "Close active leases which orders no longer match updated attributes instead of rejecting the update")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func cmdUpdateInventory(key string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-inventory [inventory-file]",
+		Short: fmt.Sprintf("Publish free capacity of %s", key),
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			cctx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			opts, err := cltypes.ClientOptionsFromFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			cl, err := aclient.DiscoverClient(ctx, cctx, opts...)
+			if err != nil {
+				return err
+			}
+
+			inv, err := config.ReadInventoryPath(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := pv1beta4.NewMsgUpdateInventory(cctx.GetFromAddress(), inv.CPU, inv.Memory, inv.GPU, inv.Storage)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			resp, err := cl.Tx().Broadcast(ctx, []sdk.Msg{msg})
+			if err != nil {
+				return err
+			}
+
+			return cl.PrintMessage(resp)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func cmdDelete(key string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: fmt.Sprintf("Deregister %s", key),
+		Long: "Deregister provider. Provider stops accepting bids immediately, open bids are closed and deposits returned. " +
+			"Active leases are closed and provider is removed once the deregistration grace period ends.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := cmd.Context()
+
+			cctx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			opts, err := cltypes.ClientOptionsFromFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			cl, err := aclient.DiscoverClient(ctx, cctx, opts...)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgDeleteProvider{
+				Owner: cctx.GetFromAddress().String(),
+			}
+
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -0,0 +1,32 @@
+package config
+
+import (
+	"os"
+
+	"gopkg.in/yaml.v3"
+
+	mv1beta5 "github.com/akash-network/node/x/market/types/v1beta5"
+)
+
+// Inventory is free capacity provider reports. CPU is in millicores, memory and storage in bytes.
+type Inventory struct {
+	CPU     uint64                      `json:"cpu" yaml:"cpu"`
+	Memory  uint64                      `json:"memory" yaml:"memory"`
+	GPU     []mv1beta5.InventoryGPU     `json:"gpu" yaml:"gpu"`
+	Storage []mv1beta5.InventoryStorage `json:"storage" yaml:"storage"`
+}
+
+// ReadInventoryPath reads and parses inventory file
+func ReadInventoryPath(path string) (Inventory, error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return Inventory{}, err
+	}
+
+	var val Inventory
+	if err := yaml.Unmarshal(buf, &val); err != nil {
+		return Inventory{}, err
+	}
+
+	return val, nil
+}
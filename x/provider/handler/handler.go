@@ -8,11 +8,13 @@ import (
 
 	mkeeper "github.com/akash-network/node/x/market/keeper"
 	"github.com/akash-network/node/x/provider/keeper"
+	pv1beta4 "github.com/akash-network/node/x/provider/types/v1beta4"
 )
 
 // NewHandler returns a handler for "provider" type messages.
-func NewHandler(keeper keeper.IKeeper, mkeeper mkeeper.IKeeper) sdk.Handler {
-	ms := NewMsgServerImpl(keeper, mkeeper)
+func NewHandler(keeper keeper.IKeeper, mkeeper mkeeper.IKeeper, dkeeper DeploymentKeeper, ekeeper EscrowKeeper) sdk.Handler {
+	ms := NewMsgServerImpl(keeper, mkeeper, dkeeper, ekeeper)
+	ns := NewNodeServer(keeper, mkeeper, dkeeper, ekeeper)
 
 	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
 		switch msg := msg.(type) {
@@ -24,6 +26,14 @@ func NewHandler(keeper keeper.IKeeper, mkeeper mkeeper.IKeeper) sdk.Handler {
 			res, err := ms.UpdateProvider(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *pv1beta4.MsgUpdateProvider:
+			res, err := ns.UpdateProvider(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *pv1beta4.MsgUpdateInventory:
+			res, err := ns.UpdateInventory(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		case *types.MsgDeleteProvider:
 			res, err := ms.DeleteProvider(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
@@ -16,6 +16,7 @@ import (
 	"github.com/akash-network/node/testutil"
 	"github.com/akash-network/node/testutil/state"
 	mkeeper "github.com/akash-network/node/x/market/keeper"
+	mv1beta5 "github.com/akash-network/node/x/market/types/v1beta5"
 	"github.com/akash-network/node/x/provider/handler"
 	"github.com/akash-network/node/x/provider/keeper"
 )
@@ -26,6 +27,7 @@ const (
 
 type testSuite struct {
 	t       testing.TB
+	state   *state.TestSuite
 	ctx     sdk.Context
 	keeper  keeper.IKeeper
 	mkeeper mkeeper.IKeeper
@@ -36,12 +38,13 @@ func setupTestSuite(t *testing.T) *testSuite {
 	ssuite := state.SetupTestSuite(t)
 	suite := &testSuite{
 		t:       t,
+		state:   ssuite,
 		ctx:     ssuite.Context(),
 		keeper:  ssuite.ProviderKeeper(),
 		mkeeper: ssuite.MarketKeeper(),
 	}
 
-	suite.handler = handler.NewHandler(suite.keeper, suite.mkeeper)
+	suite.handler = handler.NewHandler(suite.keeper, suite.mkeeper, ssuite.DeploymentKeeper(), ssuite.EscrowKeeper())
 
 	return suite
 }
@@ -247,13 +250,27 @@ func TestProviderDeleteExisting(t *testing.T) {
 	require.NoError(t, err)
 
 	res, err := suite.handler(suite.ctx, deleteMsg)
-	require.Nil(t, res)
-	require.EqualError(t, err, "NOTIMPLEMENTED: "+handler.ErrInternal.Error())
-	require.True(t, errors.Is(err, handler.ErrInternal))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	drain, found := suite.mkeeper.GetProviderDrain(suite.ctx, addr)
+	require.True(t, found)
+	require.Equal(t, suite.ctx.BlockHeight()+mkeeper.DefaultProviderDrainPeriod, drain.EndsAt)
 
 	t.Run("ensure event created", func(t *testing.T) {
-		// TODO: this should emit a ProviderDelete
+		iev := testutil.ParseEvent(t, res.Events[len(res.Events)-1:])
+
+		dev, err := mv1beta5.ParseEvent(iev)
+		require.NoError(t, err)
+		require.Equal(t, mv1beta5.NewEventProviderDraining(addr, drain.EndsAt), dev)
 	})
+
+	// provider is removed by the market EndBlock
+	_, found = suite.keeper.Get(suite.ctx, addr)
+	require.True(t, found)
+
+	_, err = suite.handler(suite.ctx, deleteMsg)
+	require.ErrorIs(t, err, mkeeper.ErrProviderDraining)
 }
 
 func TestProviderDeleteNonExisting(t *testing.T) {
@@ -0,0 +1,39 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	types "github.com/akash-network/akash-api/go/node/provider/v1beta3"
+
+	mkeeper "github.com/akash-network/node/x/market/keeper"
+	pv1beta4 "github.com/akash-network/node/x/provider/types/v1beta4"
+)
+
+// UpdateInventory publishes free capacity of a registered provider, replacing the one it reported last.
+// Updates are rate-limited by market InventoryParams and size-bounded by ProviderInventory.ValidateBasic.
+func (ms nodeMsgServer) UpdateInventory(goCtx context.Context, msg *pv1beta4.MsgUpdateInventory) (*pv1beta4.MsgUpdateInventoryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	owner, _ := sdk.AccAddressFromBech32(msg.Owner)
+
+	if _, found := ms.provider.Get(ctx, owner); !found {
+		return nil, fmt.Errorf("%w: id: %s", types.ErrProviderNotFound, msg.Owner)
+	}
+
+	if _, draining := ms.market.GetProviderDrain(ctx, owner); draining {
+		return nil, mkeeper.ErrProviderDraining
+	}
+
+	if err := ms.market.UpdateProviderInventory(ctx, msg.Inventory()); err != nil {
+		return nil, err
+	}
+
+	return &pv1beta4.MsgUpdateInventoryResponse{}, nil
+}
@@ -0,0 +1,49 @@
+package handler_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	types "github.com/akash-network/akash-api/go/node/provider/v1beta3"
+
+	"github.com/akash-network/node/testutil"
+	mkeeper "github.com/akash-network/node/x/market/keeper"
+	mv1beta5 "github.com/akash-network/node/x/market/types/v1beta5"
+	pv1beta4 "github.com/akash-network/node/x/provider/types/v1beta4"
+)
+
+func TestProviderUpdateInventory(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	addr := testutil.AccAddress(t)
+	msg := pv1beta4.NewMsgUpdateInventory(addr, 4000, 8*1024*1024*1024,
+		[]mv1beta5.InventoryGPU{{Vendor: "nvidia", Model: "a100", Count: 2}}, nil)
+
+	_, err := suite.handler(suite.ctx, msg)
+	require.ErrorIs(t, err, types.ErrProviderNotFound)
+
+	err = suite.keeper.Create(suite.ctx, types.Provider{
+		Owner:   addr.String(),
+		HostURI: testutil.ProviderHostname(t),
+	})
+	require.NoError(t, err)
+
+	res, err := suite.handler(suite.ctx, msg)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	stored, found := suite.mkeeper.GetProviderInventory(suite.ctx, addr)
+	require.True(t, found)
+	require.Equal(t, msg.GPU, stored.GPU)
+
+	_, err = suite.handler(suite.ctx, msg)
+	require.ErrorIs(t, err, mkeeper.ErrInventoryRateLimited)
+
+	_, err = suite.mkeeper.OnProviderDraining(suite.ctx, addr)
+	require.NoError(t, err)
+
+	ctx := suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + mkeeper.DefaultInventoryUpdatePeriod)
+	_, err = suite.handler(ctx, msg)
+	require.ErrorIs(t, err, mkeeper.ErrProviderDraining)
+}
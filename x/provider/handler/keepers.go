@@ -0,0 +1,18 @@
+package handler
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dtypes "github.com/akash-network/akash-api/go/node/deployment/v1beta3"
+	etypes "github.com/akash-network/akash-api/go/node/escrow/v1beta3"
+)
+
+// EscrowKeeper Interface includes escrow methods
+type EscrowKeeper interface {
+	PaymentClose(ctx sdk.Context, id etypes.AccountID, pid string) error
+}
+
+// DeploymentKeeper Interface includes deployment methods
+type DeploymentKeeper interface {
+	OnLeaseClosed(ctx sdk.Context, id dtypes.GroupID) (dtypes.Group, error)
+}
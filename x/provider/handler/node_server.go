@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	mkeeper "github.com/akash-network/node/x/market/keeper"
+	"github.com/akash-network/node/x/provider/keeper"
+	pv1beta4 "github.com/akash-network/node/x/provider/types/v1beta4"
+)
+
+var _ pv1beta4.MsgServer = nodeMsgServer{}
+
+// nodeMsgServer serves provider messages defined by node on top of akash-api ones
+type nodeMsgServer struct {
+	msgServer
+}
+
+// NewNodeServer returns an implementation of the node provider MsgServer interface
+// for the provided Keeper.
+func NewNodeServer(k keeper.IKeeper, mk mkeeper.IKeeper, dk DeploymentKeeper, ek EscrowKeeper) pv1beta4.MsgServer {
+	return &nodeMsgServer{
+		msgServer: msgServer{provider: k, market: mk, deployment: dk, escrow: ek},
+	}
+}
+
+func (ms nodeMsgServer) UpdateProvider(goCtx context.Context, msg *pv1beta4.MsgUpdateProvider) (*pv1beta4.MsgUpdateProviderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	record := msg.Record()
+	if err := ms.updateProvider(ctx, &record, msg.CloseLeases); err != nil {
+		return nil, err
+	}
+
+	return &pv1beta4.MsgUpdateProviderResponse{}, nil
+}
@@ -16,17 +16,21 @@ import (
 var (
 	// ErrInternal defines registered error code for internal error
 	ErrInternal = sdkerrors.Register(types.ModuleName, 10, "internal error")
+	// ErrLeasesUnmatched defines registered error code for provider update breaking requirements of its active leases
+	ErrLeasesUnmatched = sdkerrors.Register(types.ModuleName, 11, "attributes do not match requirements of active leases")
 )
 
 type msgServer struct {
-	provider keeper.IKeeper
-	market   mkeeper.IKeeper
+	provider   keeper.IKeeper
+	market     mkeeper.IKeeper
+	deployment DeploymentKeeper
+	escrow     EscrowKeeper
 }
 
 // NewMsgServerImpl returns an implementation of the market MsgServer interface
 // for the provided Keeper.
-func NewMsgServerImpl(k keeper.IKeeper, mk mkeeper.IKeeper) types.MsgServer {
-	return &msgServer{provider: k, market: mk}
+func NewMsgServerImpl(k keeper.IKeeper, mk mkeeper.IKeeper, dk DeploymentKeeper, ek EscrowKeeper) types.MsgServer {
+	return &msgServer{provider: k, market: mk, deployment: dk, escrow: ek}
 }
 
 var _ types.MsgServer = msgServer{}
@@ -54,21 +58,12 @@ func (ms msgServer) CreateProvider(goCtx context.Context, msg *types.MsgCreatePr
 func (ms msgServer) UpdateProvider(goCtx context.Context, msg *types.MsgUpdateProvider) (*types.MsgUpdateProviderResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	err := msg.ValidateBasic()
-	if err != nil {
+	// akash-api MsgUpdateProvider has no close leases flag, updates breaking
+	// active leases are rejected. pv1beta4.MsgUpdateProvider allows closing them.
+	if err := ms.updateProvider(ctx, msg, false); err != nil {
 		return nil, err
 	}
 
-	owner, _ := sdk.AccAddressFromBech32(msg.Owner)
-	_, found := ms.provider.Get(ctx, owner)
-	if !found {
-		return nil, fmt.Errorf("%w: id: %s", types.ErrProviderNotFound, msg.Owner)
-	}
-
-	if err := ms.provider.Update(ctx, types.Provider(*msg)); err != nil {
-		return nil, sdkerrors.Wrapf(ErrInternal, "err: %v", err)
-	}
-
 	return &types.MsgUpdateProviderResponse{}, nil
 }
 
@@ -84,6 +79,11 @@ func (ms msgServer) DeleteProvider(goCtx context.Context, msg *types.MsgDeletePr
 		return nil, types.ErrProviderNotFound
 	}
 
-	// TODO: cancel leases
-	return nil, sdkerrors.Wrapf(ErrInternal, "NOTIMPLEMENTED")
+	// provider stops bidding now, leases are closed and provider is removed
+	// by the market EndBlock once the grace period ends
+	if _, err := ms.market.OnProviderDraining(ctx, owner); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDeleteProviderResponse{}, nil
 }
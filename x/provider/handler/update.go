@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	dtypes "github.com/akash-network/akash-api/go/node/deployment/v1beta3"
+	mtypes "github.com/akash-network/akash-api/go/node/market/v1beta4"
+	types "github.com/akash-network/akash-api/go/node/provider/v1beta3"
+)
+
+// updateProvider replaces provider record with the one in msg. Active leases of the provider
+// which orders matched previous attributes but do not match new ones are either closed,
+// when closeLeases is set, or the update is rejected with ErrLeasesUnmatched listing them.
+func (ms msgServer) updateProvider(ctx sdk.Context, msg *types.MsgUpdateProvider, closeLeases bool) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	owner, _ := sdk.AccAddressFromBech32(msg.Owner)
+	prov, found := ms.provider.Get(ctx, owner)
+	if !found {
+		return fmt.Errorf("%w: id: %s", types.ErrProviderNotFound, msg.Owner)
+	}
+
+	leases := ms.market.LeasesUnmatchedByAttributes(ctx, owner, prov.Attributes, msg.Attributes)
+
+	if len(leases) > 0 && !closeLeases {
+		ids := make([]string, 0, len(leases))
+		for _, lease := range leases {
+			ids = append(ids, lease.ID().String())
+		}
+
+		return sdkerrors.Wrapf(ErrLeasesUnmatched, "leases: %s", strings.Join(ids, ", "))
+	}
+
+	for _, lease := range leases {
+		if err := ms.closeUnmatchedLease(ctx, lease); err != nil {
+			return sdkerrors.Wrapf(ErrInternal, "close lease %s: %v", lease.ID(), err)
+		}
+	}
+
+	if err := ms.provider.Update(ctx, types.Provider(*msg)); err != nil {
+		return sdkerrors.Wrapf(ErrInternal, "err: %v", err)
+	}
+
+	return nil
+}
+
+// closeUnmatchedLease closes lease along with its bid, order and escrow payment, and creates
+// new order for the group when it stays open so tenant gets bids from other providers.
+func (ms msgServer) closeUnmatchedLease(ctx sdk.Context, lease mtypes.Lease) error {
+	order, found := ms.market.GetOrder(ctx, lease.ID().OrderID())
+	if !found {
+		return mtypes.ErrOrderNotFound
+	}
+
+	bid, found := ms.market.GetBid(ctx, lease.ID().BidID())
+	if !found {
+		return mtypes.ErrBidNotFound
+	}
+
+	ms.market.OnLeaseClosedAttributesChanged(ctx, lease)
+	ms.market.OnBidClosed(ctx, bid)
+	ms.market.OnOrderClosed(ctx, order)
+
+	if err := ms.escrow.PaymentClose(ctx,
+		dtypes.EscrowAccountForDeployment(lease.ID().DeploymentID()),
+		mtypes.EscrowPaymentForLease(lease.ID()),
+	); err != nil {
+		return err
+	}
+
+	group, err := ms.deployment.OnLeaseClosed(ctx, lease.ID().GroupID())
+	if err != nil {
+		return err
+	}
+
+	if group.State != dtypes.GroupOpen {
+		return nil
+	}
+
+	_, err = ms.market.CreateOrder(ctx, group.ID(), group.GroupSpec)
+	return err
+}
@@ -0,0 +1,106 @@
+package handler_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	dtypes "github.com/akash-network/akash-api/go/node/deployment/v1beta3"
+	mtypes "github.com/akash-network/akash-api/go/node/market/v1beta4"
+	types "github.com/akash-network/akash-api/go/node/provider/v1beta3"
+	akashtypes "github.com/akash-network/akash-api/go/node/types/v1beta3"
+
+	"github.com/akash-network/node/testutil"
+	"github.com/akash-network/node/x/provider/handler"
+	pv1beta4 "github.com/akash-network/node/x/provider/types/v1beta4"
+)
+
+// createLease creates active lease of given provider for an order requiring given attributes
+func (st *testSuite) createLease(provider sdk.AccAddress, attr akashtypes.Attributes) mtypes.LeaseID {
+	st.t.Helper()
+
+	deployment := testutil.Deployment(st.t)
+	group := testutil.DeploymentGroup(st.t, deployment.ID(), 0)
+	group.GroupSpec.Resources = testutil.Resources(st.t)
+	group.GroupSpec.Requirements = akashtypes.PlacementRequirements{Attributes: attr}
+
+	err := st.state.DeploymentKeeper().Create(st.ctx, deployment, []dtypes.Group{group})
+	require.NoError(st.t, err)
+
+	owner := sdk.MustAccAddressFromBech32(deployment.ID().Owner)
+	err = st.state.EscrowKeeper().AccountCreate(st.ctx, dtypes.EscrowAccountForDeployment(deployment.ID()), owner, owner,
+		sdk.NewInt64Coin(testutil.CoinDenom, 1000))
+	require.NoError(st.t, err)
+
+	order, err := st.mkeeper.CreateOrder(st.ctx, group.ID(), group.GroupSpec)
+	require.NoError(st.t, err)
+
+	bid, err := st.mkeeper.CreateBid(st.ctx, order.ID(), provider, sdk.NewInt64DecCoin(testutil.CoinDenom, 1),
+		mtypes.ResourceOfferFromRU(group.GroupSpec.Resources))
+	require.NoError(st.t, err)
+
+	err = st.state.EscrowKeeper().PaymentCreate(st.ctx, dtypes.EscrowAccountForDeployment(deployment.ID()),
+		mtypes.EscrowPaymentForLease(bid.ID().LeaseID()), provider, bid.Price)
+	require.NoError(st.t, err)
+
+	st.mkeeper.CreateLease(st.ctx, bid)
+	st.mkeeper.OnOrderMatched(st.ctx, order)
+	st.mkeeper.OnBidMatched(st.ctx, bid)
+
+	return bid.ID().LeaseID()
+}
+
+func TestProviderUpdateUnmatchedLeases(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	addr := testutil.AccAddress(t)
+	west := akashtypes.Attributes{{Key: "region", Value: "us-west"}}
+
+	err := suite.keeper.Create(suite.ctx, types.Provider{
+		Owner:      addr.String(),
+		HostURI:    testutil.ProviderHostname(t),
+		Attributes: west,
+	})
+	require.NoError(t, err)
+
+	lid := suite.createLease(addr, west)
+
+	// attributes leases do not depend on may change
+	_, err = suite.handler(suite.ctx, &types.MsgUpdateProvider{
+		Owner:      addr.String(),
+		HostURI:    testutil.ProviderHostname(t),
+		Attributes: append(akashtypes.Attributes{{Key: "tier", Value: "community"}}, west...),
+	})
+	require.NoError(t, err)
+
+	east := &types.MsgUpdateProvider{
+		Owner:      addr.String(),
+		HostURI:    testutil.ProviderHostname(t),
+		Attributes: akashtypes.Attributes{{Key: "region", Value: "us-east"}},
+	}
+
+	_, err = suite.handler(suite.ctx, east)
+	require.ErrorIs(t, err, handler.ErrLeasesUnmatched)
+	require.ErrorContains(t, err, lid.String())
+
+	prov, _ := suite.keeper.Get(suite.ctx, addr)
+	require.Len(t, prov.Attributes, 2)
+
+	_, err = suite.handler(suite.ctx, pv1beta4.NewMsgUpdateProvider(addr, east.HostURI, east.Attributes, east.Info, true))
+	require.NoError(t, err)
+
+	prov, _ = suite.keeper.Get(suite.ctx, addr)
+	require.Equal(t, east.Attributes, prov.Attributes)
+
+	lease, _ := suite.mkeeper.GetLease(suite.ctx, lid)
+	require.Equal(t, mtypes.LeaseClosed, lease.State)
+
+	group, found := suite.state.DeploymentKeeper().GetGroup(suite.ctx, lid.GroupID())
+	require.True(t, found)
+	require.Equal(t, dtypes.GroupOpen, group.State)
+
+	reopened, found := suite.mkeeper.GetOrder(suite.ctx, mtypes.MakeOrderID(lid.GroupID(), 2))
+	require.True(t, found)
+	require.Equal(t, mtypes.OrderOpen, reopened.State)
+}
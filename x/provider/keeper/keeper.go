@@ -120,7 +120,19 @@ func (k Keeper) Update(ctx sdk.Context, provider types.Provider) error {
 	return nil
 }
 
-// Delete delete a provider
+// Delete deletes a provider. Leases, bids and audited attributes of the provider
+// are left to the caller.
 func (k Keeper) Delete(ctx sdk.Context, id sdk.Address) {
-	panic("TODO")
+	store := ctx.KVStore(k.skey)
+	key := providerKey(id)
+
+	if !store.Has(key) {
+		return
+	}
+
+	store.Delete(key)
+
+	ctx.EventManager().EmitEvent(
+		types.EventProviderDeleted{Owner: sdk.AccAddress(id.Bytes())}.ToSDKEvent(),
+	)
 }
@@ -32,6 +32,7 @@ import (
 	"github.com/akash-network/node/x/provider/handler"
 	"github.com/akash-network/node/x/provider/keeper"
 	"github.com/akash-network/node/x/provider/simulation"
+	pv1beta4 "github.com/akash-network/node/x/provider/types/v1beta4"
 )
 
 var (
@@ -53,11 +54,13 @@ func (AppModuleBasic) Name() string {
 // RegisterLegacyAminoCodec registers the provider module's types for the given codec.
 func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	types.RegisterLegacyAminoCodec(cdc)
+	pv1beta4.RegisterLegacyAminoCodec(cdc)
 }
 
 // RegisterInterfaces registers the module's interface types
 func (b AppModuleBasic) RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	types.RegisterInterfaces(registry)
+	pv1beta4.RegisterInterfaces(registry)
 	v1beta2types.RegisterInterfaces(registry)
 	v1beta1types.RegisterInterfaces(registry)
 }
@@ -112,16 +115,20 @@ type AppModule struct {
 	keeper  keeper.IKeeper
 	bkeeper bankkeeper.Keeper
 	mkeeper mkeeper.IKeeper
+	dkeeper handler.DeploymentKeeper
+	ekeeper handler.EscrowKeeper
 }
 
 // NewAppModule creates a new AppModule object
 func NewAppModule(cdc codec.Codec, k keeper.IKeeper, bkeeper bankkeeper.Keeper,
-	mkeeper mkeeper.IKeeper) AppModule {
+	mkeeper mkeeper.IKeeper, dkeeper handler.DeploymentKeeper, ekeeper handler.EscrowKeeper) AppModule {
 	return AppModule{
 		AppModuleBasic: AppModuleBasic{cdc: cdc},
 		keeper:         k,
 		bkeeper:        bkeeper,
 		mkeeper:        mkeeper,
+		dkeeper:        dkeeper,
+		ekeeper:        ekeeper,
 	}
 }
 
@@ -135,7 +142,7 @@ func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {}
 
 // Route returns the message routing key for the provider module.
 func (am AppModule) Route() sdk.Route {
-	return sdk.NewRoute(types.RouterKey, handler.NewHandler(am.keeper, am.mkeeper))
+	return sdk.NewRoute(types.RouterKey, handler.NewHandler(am.keeper, am.mkeeper, am.dkeeper, am.ekeeper))
 }
 
 // QuerierRoute returns the provider module's querier route name.
@@ -150,7 +157,8 @@ func (am AppModule) LegacyQuerierHandler(_ *codec.LegacyAmino) sdk.Querier {
 
 // RegisterServices registers the module's services
 func (am AppModule) RegisterServices(cfg module.Configurator) {
-	types.RegisterMsgServer(cfg.MsgServer(), handler.NewMsgServerImpl(am.keeper, am.mkeeper))
+	types.RegisterMsgServer(cfg.MsgServer(), handler.NewMsgServerImpl(am.keeper, am.mkeeper, am.dkeeper, am.ekeeper))
+	pv1beta4.RegisterMsgServer(cfg.MsgServer(), handler.NewNodeServer(am.keeper, am.mkeeper, am.dkeeper, am.ekeeper))
 	querier := am.keeper.NewQuerier()
 	types.RegisterQueryServer(cfg.QueryServer(), querier)
 
@@ -0,0 +1,649 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: akash/provider/v1beta4/inventorymsg.proto
+
+package v1beta4
+
+import (
+	fmt "fmt"
+	v1beta5 "github.com/akash-network/node/x/market/types/v1beta5"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgUpdateInventory publishes free capacity of a registered provider, replacing the one it reported last.
+type MsgUpdateInventory struct {
+	Owner   string                     `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner" yaml:"owner"`
+	CPU     uint64                     `protobuf:"varint,2,opt,name=cpu,proto3" json:"cpu" yaml:"cpu"`
+	Memory  uint64                     `protobuf:"varint,3,opt,name=memory,proto3" json:"memory" yaml:"memory"`
+	GPU     []v1beta5.InventoryGPU     `protobuf:"bytes,4,rep,name=gpu,proto3" json:"gpu" yaml:"gpu"`
+	Storage []v1beta5.InventoryStorage `protobuf:"bytes,5,rep,name=storage,proto3" json:"storage" yaml:"storage"`
+}
+
+func (m *MsgUpdateInventory) Reset()         { *m = MsgUpdateInventory{} }
+func (m *MsgUpdateInventory) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateInventory) ProtoMessage()    {}
+func (*MsgUpdateInventory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2fcff3dc4e916e0, []int{0}
+}
+func (m *MsgUpdateInventory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateInventory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateInventory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateInventory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateInventory.Merge(m, src)
+}
+func (m *MsgUpdateInventory) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateInventory) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateInventory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateInventory proto.InternalMessageInfo
+
+func (m *MsgUpdateInventory) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgUpdateInventory) GetCPU() uint64 {
+	if m != nil {
+		return m.CPU
+	}
+	return 0
+}
+
+func (m *MsgUpdateInventory) GetMemory() uint64 {
+	if m != nil {
+		return m.Memory
+	}
+	return 0
+}
+
+func (m *MsgUpdateInventory) GetGPU() []v1beta5.InventoryGPU {
+	if m != nil {
+		return m.GPU
+	}
+	return nil
+}
+
+func (m *MsgUpdateInventory) GetStorage() []v1beta5.InventoryStorage {
+	if m != nil {
+		return m.Storage
+	}
+	return nil
+}
+
+// MsgUpdateInventoryResponse defines the Msg/UpdateInventory response type.
+type MsgUpdateInventoryResponse struct {
+}
+
+func (m *MsgUpdateInventoryResponse) Reset()         { *m = MsgUpdateInventoryResponse{} }
+func (m *MsgUpdateInventoryResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateInventoryResponse) ProtoMessage()    {}
+func (*MsgUpdateInventoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2fcff3dc4e916e0, []int{1}
+}
+func (m *MsgUpdateInventoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateInventoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateInventoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateInventoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateInventoryResponse.Merge(m, src)
+}
+func (m *MsgUpdateInventoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateInventoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateInventoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateInventoryResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateInventory)(nil), "akash.provider.v1beta4.MsgUpdateInventory")
+	proto.RegisterType((*MsgUpdateInventoryResponse)(nil), "akash.provider.v1beta4.MsgUpdateInventoryResponse")
+}
+
+func init() {
+	proto.RegisterFile("akash/provider/v1beta4/inventorymsg.proto", fileDescriptor_e2fcff3dc4e916e0)
+}
+
+var fileDescriptor_e2fcff3dc4e916e0 = []byte{
+	// 408 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xbf, 0xae, 0xd3, 0x30,
+	0x14, 0xc6, 0x93, 0x9b, 0xde, 0x8b, 0x30, 0x7f, 0x86, 0x08, 0xa1, 0x50, 0xae, 0xe2, 0x60, 0x21,
+	0x54, 0x06, 0x62, 0xc1, 0xe5, 0x32, 0xdc, 0x31, 0x0c, 0x15, 0x03, 0x52, 0x15, 0x54, 0x06, 0xb6,
+	0x34, 0xb5, 0xdc, 0xaa, 0x24, 0xb6, 0x1c, 0xa7, 0x25, 0x6f, 0xc1, 0x23, 0xf0, 0x38, 0x1d, 0x3b,
+	0x32, 0x59, 0x28, 0x5d, 0x50, 0x24, 0x96, 0x3c, 0x01, 0x8a, 0x9d, 0x50, 0x24, 0x06, 0xb6, 0xf8,
+	0x3b, 0xbf, 0xf3, 0xe9, 0x3b, 0x27, 0x07, 0x3c, 0x4f, 0x36, 0x49, 0xb1, 0xc2, 0x5c, 0xb0, 0xed,
+	0x7a, 0x49, 0x04, 0xde, 0xbe, 0x5c, 0x10, 0x99, 0xbc, 0xc6, 0xeb, 0x7c, 0x4b, 0x72, 0xc9, 0x44,
+	0x95, 0x15, 0x34, 0xe4, 0x82, 0x49, 0xe6, 0x3e, 0xd4, 0x68, 0x38, 0xa0, 0x61, 0x8f, 0x8e, 0x1f,
+	0x50, 0x46, 0x99, 0x46, 0x70, 0xf7, 0x65, 0xe8, 0xf1, 0x53, 0x63, 0x9c, 0x25, 0x62, 0x43, 0x64,
+	0x6f, 0x7b, 0x7d, 0xb2, 0x35, 0x14, 0xfa, 0x75, 0x06, 0xdc, 0xf7, 0x05, 0x9d, 0xf3, 0x65, 0x22,
+	0xc9, 0xbb, 0xa1, 0xe8, 0x62, 0x70, 0xce, 0x76, 0x39, 0x11, 0x9e, 0x1d, 0xd8, 0x93, 0xdb, 0xd1,
+	0xa3, 0x46, 0x41, 0x23, 0xb4, 0x0a, 0xde, 0xad, 0x92, 0xec, 0xf3, 0x0d, 0xd2, 0x4f, 0x14, 0x1b,
+	0xd9, 0x0d, 0x81, 0x93, 0xf2, 0xd2, 0x3b, 0x0b, 0xec, 0xc9, 0x28, 0xba, 0xac, 0x15, 0x74, 0xde,
+	0xce, 0xe6, 0x8d, 0x82, 0x9d, 0xda, 0x2a, 0x08, 0x4c, 0x4f, 0xca, 0x4b, 0x14, 0x77, 0x92, 0x7b,
+	0x05, 0x2e, 0x32, 0x92, 0x31, 0x51, 0x79, 0x8e, 0x6e, 0x79, 0xdc, 0x28, 0xd8, 0x2b, 0xad, 0x82,
+	0xf7, 0x0c, 0x6e, 0xde, 0x28, 0xee, 0x0b, 0xee, 0x47, 0xe0, 0x50, 0x5e, 0x7a, 0xa3, 0xc0, 0x99,
+	0xdc, 0x79, 0x85, 0x42, 0xb3, 0x0e, 0x33, 0x60, 0xbf, 0x8c, 0xeb, 0xf0, 0xcf, 0x0c, 0xd3, 0xd9,
+	0x3c, 0x0a, 0xf6, 0x0a, 0x5a, 0x5d, 0x98, 0xa9, 0x09, 0x43, 0xff, 0x0e, 0x43, 0x75, 0x18, 0xca,
+	0x4b, 0x37, 0x05, 0xb7, 0x0a, 0xc9, 0x44, 0x42, 0x89, 0x77, 0xae, 0xbd, 0x9f, 0xfd, 0xc7, 0xfb,
+	0x83, 0xa1, 0xa3, 0x27, 0x9d, 0x7f, 0xa3, 0xe0, 0xd0, 0xde, 0x2a, 0x78, 0xdf, 0x98, 0xf7, 0x02,
+	0x8a, 0x87, 0xd2, 0xcd, 0xe8, 0xe7, 0x37, 0x68, 0xa1, 0x4b, 0x30, 0xfe, 0x77, 0xdd, 0x31, 0x29,
+	0x38, 0xcb, 0x0b, 0x12, 0xcd, 0xf6, 0xb5, 0x6f, 0x1f, 0x6a, 0xdf, 0xfe, 0x51, 0xfb, 0xf6, 0xd7,
+	0xa3, 0x6f, 0x1d, 0x8e, 0xbe, 0xf5, 0xfd, 0xe8, 0x5b, 0x9f, 0xde, 0xd0, 0xb5, 0x5c, 0x95, 0x8b,
+	0x30, 0x65, 0x19, 0xd6, 0xd9, 0x5e, 0xe4, 0x44, 0xee, 0x98, 0xd8, 0xe0, 0x9c, 0x2d, 0x09, 0xfe,
+	0x72, 0x3a, 0x20, 0x59, 0x71, 0x52, 0x0c, 0x67, 0xb4, 0xb8, 0xd0, 0xbf, 0xf9, 0xea, 0xf7, 0x00,
+	0x07, 0xc9, 0xa8, 0x97, 0x67, 0x02, 0x00, 0x00,
+}
+
+func (m *MsgUpdateInventory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateInventory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateInventory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Storage) > 0 {
+		for iNdEx := len(m.Storage) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Storage[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInventorymsg(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.GPU) > 0 {
+		for iNdEx := len(m.GPU) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GPU[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInventorymsg(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Memory != 0 {
+		i = encodeVarintInventorymsg(dAtA, i, uint64(m.Memory))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CPU != 0 {
+		i = encodeVarintInventorymsg(dAtA, i, uint64(m.CPU))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintInventorymsg(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateInventoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateInventoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateInventoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintInventorymsg(dAtA []byte, offset int, v uint64) int {
+	offset -= sovInventorymsg(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateInventory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovInventorymsg(uint64(l))
+	}
+	if m.CPU != 0 {
+		n += 1 + sovInventorymsg(uint64(m.CPU))
+	}
+	if m.Memory != 0 {
+		n += 1 + sovInventorymsg(uint64(m.Memory))
+	}
+	if len(m.GPU) > 0 {
+		for _, e := range m.GPU {
+			l = e.Size()
+			n += 1 + l + sovInventorymsg(uint64(l))
+		}
+	}
+	if len(m.Storage) > 0 {
+		for _, e := range m.Storage {
+			l = e.Size()
+			n += 1 + l + sovInventorymsg(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateInventoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovInventorymsg(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozInventorymsg(x uint64) (n int) {
+	return sovInventorymsg(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateInventory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInventorymsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateInventory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateInventory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInventorymsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInventorymsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInventorymsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CPU", wireType)
+			}
+			m.CPU = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInventorymsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CPU |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
+			}
+			m.Memory = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInventorymsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Memory |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GPU", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInventorymsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInventorymsg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInventorymsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GPU = append(m.GPU, v1beta5.InventoryGPU{})
+			if err := m.GPU[len(m.GPU)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInventorymsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInventorymsg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInventorymsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Storage = append(m.Storage, v1beta5.InventoryStorage{})
+			if err := m.Storage[len(m.Storage)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInventorymsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInventorymsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateInventoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInventorymsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateInventoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateInventoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInventorymsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInventorymsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipInventorymsg(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowInventorymsg
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInventorymsg
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInventorymsg
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthInventorymsg
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupInventorymsg
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthInventorymsg
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthInventorymsg        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowInventorymsg          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupInventorymsg = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,13 @@
+package v1beta4
+
+import (
+	v1beta3 "github.com/akash-network/akash-api/go/node/provider/v1beta3"
+)
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = v1beta3.ModuleName
+
+	// RouterKey is the message route for provider
+	RouterKey = v1beta3.RouterKey
+)
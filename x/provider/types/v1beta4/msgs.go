@@ -0,0 +1,116 @@
+package v1beta4
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v1beta3 "github.com/akash-network/akash-api/go/node/provider/v1beta3"
+	types "github.com/akash-network/akash-api/go/node/types/v1beta3"
+
+	mv1beta5 "github.com/akash-network/node/x/market/types/v1beta5"
+)
+
+const (
+	MsgTypeUpdateProvider  = "update-provider"
+	MsgTypeUpdateInventory = "update-inventory"
+)
+
+var (
+	_ sdk.Msg = &MsgUpdateProvider{}
+	_ sdk.Msg = &MsgUpdateInventory{}
+)
+
+// NewMsgUpdateProvider creates a new MsgUpdateProvider instance
+func NewMsgUpdateProvider(owner sdk.AccAddress, hostURI string, attributes types.Attributes, info v1beta3.ProviderInfo, closeLeases bool) *MsgUpdateProvider {
+	return &MsgUpdateProvider{
+		Owner:       owner.String(),
+		HostURI:     hostURI,
+		Attributes:  attributes,
+		Info:        info,
+		CloseLeases: closeLeases,
+	}
+}
+
+// Record returns provider record update carried by the message
+func (msg MsgUpdateProvider) Record() v1beta3.MsgUpdateProvider {
+	return v1beta3.MsgUpdateProvider{
+		Owner:      msg.Owner,
+		HostURI:    msg.HostURI,
+		Attributes: msg.Attributes,
+		Info:       msg.Info,
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgUpdateProvider) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface
+func (msg MsgUpdateProvider) Type() string { return MsgTypeUpdateProvider }
+
+// GetSignBytes encodes the message for signing
+func (msg MsgUpdateProvider) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgUpdateProvider) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{owner}
+}
+
+// ValidateBasic does basic validation of provider record
+func (msg MsgUpdateProvider) ValidateBasic() error {
+	record := msg.Record()
+	return record.ValidateBasic()
+}
+
+// NewMsgUpdateInventory creates a new MsgUpdateInventory instance
+func NewMsgUpdateInventory(owner sdk.AccAddress, cpu, memory uint64, gpu []mv1beta5.InventoryGPU, storage []mv1beta5.InventoryStorage) *MsgUpdateInventory {
+	return &MsgUpdateInventory{
+		Owner:   owner.String(),
+		CPU:     cpu,
+		Memory:  memory,
+		GPU:     gpu,
+		Storage: storage,
+	}
+}
+
+// Inventory returns provider inventory carried by the message
+func (msg MsgUpdateInventory) Inventory() mv1beta5.ProviderInventory {
+	return mv1beta5.ProviderInventory{
+		Provider: msg.Owner,
+		CPU:      msg.CPU,
+		Memory:   msg.Memory,
+		GPU:      msg.GPU,
+		Storage:  msg.Storage,
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgUpdateInventory) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface
+func (msg MsgUpdateInventory) Type() string { return MsgTypeUpdateInventory }
+
+// GetSignBytes encodes the message for signing
+func (msg MsgUpdateInventory) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgUpdateInventory) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{owner}
+}
+
+// ValidateBasic does basic validation of provider inventory
+func (msg MsgUpdateInventory) ValidateBasic() error {
+	return msg.Inventory().ValidateBasic()
+}
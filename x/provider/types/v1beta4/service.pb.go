@@ -0,0 +1,169 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: akash/provider/v1beta4/service.proto
+
+package v1beta4
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+func init() {
+	proto.RegisterFile("akash/provider/v1beta4/service.proto", fileDescriptor_3b4eb524c9b29aec)
+}
+
+var fileDescriptor_3b4eb524c9b29aec = []byte{
+	// 236 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x49, 0xcc, 0x4e, 0x2c,
+	0xce, 0xd0, 0x2f, 0x28, 0xca, 0x2f, 0xcb, 0x4c, 0x49, 0x2d, 0xd2, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
+	0x49, 0x34, 0xd1, 0x2f, 0x4e, 0x2d, 0x2a, 0xcb, 0x4c, 0x4e, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
+	0x17, 0x12, 0x03, 0xab, 0xd2, 0x83, 0xa9, 0xd2, 0x83, 0xaa, 0x92, 0xd2, 0xc4, 0xa1, 0x3b, 0x33,
+	0xaf, 0x2c, 0x35, 0xaf, 0x24, 0xbf, 0xa8, 0x32, 0xb7, 0x38, 0x1d, 0x62, 0x84, 0x94, 0x1a, 0x0e,
+	0xa5, 0xa5, 0x05, 0x29, 0x89, 0x25, 0xa9, 0x70, 0x75, 0x46, 0x2f, 0x18, 0xb9, 0x98, 0x7d, 0x8b,
+	0xd3, 0x85, 0xf2, 0xb8, 0xf8, 0x42, 0xc1, 0x52, 0x01, 0x50, 0x1d, 0x42, 0x9a, 0x7a, 0xd8, 0x5d,
+	0xa1, 0xe7, 0x5b, 0x9c, 0x8e, 0xaa, 0x54, 0xca, 0x90, 0x68, 0xa5, 0x41, 0xa9, 0xc5, 0x05, 0xf9,
+	0x79, 0xc5, 0xa9, 0x42, 0x85, 0x5c, 0xfc, 0x10, 0x19, 0x4f, 0x98, 0xdb, 0x85, 0xb4, 0x08, 0x9a,
+	0x02, 0x57, 0x2b, 0x65, 0x44, 0xbc, 0x5a, 0x98, 0x95, 0x4e, 0x01, 0x27, 0x1e, 0xc9, 0x31, 0x5e,
+	0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31,
+	0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65, 0x96, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f,
+	0xab, 0x0f, 0x36, 0x57, 0x37, 0x2f, 0xb5, 0xa4, 0x3c, 0xbf, 0x28, 0x5b, 0x3f, 0x2f, 0x3f, 0x25,
+	0x55, 0xbf, 0x02, 0x11, 0x8c, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0xb0, 0xc0, 0x4c, 0x62, 0x03, 0x87,
+	0xa1, 0x31, 0x60, 0x00, 0x4c, 0x24, 0xa9, 0x47, 0xd6, 0x01, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateProvider updates provider record, optionally closing leases it no longer matches.
+	UpdateProvider(ctx context.Context, in *MsgUpdateProvider, opts ...grpc.CallOption) (*MsgUpdateProviderResponse, error)
+	// UpdateInventory publishes free capacity of a registered provider.
+	UpdateInventory(ctx context.Context, in *MsgUpdateInventory, opts ...grpc.CallOption) (*MsgUpdateInventoryResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateProvider(ctx context.Context, in *MsgUpdateProvider, opts ...grpc.CallOption) (*MsgUpdateProviderResponse, error) {
+	out := new(MsgUpdateProviderResponse)
+	err := c.cc.Invoke(ctx, "/akash.provider.v1beta4.Msg/UpdateProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateInventory(ctx context.Context, in *MsgUpdateInventory, opts ...grpc.CallOption) (*MsgUpdateInventoryResponse, error) {
+	out := new(MsgUpdateInventoryResponse)
+	err := c.cc.Invoke(ctx, "/akash.provider.v1beta4.Msg/UpdateInventory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateProvider updates provider record, optionally closing leases it no longer matches.
+	UpdateProvider(context.Context, *MsgUpdateProvider) (*MsgUpdateProviderResponse, error)
+	// UpdateInventory publishes free capacity of a registered provider.
+	UpdateInventory(context.Context, *MsgUpdateInventory) (*MsgUpdateInventoryResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateProvider(ctx context.Context, req *MsgUpdateProvider) (*MsgUpdateProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProvider not implemented")
+}
+func (*UnimplementedMsgServer) UpdateInventory(ctx context.Context, req *MsgUpdateInventory) (*MsgUpdateInventoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateInventory not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateProvider)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/akash.provider.v1beta4.Msg/UpdateProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateProvider(ctx, req.(*MsgUpdateProvider))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateInventory)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateInventory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/akash.provider.v1beta4.Msg/UpdateInventory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateInventory(ctx, req.(*MsgUpdateInventory))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "akash.provider.v1beta4.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateProvider",
+			Handler:    _Msg_UpdateProvider_Handler,
+		},
+		{
+			MethodName: "UpdateInventory",
+			Handler:    _Msg_UpdateInventory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "akash/provider/v1beta4/service.proto",
+}
@@ -0,0 +1,669 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: akash/provider/v1beta4/updatemsg.proto
+
+package v1beta4
+
+import (
+	fmt "fmt"
+	v1beta31 "github.com/akash-network/akash-api/go/node/provider/v1beta3"
+	github_com_akash_network_akash_api_go_node_types_v1beta3 "github.com/akash-network/akash-api/go/node/types/v1beta3"
+	v1beta3 "github.com/akash-network/akash-api/go/node/types/v1beta3"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgUpdateProvider updates provider record. Active leases which requirements new attributes do not
+// match any more are closed when close_leases is set, otherwise the update is rejected.
+type MsgUpdateProvider struct {
+	Owner       string                                                              `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner" yaml:"owner"`
+	HostURI     string                                                              `protobuf:"bytes,2,opt,name=host_uri,json=hostUri,proto3" json:"host_uri" yaml:"host_uri"`
+	Attributes  github_com_akash_network_akash_api_go_node_types_v1beta3.Attributes `protobuf:"bytes,3,rep,name=attributes,proto3,castrepeated=github.com/akash-network/akash-api/go/node/types/v1beta3.Attributes" json:"attributes" yaml:"attributes"`
+	Info        v1beta31.ProviderInfo                                               `protobuf:"bytes,4,opt,name=info,proto3" json:"info" yaml:"info"`
+	CloseLeases bool                                                                `protobuf:"varint,5,opt,name=close_leases,json=closeLeases,proto3" json:"close_leases" yaml:"close_leases"`
+}
+
+func (m *MsgUpdateProvider) Reset()         { *m = MsgUpdateProvider{} }
+func (m *MsgUpdateProvider) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateProvider) ProtoMessage()    {}
+func (*MsgUpdateProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7bb11ffc54990b8d, []int{0}
+}
+func (m *MsgUpdateProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateProvider.Merge(m, src)
+}
+func (m *MsgUpdateProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateProvider proto.InternalMessageInfo
+
+func (m *MsgUpdateProvider) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgUpdateProvider) GetHostURI() string {
+	if m != nil {
+		return m.HostURI
+	}
+	return ""
+}
+
+func (m *MsgUpdateProvider) GetAttributes() github_com_akash_network_akash_api_go_node_types_v1beta3.Attributes {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+func (m *MsgUpdateProvider) GetInfo() v1beta31.ProviderInfo {
+	if m != nil {
+		return m.Info
+	}
+	return v1beta31.ProviderInfo{}
+}
+
+func (m *MsgUpdateProvider) GetCloseLeases() bool {
+	if m != nil {
+		return m.CloseLeases
+	}
+	return false
+}
+
+// MsgUpdateProviderResponse defines the Msg/UpdateProvider response type.
+type MsgUpdateProviderResponse struct {
+}
+
+func (m *MsgUpdateProviderResponse) Reset()         { *m = MsgUpdateProviderResponse{} }
+func (m *MsgUpdateProviderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateProviderResponse) ProtoMessage()    {}
+func (*MsgUpdateProviderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7bb11ffc54990b8d, []int{1}
+}
+func (m *MsgUpdateProviderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateProviderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateProviderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateProviderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateProviderResponse.Merge(m, src)
+}
+func (m *MsgUpdateProviderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateProviderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateProviderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateProviderResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateProvider)(nil), "akash.provider.v1beta4.MsgUpdateProvider")
+	proto.RegisterType((*MsgUpdateProviderResponse)(nil), "akash.provider.v1beta4.MsgUpdateProviderResponse")
+}
+
+func init() {
+	proto.RegisterFile("akash/provider/v1beta4/updatemsg.proto", fileDescriptor_7bb11ffc54990b8d)
+}
+
+var fileDescriptor_7bb11ffc54990b8d = []byte{
+	// 465 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0xcf, 0x6f, 0xd3, 0x30,
+	0x14, 0x4e, 0x68, 0xc7, 0x86, 0x3b, 0x09, 0x2d, 0x20, 0x94, 0x6d, 0x22, 0xae, 0x2c, 0x7e, 0x54,
+	0x48, 0xc4, 0x62, 0x99, 0x38, 0xec, 0x46, 0x10, 0x12, 0x43, 0x20, 0x4d, 0x91, 0x7a, 0xe1, 0x32,
+	0x39, 0xab, 0x97, 0x46, 0x6b, 0xf3, 0x22, 0xdb, 0xdd, 0xd8, 0x7f, 0xc1, 0x9f, 0xc0, 0x19, 0x89,
+	0xff, 0x63, 0xc7, 0x1d, 0x39, 0x19, 0xd4, 0x72, 0x40, 0x3d, 0xe6, 0x2f, 0x40, 0xb5, 0xd3, 0xae,
+	0x68, 0xdb, 0xcd, 0xdf, 0xe7, 0xef, 0xbd, 0xef, 0xf9, 0xf3, 0x43, 0xcf, 0xd8, 0x09, 0x93, 0x7d,
+	0x5a, 0x0a, 0x38, 0xcd, 0x7b, 0x5c, 0xd0, 0xd3, 0x57, 0x29, 0x57, 0x6c, 0x97, 0x8e, 0xca, 0x1e,
+	0x53, 0x7c, 0x28, 0xb3, 0xb0, 0x14, 0xa0, 0xc0, 0x7b, 0x64, 0x74, 0xe1, 0x5c, 0x17, 0xd6, 0xba,
+	0xad, 0x87, 0x19, 0x64, 0x60, 0x24, 0x74, 0x76, 0xb2, 0xea, 0x2d, 0x62, 0xbb, 0xa6, 0x4c, 0xf2,
+	0xba, 0x63, 0x44, 0x99, 0x52, 0x22, 0x4f, 0x47, 0x8a, 0xd7, 0x9a, 0xa7, 0x37, 0x3a, 0x47, 0x0b,
+	0xc2, 0xca, 0xc8, 0x9f, 0x06, 0xda, 0xf8, 0x24, 0xb3, 0xae, 0x99, 0xe7, 0xa0, 0xbe, 0xf3, 0x28,
+	0x5a, 0x81, 0xb3, 0x82, 0x0b, 0xdf, 0x6d, 0xbb, 0x9d, 0x7b, 0xf1, 0xe6, 0x54, 0x63, 0x4b, 0x54,
+	0x1a, 0xaf, 0x9f, 0xb3, 0xe1, 0x60, 0x8f, 0x18, 0x48, 0x12, 0x4b, 0x7b, 0xef, 0xd0, 0x5a, 0x1f,
+	0xa4, 0x3a, 0x1c, 0x89, 0xdc, 0xbf, 0x63, 0x6a, 0x5e, 0x8c, 0x35, 0x5e, 0x7d, 0x0f, 0x52, 0x75,
+	0x93, 0xfd, 0xa9, 0xc6, 0x8b, 0xeb, 0x4a, 0xe3, 0xfb, 0xb6, 0xc3, 0x9c, 0x21, 0xc9, 0xea, 0xec,
+	0xd8, 0x15, 0xb9, 0xf7, 0xc3, 0x45, 0x68, 0xf1, 0x10, 0xe9, 0x37, 0xda, 0x8d, 0x4e, 0x6b, 0xe7,
+	0x71, 0x68, 0xc3, 0x99, 0x3d, 0xb7, 0x0e, 0x26, 0x0a, 0xdf, 0xcc, 0x55, 0x71, 0x71, 0xa1, 0xb1,
+	0x33, 0xd5, 0x78, 0xa9, 0xb0, 0xd2, 0x78, 0xc3, 0x7a, 0x5c, 0x71, 0xe4, 0xfb, 0x2f, 0xfc, 0x36,
+	0xcb, 0x55, 0x7f, 0x94, 0x86, 0x47, 0x30, 0xa4, 0xa6, 0xe7, 0xcb, 0x82, 0xab, 0x33, 0x10, 0x27,
+	0x35, 0x62, 0x65, 0x4e, 0x33, 0xa0, 0x05, 0xf4, 0x38, 0x55, 0xe7, 0x25, 0x97, 0xf4, 0x9a, 0x9d,
+	0x4c, 0x96, 0x7c, 0xbc, 0x2e, 0x6a, 0xe6, 0xc5, 0x31, 0xf8, 0xcd, 0xb6, 0xdb, 0x69, 0xed, 0x3c,
+	0x09, 0x6f, 0xfc, 0xc5, 0x28, 0x9c, 0xe7, 0xba, 0x5f, 0x1c, 0x43, 0xbc, 0x5d, 0xcf, 0x6b, 0x2a,
+	0x2b, 0x8d, 0x5b, 0x76, 0xd2, 0x19, 0x22, 0x89, 0x21, 0xbd, 0x0f, 0x68, 0xfd, 0x68, 0x00, 0x92,
+	0x1f, 0x0e, 0x38, 0x93, 0x5c, 0xfa, 0x2b, 0x6d, 0xb7, 0xb3, 0x16, 0x3f, 0x9f, 0x6a, 0xfc, 0x1f,
+	0x5f, 0x69, 0xfc, 0xc0, 0x16, 0x2f, 0xb3, 0x24, 0x69, 0x19, 0xf8, 0xd1, 0xa0, 0xbd, 0xe6, 0xdf,
+	0x6f, 0xd8, 0x21, 0xdb, 0x68, 0xf3, 0xda, 0x2f, 0x27, 0x5c, 0x96, 0x50, 0x48, 0x1e, 0x1f, 0x5c,
+	0x8c, 0x03, 0xf7, 0x72, 0x1c, 0xb8, 0xbf, 0xc7, 0x81, 0xfb, 0x75, 0x12, 0x38, 0x97, 0x93, 0xc0,
+	0xf9, 0x39, 0x09, 0x9c, 0xcf, 0xaf, 0x6f, 0x0d, 0xcc, 0x64, 0xf4, 0xe5, 0x6a, 0xbd, 0x96, 0xe3,
+	0xda, 0x4d, 0xef, 0x9a, 0xe5, 0x8a, 0xfe, 0x0d, 0x00, 0x6c, 0x4c, 0xda, 0x25, 0xff, 0x02, 0x00,
+	0x00,
+}
+
+func (m *MsgUpdateProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CloseLeases {
+		i--
+		if m.CloseLeases {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUpdatemsg(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Attributes) > 0 {
+		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintUpdatemsg(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.HostURI) > 0 {
+		i -= len(m.HostURI)
+		copy(dAtA[i:], m.HostURI)
+		i = encodeVarintUpdatemsg(dAtA, i, uint64(len(m.HostURI)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintUpdatemsg(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintUpdatemsg(dAtA []byte, offset int, v uint64) int {
+	offset -= sovUpdatemsg(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovUpdatemsg(uint64(l))
+	}
+	l = len(m.HostURI)
+	if l > 0 {
+		n += 1 + l + sovUpdatemsg(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.Size()
+			n += 1 + l + sovUpdatemsg(uint64(l))
+		}
+	}
+	l = m.Info.Size()
+	n += 1 + l + sovUpdatemsg(uint64(l))
+	if m.CloseLeases {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgUpdateProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovUpdatemsg(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozUpdatemsg(x uint64) (n int) {
+	return sovUpdatemsg(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpdatemsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpdatemsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpdatemsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpdatemsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostURI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpdatemsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpdatemsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpdatemsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostURI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpdatemsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUpdatemsg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpdatemsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes, v1beta3.Attribute{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpdatemsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUpdatemsg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpdatemsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseLeases", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpdatemsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CloseLeases = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpdatemsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpdatemsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpdatemsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpdatemsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpdatemsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipUpdatemsg(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowUpdatemsg
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowUpdatemsg
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowUpdatemsg
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthUpdatemsg
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupUpdatemsg
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthUpdatemsg
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthUpdatemsg        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowUpdatemsg          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupUpdatemsg = fmt.Errorf("proto: unexpected end of group")
+)